@@ -12,6 +12,8 @@ import (
 	config "server/internal/config"
 	logging "server/internal/logging"
 	"server/internal/service"
+	"server/internal/service/notification"
+	"server/internal/service/task"
 	"server/internal/storage"
 	"server/internal/storage/postgresql"
 
@@ -100,6 +102,15 @@ func main() {
 		Handler: mux,
 	}
 
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	reminderScheduler := notification.NewReminderScheduler(storages.Notification, config.Reminders.Interval)
+	go reminderScheduler.Run(schedulerCtx, &logger)
+	logger.Info("Reminder scheduler started")
+
+	recurrenceGenerator := task.NewRecurrenceGenerator(storages.Task, storages.History, config.Recurrence.Interval)
+	go recurrenceGenerator.Run(schedulerCtx, &logger)
+	logger.Info("Recurrence generator started")
+
 	logger.Info("Server is running")
 
 	idleConnsClosed := make(chan struct{})
@@ -108,6 +119,8 @@ func main() {
 		signal.Notify(sigint, os.Interrupt)
 		<-sigint
 
+		stopScheduler()
+
 		// We received an interrupt signal, shut down.
 		if err := server.Shutdown(context.Background()); err != nil {
 			// Error from closing listeners, or context timeout:
@@ -0,0 +1,24 @@
+ALTER TABLE public.edit_history
+    ADD CONSTRAINT edit_history_pkey PRIMARY KEY (id),
+    ADD COLUMN entity_type text NOT NULL DEFAULT 'board',
+    ADD COLUMN id_entity integer NOT NULL DEFAULT 0,
+    ADD COLUMN action text NOT NULL DEFAULT 'update',
+    ADD COLUMN changes jsonb NOT NULL DEFAULT '[]'::jsonb;
+
+CREATE INDEX IF NOT EXISTS edit_history_board_date_idx
+    ON public.edit_history (id_board, edit_date);
+
+CREATE INDEX IF NOT EXISTS edit_history_entity_idx
+    ON public.edit_history (entity_type, id_entity);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS public.edit_history_entity_idx;
+DROP INDEX IF EXISTS public.edit_history_board_date_idx;
+
+ALTER TABLE public.edit_history
+    DROP CONSTRAINT IF EXISTS edit_history_pkey,
+    DROP COLUMN IF EXISTS entity_type,
+    DROP COLUMN IF EXISTS id_entity,
+    DROP COLUMN IF EXISTS action,
+    DROP COLUMN IF EXISTS changes;
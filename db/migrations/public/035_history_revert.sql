@@ -0,0 +1,12 @@
+ALTER TABLE public.edit_history
+    ADD COLUMN id_reverted integer,
+    ADD CONSTRAINT edit_history_id_reverted_fkey FOREIGN KEY (id_reverted)
+        REFERENCES public.edit_history (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE SET NULL;
+
+---- create above / drop below ----
+
+ALTER TABLE public.edit_history
+    DROP CONSTRAINT IF EXISTS edit_history_id_reverted_fkey,
+    DROP COLUMN IF EXISTS id_reverted;
@@ -0,0 +1,9 @@
+ALTER TABLE public.list
+    ADD COLUMN wip_limit integer CHECK (wip_limit > 0),
+    ADD COLUMN wip_mode text NOT NULL DEFAULT 'soft' CHECK (wip_mode IN ('soft', 'hard'));
+
+---- create above / drop below ----
+
+ALTER TABLE public.list
+    DROP COLUMN IF EXISTS wip_limit,
+    DROP COLUMN IF EXISTS wip_mode;
@@ -0,0 +1,43 @@
+CREATE TABLE IF NOT EXISTS public.custom_field
+(
+    id serial NOT NULL,
+    id_board integer NOT NULL,
+    name text NOT NULL,
+    field_type text NOT NULL,
+    options text[] NOT NULL DEFAULT '{}',
+    list_position integer NOT NULL DEFAULT 0,
+    CONSTRAINT custom_field_pkey PRIMARY KEY (id),
+    CONSTRAINT custom_field_id_board_fkey FOREIGN KEY (id_board)
+        REFERENCES public.board (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE,
+    CONSTRAINT custom_field_name_length_check CHECK (length(name) <= 50),
+    CONSTRAINT custom_field_type_check CHECK (field_type IN ('text', 'number', 'date', 'checkbox', 'single_select', 'multi_select'))
+);
+
+CREATE INDEX IF NOT EXISTS custom_field_board_idx
+    ON public.custom_field (id_board, list_position);
+
+CREATE TABLE IF NOT EXISTS public.task_custom_field_value
+(
+    id_task integer NOT NULL,
+    id_field integer NOT NULL,
+    value jsonb NOT NULL,
+    CONSTRAINT task_custom_field_value_pkey PRIMARY KEY (id_task, id_field),
+    CONSTRAINT task_custom_field_value_id_task_fkey FOREIGN KEY (id_task)
+        REFERENCES public.task (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE,
+    CONSTRAINT task_custom_field_value_id_field_fkey FOREIGN KEY (id_field)
+        REFERENCES public.custom_field (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS task_custom_field_value_field_idx
+    ON public.task_custom_field_value (id_field);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS public.task_custom_field_value;
+DROP TABLE IF EXISTS public.custom_field;
@@ -0,0 +1,17 @@
+ALTER TABLE public.list
+    ADD COLUMN is_done boolean NOT NULL DEFAULT false;
+
+ALTER TABLE public.task
+    ADD COLUMN date_completed timestamp without time zone;
+
+CREATE INDEX IF NOT EXISTS task_date_completed_idx ON public.task (date_completed);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS public.task_date_completed_idx;
+
+ALTER TABLE public.task
+    DROP COLUMN IF EXISTS date_completed;
+
+ALTER TABLE public.list
+    DROP COLUMN IF EXISTS is_done;
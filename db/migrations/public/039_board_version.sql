@@ -0,0 +1,18 @@
+ALTER TABLE public.board
+    ADD COLUMN version bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE public.edit_history
+    ADD COLUMN board_version bigint;
+
+CREATE INDEX IF NOT EXISTS edit_history_board_version_idx
+    ON public.edit_history (id_board, board_version);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS public.edit_history_board_version_idx;
+
+ALTER TABLE public.edit_history
+    DROP COLUMN IF EXISTS board_version;
+
+ALTER TABLE public.board
+    DROP COLUMN IF EXISTS version;
@@ -0,0 +1,19 @@
+ALTER TABLE public.board
+    ADD COLUMN row_version bigint NOT NULL DEFAULT 1;
+
+ALTER TABLE public.list
+    ADD COLUMN row_version bigint NOT NULL DEFAULT 1;
+
+ALTER TABLE public.task
+    ADD COLUMN row_version bigint NOT NULL DEFAULT 1;
+
+---- create above / drop below ----
+
+ALTER TABLE public.task
+    DROP COLUMN IF EXISTS row_version;
+
+ALTER TABLE public.list
+    DROP COLUMN IF EXISTS row_version;
+
+ALTER TABLE public.board
+    DROP COLUMN IF EXISTS row_version;
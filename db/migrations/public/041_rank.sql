@@ -0,0 +1,49 @@
+ALTER TABLE public.list
+    ADD COLUMN rank text COLLATE "C" NOT NULL DEFAULT 'i';
+
+ALTER TABLE public.task
+    ADD COLUMN rank text COLLATE "C" NOT NULL DEFAULT 'i';
+
+ALTER TABLE public.checklist_item
+    ADD COLUMN rank text COLLATE "C" NOT NULL DEFAULT 'i';
+
+-- ключи строятся из прежнего порядка: шестнадцатеричный номер фиксированной длины с ненулевым окончанием
+UPDATE public.list SET rank = ranked.rank
+FROM (
+    SELECT id, lpad(to_hex(row_number() OVER (PARTITION BY id_board ORDER BY list_position, id)), 8, '0') || 'i' AS rank
+    FROM public.list
+) AS ranked
+WHERE ranked.id = public.list.id;
+
+UPDATE public.task SET rank = ranked.rank
+FROM (
+    SELECT id, lpad(to_hex(row_number() OVER (PARTITION BY id_list ORDER BY list_position, id)), 8, '0') || 'i' AS rank
+    FROM public.task
+) AS ranked
+WHERE ranked.id = public.task.id;
+
+UPDATE public.checklist_item SET rank = ranked.rank
+FROM (
+    SELECT id, lpad(to_hex(row_number() OVER (PARTITION BY id_checklist ORDER BY list_position, id)), 8, '0') || 'i' AS rank
+    FROM public.checklist_item
+) AS ranked
+WHERE ranked.id = public.checklist_item.id;
+
+CREATE INDEX IF NOT EXISTS list_rank_idx ON public.list (id_board, rank);
+CREATE INDEX IF NOT EXISTS task_rank_idx ON public.task (id_list, rank);
+CREATE INDEX IF NOT EXISTS checklist_item_rank_idx ON public.checklist_item (id_checklist, rank);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS public.checklist_item_rank_idx;
+DROP INDEX IF EXISTS public.task_rank_idx;
+DROP INDEX IF EXISTS public.list_rank_idx;
+
+ALTER TABLE public.checklist_item
+    DROP COLUMN IF EXISTS rank;
+
+ALTER TABLE public.task
+    DROP COLUMN IF EXISTS rank;
+
+ALTER TABLE public.list
+    DROP COLUMN IF EXISTS rank;
@@ -0,0 +1,20 @@
+ALTER TABLE public.list
+    ADD COLUMN date_archived timestamp without time zone;
+
+ALTER TABLE public.task
+    ADD COLUMN date_archived timestamp without time zone;
+
+-- доска и списки строятся только из неархивных строк
+CREATE INDEX IF NOT EXISTS list_active_idx ON public.list (id_board) WHERE date_archived IS NULL;
+CREATE INDEX IF NOT EXISTS task_active_idx ON public.task (id_list) WHERE date_archived IS NULL;
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS public.task_active_idx;
+DROP INDEX IF EXISTS public.list_active_idx;
+
+ALTER TABLE public.task
+    DROP COLUMN IF EXISTS date_archived;
+
+ALTER TABLE public.list
+    DROP COLUMN IF EXISTS date_archived;
@@ -0,0 +1,20 @@
+-- сроки заданий хранятся как моменты времени в UTC и показываются в часовом поясе пользователя
+ALTER TABLE public.task
+    ALTER COLUMN task_start TYPE timestamp with time zone USING task_start AT TIME ZONE 'UTC',
+    ALTER COLUMN task_end TYPE timestamp with time zone USING task_end AT TIME ZONE 'UTC',
+    ADD COLUMN all_day boolean NOT NULL DEFAULT false,
+    ADD CONSTRAINT task_schedule_order CHECK (task_start IS NULL OR task_end IS NULL OR task_start <= task_end) NOT VALID;
+
+ALTER TABLE public."user"
+    ADD COLUMN timezone text NOT NULL DEFAULT 'UTC';
+
+---- create above / drop below ----
+
+ALTER TABLE public."user"
+    DROP COLUMN IF EXISTS timezone;
+
+ALTER TABLE public.task
+    DROP CONSTRAINT IF EXISTS task_schedule_order,
+    DROP COLUMN IF EXISTS all_day,
+    ALTER COLUMN task_start TYPE timestamp without time zone USING task_start AT TIME ZONE 'UTC',
+    ALTER COLUMN task_end TYPE timestamp without time zone USING task_end AT TIME ZONE 'UTC';
@@ -0,0 +1,57 @@
+-- смещения напоминаний задаются в минутах до срока задания;
+-- у заданий без своих смещений используются смещения исполнителя по умолчанию
+CREATE TABLE IF NOT EXISTS public.task_reminder
+(
+    id_task integer NOT NULL,
+    offset_minutes integer NOT NULL,
+    CONSTRAINT task_reminder_pkey PRIMARY KEY (id_task, offset_minutes),
+    CONSTRAINT task_reminder_id_task_fkey FOREIGN KEY (id_task)
+        REFERENCES public.task (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE,
+    CONSTRAINT task_reminder_offset_check CHECK (offset_minutes > 0)
+);
+
+ALTER TABLE public."user"
+    ADD COLUMN reminder_offsets integer[] NOT NULL DEFAULT '{1440}';
+
+CREATE TABLE IF NOT EXISTS public.notification
+(
+    id serial NOT NULL,
+    id_user integer NOT NULL,
+    id_task integer,
+    kind text NOT NULL,
+    due_at timestamp with time zone,
+    offset_minutes integer,
+    date_created timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    date_read timestamp with time zone,
+    CONSTRAINT notification_pkey PRIMARY KEY (id),
+    CONSTRAINT notification_id_user_fkey FOREIGN KEY (id_user)
+        REFERENCES public."user" (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE,
+    CONSTRAINT notification_id_task_fkey FOREIGN KEY (id_task)
+        REFERENCES public.task (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS notification_user_idx
+    ON public.notification (id_user, date_created DESC);
+
+-- одно напоминание на исполнителя, срок и смещение: повторные проходы планировщика
+-- на любом из экземпляров бэкенда не создают дублей
+CREATE UNIQUE INDEX IF NOT EXISTS notification_due_reminder_once
+    ON public.notification (id_user, id_task, due_at, offset_minutes)
+    WHERE kind = 'due_reminder';
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS public.notification_due_reminder_once;
+DROP INDEX IF EXISTS public.notification_user_idx;
+DROP TABLE IF EXISTS public.notification;
+
+ALTER TABLE public."user"
+    DROP COLUMN IF EXISTS reminder_offsets;
+
+DROP TABLE IF EXISTS public.task_reminder;
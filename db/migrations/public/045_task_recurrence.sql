@@ -0,0 +1,52 @@
+-- серия повторяющихся заданий: правило повторения (подмножество RRULE), часовой пояс, в котором считаются сроки,
+-- текущее задание серии и его срок по правилу. Следующее задание создаётся, когда текущее выполнено
+-- или наступил его срок; удаление текущего задания завершает серию. Задания серии записываются в историю
+-- от имени пользователя, последним задавшего правило
+CREATE TABLE IF NOT EXISTS public.task_recurrence
+(
+    id serial NOT NULL,
+    id_task integer NOT NULL,
+    id_list integer NOT NULL,
+    id_user integer NOT NULL,
+    rule text NOT NULL,
+    timezone text NOT NULL DEFAULT 'UTC',
+    due_at timestamp with time zone NOT NULL,
+    date_created timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    date_stopped timestamp with time zone,
+    CONSTRAINT task_recurrence_pkey PRIMARY KEY (id),
+    CONSTRAINT task_recurrence_id_task_key UNIQUE (id_task),
+    CONSTRAINT task_recurrence_id_task_fkey FOREIGN KEY (id_task)
+        REFERENCES public.task (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE,
+    CONSTRAINT task_recurrence_id_list_fkey FOREIGN KEY (id_list)
+        REFERENCES public.list (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE,
+    CONSTRAINT task_recurrence_id_user_fkey FOREIGN KEY (id_user)
+        REFERENCES public.user (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE
+);
+
+-- серия, к которой относится задание; у прежних заданий серии ссылка остаётся
+ALTER TABLE public.task
+    ADD COLUMN id_recurrence integer,
+    ADD CONSTRAINT task_id_recurrence_fkey FOREIGN KEY (id_recurrence)
+        REFERENCES public.task_recurrence (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS task_recurrence_active_idx
+    ON public.task_recurrence (due_at)
+    WHERE date_stopped IS NULL;
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS public.task_recurrence_active_idx;
+
+ALTER TABLE public.task
+    DROP CONSTRAINT IF EXISTS task_id_recurrence_fkey,
+    DROP COLUMN IF EXISTS id_recurrence;
+
+DROP TABLE IF EXISTS public.task_recurrence;
@@ -0,0 +1,33 @@
+-- зависимость между заданиями: id_blocker блокирует id_blocked, пока не выполнено.
+-- Связанные задания могут лежать на разных досках одного рабочего пространства
+CREATE TABLE IF NOT EXISTS public.task_dependency
+(
+    id_blocker integer NOT NULL,
+    id_blocked integer NOT NULL,
+    date_created timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT task_dependency_pkey PRIMARY KEY (id_blocker, id_blocked),
+    CONSTRAINT task_dependency_not_self CHECK (id_blocker <> id_blocked),
+    CONSTRAINT task_dependency_id_blocker_fkey FOREIGN KEY (id_blocker)
+        REFERENCES public.task (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE,
+    CONSTRAINT task_dependency_id_blocked_fkey FOREIGN KEY (id_blocked)
+        REFERENCES public.task (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS task_dependency_id_blocked_idx ON public.task_dependency (id_blocked);
+
+-- в завершающий список с этим флагом нельзя перенести задание, у которого есть невыполненные блокирующие задания
+ALTER TABLE public.list
+    ADD COLUMN require_unblocked boolean NOT NULL DEFAULT false;
+
+---- create above / drop below ----
+
+ALTER TABLE public.list
+    DROP COLUMN IF EXISTS require_unblocked;
+
+DROP INDEX IF EXISTS public.task_dependency_id_blocked_idx;
+
+DROP TABLE IF EXISTS public.task_dependency;
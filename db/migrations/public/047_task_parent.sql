@@ -0,0 +1,20 @@
+-- родительское задание: подзадачи лежат в любых списках той же доски, что и родитель.
+-- При удалении родителя подзадачи остаются без него, если не выбрано каскадное удаление
+ALTER TABLE public.task
+    ADD COLUMN id_parent integer,
+    ADD CONSTRAINT task_id_parent_fkey FOREIGN KEY (id_parent)
+        REFERENCES public.task (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE SET NULL,
+    ADD CONSTRAINT task_parent_not_self CHECK (id_parent <> id);
+
+CREATE INDEX IF NOT EXISTS task_id_parent_idx ON public.task (id_parent);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS public.task_id_parent_idx;
+
+ALTER TABLE public.task
+    DROP CONSTRAINT IF EXISTS task_parent_not_self,
+    DROP CONSTRAINT IF EXISTS task_id_parent_fkey,
+    DROP COLUMN IF EXISTS id_parent;
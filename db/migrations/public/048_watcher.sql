@@ -0,0 +1,56 @@
+-- наблюдатели задания и доски получают уведомления об изменениях;
+-- исполнители, упомянутые в комментариях и комментаторы подписываются на задание автоматически
+CREATE TABLE IF NOT EXISTS public.task_watcher
+(
+    id_task integer NOT NULL,
+    id_user integer NOT NULL,
+    date_created timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT task_watcher_pkey PRIMARY KEY (id_task, id_user),
+    CONSTRAINT task_watcher_id_task_fkey FOREIGN KEY (id_task)
+        REFERENCES public.task (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE,
+    CONSTRAINT task_watcher_id_user_fkey FOREIGN KEY (id_user)
+        REFERENCES public."user" (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS public.board_watcher
+(
+    id_board integer NOT NULL,
+    id_user integer NOT NULL,
+    date_created timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT board_watcher_pkey PRIMARY KEY (id_board, id_user),
+    CONSTRAINT board_watcher_id_board_fkey FOREIGN KEY (id_board)
+        REFERENCES public.board (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE,
+    CONSTRAINT board_watcher_id_user_fkey FOREIGN KEY (id_user)
+        REFERENCES public."user" (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE
+);
+
+-- текущие исполнители становятся наблюдателями своих заданий
+INSERT INTO public.task_watcher (id_task, id_user)
+SELECT id_task, id_user FROM public.task_user
+ON CONFLICT DO NOTHING;
+
+-- уведомление об изменении ссылается на запись истории с подробностями изменения
+ALTER TABLE public.notification
+    ADD COLUMN id_history integer,
+    ADD CONSTRAINT notification_id_history_fkey FOREIGN KEY (id_history)
+        REFERENCES public.edit_history (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE;
+
+---- create above / drop below ----
+
+ALTER TABLE public.notification
+    DROP CONSTRAINT IF EXISTS notification_id_history_fkey,
+    DROP COLUMN IF EXISTS id_history;
+
+DROP TABLE IF EXISTS public.board_watcher;
+
+DROP TABLE IF EXISTS public.task_watcher;
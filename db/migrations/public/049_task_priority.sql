@@ -0,0 +1,14 @@
+-- приоритет задания и оценка трудозатрат в условных единицах; без оценки -- NULL
+ALTER TABLE public.task
+    ADD COLUMN priority text NOT NULL DEFAULT 'none',
+    ADD COLUMN estimate numeric(10, 2),
+    ADD CONSTRAINT task_priority_check CHECK (priority IN ('none', 'low', 'medium', 'high', 'urgent')),
+    ADD CONSTRAINT task_estimate_check CHECK (estimate >= 0);
+
+---- create above / drop below ----
+
+ALTER TABLE public.task
+    DROP CONSTRAINT IF EXISTS task_estimate_check,
+    DROP CONSTRAINT IF EXISTS task_priority_check,
+    DROP COLUMN IF EXISTS estimate,
+    DROP COLUMN IF EXISTS priority;
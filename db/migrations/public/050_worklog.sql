@@ -0,0 +1,34 @@
+-- учёт времени по заданиям: запись с пустым date_stopped -- запущенный таймер, у пользователя он не больше одного;
+-- записи, внесённые вручную, сразу сохраняются с началом и окончанием
+CREATE TABLE IF NOT EXISTS public.worklog
+(
+    id serial NOT NULL,
+    id_task integer NOT NULL,
+    id_user integer NOT NULL,
+    date_started timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    date_stopped timestamp with time zone,
+    note text,
+    date_created timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT worklog_pkey PRIMARY KEY (id),
+    CONSTRAINT worklog_id_task_fkey FOREIGN KEY (id_task)
+        REFERENCES public.task (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE,
+    CONSTRAINT worklog_id_user_fkey FOREIGN KEY (id_user)
+        REFERENCES public."user" (id) MATCH SIMPLE
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE,
+    CONSTRAINT worklog_dates_check CHECK (date_stopped IS NULL OR date_stopped >= date_started)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS worklog_running_timer_idx ON public.worklog (id_user) WHERE date_stopped IS NULL;
+CREATE INDEX IF NOT EXISTS worklog_id_task_idx ON public.worklog (id_task);
+CREATE INDEX IF NOT EXISTS worklog_date_started_idx ON public.worklog (date_started);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS public.worklog_date_started_idx;
+DROP INDEX IF EXISTS public.worklog_id_task_idx;
+DROP INDEX IF EXISTS public.worklog_running_timer_idx;
+
+DROP TABLE IF EXISTS public.worklog;
@@ -0,0 +1,57 @@
+-- задание, к которому относится запись истории: по нему собирается лента активности задания.
+-- Внешнего ключа нет, чтобы записи об удалённых заданиях оставались в истории доски
+ALTER TABLE public.edit_history
+    ADD COLUMN id_task integer;
+
+UPDATE public.edit_history
+SET id_task = id_entity
+WHERE entity_type = 'task' AND id_entity <> 0;
+
+-- комментарии и чек-листы: задание берётся из строки сущности, а для удалённых -- из записанных изменений
+UPDATE public.edit_history
+SET id_task = COALESCE(
+    (SELECT id_task FROM public.comment WHERE public.comment.id = public.edit_history.id_entity),
+    (SELECT (change->>'before')::integer FROM jsonb_array_elements(public.edit_history.changes) AS change
+        WHERE change->>'field' = 'id_task' AND change->>'before' IS NOT NULL LIMIT 1)
+)
+WHERE entity_type = 'comment';
+
+UPDATE public.edit_history
+SET id_task = COALESCE(
+    (SELECT id_task FROM public.checklist WHERE public.checklist.id = public.edit_history.id_entity),
+    (SELECT (change->>'before')::integer FROM jsonb_array_elements(public.edit_history.changes) AS change
+        WHERE change->>'field' = 'id_task' AND change->>'before' IS NOT NULL LIMIT 1)
+)
+WHERE entity_type = 'checklist';
+
+-- пункты чек-листов: задание берётся из чек-листа, а если удалён и он -- из уже заполненных выше
+-- записей истории самого чек-листа (в том числе записи о его удалении)
+UPDATE public.edit_history
+SET id_task = COALESCE(
+    (SELECT id_task FROM public.checklist WHERE public.checklist.id = item.id_checklist),
+    (SELECT checklist_entry.id_task FROM public.edit_history AS checklist_entry
+        WHERE checklist_entry.entity_type = 'checklist' AND checklist_entry.id_entity = item.id_checklist
+            AND checklist_entry.id_task IS NOT NULL
+        ORDER BY checklist_entry.edit_date DESC LIMIT 1)
+)
+FROM (
+    SELECT entry.id, COALESCE(
+        (SELECT id_checklist FROM public.checklist_item WHERE public.checklist_item.id = entry.id_entity),
+        (SELECT (change->>'before')::integer FROM jsonb_array_elements(entry.changes) AS change
+            WHERE change->>'field' = 'id_checklist' AND change->>'before' IS NOT NULL LIMIT 1)
+    ) AS id_checklist
+    FROM public.edit_history AS entry
+    WHERE entry.entity_type = 'checklist_item'
+) AS item
+WHERE public.edit_history.id = item.id;
+
+CREATE INDEX IF NOT EXISTS edit_history_task_idx
+    ON public.edit_history (id_task, edit_date)
+    WHERE id_task IS NOT NULL;
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS public.edit_history_task_idx;
+
+ALTER TABLE public.edit_history
+    DROP COLUMN IF EXISTS id_task;
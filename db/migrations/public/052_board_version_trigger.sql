@@ -0,0 +1,117 @@
+-- версию доски увеличивают только эти триггеры, в той же транзакции, что и изменение её содержимого;
+-- запись истории об изменении делается в этой же транзакции и сохраняет увеличенную версию.
+-- kind -- к чему относится parent_id: board, list, task, checklist или tag (тэг может быть на нескольких досках)
+CREATE OR REPLACE FUNCTION public.bump_board_version(kind text, parent_id bigint) RETURNS void AS $$
+BEGIN
+    IF parent_id IS NULL THEN
+        RETURN;
+    END IF;
+
+    UPDATE public.board
+    SET version = version + 1
+    WHERE id IN (
+        SELECT parent_id WHERE kind = 'board'
+        UNION
+        SELECT id_board FROM public.list WHERE kind = 'list' AND id = parent_id
+        UNION
+        SELECT public.list.id_board FROM public.task
+            JOIN public.list ON public.list.id = public.task.id_list
+            WHERE kind = 'task' AND public.task.id = parent_id
+        UNION
+        SELECT public.list.id_board FROM public.checklist
+            JOIN public.task ON public.task.id = public.checklist.id_task
+            JOIN public.list ON public.list.id = public.task.id_list
+            WHERE kind = 'checklist' AND public.checklist.id = parent_id
+        UNION
+        SELECT id_board FROM public.tag_board WHERE kind = 'tag' AND id_tag = parent_id
+    );
+END;
+$$ LANGUAGE plpgsql;
+
+-- аргументы триггера: вид родителя и колонка строки с его id. При переносе строки
+-- увеличиваются версии и старой, и новой доски. Строки, удалённые каскадом вместе с доской
+-- или списком, доску уже не находят -- её версию увеличивает удаление родителя
+CREATE OR REPLACE FUNCTION public.bump_board_version_on_change() RETURNS trigger AS $$
+DECLARE
+    old_parent bigint;
+    new_parent bigint;
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        old_parent := (to_jsonb(OLD) ->> TG_ARGV[1])::bigint;
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        new_parent := (to_jsonb(NEW) ->> TG_ARGV[1])::bigint;
+    END IF;
+
+    PERFORM public.bump_board_version(TG_ARGV[0], new_parent);
+    IF old_parent IS DISTINCT FROM new_parent THEN
+        PERFORM public.bump_board_version(TG_ARGV[0], old_parent);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- изменение самой доски увеличивает её версию, если запрос не поменял её явно
+CREATE OR REPLACE FUNCTION public.bump_board_version_on_update() RETURNS trigger AS $$
+BEGIN
+    IF NEW.version = OLD.version THEN
+        NEW.version := OLD.version + 1;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER board_version BEFORE UPDATE ON public.board
+    FOR EACH ROW EXECUTE FUNCTION public.bump_board_version_on_update();
+
+CREATE TRIGGER board_version AFTER INSERT OR UPDATE OR DELETE ON public.list
+    FOR EACH ROW EXECUTE FUNCTION public.bump_board_version_on_change('board', 'id_board');
+CREATE TRIGGER board_version AFTER INSERT OR UPDATE OR DELETE ON public.board_user
+    FOR EACH ROW EXECUTE FUNCTION public.bump_board_version_on_change('board', 'id_board');
+CREATE TRIGGER board_version AFTER INSERT OR UPDATE OR DELETE ON public.tag_board
+    FOR EACH ROW EXECUTE FUNCTION public.bump_board_version_on_change('board', 'id_board');
+CREATE TRIGGER board_version AFTER INSERT OR UPDATE OR DELETE ON public.custom_field
+    FOR EACH ROW EXECUTE FUNCTION public.bump_board_version_on_change('board', 'id_board');
+
+CREATE TRIGGER board_version AFTER UPDATE ON public.tag
+    FOR EACH ROW EXECUTE FUNCTION public.bump_board_version_on_change('tag', 'id');
+
+CREATE TRIGGER board_version AFTER INSERT OR UPDATE OR DELETE ON public.task
+    FOR EACH ROW EXECUTE FUNCTION public.bump_board_version_on_change('list', 'id_list');
+
+CREATE TRIGGER board_version AFTER INSERT OR UPDATE OR DELETE ON public.checklist
+    FOR EACH ROW EXECUTE FUNCTION public.bump_board_version_on_change('task', 'id_task');
+CREATE TRIGGER board_version AFTER INSERT OR UPDATE OR DELETE ON public.comment
+    FOR EACH ROW EXECUTE FUNCTION public.bump_board_version_on_change('task', 'id_task');
+CREATE TRIGGER board_version AFTER INSERT OR UPDATE OR DELETE ON public.task_user
+    FOR EACH ROW EXECUTE FUNCTION public.bump_board_version_on_change('task', 'id_task');
+CREATE TRIGGER board_version AFTER INSERT OR UPDATE OR DELETE ON public.tag_task
+    FOR EACH ROW EXECUTE FUNCTION public.bump_board_version_on_change('task', 'id_task');
+CREATE TRIGGER board_version AFTER INSERT OR UPDATE OR DELETE ON public.task_file
+    FOR EACH ROW EXECUTE FUNCTION public.bump_board_version_on_change('task', 'id_task');
+CREATE TRIGGER board_version AFTER INSERT OR UPDATE OR DELETE ON public.task_custom_field_value
+    FOR EACH ROW EXECUTE FUNCTION public.bump_board_version_on_change('task', 'id_task');
+
+CREATE TRIGGER board_version AFTER INSERT OR UPDATE OR DELETE ON public.checklist_item
+    FOR EACH ROW EXECUTE FUNCTION public.bump_board_version_on_change('checklist', 'id_checklist');
+
+---- create above / drop below ----
+
+DROP TRIGGER IF EXISTS board_version ON public.checklist_item;
+DROP TRIGGER IF EXISTS board_version ON public.task_custom_field_value;
+DROP TRIGGER IF EXISTS board_version ON public.task_file;
+DROP TRIGGER IF EXISTS board_version ON public.tag_task;
+DROP TRIGGER IF EXISTS board_version ON public.task_user;
+DROP TRIGGER IF EXISTS board_version ON public.comment;
+DROP TRIGGER IF EXISTS board_version ON public.checklist;
+DROP TRIGGER IF EXISTS board_version ON public.task;
+DROP TRIGGER IF EXISTS board_version ON public.tag;
+DROP TRIGGER IF EXISTS board_version ON public.custom_field;
+DROP TRIGGER IF EXISTS board_version ON public.tag_board;
+DROP TRIGGER IF EXISTS board_version ON public.board_user;
+DROP TRIGGER IF EXISTS board_version ON public.list;
+DROP TRIGGER IF EXISTS board_version ON public.board;
+
+DROP FUNCTION IF EXISTS public.bump_board_version_on_update();
+DROP FUNCTION IF EXISTS public.bump_board_version_on_change();
+DROP FUNCTION IF EXISTS public.bump_board_version(text, bigint);
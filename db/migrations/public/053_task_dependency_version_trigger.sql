@@ -0,0 +1,33 @@
+-- зависимость меняет блокировку заданий на досках обоих связанных заданий
+CREATE TRIGGER board_version_blocker AFTER INSERT OR UPDATE OR DELETE ON public.task_dependency
+    FOR EACH ROW EXECUTE FUNCTION public.bump_board_version_on_change('task', 'id_blocker');
+CREATE TRIGGER board_version_blocked AFTER INSERT OR UPDATE OR DELETE ON public.task_dependency
+    FOR EACH ROW EXECUTE FUNCTION public.bump_board_version_on_change('task', 'id_blocked');
+
+-- выполнение блокирующего задания или его возврат в работу меняет блокировку заданий,
+-- которые могут лежать на других досках: их версии увеличиваются вместе с версией доски блокирующего
+CREATE OR REPLACE FUNCTION public.bump_blocked_boards_version() RETURNS trigger AS $$
+BEGIN
+    UPDATE public.board
+    SET version = version + 1
+    WHERE id IN (
+        SELECT public.list.id_board FROM public.task_dependency
+            JOIN public.task ON public.task.id = public.task_dependency.id_blocked
+            JOIN public.list ON public.list.id = public.task.id_list
+            WHERE public.task_dependency.id_blocker = NEW.id
+    );
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER blocked_boards_version AFTER UPDATE OF date_completed ON public.task
+    FOR EACH ROW WHEN (OLD.date_completed IS DISTINCT FROM NEW.date_completed)
+    EXECUTE FUNCTION public.bump_blocked_boards_version();
+
+---- create above / drop below ----
+
+DROP TRIGGER IF EXISTS blocked_boards_version ON public.task;
+DROP FUNCTION IF EXISTS public.bump_blocked_boards_version();
+
+DROP TRIGGER IF EXISTS board_version_blocked ON public.task_dependency;
+DROP TRIGGER IF EXISTS board_version_blocker ON public.task_dependency;
@@ -0,0 +1,7 @@
+-- напоминания о сроке приходят в составе задания, поэтому их замена увеличивает версию его доски
+CREATE TRIGGER board_version AFTER INSERT OR UPDATE OR DELETE ON public.task_reminder
+    FOR EACH ROW EXECUTE FUNCTION public.bump_board_version_on_change('task', 'id_task');
+
+---- create above / drop below ----
+
+DROP TRIGGER IF EXISTS board_version ON public.task_reminder;
@@ -0,0 +1,8 @@
+-- правило действующей серии приходит в составе её текущего задания, поэтому изменение серии
+-- и переход к следующему заданию увеличивают версию доски этого задания
+CREATE TRIGGER board_version AFTER INSERT OR UPDATE OR DELETE ON public.task_recurrence
+    FOR EACH ROW EXECUTE FUNCTION public.bump_board_version_on_change('task', 'id_task');
+
+---- create above / drop below ----
+
+DROP TRIGGER IF EXISTS board_version ON public.task_recurrence;
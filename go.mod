@@ -21,6 +21,7 @@ require (
 	github.com/swaggo/swag v1.16.2
 	go.opentelemetry.io/otel/trace v1.21.0
 	go.uber.org/mock v0.3.0
+	golang.org/x/image v0.13.0
 	google.golang.org/grpc v1.59.0
 	google.golang.org/protobuf v1.31.0
 )
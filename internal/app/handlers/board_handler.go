@@ -2,12 +2,17 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
 	"net/http"
 	"server/internal/apperrors"
 	_ "server/internal/pkg/doc_structs"
 	"server/internal/pkg/dto"
 	"server/internal/pkg/entities"
 	"server/internal/service"
+	"strconv"
+	"strings"
 
 	logger "server/internal/logging"
 
@@ -22,15 +27,17 @@ type BoardHandler struct {
 }
 
 // @Summary Получить доску
-// @Description Получить доску
+// @Description Получить доску; задания можно отфильтровать по исполнителям, тегам, сроку, тексту и значениям пользовательских полей, а комментарии и чеклисты -- не загружать
 // @Tags boards
 //
 // @Accept  json
 // @Produce  json
 //
-// @Param boardID body dto.BoardID true "id доски"
+// @Param boardRequest body dto.IndividualBoardRequest true "id доски и фильтры заданий"
+// @Param If-None-Match header string false "ETag из предыдущего ответа"
 //
 // @Success 200  {object}  doc_structs.BoardResponse "объект доски"
+// @Success 304  {string}  string "доска не изменилась"
 // @Failure 400  {object}  apperrors.ErrorResponse
 // @Failure 401  {object}  apperrors.ErrorResponse
 // @Failure 500  {object}  apperrors.ErrorResponse
@@ -47,8 +54,8 @@ func (bh BoardHandler) GetFullBoard(w http.ResponseWriter, r *http.Request) {
 
 	logger.Info("---------------------------------- Get board ----------------------------------")
 
-	var boardID dto.BoardID
-	err := easyjson.UnmarshalFromReader(r.Body, &boardID)
+	var boardRequest dto.IndividualBoardRequest
+	err := easyjson.UnmarshalFromReader(r.Body, &boardRequest)
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
 		logger.Info(failBorder)
@@ -66,10 +73,24 @@ func (bh BoardHandler) GetFullBoard(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.DebugFmt("User object acquired from context", requestID.String(), funcName, nodeName)
 
-	boardRequest := dto.IndividualBoardRequest{
-		UserID:  user.ID,
-		BoardID: boardID.Value,
+	boardRequest.UserID = user.ID
+	if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		version, err := bh.bs.GetVersion(rCtx, dto.BoardID{Value: boardRequest.BoardID})
+		if err != nil {
+			logger.Error(errorMessage + err.Error())
+			logger.Info(failBorder)
+			apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+			return
+		}
+		if etag := boardETag(version.Version, version.Timezone, boardRequest); etagMatches(ifNoneMatch, etag) {
+			w.Header().Set("ETag", etag)
+			w.WriteHeader(http.StatusNotModified)
+			logger.Info("---------------------------------- Get board NOT MODIFIED ----------------------------------")
+			return
+		}
+		logger.DebugFmt("Board changed since the cached version", requestID.String(), funcName, nodeName)
 	}
+
 	board, err := bh.bs.GetFullBoard(rCtx, boardRequest)
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
@@ -79,6 +100,7 @@ func (bh BoardHandler) GetFullBoard(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.DebugFmt("Got board", requestID.String(), funcName, nodeName)
 
+	w.Header().Set("ETag", boardETag(board.Board.Version, board.Timezone, boardRequest))
 	response := dto.JSONResponse{
 		Body: board,
 	}
@@ -181,7 +203,7 @@ func (bh BoardHandler) Create(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary Обновить доску
-// @Description Обновить доску
+// @Description Обновить доску. В row_version передаётся версия доски, полученная клиентом; если с тех пор доску изменили, возвращается 409 с её текущим состоянием
 // @Tags boards
 //
 // @Accept  json
@@ -189,9 +211,10 @@ func (bh BoardHandler) Create(w http.ResponseWriter, r *http.Request) {
 //
 // @Param boardInfo body dto.UpdatedBoardInfo true "обновленные данные доски"
 //
-// @Success 204  {string}  string "no content"
+// @Success 200  {object}  doc_structs.BoardUpdateResponse "текущее состояние доски"
 // @Failure 400  {object}  apperrors.ErrorResponse
 // @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 409  {object}  doc_structs.BoardConflictResponse "доска уже изменена, в ответе её текущее состояние"
 // @Failure 500  {object}  apperrors.ErrorResponse
 //
 // @Router /board/update/ [post]
@@ -224,7 +247,15 @@ func (bh BoardHandler) UpdateData(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.DebugFmt("User object acquired from context", requestID.String(), funcName, nodeName)
 
-	err = bh.bs.UpdateData(rCtx, boardInfo)
+	board, err := bh.bs.UpdateData(rCtx, boardInfo)
+	if errors.Is(err, apperrors.ErrRowVersionConflict) {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		if err = WriteConflictResponse("board", board, w, r); err != nil {
+			logger.Error(errorMessage + err.Error())
+		}
+		return
+	}
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
 		logger.Info(failBorder)
@@ -234,7 +265,9 @@ func (bh BoardHandler) UpdateData(w http.ResponseWriter, r *http.Request) {
 	logger.DebugFmt("board data updated", requestID.String(), funcName, nodeName)
 
 	response := dto.JSONResponse{
-		Body: dto.JSONMap{},
+		Body: dto.JSONMap{
+			"board": board,
+		},
 	}
 	err = WriteResponse(response, w, r)
 	if err != nil {
@@ -260,6 +293,7 @@ func (bh BoardHandler) UpdateData(w http.ResponseWriter, r *http.Request) {
 // @Success 200  {object}  doc_structs.ThumbnailUploadResponse "Ссылка на новую картинку"
 // @Failure 400  {object}  apperrors.ErrorResponse
 // @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 413  {object}  apperrors.ErrorResponse
 // @Failure 500  {object}  apperrors.ErrorResponse
 //
 // @Router /board/update/change_thumbnail/ [post]
@@ -536,17 +570,18 @@ func (bh BoardHandler) RemoveUser(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary Получить историю изменений доски
-// @Description Получить историю изменений доски: список, каждый элемент которого состоит из автора изменения, даты изменения и изменения
+// @Description Получить историю изменений доски, начиная с новых: автор, дата, тип и ID сущности, действие и значения изменённых полей до и после. Поддерживает фильтры по сущности, пользователю и периоду, а также пагинацию
 // @Tags boards
 //
 // @Accept  json
 // @Produce  json
 //
-// @Param info body dto.BoardID true "ID доски, для которой запрашивается история"
+// @Param info body dto.BoardHistoryRequest true "ID доски, фильтры и пагинация"
 //
 // @Success 200  {object}  doc_structs.GetHistoryResponse "Список изменений"
 // @Failure 400  {object}  apperrors.ErrorResponse
 // @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
 // @Failure 500  {object}  apperrors.ErrorResponse
 //
 // @Router /board/history/ [post]
@@ -560,7 +595,7 @@ func (bh BoardHandler) GetHistory(w http.ResponseWriter, r *http.Request) {
 	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
 	logger.Info("---------------------------------- Getting board history ----------------------------------")
 
-	var info dto.BoardID
+	var info dto.BoardHistoryRequest
 	err := easyjson.UnmarshalFromReader(r.Body, &info)
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
@@ -605,32 +640,104 @@ func (bh BoardHandler) GetHistory(w http.ResponseWriter, r *http.Request) {
 	logger.Info("---------------------------------- Get board history SUCCESS ----------------------------------")
 }
 
-// @Summary Добавить изменение в историю
-// @Description Записывает изменения данной доски в историю изменений
+// @Summary Получить архив доски
+// @Description Получить архивные списки доски и скрытые архивом задания: архивные или лежащие в архивных списках. На основной доске они не показываются
 // @Tags boards
 //
 // @Accept  json
 // @Produce  json
 //
-// @Param info body dto.NewHistoryEntry true "ID доски и изменения"
+// @Param boardID body dto.BoardID true "ID доски"
 //
-// @Success 200  {string}  string "no content"
+// @Success 200  {object}  doc_structs.BoardArchiveResponse "Архив доски"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /board/archive/ [post]
+func (bh BoardHandler) GetArchive(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "GetArchive"
+	errorMessage := "Getting board archive failed with error: "
+	failBorder := "---------------------------------- Getting board archive FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+	logger.Info("---------------------------------- Getting board archive ----------------------------------")
+
+	var boardID dto.BoardID
+	err := easyjson.UnmarshalFromReader(r.Body, &boardID)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("JSON Decoded", requestID.String(), funcName, nodeName)
+
+	_, ok := rCtx.Value(dto.UserObjKey).(*entities.User)
+	if !ok {
+		logger.Error(errorMessage + "User not found")
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.GenericUnauthorizedResponse, w, r)
+		return
+	}
+	logger.DebugFmt("User object acquired from context", requestID.String(), funcName, nodeName)
+
+	archive, err := bh.bs.GetArchive(rCtx, boardID)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("Archive collected", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"archive": archive,
+		},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+
+	logger.DebugFmt("Response written", requestID.String(), funcName, nodeName)
+	logger.Info("---------------------------------- Getting board archive SUCCESS ----------------------------------")
+}
+
+// @Summary Получить статистику доски
+// @Description Получить статистику доски: количество заданий по спискам, исполнителям и тэгам, просроченные и со сроком на этой неделе, долю выполненных пунктов чеклистов, созданные и выполненные задания по неделям. Выполненными считаются задания в завершающих списках
+// @Tags boards
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param info body dto.BoardStatsRequest true "ID доски и количество недель (по умолчанию 12, не больше 52)"
+//
+// @Success 200  {object}  doc_structs.BoardStatsResponse "Статистика доски"
 // @Failure 400  {object}  apperrors.ErrorResponse
 // @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
 // @Failure 500  {object}  apperrors.ErrorResponse
 //
-// @Router /board/history/submit/ [post]
-func (bh BoardHandler) SubmitEdit(w http.ResponseWriter, r *http.Request) {
+// @Router /board/stats/ [post]
+func (bh BoardHandler) GetStats(w http.ResponseWriter, r *http.Request) {
 	rCtx := r.Context()
-	funcName := "SubmitEdit"
-	errorMessage := "Adding edit to history failed with error: "
-	failBorder := "---------------------------------- Adding edit to history FAIL ----------------------------------"
+	funcName := "GetStats"
+	errorMessage := "Getting board stats failed with error: "
+	failBorder := "---------------------------------- Getting board stats FAIL ----------------------------------"
 
 	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
 	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
-	logger.Info("---------------------------------- Adding edit to history ----------------------------------")
+	logger.Info("---------------------------------- Getting board stats ----------------------------------")
 
-	var info dto.NewHistoryEntry
+	var info dto.BoardStatsRequest
 	err := easyjson.UnmarshalFromReader(r.Body, &info)
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
@@ -640,7 +747,7 @@ func (bh BoardHandler) SubmitEdit(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.DebugFmt("JSON Decoded", requestID.String(), funcName, nodeName)
 
-	user, ok := rCtx.Value(dto.UserObjKey).(*entities.User)
+	_, ok := rCtx.Value(dto.UserObjKey).(*entities.User)
 	if !ok {
 		logger.Error(errorMessage + "User not found")
 		logger.Info(failBorder)
@@ -649,16 +756,87 @@ func (bh BoardHandler) SubmitEdit(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.DebugFmt("User object acquired from context", requestID.String(), funcName, nodeName)
 
-	info.UserID = user.ID
+	stats, err := bh.bs.GetStats(rCtx, info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("Stats computed", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"stats": stats,
+		},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+
+	logger.DebugFmt("Response written", requestID.String(), funcName, nodeName)
+	logger.Info("---------------------------------- Getting board stats SUCCESS ----------------------------------")
+}
+
+// @Summary Отменить изменение из истории
+// @Description Отменяет изменение из истории доски, если сущность всё ещё находится в записанном состоянии: восстанавливает удалённое, возвращает прежние значения полей, переносит задание обратно. Отмена записывается в историю
+// @Tags boards
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param info body dto.HistoryEntryID true "ID записи в истории"
+//
+// @Success 200  {string}  string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 409  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /board/history/revert/ [post]
+func (bh BoardHandler) RevertHistory(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "RevertHistory"
+	errorMessage := "Reverting history entry failed with error: "
+	failBorder := "---------------------------------- Reverting history entry FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+	logger.Info("---------------------------------- Reverting history entry ----------------------------------")
+
+	var info dto.HistoryEntryID
+	err := easyjson.UnmarshalFromReader(r.Body, &info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("JSON Decoded", requestID.String(), funcName, nodeName)
+
+	_, ok := rCtx.Value(dto.UserObjKey).(*entities.User)
+	if !ok {
+		logger.Error(errorMessage + "User not found")
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.GenericUnauthorizedResponse, w, r)
+		return
+	}
+	logger.DebugFmt("User object acquired from context", requestID.String(), funcName, nodeName)
 
-	err = bh.bs.SubmitEdit(rCtx, info)
+	err = bh.bs.RevertHistory(rCtx, info)
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
 		logger.Info(failBorder)
 		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
 		return
 	}
-	logger.DebugFmt("Edit recorded", requestID.String(), funcName, nodeName)
+	logger.DebugFmt("History entry reverted", requestID.String(), funcName, nodeName)
 
 	response := dto.JSONResponse{
 		Body: dto.JSONMap{},
@@ -672,5 +850,558 @@ func (bh BoardHandler) SubmitEdit(w http.ResponseWriter, r *http.Request) {
 	}
 
 	logger.DebugFmt("Response written", requestID.String(), funcName, nodeName)
-	logger.Info("---------------------------------- Add edit to history SUCCESS ----------------------------------")
+	logger.Info("---------------------------------- Revert history entry SUCCESS ----------------------------------")
+}
+
+// @Summary Выгрузить доску в CSV
+// @Description Выгрузить задания доски в формате CSV
+// @Tags boards
+//
+// @Accept  json
+// @Produce  text/csv
+//
+// @Param boardID body dto.BoardID true "id доски"
+//
+// @Success 200  {file}  file "CSV-файл с заданиями доски"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /board/export/csv/ [post]
+func (bh BoardHandler) ExportCSV(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "ExportCSV"
+	errorMessage := "Exporting board to CSV failed with error: "
+	failBorder := "---------------------------------- Export board to CSV FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- Export board to CSV ----------------------------------")
+
+	var boardID dto.BoardID
+	err := easyjson.UnmarshalFromReader(r.Body, &boardID)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("JSON Decoded", requestID.String(), funcName, nodeName)
+
+	user, ok := rCtx.Value(dto.UserObjKey).(*entities.User)
+	if !ok {
+		logger.Error(errorMessage + "User not found")
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.GenericUnauthorizedResponse, w, r)
+		return
+	}
+	logger.DebugFmt("User object acquired from context", requestID.String(), funcName, nodeName)
+
+	boardRequest := dto.IndividualBoardRequest{
+		UserID:  user.ID,
+		BoardID: boardID.Value,
+	}
+	file, err := bh.bs.ExportCSV(rCtx, boardRequest)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("Board exported", requestID.String(), funcName, nodeName)
+
+	err = WriteFileResponse(*file, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- Export board to CSV SUCCESS ----------------------------------")
+}
+
+// @Summary Выгрузить доску в Markdown
+// @Description Выгрузить задания доски в формате Markdown, по разделу на каждый список
+// @Tags boards
+//
+// @Accept  json
+// @Produce  text/markdown
+//
+// @Param boardID body dto.BoardID true "id доски"
+//
+// @Success 200  {file}  file "Markdown-файл с заданиями доски"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /board/export/markdown/ [post]
+func (bh BoardHandler) ExportMarkdown(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "ExportMarkdown"
+	errorMessage := "Exporting board to Markdown failed with error: "
+	failBorder := "---------------------------------- Export board to Markdown FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- Export board to Markdown ----------------------------------")
+
+	var boardID dto.BoardID
+	err := easyjson.UnmarshalFromReader(r.Body, &boardID)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("JSON Decoded", requestID.String(), funcName, nodeName)
+
+	user, ok := rCtx.Value(dto.UserObjKey).(*entities.User)
+	if !ok {
+		logger.Error(errorMessage + "User not found")
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.GenericUnauthorizedResponse, w, r)
+		return
+	}
+	logger.DebugFmt("User object acquired from context", requestID.String(), funcName, nodeName)
+
+	boardRequest := dto.IndividualBoardRequest{
+		UserID:  user.ID,
+		BoardID: boardID.Value,
+	}
+	file, err := bh.bs.ExportMarkdown(rCtx, boardRequest)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("Board exported", requestID.String(), funcName, nodeName)
+
+	err = WriteFileResponse(*file, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- Export board to Markdown SUCCESS ----------------------------------")
+}
+
+// @Summary Получить изменения доски
+// @Description Возвращает сущности доски, созданные, изменённые или удалённые после указанной версии. Удалённые сущности приходят в deleted, версию из ответа нужно передать в следующий запрос
+// @Tags boards
+//
+// @Produce  json
+//
+// @Param board_id query int true "id доски"
+// @Param since query int false "версия доски, с которой нужны изменения"
+//
+// @Success 200  {object}  doc_structs.BoardChangesResponse "изменения доски"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /board/changes/ [get]
+func (bh BoardHandler) GetChanges(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "GetChanges"
+	errorMessage := "Getting board changes failed with error: "
+	failBorder := "---------------------------------- Getting board changes FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+	logger.Info("---------------------------------- Getting board changes ----------------------------------")
+
+	var request dto.BoardChangesRequest
+	boardID, err := strconv.ParseUint(r.URL.Query().Get("board_id"), 10, 64)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	request.BoardID = boardID
+	if since := r.URL.Query().Get("since"); since != "" {
+		request.Since, err = strconv.ParseUint(since, 10, 64)
+		if err != nil {
+			logger.Error(errorMessage + err.Error())
+			logger.Info(failBorder)
+			apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+			return
+		}
+	}
+	logger.DebugFmt("Query parameters parsed", requestID.String(), funcName, nodeName)
+
+	_, ok := rCtx.Value(dto.UserObjKey).(*entities.User)
+	if !ok {
+		logger.Error(errorMessage + "User not found")
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.GenericUnauthorizedResponse, w, r)
+		return
+	}
+	logger.DebugFmt("User object acquired from context", requestID.String(), funcName, nodeName)
+
+	changes, err := bh.bs.GetChanges(rCtx, request)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("Changes collected", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"changes": changes,
+		},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+
+	logger.DebugFmt("Response written", requestID.String(), funcName, nodeName)
+	logger.Info("---------------------------------- Getting board changes SUCCESS ----------------------------------")
+}
+
+// boardETag
+// строит ETag ответа с доской из её версии, часового пояса пользователя, в котором возвращаются даты,
+// и параметров запроса, от которых зависит содержимое ответа
+func boardETag(version uint64, timezone string, request dto.IndividualBoardRequest) string {
+	encoded, _ := easyjson.Marshal(request)
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(timezone))
+	_, _ = hash.Write(encoded)
+	return fmt.Sprintf(`"%d-%x"`, version, hash.Sum64())
+}
+
+// etagMatches
+// проверяет, есть ли ETag среди перечисленных в заголовке If-None-Match
+func etagMatches(header string, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// @Summary Подписаться на доску
+// @Description Подписывает пользователя на изменения всех сущностей доски. В ответ ничего не шлёт
+// @Tags boards
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param boardID body dto.BoardID true "id доски"
+//
+// @Success 200  {string}  string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /board/watchers/add/ [post]
+func (bh BoardHandler) Watch(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "BoardHandler.Watch"
+	errorMessage := "Watching board failed with error: "
+	failBorder := "---------------------------------- BoardHandler.Watch FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- BoardHandler.Watch ----------------------------------")
+
+	var id dto.BoardID
+	err := easyjson.UnmarshalFromReader(r.Body, &id)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	err = bh.bs.Watch(rCtx, id)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("watching board done", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- BoardHandler.Watch SUCCESS ----------------------------------")
+}
+
+// @Summary Отписаться от доски
+// @Description Отписывает пользователя от изменений доски; подписки на отдельные задания остаются. В ответ ничего не шлёт
+// @Tags boards
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param boardID body dto.BoardID true "id доски"
+//
+// @Success 200  {string}  string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /board/watchers/remove/ [post]
+func (bh BoardHandler) Unwatch(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "BoardHandler.Unwatch"
+	errorMessage := "Unwatching board failed with error: "
+	failBorder := "---------------------------------- BoardHandler.Unwatch FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- BoardHandler.Unwatch ----------------------------------")
+
+	var id dto.BoardID
+	err := easyjson.UnmarshalFromReader(r.Body, &id)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	err = bh.bs.Unwatch(rCtx, id)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("unwatching board done", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- BoardHandler.Unwatch SUCCESS ----------------------------------")
+}
+
+// @Summary Получить наблюдателей доски
+// @Description Возвращает пользователей, подписанных на изменения доски
+// @Tags boards
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param boardID body dto.BoardID true "id доски"
+//
+// @Success 200  {object}  doc_structs.WatchersResponse "наблюдатели в порядке подписки"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /board/watchers/ [post]
+func (bh BoardHandler) GetWatchers(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "BoardHandler.GetWatchers"
+	errorMessage := "Getting board watchers failed with error: "
+	failBorder := "---------------------------------- BoardHandler.GetWatchers FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- BoardHandler.GetWatchers ----------------------------------")
+
+	var id dto.BoardID
+	err := easyjson.UnmarshalFromReader(r.Body, &id)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	watchers, err := bh.bs.GetWatchers(rCtx, id)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("getting board watchers done", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"watchers": watchers,
+		},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- BoardHandler.GetWatchers SUCCESS ----------------------------------")
+}
+
+// @Summary Получить отчёт о времени
+// @Description Возвращает время по заданиям доски за период [from, to) по дням, пользователям и заданиям; при заданном user_id -- только по этому пользователю. Дни считаются в часовом поясе текущего пользователя, запущенные таймеры не учитываются. Период -- не больше 366 дней
+// @Tags boards
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param info body dto.TimeReportRequest true "доска и период"
+//
+// @Success 200  {object}  doc_structs.TimeReportResponse "отчёт о времени"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /board/time/report/ [post]
+func (bh BoardHandler) GetTimeReport(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "BoardHandler.GetTimeReport"
+	errorMessage := "Getting time report failed with error: "
+	failBorder := "---------------------------------- BoardHandler.GetTimeReport FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- BoardHandler.GetTimeReport ----------------------------------")
+
+	var info dto.TimeReportRequest
+	err := easyjson.UnmarshalFromReader(r.Body, &info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	result, err := bh.bs.GetTimeReport(rCtx, info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("getting time report done", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"report": result,
+		},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- BoardHandler.GetTimeReport SUCCESS ----------------------------------")
+}
+
+// @Summary Выгрузить отчёт о времени в CSV
+// @Description Выгружает отчёт о времени по доске за период в формате CSV: строка на день, пользователя и задание с временем в часах и итоговая строка
+// @Tags boards
+//
+// @Accept  json
+// @Produce  text/csv
+//
+// @Param info body dto.TimeReportRequest true "доска и период"
+//
+// @Success 200  {file}  file "CSV-файл с отчётом о времени"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /board/time/report/csv/ [post]
+func (bh BoardHandler) ExportTimeReportCSV(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "BoardHandler.ExportTimeReportCSV"
+	errorMessage := "Exporting time report failed with error: "
+	failBorder := "---------------------------------- BoardHandler.ExportTimeReportCSV FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- BoardHandler.ExportTimeReportCSV ----------------------------------")
+
+	var info dto.TimeReportRequest
+	err := easyjson.UnmarshalFromReader(r.Body, &info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	result, err := bh.bs.ExportTimeReportCSV(rCtx, info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("exporting time report done", requestID.String(), funcName, nodeName)
+
+	err = WriteFileResponse(*result, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- BoardHandler.ExportTimeReportCSV SUCCESS ----------------------------------")
 }
@@ -39,6 +39,14 @@ func createBoardMux(
 				r.Post("/add/", BoardHandler.AddUser)
 				r.Post("/remove/", BoardHandler.RemoveUser)
 			})
+			r.Post("/history/", BoardHandler.GetHistory)
+			r.Post("/history/revert/", BoardHandler.RevertHistory)
+			r.Post("/stats/", BoardHandler.GetStats)
+			r.Get("/changes/", BoardHandler.GetChanges)
+			r.Route("/export", func(r chi.Router) {
+				r.Post("/csv/", BoardHandler.ExportCSV)
+				r.Post("/markdown/", BoardHandler.ExportMarkdown)
+			})
 			r.Delete("/delete/", BoardHandler.Delete)
 		})
 	})
@@ -125,6 +133,128 @@ func TestBoardHandler_Unit_GetFullBoard(t *testing.T) {
 			wantErr:      false,
 			expectedCode: http.StatusOK,
 		},
+		{
+			name: "Successful get with filters",
+			args: args{
+				user: &entities.User{
+					ID:    uint64(1),
+					Email: "mock@mail.com",
+				},
+				boardID: dto.BoardID{
+					Value: uint64(1),
+				},
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					dueTo := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+					includeComments := false
+					requestedBoard := dto.IndividualBoardRequest{
+						BoardID:         args.boardID.Value,
+						UserID:          args.user.ID,
+						AssigneeIDs:     []uint64{2},
+						TagIDs:          []uint64{3, 4},
+						DueTo:           &dueTo,
+						Text:            "release",
+						IncludeComments: &includeComments,
+					}
+
+					bs.
+						EXPECT().
+						GetFullBoard(gomock.Any(), requestedBoard).
+						Return(&args.resultBoard, nil)
+
+					body := bytes.NewReader([]byte(`{"board_id":1,"assignees":[2],"tags":[3,4],` +
+						`"due_to":"2024-03-01T00:00:00Z","text":"release","include_comments":false}`))
+
+					return httptest.
+						NewRequest("POST", "/api/v2/board/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(
+									context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+									dto.UserObjKey, args.user,
+								),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      false,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Not modified",
+			args: args{
+				user: &entities.User{
+					ID:    uint64(1),
+					Email: "mock@mail.com",
+				},
+				boardID: dto.BoardID{
+					Value: uint64(1),
+				},
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					bs.
+						EXPECT().
+						GetVersion(gomock.Any(), args.boardID).
+						Return(&dto.BoardVersion{Version: uint64(5), Timezone: "UTC"}, nil)
+
+					r := httptest.
+						NewRequest("POST", "/api/v2/board/", bytes.NewReader([]byte(`{"board_id":1}`))).
+						WithContext(
+							context.WithValue(
+								context.WithValue(
+									context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+									dto.UserObjKey, args.user,
+								),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+					r.Header.Set("If-None-Match", "*")
+					return r
+				},
+			},
+			wantErr:      false,
+			expectedCode: http.StatusNotModified,
+		},
+		{
+			name: "Successful get (cached version is stale)",
+			args: args{
+				user: &entities.User{
+					ID:    uint64(1),
+					Email: "mock@mail.com",
+				},
+				boardID: dto.BoardID{
+					Value: uint64(1),
+				},
+				resultBoard: dto.FullBoardResult{
+					Board: dto.SingleBoardInfo{ID: uint64(1), Version: uint64(5)},
+				},
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					bs.
+						EXPECT().
+						GetVersion(gomock.Any(), args.boardID).
+						Return(&dto.BoardVersion{Version: uint64(5), Timezone: "UTC"}, nil)
+					bs.
+						EXPECT().
+						GetFullBoard(gomock.Any(), dto.IndividualBoardRequest{BoardID: args.boardID.Value, UserID: args.user.ID}).
+						Return(&args.resultBoard, nil)
+
+					r := httptest.
+						NewRequest("POST", "/api/v2/board/", bytes.NewReader([]byte(`{"board_id":1}`))).
+						WithContext(
+							context.WithValue(
+								context.WithValue(
+									context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+									dto.UserObjKey, args.user,
+								),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+					r.Header.Set("If-None-Match", `"4-0"`)
+					return r
+				},
+			},
+			wantErr:      false,
+			expectedCode: http.StatusOK,
+		},
 		{
 			name: "Bad request (unauthorized - no user object in context)",
 			args: args{
@@ -354,6 +484,9 @@ func TestBoardHandler_Unit_GetFullBoard(t *testing.T) {
 				w.Code, http.StatusText(w.Code))
 
 			if !tt.wantErr {
+				require.NotEmpty(t, w.Header().Get("ETag"), "Board response has no ETag")
+			}
+			if !tt.wantErr && status == http.StatusOK {
 				responseBody := w.Body.Bytes()
 				var jsonBody map[string]dto.FullBoardResult
 				err = json.Unmarshal(responseBody, &jsonBody)
@@ -364,6 +497,65 @@ func TestBoardHandler_Unit_GetFullBoard(t *testing.T) {
 	}
 }
 
+func TestBoardHandler_Unit_GetFullBoard_TimezoneChanged(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockAuthService := mock_service.NewMockIAuthService(ctrl)
+	mockBoardService := mock_service.NewMockIBoardService(ctrl)
+	mux, err := createBoardMux(mockAuthService, mockBoardService)
+	require.Equal(t, nil, err)
+
+	user := &entities.User{ID: uint64(1), Email: "mock@mail.com"}
+	request := dto.IndividualBoardRequest{BoardID: uint64(1), UserID: user.ID}
+	newRequest := func(ifNoneMatch string) *http.Request {
+		r := httptest.
+			NewRequest("POST", "/api/v2/board/", bytes.NewReader([]byte(`{"board_id":1}`))).
+			WithContext(
+				context.WithValue(
+					context.WithValue(
+						context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+						dto.UserObjKey, user,
+					),
+					dto.RequestIDKey, uuid.New(),
+				),
+			)
+		if ifNoneMatch != "" {
+			r.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		return r
+	}
+
+	mockBoardService.
+		EXPECT().
+		GetFullBoard(gomock.Any(), request).
+		Return(&dto.FullBoardResult{
+			Board:    dto.SingleBoardInfo{ID: uint64(1), Version: uint64(5)},
+			Timezone: "UTC",
+		}, nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, newRequest(""))
+	require.Equal(t, http.StatusOK, w.Code)
+	cached := w.Header().Get("ETag")
+	require.NotEmpty(t, cached)
+
+	mockBoardService.
+		EXPECT().
+		GetVersion(gomock.Any(), dto.BoardID{Value: uint64(1)}).
+		Return(&dto.BoardVersion{Version: uint64(5), Timezone: "Europe/Moscow"}, nil)
+	mockBoardService.
+		EXPECT().
+		GetFullBoard(gomock.Any(), request).
+		Return(&dto.FullBoardResult{
+			Board:    dto.SingleBoardInfo{ID: uint64(1), Version: uint64(5)},
+			Timezone: "Europe/Moscow",
+		}, nil)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, newRequest(cached))
+	require.Equal(t, http.StatusOK, w.Code, "Board cached in another timezone reported as not modified")
+	require.NotEqual(t, cached, w.Header().Get("ETag"))
+}
+
 func TestBoardHandler_Unit_Create(t *testing.T) {
 	t.Parallel()
 
@@ -648,7 +840,7 @@ func TestBoardHandler_Unit_UpdateData(t *testing.T) {
 					bs.
 						EXPECT().
 						UpdateData(gomock.Any(), args.updatedBoard).
-						Return(nil)
+						Return(&dto.SingleBoardInfo{ID: args.updatedBoard.ID, Name: args.updatedBoard.Name, RowVersion: 2}, nil)
 
 					body := bytes.NewReader([]byte(fmt.Sprintf(`{"name":"%s", "id":%v}`, args.updatedBoard.Name, args.updatedBoard.ID)))
 
@@ -763,7 +955,7 @@ func TestBoardHandler_Unit_UpdateData(t *testing.T) {
 					bs.
 						EXPECT().
 						UpdateData(gomock.Any(), args.updatedBoard).
-						Return(apperrors.ErrBoardNotUpdated)
+						Return(nil, apperrors.ErrBoardNotUpdated)
 
 					body := bytes.NewReader([]byte(fmt.Sprintf(`{"name":"%s", "id":%v}`, args.updatedBoard.Name, args.updatedBoard.ID)))
 
@@ -786,6 +978,57 @@ func TestBoardHandler_Unit_UpdateData(t *testing.T) {
 			wantErr:      true,
 			expectedCode: http.StatusInternalServerError,
 		},
+		{
+			name: "Conflict (stale row version)",
+			args: args{
+				user: &entities.User{
+					ID:           uint64(1),
+					Email:        "mock@mail.com",
+					PasswordHash: "Mock hash",
+				},
+				session: dto.SessionToken{
+					ID: "Mock session",
+				},
+				updatedBoard: dto.UpdatedBoardInfo{
+					Name: "Mock new board name",
+					ID:   uint64(1),
+				},
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					cookie := &http.Cookie{
+						Name:     "tabula_user",
+						Value:    args.session.ID,
+						HttpOnly: true,
+						SameSite: http.SameSiteLaxMode,
+						Expires:  args.session.ExpirationDate,
+						Path:     "/api/v2/",
+					}
+
+					bs.
+						EXPECT().
+						UpdateData(gomock.Any(), args.updatedBoard).
+						Return(&dto.SingleBoardInfo{ID: args.updatedBoard.ID, Name: args.updatedBoard.Name, RowVersion: 2}, apperrors.ErrRowVersionConflict)
+
+					body := bytes.NewReader([]byte(fmt.Sprintf(`{"name":"%s", "id":%v}`, args.updatedBoard.Name, args.updatedBoard.ID)))
+
+					r := httptest.
+						NewRequest("POST", "/api/v2/board/update/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(
+									context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+									dto.UserObjKey, args.user,
+								),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+					r.AddCookie(cookie)
+
+					return r
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusConflict,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -1909,3 +2152,832 @@ func TestBoardHandler_Unit_RemoveUser(t *testing.T) {
 		})
 	}
 }
+
+func TestBoardHandler_Unit_ExportCSV(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		user         *entities.User
+		boardID      dto.BoardID
+		file         dto.ExportedBoard
+		expectations func(bs *mock_service.MockIBoardService, args args) *http.Request
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		expectedCode int
+	}{
+		{
+			name: "Successful export",
+			args: args{
+				user: &entities.User{
+					ID:    uint64(1),
+					Email: "mock@mail.com",
+				},
+				boardID: dto.BoardID{
+					Value: uint64(1),
+				},
+				file: dto.ExportedBoard{
+					Filename:    "board_1.csv",
+					ContentType: "text/csv; charset=utf-8",
+					Content:     []byte("list,name\nTodo,Task\n"),
+				},
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					bs.
+						EXPECT().
+						ExportCSV(gomock.Any(), dto.IndividualBoardRequest{
+							BoardID: args.boardID.Value,
+							UserID:  args.user.ID,
+						}).
+						Return(&args.file, nil)
+
+					body := bytes.NewReader([]byte(fmt.Sprintf(`{"board_id":%v}`, args.boardID.Value)))
+
+					return httptest.
+						NewRequest("POST", "/api/v2/board/export/csv/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(
+									context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+									dto.UserObjKey, args.user,
+								),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      false,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Bad request (unauthorized - no user object in context)",
+			args: args{
+				boardID: dto.BoardID{
+					Value: uint64(1),
+				},
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					body := bytes.NewReader([]byte(fmt.Sprintf(`{"board_id":%v}`, args.boardID.Value)))
+
+					return httptest.
+						NewRequest("POST", "/api/v2/board/export/csv/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name: "Bad request (invalid JSON)",
+			args: args{
+				user: &entities.User{
+					ID:    uint64(1),
+					Email: "mock@mail.com",
+				},
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					body := bytes.NewReader([]byte(""))
+
+					return httptest.
+						NewRequest("POST", "/api/v2/board/export/csv/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(
+									context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+									dto.UserObjKey, args.user,
+								),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Bad request (no access to board)",
+			args: args{
+				user: &entities.User{
+					ID:    uint64(1),
+					Email: "mock@mail.com",
+				},
+				boardID: dto.BoardID{
+					Value: uint64(1),
+				},
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					bs.
+						EXPECT().
+						ExportCSV(gomock.Any(), dto.IndividualBoardRequest{
+							BoardID: args.boardID.Value,
+							UserID:  args.user.ID,
+						}).
+						Return(nil, apperrors.ErrNoBoardAccess)
+
+					body := bytes.NewReader([]byte(fmt.Sprintf(`{"board_id":%v}`, args.boardID.Value)))
+
+					return httptest.
+						NewRequest("POST", "/api/v2/board/export/csv/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(
+									context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+									dto.UserObjKey, args.user,
+								),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockAuthService := mock_service.NewMockIAuthService(ctrl)
+			mockBoardService := mock_service.NewMockIBoardService(ctrl)
+
+			testRequest := tt.args.expectations(mockBoardService, tt.args)
+
+			mux, err := createBoardMux(mockAuthService, mockBoardService)
+			require.Equal(t, nil, err)
+
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, testRequest)
+
+			status := w.Result().StatusCode
+
+			require.EqualValuesf(t, tt.expectedCode, status,
+				"Expected code %d (%s), received code %d (%s)",
+				tt.expectedCode, http.StatusText(tt.expectedCode),
+				w.Code, http.StatusText(w.Code))
+
+			if !tt.wantErr {
+				require.Equal(t, tt.args.file.ContentType, w.Header().Get("Content-Type"))
+				require.Contains(t, w.Header().Get("Content-Disposition"), tt.args.file.Filename)
+				require.Equal(t, tt.args.file.Content, w.Body.Bytes())
+			}
+		})
+	}
+}
+
+func TestBoardHandler_Unit_GetHistory(t *testing.T) {
+	t.Parallel()
+
+	entityType := dto.HistoryEntityTask
+
+	type args struct {
+		user         *entities.User
+		request      dto.BoardHistoryRequest
+		body         string
+		expectations func(bs *mock_service.MockIBoardService, args args) *http.Request
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		expectedCode int
+	}{
+		{
+			name: "Successful get with filters",
+			args: args{
+				user: &entities.User{
+					ID:    uint64(1),
+					Email: "mock@mail.com",
+				},
+				request: dto.BoardHistoryRequest{
+					BoardID:    uint64(1),
+					EntityType: &entityType,
+					Limit:      uint64(10),
+					Offset:     uint64(20),
+				},
+				body: `{"board_id":1,"entity_type":"task","limit":10,"offset":20}`,
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					bs.
+						EXPECT().
+						GetHistory(gomock.Any(), args.request).
+						Return(&[]dto.BoardHistoryEntry{
+							{
+								ID:         uint64(1),
+								EntityType: dto.HistoryEntityTask,
+								EntityID:   uint64(1),
+								Action:     dto.HistoryActionUpdate,
+								Changes:    []dto.HistoryFieldChange{{Field: "name", Before: "old", After: "new"}},
+							},
+						}, nil)
+
+					return httptest.
+						NewRequest("POST", "/api/v2/board/history/", bytes.NewReader([]byte(args.body))).
+						WithContext(
+							context.WithValue(
+								context.WithValue(
+									context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+									dto.UserObjKey, args.user,
+								),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      false,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Bad request (unauthorized - no user object in context)",
+			args: args{
+				body: `{"board_id":1}`,
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					return httptest.
+						NewRequest("POST", "/api/v2/board/history/", bytes.NewReader([]byte(args.body))).
+						WithContext(
+							context.WithValue(
+								context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name: "Bad request (invalid JSON)",
+			args: args{
+				user: &entities.User{
+					ID:    uint64(1),
+					Email: "mock@mail.com",
+				},
+				body: "",
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					return httptest.
+						NewRequest("POST", "/api/v2/board/history/", bytes.NewReader([]byte(args.body))).
+						WithContext(
+							context.WithValue(
+								context.WithValue(
+									context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+									dto.UserObjKey, args.user,
+								),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Bad request (no access to board)",
+			args: args{
+				user: &entities.User{
+					ID:    uint64(1),
+					Email: "mock@mail.com",
+				},
+				request: dto.BoardHistoryRequest{
+					BoardID: uint64(1),
+				},
+				body: `{"board_id":1}`,
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					bs.
+						EXPECT().
+						GetHistory(gomock.Any(), args.request).
+						Return(nil, apperrors.ErrNoBoardAccess)
+
+					return httptest.
+						NewRequest("POST", "/api/v2/board/history/", bytes.NewReader([]byte(args.body))).
+						WithContext(
+							context.WithValue(
+								context.WithValue(
+									context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+									dto.UserObjKey, args.user,
+								),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockAuthService := mock_service.NewMockIAuthService(ctrl)
+			mockBoardService := mock_service.NewMockIBoardService(ctrl)
+
+			testRequest := tt.args.expectations(mockBoardService, tt.args)
+
+			mux, err := createBoardMux(mockAuthService, mockBoardService)
+			require.Equal(t, nil, err)
+
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, testRequest)
+
+			status := w.Result().StatusCode
+
+			require.EqualValuesf(t, tt.expectedCode, status,
+				"Expected code %d (%s), received code %d (%s)",
+				tt.expectedCode, http.StatusText(tt.expectedCode),
+				w.Code, http.StatusText(w.Code))
+		})
+	}
+}
+
+func TestBoardHandler_Unit_GetStats(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		user         *entities.User
+		request      dto.BoardStatsRequest
+		body         string
+		expectations func(bs *mock_service.MockIBoardService, args args) *http.Request
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		expectedCode int
+	}{
+		{
+			name: "Successful get",
+			args: args{
+				user: &entities.User{
+					ID:    uint64(1),
+					Email: "mock@mail.com",
+				},
+				request: dto.BoardStatsRequest{
+					BoardID: uint64(1),
+					Weeks:   uint64(4),
+				},
+				body: `{"board_id":1,"weeks":4}`,
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					bs.
+						EXPECT().
+						GetStats(gomock.Any(), args.request).
+						Return(&dto.BoardStats{
+							BoardID:   uint64(1),
+							TaskCount: uint64(3),
+							TasksPerList: []dto.ListTaskCount{
+								{ListID: uint64(1), Name: "Done", IsDone: true, TaskCount: uint64(3)},
+							},
+						}, nil)
+
+					return httptest.
+						NewRequest("POST", "/api/v2/board/stats/", bytes.NewReader([]byte(args.body))).
+						WithContext(
+							context.WithValue(
+								context.WithValue(
+									context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+									dto.UserObjKey, args.user,
+								),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      false,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Bad request (unauthorized - no user object in context)",
+			args: args{
+				body: `{"board_id":1}`,
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					return httptest.
+						NewRequest("POST", "/api/v2/board/stats/", bytes.NewReader([]byte(args.body))).
+						WithContext(
+							context.WithValue(
+								context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name: "Bad request (invalid JSON)",
+			args: args{
+				user: &entities.User{
+					ID:    uint64(1),
+					Email: "mock@mail.com",
+				},
+				body: "",
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					return httptest.
+						NewRequest("POST", "/api/v2/board/stats/", bytes.NewReader([]byte(args.body))).
+						WithContext(
+							context.WithValue(
+								context.WithValue(
+									context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+									dto.UserObjKey, args.user,
+								),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Bad request (no access to board)",
+			args: args{
+				user: &entities.User{
+					ID:    uint64(1),
+					Email: "mock@mail.com",
+				},
+				request: dto.BoardStatsRequest{
+					BoardID: uint64(1),
+				},
+				body: `{"board_id":1}`,
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					bs.
+						EXPECT().
+						GetStats(gomock.Any(), args.request).
+						Return(nil, apperrors.ErrNoBoardAccess)
+
+					return httptest.
+						NewRequest("POST", "/api/v2/board/stats/", bytes.NewReader([]byte(args.body))).
+						WithContext(
+							context.WithValue(
+								context.WithValue(
+									context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+									dto.UserObjKey, args.user,
+								),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockAuthService := mock_service.NewMockIAuthService(ctrl)
+			mockBoardService := mock_service.NewMockIBoardService(ctrl)
+
+			testRequest := tt.args.expectations(mockBoardService, tt.args)
+
+			mux, err := createBoardMux(mockAuthService, mockBoardService)
+			require.Equal(t, nil, err)
+
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, testRequest)
+
+			status := w.Result().StatusCode
+
+			require.EqualValuesf(t, tt.expectedCode, status,
+				"Expected code %d (%s), received code %d (%s)",
+				tt.expectedCode, http.StatusText(tt.expectedCode),
+				w.Code, http.StatusText(w.Code))
+		})
+	}
+}
+
+func TestBoardHandler_Unit_GetChanges(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		user         *entities.User
+		request      dto.BoardChangesRequest
+		body         string
+		expectations func(bs *mock_service.MockIBoardService, args args) *http.Request
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		expectedCode int
+	}{
+		{
+			name: "Successful get",
+			args: args{
+				user: &entities.User{
+					ID:    uint64(1),
+					Email: "mock@mail.com",
+				},
+				request: dto.BoardChangesRequest{
+					BoardID: uint64(1),
+					Since:   uint64(4),
+				},
+				body: "?board_id=1&since=4",
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					bs.
+						EXPECT().
+						GetChanges(gomock.Any(), args.request).
+						Return(&dto.BoardChanges{
+							Version: uint64(6),
+							Deleted: []dto.DeletedEntity{
+								{EntityType: dto.HistoryEntityTask, EntityID: uint64(2), Version: uint64(5)},
+							},
+						}, nil)
+
+					return httptest.
+						NewRequest("GET", "/api/v2/board/changes/"+args.body, nil).
+						WithContext(
+							context.WithValue(
+								context.WithValue(
+									context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+									dto.UserObjKey, args.user,
+								),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      false,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Bad request (unauthorized - no user object in context)",
+			args: args{
+				body: "?board_id=1",
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					return httptest.
+						NewRequest("GET", "/api/v2/board/changes/"+args.body, nil).
+						WithContext(
+							context.WithValue(
+								context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name: "Bad request (invalid version)",
+			args: args{
+				user: &entities.User{
+					ID:    uint64(1),
+					Email: "mock@mail.com",
+				},
+				body: "?board_id=1&since=latest",
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					return httptest.
+						NewRequest("GET", "/api/v2/board/changes/"+args.body, nil).
+						WithContext(
+							context.WithValue(
+								context.WithValue(
+									context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+									dto.UserObjKey, args.user,
+								),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Bad request (no access to board)",
+			args: args{
+				user: &entities.User{
+					ID:    uint64(1),
+					Email: "mock@mail.com",
+				},
+				request: dto.BoardChangesRequest{
+					BoardID: uint64(1),
+				},
+				body: "?board_id=1",
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					bs.
+						EXPECT().
+						GetChanges(gomock.Any(), args.request).
+						Return(nil, apperrors.ErrNoBoardAccess)
+
+					return httptest.
+						NewRequest("GET", "/api/v2/board/changes/"+args.body, nil).
+						WithContext(
+							context.WithValue(
+								context.WithValue(
+									context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+									dto.UserObjKey, args.user,
+								),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockAuthService := mock_service.NewMockIAuthService(ctrl)
+			mockBoardService := mock_service.NewMockIBoardService(ctrl)
+
+			testRequest := tt.args.expectations(mockBoardService, tt.args)
+
+			mux, err := createBoardMux(mockAuthService, mockBoardService)
+			require.Equal(t, nil, err)
+
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, testRequest)
+
+			status := w.Result().StatusCode
+
+			require.EqualValuesf(t, tt.expectedCode, status,
+				"Expected code %d (%s), received code %d (%s)",
+				tt.expectedCode, http.StatusText(tt.expectedCode),
+				w.Code, http.StatusText(w.Code))
+		})
+	}
+}
+
+func TestBoardHandler_Unit_RevertHistory(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		user         *entities.User
+		entryID      dto.HistoryEntryID
+		expectations func(bs *mock_service.MockIBoardService, args args) *http.Request
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		expectedCode int
+	}{
+		{
+			name: "Successful revert",
+			args: args{
+				user: &entities.User{
+					ID:    uint64(1),
+					Email: "mock@mail.com",
+				},
+				entryID: dto.HistoryEntryID{
+					Value: uint64(5),
+				},
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					bs.
+						EXPECT().
+						RevertHistory(gomock.Any(), args.entryID).
+						Return(nil)
+
+					body := bytes.NewReader([]byte(fmt.Sprintf(`{"entry_id":%v}`, args.entryID.Value)))
+
+					return httptest.
+						NewRequest("POST", "/api/v2/board/history/revert/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(
+									context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+									dto.UserObjKey, args.user,
+								),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      false,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Bad request (entry conflicts with current state)",
+			args: args{
+				user: &entities.User{
+					ID:    uint64(1),
+					Email: "mock@mail.com",
+				},
+				entryID: dto.HistoryEntryID{
+					Value: uint64(5),
+				},
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					bs.
+						EXPECT().
+						RevertHistory(gomock.Any(), args.entryID).
+						Return(apperrors.ErrHistoryRevertConflict)
+
+					body := bytes.NewReader([]byte(fmt.Sprintf(`{"entry_id":%v}`, args.entryID.Value)))
+
+					return httptest.
+						NewRequest("POST", "/api/v2/board/history/revert/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(
+									context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+									dto.UserObjKey, args.user,
+								),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name: "Bad request (entry not found)",
+			args: args{
+				user: &entities.User{
+					ID:    uint64(1),
+					Email: "mock@mail.com",
+				},
+				entryID: dto.HistoryEntryID{
+					Value: uint64(5),
+				},
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					bs.
+						EXPECT().
+						RevertHistory(gomock.Any(), args.entryID).
+						Return(apperrors.ErrHistoryEntryNotFound)
+
+					body := bytes.NewReader([]byte(fmt.Sprintf(`{"entry_id":%v}`, args.entryID.Value)))
+
+					return httptest.
+						NewRequest("POST", "/api/v2/board/history/revert/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(
+									context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+									dto.UserObjKey, args.user,
+								),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name: "Bad request (unauthorized - no user object in context)",
+			args: args{
+				entryID: dto.HistoryEntryID{
+					Value: uint64(5),
+				},
+				expectations: func(bs *mock_service.MockIBoardService, args args) *http.Request {
+					body := bytes.NewReader([]byte(fmt.Sprintf(`{"entry_id":%v}`, args.entryID.Value)))
+
+					return httptest.
+						NewRequest("POST", "/api/v2/board/history/revert/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockAuthService := mock_service.NewMockIAuthService(ctrl)
+			mockBoardService := mock_service.NewMockIBoardService(ctrl)
+
+			testRequest := tt.args.expectations(mockBoardService, tt.args)
+
+			mux, err := createBoardMux(mockAuthService, mockBoardService)
+			require.Equal(t, nil, err)
+
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, testRequest)
+
+			status := w.Result().StatusCode
+
+			require.EqualValuesf(t, tt.expectedCode, status,
+				"Expected code %d (%s), received code %d (%s)",
+				tt.expectedCode, http.StatusText(tt.expectedCode),
+				w.Code, http.StatusText(w.Code))
+		})
+	}
+}
@@ -198,14 +198,14 @@ func (clh ChecklistItemHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	logger.Info("---------------------------------- Deleting ChecklistItem SUCCESS ----------------------------------")
 }
 
-// @Summary Обновить порядок вещей в чеклисте
-// @Description Обновить вещи в чеклисте по порядку в списке
+// @Summary Переставить вещь в чеклисте
+// @Description Поставить вещь сразу после вещи after_id того же чеклиста; без after_id вещь ставится первой
 // @Tags checklist_items
 //
 // @Accept  json
 // @Produce  json
 //
-// @Param checklistIDs body dto.ChecklistItemIDs true "id вещей в чеклисте"
+// @Param moveInfo body dto.ChecklistItemMoveInfo true "id вещи и id вещи, после которой она встаёт"
 //
 // @Success 204  {string}  string "no content"
 // @Failure 400  {object}  apperrors.ErrorResponse
@@ -224,8 +224,8 @@ func (clh ChecklistItemHandler) UpdateOrder(w http.ResponseWriter, r *http.Reque
 
 	logger.Info("---------------------------------- ChecklistItemHandler.UpdateOrder ----------------------------------")
 
-	var checklistIDs dto.ChecklistItemIDs
-	err := easyjson.UnmarshalFromReader(r.Body, &checklistIDs)
+	var moveInfo dto.ChecklistItemMoveInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &moveInfo)
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
 		logger.Info(failBorder)
@@ -234,7 +234,7 @@ func (clh ChecklistItemHandler) UpdateOrder(w http.ResponseWriter, r *http.Reque
 	}
 	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
 
-	err = clh.clis.UpdateOrder(rCtx, checklistIDs)
+	err = clh.clis.UpdateOrder(rCtx, moveInfo)
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
 		logger.Info(failBorder)
@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"net/http"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	_ "server/internal/pkg/doc_structs"
+	"server/internal/pkg/dto"
+	"server/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/mailru/easyjson"
+)
+
+type CustomFieldHandler struct {
+	cfs service.ICustomFieldService
+}
+
+// @Summary Создать пользовательское поле
+// @Description Создать пользовательское поле доски. Тип поля: text, number, date, checkbox, single_select или multi_select; варианты выбора задаются только для полей с выбором
+// @Tags custom fields
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param newCustomFieldInfo body dto.NewCustomFieldInfo true "данные нового поля"
+//
+// @Success 200  {object}  doc_structs.CustomFieldResponse "объект поля"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /custom_field/create/ [post]
+func (cfh CustomFieldHandler) Create(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "CustomFieldHandler.Create"
+	errorMessage := "Creating custom field failed with error: "
+	failBorder := "---------------------------------- Creating CustomField FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- Creating CustomField ----------------------------------")
+
+	var newFieldInfo dto.NewCustomFieldInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &newFieldInfo)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	field, err := cfh.cfs.Create(rCtx, newFieldInfo)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("Custom field created", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"custom_field": field,
+		},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- Creating CustomField SUCCESS ----------------------------------")
+}
+
+// @Summary Обновить пользовательское поле
+// @Description Обновить название, варианты выбора и позицию поля. Тип поля не меняется; значения с удалёнными вариантами выбора удаляются
+// @Tags custom fields
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param customFieldInfo body dto.UpdatedCustomFieldInfo true "обновленные данные поля"
+//
+// @Success 204  {string}  string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /custom_field/edit/ [post]
+func (cfh CustomFieldHandler) Update(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "CustomFieldHandler.Update"
+	errorMessage := "Updating custom field failed with error: "
+	failBorder := "---------------------------------- Updating CustomField FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- Updating CustomField ----------------------------------")
+
+	var fieldInfo dto.UpdatedCustomFieldInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &fieldInfo)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	err = cfh.cfs.Update(rCtx, fieldInfo)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("Custom field updated", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- Updating CustomField SUCCESS ----------------------------------")
+}
+
+// @Summary Удалить пользовательское поле
+// @Description Удалить пользовательское поле вместе с его значениями у заданий
+// @Tags custom fields
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param customFieldID body dto.CustomFieldID true "id поля"
+//
+// @Success 204  {string}  string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /custom_field/delete/ [delete]
+func (cfh CustomFieldHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "CustomFieldHandler.Delete"
+	errorMessage := "Deleting custom field failed with error: "
+	failBorder := "---------------------------------- Deleting CustomField FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- Deleting CustomField ----------------------------------")
+
+	var fieldID dto.CustomFieldID
+	err := easyjson.UnmarshalFromReader(r.Body, &fieldID)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	err = cfh.cfs.Delete(rCtx, fieldID)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("Custom field deleted", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- Deleting CustomField SUCCESS ----------------------------------")
+}
@@ -2,8 +2,10 @@ package handlers
 
 import (
 	"net/http"
+	"server/internal/apperrors"
 	"server/internal/pkg/dto"
 	"server/internal/service"
+	"strconv"
 
 	"github.com/mailru/easyjson"
 )
@@ -23,6 +25,8 @@ type Handlers struct {
 	CSATAnswerHandler
 	CSATQuestionHandler
 	TagHandler
+	CustomFieldHandler
+	NotificationHandler
 }
 
 const nodeName = "storage"
@@ -43,6 +47,8 @@ func NewHandlers(services *service.Services) *Handlers {
 		CSATAnswerHandler:    *NewCSATAnswerHandler(services.CSATAnswer, services.CSATQuestion),
 		CSATQuestionHandler:  *NewCSATQuestionHandler(services.CSATQuestion),
 		TagHandler:           *NewTagHandler(services.Tag),
+		CustomFieldHandler:   *NewCustomFieldHandler(services.CustomField),
+		NotificationHandler:  *NewNotificationHandler(services.Notification),
 	}
 }
 
@@ -64,6 +70,22 @@ func NewTagHandler(ts service.ITagService) *TagHandler {
 	}
 }
 
+// NewCustomFieldHandler
+// возвращает CustomFieldHandler с необходимыми сервисами
+func NewCustomFieldHandler(cfs service.ICustomFieldService) *CustomFieldHandler {
+	return &CustomFieldHandler{
+		cfs: cfs,
+	}
+}
+
+// NewNotificationHandler
+// возвращает NotificationHandler с необходимыми сервисами
+func NewNotificationHandler(ns service.INotificationService) *NotificationHandler {
+	return &NotificationHandler{
+		ns: ns,
+	}
+}
+
 // NewChecklistItemHandler
 // возвращает NewChecklistItemHandler с необходимыми сервисами
 func NewChecklistItemHandler(clis service.IChecklistItemService) *ChecklistItemHandler {
@@ -163,3 +185,32 @@ func WriteResponse(response dto.JSONResponse, w http.ResponseWriter, r *http.Req
 
 	return nil
 }
+
+// WriteConflictResponse
+// отправляет клиенту ошибку конфликта версий вместе с текущим состоянием сущности под ключом name
+func WriteConflictResponse(name string, state interface{}, w http.ResponseWriter, r *http.Request) error {
+	w.WriteHeader(apperrors.StateConflictResponse.Code)
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"error_response": apperrors.StateConflictResponse.Message,
+			name:             state,
+		},
+	}
+	return WriteResponse(response, w, r)
+}
+
+// WriteFileResponse
+// отправляет клиенту файл с указанным типом содержимого
+func WriteFileResponse(file dto.ExportedBoard, w http.ResponseWriter, r *http.Request) error {
+	w.Header().Set("Content-Type", file.ContentType)
+	w.Header().Set("Content-Disposition", "attachment; filename="+strconv.Quote(file.Filename))
+
+	_, err := w.Write(file.Content)
+	if err != nil {
+		return err
+	}
+
+	r.Body.Close()
+
+	return nil
+}
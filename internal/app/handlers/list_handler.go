@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 	"server/internal/apperrors"
 	logger "server/internal/logging"
@@ -79,7 +80,7 @@ func (lh ListHandler) Create(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary Обновить список
-// @Description Обновить список
+// @Description Обновить список. В row_version передаётся версия списка, полученная клиентом; если с тех пор список изменили, возвращается 409 с его текущим состоянием
 // @Tags lists
 //
 // @Accept  json
@@ -87,9 +88,10 @@ func (lh ListHandler) Create(w http.ResponseWriter, r *http.Request) {
 //
 // @Param listInfo body dto.UpdatedListInfo true "обновленные данные списка"
 //
-// @Success 204  {string}  string "no content"
+// @Success 200  {object}  doc_structs.ListUpdateResponse "текущее состояние списка"
 // @Failure 400  {object}  apperrors.ErrorResponse
 // @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 409  {object}  doc_structs.ListConflictResponse "список уже изменён, в ответе его текущее состояние"
 // @Failure 500  {object}  apperrors.ErrorResponse
 //
 // @Router /list/edit/ [post]
@@ -114,7 +116,15 @@ func (lh ListHandler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
 
-	err = lh.ls.Update(rCtx, listInfo)
+	list, err := lh.ls.Update(rCtx, listInfo)
+	if errors.Is(err, apperrors.ErrRowVersionConflict) {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		if err = WriteConflictResponse("list", list, w, r); err != nil {
+			logger.Error(errorMessage + err.Error())
+		}
+		return
+	}
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
 		logger.Info(failBorder)
@@ -124,7 +134,9 @@ func (lh ListHandler) Update(w http.ResponseWriter, r *http.Request) {
 	logger.DebugFmt("list updated", requestID.String(), funcName, nodeName)
 
 	response := dto.JSONResponse{
-		Body: dto.JSONMap{},
+		Body: dto.JSONMap{
+			"list": list,
+		},
 	}
 	err = WriteResponse(response, w, r)
 	if err != nil {
@@ -198,14 +210,202 @@ func (lh ListHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	logger.Info("---------------------------------- Deleting list SUCCESS ----------------------------------")
 }
 
-// @Summary Обновить порядок списков
-// @Description Поставить списки по порядку в запросе
+// @Summary Перенести список в архив
+// @Description Скрыть список вместе с заданиями с доски, не удаляя его. Архивные списки видны в архиве доски
+// @Tags lists
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param listID body dto.ListID true "id списка"
+//
+// @Success 204  {string}  string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /list/archive/ [post]
+func (lh ListHandler) Archive(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "ListHandler.Archive"
+	errorMessage := "Archiving list failed with error: "
+	failBorder := "---------------------------------- Archiving list FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- Archiving list ----------------------------------")
+
+	var listID dto.ListID
+	err := easyjson.UnmarshalFromReader(r.Body, &listID)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	err = lh.ls.Archive(rCtx, listID)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("list archived", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- Archiving list SUCCESS ----------------------------------")
+}
+
+// @Summary Вернуть список из архива
+// @Description Вернуть архивный список на доску на прежнее место; задания списка не должны превышать его жёсткий лимит
+// @Tags lists
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param listID body dto.ListID true "id списка"
+//
+// @Success 200  {object}  doc_structs.WipWarningResponse "предупреждение о превышении мягкого лимита задач, если он превышен"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 409  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /list/unarchive/ [post]
+func (lh ListHandler) Unarchive(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "ListHandler.Unarchive"
+	errorMessage := "Unarchiving list failed with error: "
+	failBorder := "---------------------------------- Unarchiving list FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- Unarchiving list ----------------------------------")
+
+	var listID dto.ListID
+	err := easyjson.UnmarshalFromReader(r.Body, &listID)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	warning, err := lh.ls.Unarchive(rCtx, listID)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("list unarchived", requestID.String(), funcName, nodeName)
+
+	body := dto.JSONMap{}
+	if warning != nil {
+		body["wip_warning"] = warning
+	}
+	response := dto.JSONResponse{
+		Body: body,
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- Unarchiving list SUCCESS ----------------------------------")
+}
+
+// @Summary Перенести в архив все задания списка
+// @Description Скрыть с доски все задания списка, сам список остаётся на доске
+// @Tags lists
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param listID body dto.ListID true "id списка"
+//
+// @Success 204  {string}  string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /list/archive/tasks/ [post]
+func (lh ListHandler) ArchiveTasks(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "ListHandler.ArchiveTasks"
+	errorMessage := "Archiving list tasks failed with error: "
+	failBorder := "---------------------------------- Archiving list tasks FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- Archiving list tasks ----------------------------------")
+
+	var listID dto.ListID
+	err := easyjson.UnmarshalFromReader(r.Body, &listID)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	err = lh.ls.ArchiveTasks(rCtx, listID)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("list tasks archived", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- Archiving list tasks SUCCESS ----------------------------------")
+}
+
+// @Summary Переставить список
+// @Description Поставить список сразу после списка after_id той же доски; без after_id список ставится первым
 // @Tags lists
 //
 // @Accept  json
 // @Produce  json
 //
-// @Param listID body dto.ListIDs true "id списков"
+// @Param moveInfo body dto.ListMoveInfo true "id списка и id списка, после которого он встаёт"
 //
 // @Success 204  {string}  string "no content"
 // @Failure 400  {object}  apperrors.ErrorResponse
@@ -224,8 +424,8 @@ func (lh ListHandler) UpdateOrder(w http.ResponseWriter, r *http.Request) {
 
 	logger.Info("---------------------------------- ListHandler.UpdateOrder ----------------------------------")
 
-	var listIDs dto.ListIDs
-	err := easyjson.UnmarshalFromReader(r.Body, &listIDs)
+	var moveInfo dto.ListMoveInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &moveInfo)
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
 		logger.Info(failBorder)
@@ -234,7 +434,7 @@ func (lh ListHandler) UpdateOrder(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
 
-	err = lh.ls.UpdateOrder(rCtx, listIDs)
+	err = lh.ls.UpdateOrder(rCtx, moveInfo)
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
 		logger.Info(failBorder)
@@ -257,3 +457,194 @@ func (lh ListHandler) UpdateOrder(w http.ResponseWriter, r *http.Request) {
 
 	logger.Info("---------------------------------- ListHandler.UpdateOrder SUCCESS ----------------------------------")
 }
+
+// @Summary Отсортировать задания списка
+// @Description Переставить задания списка по ключу by: priority -- сначала самые важные, due -- сначала ближайшие сроки, name -- по алфавиту, created -- сначала старые; reverse переворачивает порядок. Задания без срока всегда в конце, новый порядок сохраняется
+// @Tags lists
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param sortInfo body dto.ListSortInfo true "id списка, ключ сортировки и обратный ли порядок"
+//
+// @Success 204  {string}  string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse "неизвестный ключ сортировки"
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /list/sort/ [post]
+func (lh ListHandler) SortTasks(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "ListHandler.SortTasks"
+	errorMessage := "Sorting list tasks failed with error: "
+	failBorder := "---------------------------------- ListHandler.SortTasks FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- ListHandler.SortTasks ----------------------------------")
+
+	var sortInfo dto.ListSortInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &sortInfo)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	err = lh.ls.SortTasks(rCtx, sortInfo)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("list tasks sorted", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- ListHandler.SortTasks SUCCESS ----------------------------------")
+}
+
+// @Summary Перенести список на другую доску
+// @Description Перенести список со всеми заданиями на доску board_id после списка after_id. Тэги заданий заменяются на тэги доски с теми же названиями (недостающие создаются), исполнители, не состоящие в доске, снимаются, значения чужих пользовательских полей удаляются; всё это перечислено в отчёте
+// @Tags lists
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param transferInfo body dto.ListTransferInfo true "id списка, id целевой доски и id списка, после которого он встаёт"
+//
+// @Success 200  {object}  doc_structs.ListTransferResponse "отчёт о переносе"
+// @Failure 400  {object}  apperrors.ErrorResponse "список уже на этой доске или неверная позиция"
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 409  {object}  apperrors.ErrorResponse "в списке больше заданий, чем позволяет жёсткий лимит"
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /list/move/ [post]
+func (lh ListHandler) Move(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "ListHandler.Move"
+	errorMessage := "Moving list failed with error: "
+	failBorder := "---------------------------------- Moving list FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- Moving list ----------------------------------")
+
+	var transferInfo dto.ListTransferInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &transferInfo)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	report, err := lh.ls.Move(rCtx, transferInfo)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("list moved", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"report": report,
+		},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- Moving list SUCCESS ----------------------------------")
+}
+
+// @Summary Скопировать список
+// @Description Скопировать список с заданиями, их чеклистами, тэгами и исполнителями на доску board_id после списка after_id. Задания копии приводятся к доске так же, как при переносе; комментарии и файлы не копируются
+// @Tags lists
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param transferInfo body dto.ListTransferInfo true "id списка, id целевой доски и id списка, после которого он встаёт"
+//
+// @Success 200  {object}  doc_structs.ListTransferResponse "отчёт о копировании с id нового списка"
+// @Failure 400  {object}  apperrors.ErrorResponse "неверная позиция"
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 409  {object}  apperrors.ErrorResponse "в списке больше заданий, чем позволяет жёсткий лимит"
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /list/copy/ [post]
+func (lh ListHandler) Copy(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "ListHandler.Copy"
+	errorMessage := "Copying list failed with error: "
+	failBorder := "---------------------------------- Copying list FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- Copying list ----------------------------------")
+
+	var transferInfo dto.ListTransferInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &transferInfo)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	report, err := lh.ls.Copy(rCtx, transferInfo)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("list copied", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"report": report,
+		},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- Copying list SUCCESS ----------------------------------")
+}
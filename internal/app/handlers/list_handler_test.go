@@ -32,6 +32,8 @@ func createListMux(
 			r.Post("/create/", ListHandler.Create)
 			r.Post("/edit/", ListHandler.Update)
 			r.Delete("/delete/", ListHandler.Delete)
+			r.Post("/move/", ListHandler.Move)
+			r.Post("/archive/", ListHandler.Archive)
 		})
 	})
 	return mux, nil
@@ -217,7 +219,7 @@ func TestListHandler_Update(t *testing.T) {
 					cls.
 						EXPECT().
 						Update(gomock.Any(), args.info).
-						Return(nil)
+						Return(&dto.SingleListInfo{ID: args.info.ID, Name: args.info.Name, RowVersion: 2}, nil)
 
 					body := bytes.NewReader([]byte(fmt.Sprintf(`{"id":%v, "name":"%s", "list_position":%v}`,
 						args.info.ID, args.info.Name, args.info.ListPosition)))
@@ -270,7 +272,7 @@ func TestListHandler_Update(t *testing.T) {
 					cls.
 						EXPECT().
 						Update(gomock.Any(), args.info).
-						Return(apperrors.ErrListNotUpdated)
+						Return(nil, apperrors.ErrListNotUpdated)
 
 					body := bytes.NewReader([]byte(fmt.Sprintf(`{"id":%v, "name":"%s", "list_position":%v}`,
 						args.info.ID, args.info.Name, args.info.ListPosition)))
@@ -290,6 +292,38 @@ func TestListHandler_Update(t *testing.T) {
 			wantErr:      true,
 			expectedCode: http.StatusInternalServerError,
 		},
+		{
+			name: "Conflict (stale row version)",
+			args: args{
+				info: dto.UpdatedListInfo{
+					ID:           uint64(1),
+					Name:         "Mock updated List info",
+					ListPosition: uint64(1),
+				},
+				expectations: func(cls *mock_service.MockIListService, args args) *http.Request {
+					cls.
+						EXPECT().
+						Update(gomock.Any(), args.info).
+						Return(&dto.SingleListInfo{ID: args.info.ID, Name: args.info.Name, RowVersion: 2}, apperrors.ErrRowVersionConflict)
+
+					body := bytes.NewReader([]byte(fmt.Sprintf(`{"id":%v, "name":"%s", "list_position":%v}`,
+						args.info.ID, args.info.Name, args.info.ListPosition)))
+
+					r := httptest.
+						NewRequest("POST", "/api/v2/list/edit/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+
+					return r
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusConflict,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -443,3 +477,206 @@ func TestListHandler_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestListHandler_Move(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		info         dto.ListTransferInfo
+		expectations func(cls *mock_service.MockIListService, args args) *http.Request
+	}
+	tests := []struct {
+		name         string
+		args         args
+		expectedCode int
+	}{
+		{
+			name: "Successful move",
+			args: args{
+				info: dto.ListTransferInfo{ListID: 1, BoardID: 4},
+				expectations: func(cls *mock_service.MockIListService, args args) *http.Request {
+					cls.
+						EXPECT().
+						Move(gomock.Any(), args.info).
+						Return(&dto.ListTransferReport{ListID: 1, BoardID: 4}, nil)
+
+					body := bytes.NewReader([]byte(fmt.Sprintf(`{"list_id":%v,"board_id":%v}`,
+						args.info.ListID, args.info.BoardID)))
+
+					return httptest.
+						NewRequest("POST", "/api/v2/list/move/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "No access to target board",
+			args: args{
+				info: dto.ListTransferInfo{ListID: 1, BoardID: 4},
+				expectations: func(cls *mock_service.MockIListService, args args) *http.Request {
+					cls.
+						EXPECT().
+						Move(gomock.Any(), args.info).
+						Return(nil, apperrors.ErrNoBoardAccess)
+
+					body := bytes.NewReader([]byte(fmt.Sprintf(`{"list_id":%v,"board_id":%v}`,
+						args.info.ListID, args.info.BoardID)))
+
+					return httptest.
+						NewRequest("POST", "/api/v2/list/move/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name: "Already on board",
+			args: args{
+				info: dto.ListTransferInfo{ListID: 1, BoardID: 3},
+				expectations: func(cls *mock_service.MockIListService, args args) *http.Request {
+					cls.
+						EXPECT().
+						Move(gomock.Any(), args.info).
+						Return(nil, apperrors.ErrListAlreadyOnBoard)
+
+					body := bytes.NewReader([]byte(fmt.Sprintf(`{"list_id":%v,"board_id":%v}`,
+						args.info.ListID, args.info.BoardID)))
+
+					return httptest.
+						NewRequest("POST", "/api/v2/list/move/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockListService := mock_service.NewMockIListService(ctrl)
+
+			testRequest := tt.args.expectations(mockListService, tt.args)
+
+			mux, err := createListMux(mockListService)
+			require.Equal(t, nil, err)
+
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, testRequest)
+
+			status := w.Result().StatusCode
+
+			require.EqualValuesf(t, tt.expectedCode, status,
+				"Expected code %d (%s), received code %d (%s)",
+				tt.expectedCode, http.StatusText(tt.expectedCode),
+				w.Code, http.StatusText(w.Code))
+		})
+	}
+}
+
+func TestListHandler_Archive(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ListID       dto.ListID
+		expectations func(cls *mock_service.MockIListService, args args) *http.Request
+	}
+	tests := []struct {
+		name         string
+		args         args
+		expectedCode int
+	}{
+		{
+			name: "Successful archive",
+			args: args{
+				ListID: dto.ListID{Value: 1},
+				expectations: func(cls *mock_service.MockIListService, args args) *http.Request {
+					cls.
+						EXPECT().
+						Archive(gomock.Any(), args.ListID).
+						Return(nil)
+
+					body := bytes.NewReader([]byte(fmt.Sprintf(`{"id":%v}`, args.ListID.Value)))
+
+					return httptest.
+						NewRequest("POST", "/api/v2/list/archive/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "List not found",
+			args: args{
+				ListID: dto.ListID{Value: 1},
+				expectations: func(cls *mock_service.MockIListService, args args) *http.Request {
+					cls.
+						EXPECT().
+						Archive(gomock.Any(), args.ListID).
+						Return(apperrors.ErrListNotFound)
+
+					body := bytes.NewReader([]byte(fmt.Sprintf(`{"id":%v}`, args.ListID.Value)))
+
+					return httptest.
+						NewRequest("POST", "/api/v2/list/archive/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+				},
+			},
+			expectedCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockListService := mock_service.NewMockIListService(ctrl)
+
+			testRequest := tt.args.expectations(mockListService, tt.args)
+
+			mux, err := createListMux(mockListService)
+			require.Equal(t, nil, err)
+
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, testRequest)
+
+			status := w.Result().StatusCode
+
+			require.EqualValuesf(t, tt.expectedCode, status,
+				"Expected code %d (%s), received code %d (%s)",
+				tt.expectedCode, http.StatusText(tt.expectedCode),
+				w.Code, http.StatusText(w.Code))
+		})
+	}
+}
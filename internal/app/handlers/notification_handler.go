@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"net/http"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	_ "server/internal/pkg/doc_structs"
+	"server/internal/pkg/dto"
+	"server/internal/pkg/entities"
+	"server/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/mailru/easyjson"
+)
+
+type NotificationHandler struct {
+	ns service.INotificationService
+}
+
+// @Summary Получить уведомления
+// @Description Возвращает уведомления пользователя, начиная с новых. Сроки показываются в часовом поясе пользователя
+// @Tags notifications
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param notificationsRequest body dto.NotificationsRequest true "только непрочитанные и максимальное количество (по умолчанию 50, не больше 200)"
+//
+// @Success 200  {object}  doc_structs.NotificationsResponse "уведомления"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /user/notifications/ [post]
+func (nh NotificationHandler) GetNotifications(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "NotificationHandler.GetNotifications"
+	errorMessage := "Getting notifications failed with error: "
+	failBorder := "---------------------------------- NotificationHandler.GetNotifications FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- NotificationHandler.GetNotifications ----------------------------------")
+
+	var request dto.NotificationsRequest
+	err := easyjson.UnmarshalFromReader(r.Body, &request)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	user, ok := rCtx.Value(dto.UserObjKey).(*entities.User)
+	if !ok {
+		logger.Error(errorMessage + "No user object found")
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.GenericUnauthorizedResponse, w, r)
+		return
+	}
+	logger.DebugFmt("User object acquired from context", requestID.String(), funcName, nodeName)
+
+	request.UserID = user.ID
+	notifications, err := nh.ns.GetNotifications(rCtx, request)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("notifications received", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"notifications": notifications,
+		},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- NotificationHandler.GetNotifications SUCCESS ----------------------------------")
+}
+
+// @Summary Прочитать уведомления
+// @Description Отмечает прочитанными перечисленные уведомления пользователя, а без ids -- все. В ответ ничего не шлёт
+// @Tags notifications
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param readInfo body dto.NotificationsReadInfo true "id уведомлений"
+//
+// @Success 200  {string} string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /user/notifications/read/ [post]
+func (nh NotificationHandler) MarkRead(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "NotificationHandler.MarkRead"
+	errorMessage := "Marking notifications as read failed with error: "
+	failBorder := "---------------------------------- NotificationHandler.MarkRead FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- NotificationHandler.MarkRead ----------------------------------")
+
+	var readInfo dto.NotificationsReadInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &readInfo)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	user, ok := rCtx.Value(dto.UserObjKey).(*entities.User)
+	if !ok {
+		logger.Error(errorMessage + "No user object found")
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.GenericUnauthorizedResponse, w, r)
+		return
+	}
+	logger.DebugFmt("User object acquired from context", requestID.String(), funcName, nodeName)
+
+	readInfo.UserID = user.ID
+	err = nh.ns.MarkRead(rCtx, readInfo)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("notifications marked as read", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- NotificationHandler.MarkRead SUCCESS ----------------------------------")
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"server/internal/apperrors"
 	logger "server/internal/logging"
@@ -27,9 +28,10 @@ type TaskHandler struct {
 //
 // @Param newTaskInfo body dto.NewTaskInfo true "данные нового задания"
 //
-// @Success 200  {object}  doc_structs.TaskResponse "объект задания"
+// @Success 200  {object}  doc_structs.TaskResponse "объект задания и предупреждение о превышении мягкого лимита задач"
 // @Failure 400  {object}  apperrors.ErrorResponse
 // @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 409  {object}  apperrors.ErrorResponse
 // @Failure 500  {object}  apperrors.ErrorResponse
 //
 // @Router /task/create/ [post]
@@ -54,7 +56,7 @@ func (th TaskHandler) Create(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.DebugFmt("JSON Decoded", requestID.String(), funcName, nodeName)
 
-	task, err := th.ts.Create(rCtx, newTaskInfo)
+	task, warning, err := th.ts.Create(rCtx, newTaskInfo)
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
 		logger.Info(failBorder)
@@ -63,10 +65,14 @@ func (th TaskHandler) Create(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.DebugFmt("Task created", requestID.String(), funcName, nodeName)
 
+	body := dto.JSONMap{
+		"task": task,
+	}
+	if warning != nil {
+		body["wip_warning"] = warning
+	}
 	response := dto.JSONResponse{
-		Body: dto.JSONMap{
-			"task": task,
-		},
+		Body: body,
 	}
 	err = WriteResponse(response, w, r)
 	if err != nil {
@@ -143,7 +149,7 @@ func (th TaskHandler) Read(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary Обновить задание
-// @Description Обновить задание
+// @Description Обновить задание. В row_version передаётся версия задания, полученная клиентом; если с тех пор задание изменили, возвращается 409 с его текущим состоянием
 // @Tags tasks
 //
 // @Accept  json
@@ -151,9 +157,10 @@ func (th TaskHandler) Read(w http.ResponseWriter, r *http.Request) {
 //
 // @Param taskInfo body dto.UpdatedTaskInfo true "обновленные данные задания"
 //
-// @Success 204  {string}  string "no content"
+// @Success 200  {object}  doc_structs.TaskUpdateResponse "текущее состояние задания"
 // @Failure 400  {object}  apperrors.ErrorResponse
 // @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 409  {object}  doc_structs.TaskConflictResponse "задание уже изменено, в ответе его текущее состояние"
 // @Failure 500  {object}  apperrors.ErrorResponse
 //
 // @Router /task/update/ [post]
@@ -178,7 +185,15 @@ func (th TaskHandler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.DebugFmt("JSON Decoded", requestID.String(), funcName, nodeName)
 
-	err = th.ts.Update(rCtx, taskInfo)
+	task, err := th.ts.Update(rCtx, taskInfo)
+	if errors.Is(err, apperrors.ErrRowVersionConflict) {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		if err = WriteConflictResponse("task", task, w, r); err != nil {
+			logger.Error(errorMessage + err.Error())
+		}
+		return
+	}
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
 		logger.Info(failBorder)
@@ -188,7 +203,9 @@ func (th TaskHandler) Update(w http.ResponseWriter, r *http.Request) {
 	logger.DebugFmt("task updated", requestID.String(), funcName, nodeName)
 
 	response := dto.JSONResponse{
-		Body: dto.JSONMap{},
+		Body: dto.JSONMap{
+			"task": task,
+		},
 	}
 	err = WriteResponse(response, w, r)
 	if err != nil {
@@ -203,13 +220,13 @@ func (th TaskHandler) Update(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary Удалить задание
-// @Description Удалить задание
+// @Description Удалить задание. При cascade вместе с ним удаляются все его подзадачи, иначе они остаются без родителя
 // @Tags tasks
 //
 // @Accept  json
 // @Produce  json
 //
-// @Param taskID body dto.TaskID true "id задания"
+// @Param cascadeInfo body dto.TaskCascadeInfo true "id задания и нужно ли удалить его подзадачи"
 //
 // @Success 204  {string}  string "no content"
 // @Failure 400  {object}  apperrors.ErrorResponse
@@ -228,8 +245,8 @@ func (th TaskHandler) Delete(w http.ResponseWriter, r *http.Request) {
 
 	logger.Info("---------------------------------- Deleting task ----------------------------------")
 
-	var taskID dto.TaskID
-	err := easyjson.UnmarshalFromReader(r.Body, &taskID)
+	var info dto.TaskCascadeInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &info)
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
 		logger.Info(failBorder)
@@ -238,7 +255,7 @@ func (th TaskHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.DebugFmt("JSON Decoded", requestID.String(), funcName, nodeName)
 
-	err = th.ts.Delete(rCtx, taskID)
+	err = th.ts.Delete(rCtx, info)
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
 		logger.Info(failBorder)
@@ -262,6 +279,134 @@ func (th TaskHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	logger.Info("---------------------------------- Deleting task SUCCESS ----------------------------------")
 }
 
+// @Summary Перенести задание в архив
+// @Description Скрыть задание с доски, не удаляя его. Архивные задания видны в архиве доски.
+// @Description При cascade в архив переносятся и все подзадачи задания
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param cascadeInfo body dto.TaskCascadeInfo true "id задания и нужно ли перенести в архив его подзадачи"
+//
+// @Success 204  {string}  string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/archive/ [post]
+func (th TaskHandler) Archive(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.Archive"
+	errorMessage := "Archiving task failed with error: "
+	failBorder := "---------------------------------- Archiving task FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- Archiving task ----------------------------------")
+
+	var info dto.TaskCascadeInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	err = th.ts.Archive(rCtx, info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("task archived", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- Archiving task SUCCESS ----------------------------------")
+}
+
+// @Summary Вернуть задание из архива
+// @Description Вернуть архивное задание в его список на прежнее место; жёсткий лимит задач списка и блокирующие задания проверяются так же, как при переносе
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param taskID body dto.TaskID true "id задания"
+//
+// @Success 200  {object}  doc_structs.WipWarningResponse "предупреждение о превышении мягкого лимита задач, если он превышен"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 409  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/unarchive/ [post]
+func (th TaskHandler) Unarchive(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.Unarchive"
+	errorMessage := "Unarchiving task failed with error: "
+	failBorder := "---------------------------------- Unarchiving task FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- Unarchiving task ----------------------------------")
+
+	var taskID dto.TaskID
+	err := easyjson.UnmarshalFromReader(r.Body, &taskID)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	warning, err := th.ts.Unarchive(rCtx, taskID)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("task unarchived", requestID.String(), funcName, nodeName)
+
+	body := dto.JSONMap{}
+	if warning != nil {
+		body["wip_warning"] = warning
+	}
+	response := dto.JSONResponse{
+		Body: body,
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- Unarchiving task SUCCESS ----------------------------------")
+}
+
 // @Summary Добавить пользователя на карточку
 // @Description Добавить пользователя на карточку
 // @Tags tasks
@@ -382,18 +527,81 @@ func (th TaskHandler) RemoveUser(w http.ResponseWriter, r *http.Request) {
 	logger.Info("---------------------------------- Removing user from task SUCCESS ----------------------------------")
 }
 
+// @Summary Задать значение пользовательского поля
+// @Description Задать значение пользовательского поля доски у карточки. Значение проверяется по типу поля; null или пустое значение удаляет его
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param valueInfo body dto.CustomFieldValueInfo true "ID карточки, ID поля и значение"
+//
+// @Success 200  {string}  string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/custom_field/set/ [post]
+func (th TaskHandler) SetCustomField(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.SetCustomField"
+	errorMessage := "Setting custom field value failed with error: "
+	failBorder := "---------------------------------- Setting custom field value FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- Setting custom field value ----------------------------------")
+
+	var info dto.CustomFieldValueInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("JSON Decoded", requestID.String(), funcName, nodeName)
+
+	err = th.ts.SetCustomFieldValue(rCtx, info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("Custom field value set", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("Response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- Setting custom field value SUCCESS ----------------------------------")
+}
+
 // @Summary Перенести задание в другой список
-// @Description Меняет порядок у заданий в старом и новом списках и меняет связь задания со списком
+// @Description Ставит задание в список list_id сразу после задания after_id (без after_id -- первым); порядок остальных заданий не меняется
 // @Tags tasks
 //
 // @Accept  json
 // @Produce  json
 //
-// @Param taskMoveInfo body dto.TaskMoveInfo true "id заданий из обоих списков и id нового списка"
+// @Param taskMoveInfo body dto.TaskMoveInfo true "id задания, id списка той же доски и id задания, после которого оно встаёт"
 //
-// @Success 204  {string}  string "no content"
+// @Success 200  {object}  doc_structs.WipWarningResponse "предупреждение о превышении мягкого лимита задач, если он превышен"
 // @Failure 400  {object}  apperrors.ErrorResponse
 // @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 409  {object}  apperrors.ErrorResponse
 // @Failure 500  {object}  apperrors.ErrorResponse
 //
 // @Router /task/move/ [post]
@@ -418,7 +626,7 @@ func (th TaskHandler) Move(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
 
-	err = th.ts.Move(rCtx, taskMoveInfo)
+	warning, err := th.ts.Move(rCtx, taskMoveInfo)
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
 		logger.Info(failBorder)
@@ -427,8 +635,12 @@ func (th TaskHandler) Move(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.DebugFmt("task order changed", requestID.String(), funcName, nodeName)
 
+	body := dto.JSONMap{}
+	if warning != nil {
+		body["wip_warning"] = warning
+	}
 	response := dto.JSONResponse{
-		Body: dto.JSONMap{},
+		Body: body,
 	}
 	err = WriteResponse(response, w, r)
 	if err != nil {
@@ -442,34 +654,37 @@ func (th TaskHandler) Move(w http.ResponseWriter, r *http.Request) {
 	logger.Info("---------------------------------- TaskHandler.UpdateOrder SUCCESS ----------------------------------")
 }
 
-// @Summary Получить список прикреплённых к файлу заданий
-// @Description Получает актуальный список файлов, прикреплённых к полученному заданию
+// @Summary Перенести задание на другую доску
+// @Description Переносит задание в список list_id другой доски после задания after_id (без after_id -- первым). Чеклисты, комментарии и файлы остаются у задания; тэги заменяются на тэги доски с теми же названиями (недостающие создаются), исполнители, не состоящие в доске, снимаются, значения чужих пользовательских полей удаляются; всё это перечислено в отчёте
 // @Tags tasks
 //
 // @Accept  json
 // @Produce  json
 //
-// @Param taskID body dto.TaskID true "id задания"
+// @Param transferInfo body dto.TaskTransferInfo true "id задания, id списка другой доски и id задания, после которого оно встаёт"
 //
-// @Success 200  {object}  doc_structs.FileListResponse "список объектов файлов"
-// @Failure 400  {object}  apperrors.ErrorResponse
+// @Success 200  {object}  doc_structs.TaskTransferResponse "отчёт о переносе и предупреждение о превышении мягкого лимита задач, если он превышен"
+// @Failure 400  {object}  apperrors.ErrorResponse "задание уже на этой доске или неверная позиция"
 // @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 409  {object}  apperrors.ErrorResponse
 // @Failure 500  {object}  apperrors.ErrorResponse
 //
-// @Router /task/file/ [post]
-func (th TaskHandler) GetFileList(w http.ResponseWriter, r *http.Request) {
+// @Router /task/move/board/ [post]
+func (th TaskHandler) MoveToBoard(w http.ResponseWriter, r *http.Request) {
 	rCtx := r.Context()
-	funcName := "TaskHandler.GetFileList"
-	errorMessage := "Getting file list failed with error: "
-	failBorder := "---------------------------------- TaskHandler.GetFileList FAIL ----------------------------------"
+	funcName := "TaskHandler.MoveToBoard"
+	errorMessage := "Moving task to another board failed with error: "
+	failBorder := "---------------------------------- TaskHandler.MoveToBoard FAIL ----------------------------------"
 
 	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
 	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
 
-	logger.Info("---------------------------------- TaskHandler.GetFileList ----------------------------------")
+	logger.Info("---------------------------------- TaskHandler.MoveToBoard ----------------------------------")
 
-	var taskID dto.TaskID
-	err := easyjson.UnmarshalFromReader(r.Body, &taskID)
+	var transferInfo dto.TaskTransferInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &transferInfo)
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
 		logger.Info(failBorder)
@@ -478,19 +693,23 @@ func (th TaskHandler) GetFileList(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
 
-	fileList, err := th.ts.GetFileList(rCtx, taskID)
+	report, warning, err := th.ts.MoveToBoard(rCtx, transferInfo)
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
 		logger.Info(failBorder)
 		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
 		return
 	}
-	logger.DebugFmt("got file list", requestID.String(), funcName, nodeName)
+	logger.DebugFmt("task moved", requestID.String(), funcName, nodeName)
 
+	body := dto.JSONMap{
+		"report": report,
+	}
+	if warning != nil {
+		body["wip_warning"] = warning
+	}
 	response := dto.JSONResponse{
-		Body: dto.JSONMap{
-			"files": fileList,
-		},
+		Body: body,
 	}
 	err = WriteResponse(response, w, r)
 	if err != nil {
@@ -501,38 +720,40 @@ func (th TaskHandler) GetFileList(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
 
-	logger.Info("---------------------------------- Getting file list SUCCESS ----------------------------------")
+	logger.Info("---------------------------------- TaskHandler.MoveToBoard SUCCESS ----------------------------------")
 }
 
-// @Summary Прикрепить файл к заданию
-// @Description Сохраняет полученный файл, возвращает оригинальное название и путь к файлу
+// @Summary Скопировать задание
+// @Description Копирует задание в список list_id после задания after_id (без after_id -- первым). В parts выбирается, что копировать кроме названия: описание, чеклисты с элементами, тэги, исполнителей, даты, файлы (как новые ссылки на те же файлы) и комментарии. Копия в списке другой доски приводится к ней так же, как при переносе
 // @Tags tasks
 //
 // @Accept  json
 // @Produce  json
 //
-// @Param newFileInfo body dto.NewFileInfo true "файл и информация о нём"
+// @Param copyInfo body dto.TaskCopyInfo true "id задания, id списка, id задания, после которого встаёт копия, и копируемые части"
 //
-// @Success 200  {object}  doc_structs.FileResponse "объект с информацией о сохранённом файле"
-// @Failure 400  {object}  apperrors.ErrorResponse
+// @Success 200  {object}  doc_structs.TaskTransferResponse "отчёт о копировании с id копии и предупреждение о превышении мягкого лимита задач, если он превышен"
+// @Failure 400  {object}  apperrors.ErrorResponse "неверная позиция"
 // @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 409  {object}  apperrors.ErrorResponse
 // @Failure 500  {object}  apperrors.ErrorResponse
 //
-// @Router /task/file/attach/ [post]
-func (th TaskHandler) AttachFile(w http.ResponseWriter, r *http.Request) {
+// @Router /task/copy/ [post]
+func (th TaskHandler) Copy(w http.ResponseWriter, r *http.Request) {
 	rCtx := r.Context()
-	funcName := "TaskHandler.Attach"
-	errorMessage := "Attaching file failed with error: "
-	failBorder := "---------------------------------- TaskHandler.Attach FAIL ----------------------------------"
+	funcName := "TaskHandler.Copy"
+	errorMessage := "Copying task failed with error: "
+	failBorder := "---------------------------------- TaskHandler.Copy FAIL ----------------------------------"
 
 	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
 	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
 
-	logger.Info("---------------------------------- TaskHandler.Attach ----------------------------------")
+	logger.Info("---------------------------------- TaskHandler.Copy ----------------------------------")
 
-	var newFileInfo dto.NewFileInfo
-	// err := easyjson.UnmarshalFromReader(r.Body, &newFileInfo)
-	err := json.NewDecoder(r.Body).Decode(&newFileInfo)
+	var copyInfo dto.TaskCopyInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &copyInfo)
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
 		logger.Info(failBorder)
@@ -541,30 +762,23 @@ func (th TaskHandler) AttachFile(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
 
-	user, ok := rCtx.Value(dto.UserObjKey).(*entities.User)
-	if !ok {
-		logger.Error(errorMessage + "User not found")
-		logger.Info(failBorder)
-		apperrors.ReturnError(apperrors.GenericUnauthorizedResponse, w, r)
-		return
-	}
-	logger.DebugFmt("User found", requestID.String(), funcName, nodeName)
-
-	newFileInfo.UserID = user.ID
-
-	fileData, err := th.ts.Attach(rCtx, newFileInfo)
+	report, warning, err := th.ts.Copy(rCtx, copyInfo)
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
 		logger.Info(failBorder)
 		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
 		return
 	}
-	logger.DebugFmt("file attached", requestID.String(), funcName, nodeName)
+	logger.DebugFmt("task copied", requestID.String(), funcName, nodeName)
 
+	body := dto.JSONMap{
+		"report": report,
+	}
+	if warning != nil {
+		body["wip_warning"] = warning
+	}
 	response := dto.JSONResponse{
-		Body: dto.JSONMap{
-			"file": fileData,
-		},
+		Body: body,
 	}
 	err = WriteResponse(response, w, r)
 	if err != nil {
@@ -575,37 +789,39 @@ func (th TaskHandler) AttachFile(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
 
-	logger.Info("---------------------------------- Attaching file SUCCESS ----------------------------------")
+	logger.Info("---------------------------------- TaskHandler.Copy SUCCESS ----------------------------------")
 }
 
-// @Summary Удалить файл из задания
-// @Description Удаляет указанный файл и открепляет его от задания
+// @Summary Задать напоминания задания
+// @Description Задаёт смещения напоминаний о сроке в минутах (от 1 минуты до 30 дней, не больше 5). Пустой список возвращает напоминания по умолчанию из профилей исполнителей
 // @Tags tasks
 //
 // @Accept  json
 // @Produce  json
 //
-// @Param removeFileInfo body dto.RemoveFileInfo true "информация об удаляемом файле"
+// @Param remindersInfo body dto.TaskRemindersInfo true "id задания и смещения напоминаний в минутах до срока"
 //
-// @Success 204  {string}  string "no content"
+// @Success 200  {object}  doc_structs.RemindersResponse "сохранённые смещения"
 // @Failure 400  {object}  apperrors.ErrorResponse
 // @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
 // @Failure 500  {object}  apperrors.ErrorResponse
 //
-// @Router /task/file/remove/ [delete]
-func (th TaskHandler) RemoveFile(w http.ResponseWriter, r *http.Request) {
+// @Router /task/reminders/ [post]
+func (th TaskHandler) SetReminders(w http.ResponseWriter, r *http.Request) {
 	rCtx := r.Context()
-	funcName := "TaskHandler.Remove"
-	errorMessage := "Removing file failed with error: "
-	failBorder := "---------------------------------- TaskHandler.Remove FAIL ----------------------------------"
+	funcName := "TaskHandler.SetReminders"
+	errorMessage := "Setting task reminders failed with error: "
+	failBorder := "---------------------------------- TaskHandler.SetReminders FAIL ----------------------------------"
 
 	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
 	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
 
-	logger.Info("---------------------------------- TaskHandler.Remove ----------------------------------")
+	logger.Info("---------------------------------- TaskHandler.SetReminders ----------------------------------")
 
-	var fileInfo dto.RemoveFileInfo
-	err := easyjson.UnmarshalFromReader(r.Body, &fileInfo)
+	var remindersInfo dto.TaskRemindersInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &remindersInfo)
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
 		logger.Info(failBorder)
@@ -614,26 +830,19 @@ func (th TaskHandler) RemoveFile(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
 
-	_, ok := rCtx.Value(dto.UserObjKey).(*entities.User)
-	if !ok {
-		logger.Error(errorMessage + "User not found")
-		logger.Info(failBorder)
-		apperrors.ReturnError(apperrors.GenericUnauthorizedResponse, w, r)
-		return
-	}
-	logger.DebugFmt("User found", requestID.String(), funcName, nodeName)
-
-	err = th.ts.Remove(rCtx, fileInfo)
+	offsets, err := th.ts.SetReminders(rCtx, remindersInfo)
 	if err != nil {
 		logger.Error(errorMessage + err.Error())
 		logger.Info(failBorder)
 		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
 		return
 	}
-	logger.DebugFmt("file removed", requestID.String(), funcName, nodeName)
+	logger.DebugFmt("task reminders set", requestID.String(), funcName, nodeName)
 
 	response := dto.JSONResponse{
-		Body: dto.JSONMap{},
+		Body: dto.JSONMap{
+			"reminders": offsets,
+		},
 	}
 	err = WriteResponse(response, w, r)
 	if err != nil {
@@ -644,5 +853,1270 @@ func (th TaskHandler) RemoveFile(w http.ResponseWriter, r *http.Request) {
 	}
 	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
 
-	logger.Info("---------------------------------- Removing file SUCCESS ----------------------------------")
+	logger.Info("---------------------------------- TaskHandler.SetReminders SUCCESS ----------------------------------")
+}
+
+// @Summary Задать повторение задания
+// @Description Задаёт правило повторения в виде подмножества RRULE: FREQ=DAILY|WEEKLY|MONTHLY, INTERVAL, BYDAY (для WEEKLY), BYMONTHDAY (для MONTHLY) и UNTIL.
+// @Description Задание должно иметь срок. Когда текущее задание серии выполнено или наступил его срок, создаётся следующее с описанием,
+// @Description чеклистами с неотмеченными элементами, тэгами и исполнителями. Для задания из серии меняет правило серии и возобновляет остановленную серию
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param recurrenceRequest body dto.TaskRecurrenceRequest true "id задания и правило повторения, например FREQ=WEEKLY;BYDAY=MO,TH"
+//
+// @Success 200  {object}  doc_structs.RecurrenceResponse "правило в каноническом виде"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/recurrence/set/ [post]
+func (th TaskHandler) SetRecurrence(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.SetRecurrence"
+	errorMessage := "Setting task recurrence failed with error: "
+	failBorder := "---------------------------------- TaskHandler.SetRecurrence FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- TaskHandler.SetRecurrence ----------------------------------")
+
+	var request dto.TaskRecurrenceRequest
+	err := easyjson.UnmarshalFromReader(r.Body, &request)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	rule, err := th.ts.SetRecurrence(rCtx, request)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("task recurrence set", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"rule": rule,
+		},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- TaskHandler.SetRecurrence SUCCESS ----------------------------------")
+}
+
+// @Summary Остановить повторение задания
+// @Description Останавливает серию повторений, к которой относится задание. Созданные задания серии остаются. В ответ ничего не шлёт
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param taskID body dto.TaskID true "id задания серии"
+//
+// @Success 200  {string}  string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/recurrence/stop/ [post]
+func (th TaskHandler) StopRecurrence(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.StopRecurrence"
+	errorMessage := "Stopping task recurrence failed with error: "
+	failBorder := "---------------------------------- TaskHandler.StopRecurrence FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- TaskHandler.StopRecurrence ----------------------------------")
+
+	var taskID dto.TaskID
+	err := easyjson.UnmarshalFromReader(r.Body, &taskID)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	err = th.ts.StopRecurrence(rCtx, taskID)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("task recurrence stopped", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- TaskHandler.StopRecurrence SUCCESS ----------------------------------")
+}
+
+// @Summary Связать задания зависимостью
+// @Description Делает первое задание блокирующим для второго. Задания могут лежать на разных досках одного рабочего пространства, связь, замыкающая цикл, отклоняется. В ответ ничего не шлёт
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param dependencyInfo body dto.TaskDependencyInfo true "id блокирующего и блокируемого заданий"
+//
+// @Success 200  {string}  string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 409  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/dependency/add/ [post]
+func (th TaskHandler) Link(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.Link"
+	errorMessage := "Linking tasks failed with error: "
+	failBorder := "---------------------------------- TaskHandler.Link FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- TaskHandler.Link ----------------------------------")
+
+	var info dto.TaskDependencyInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	err = th.ts.Link(rCtx, info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("tasks linked", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- TaskHandler.Link SUCCESS ----------------------------------")
+}
+
+// @Summary Удалить зависимость между заданиями
+// @Description Удаляет связь, в которой первое задание блокирует второе. В ответ ничего не шлёт
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param dependencyInfo body dto.TaskDependencyInfo true "id блокирующего и блокируемого заданий"
+//
+// @Success 200  {string}  string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/dependency/remove/ [post]
+func (th TaskHandler) Unlink(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.Unlink"
+	errorMessage := "Unlinking tasks failed with error: "
+	failBorder := "---------------------------------- TaskHandler.Unlink FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- TaskHandler.Unlink ----------------------------------")
+
+	var info dto.TaskDependencyInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	err = th.ts.Unlink(rCtx, info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("tasks unlinked", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- TaskHandler.Unlink SUCCESS ----------------------------------")
+}
+
+// @Summary Сделать задание подзадачей
+// @Description Привязывает задание к родительскому заданию той же доски; прежний родитель заменяется. Привязка, замыкающая цикл, отклоняется. В ответ ничего не шлёт
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param subtaskInfo body dto.SubtaskInfo true "id родительского задания и подзадачи"
+//
+// @Success 200  {string}  string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 409  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/subtask/attach/ [post]
+func (th TaskHandler) AttachSubtask(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.AttachSubtask"
+	errorMessage := "Attaching subtask failed with error: "
+	failBorder := "---------------------------------- TaskHandler.AttachSubtask FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- TaskHandler.AttachSubtask ----------------------------------")
+
+	var info dto.SubtaskInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	err = th.ts.AttachSubtask(rCtx, info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("subtask attached", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- TaskHandler.AttachSubtask SUCCESS ----------------------------------")
+}
+
+// @Summary Отвязать подзадачу
+// @Description Отвязывает задание от родительского задания, само задание остаётся на месте. В ответ ничего не шлёт
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param taskID body dto.TaskID true "id подзадачи"
+//
+// @Success 200  {string}  string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/subtask/detach/ [post]
+func (th TaskHandler) DetachSubtask(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.DetachSubtask"
+	errorMessage := "Detaching subtask failed with error: "
+	failBorder := "---------------------------------- TaskHandler.DetachSubtask FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- TaskHandler.DetachSubtask ----------------------------------")
+
+	var taskID dto.TaskID
+	err := easyjson.UnmarshalFromReader(r.Body, &taskID)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	err = th.ts.DetachSubtask(rCtx, taskID)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("subtask detached", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- TaskHandler.DetachSubtask SUCCESS ----------------------------------")
+}
+
+// @Summary Применить операцию к нескольким заданиям
+// @Description Переносит в конец списка, назначает или снимает пользователя, добавляет или убирает тэг, ставит срок,
+// @Description архивирует или удаляет задания одной доски в одной транзакции; в историю пишется одна запись на всю операцию
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param taskBulkRequest body dto.TaskBulkRequest true "id заданий, операция и её параметр"
+//
+// @Success 200  {object}  doc_structs.WipWarningResponse "предупреждение о превышении мягкого лимита задач при переносе, если он превышен"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 409  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/bulk/ [post]
+func (th TaskHandler) Bulk(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.Bulk"
+	errorMessage := "Bulk task operation failed with error: "
+	failBorder := "---------------------------------- TaskHandler.Bulk FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- TaskHandler.Bulk ----------------------------------")
+
+	var request dto.TaskBulkRequest
+	err := easyjson.UnmarshalFromReader(r.Body, &request)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	warning, err := th.ts.Bulk(rCtx, request)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("bulk operation applied", requestID.String(), funcName, nodeName)
+
+	body := dto.JSONMap{}
+	if warning != nil {
+		body["wip_warning"] = warning
+	}
+	response := dto.JSONResponse{
+		Body: body,
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- TaskHandler.Bulk SUCCESS ----------------------------------")
+}
+
+// @Summary Получить список прикреплённых к файлу заданий
+// @Description Получает актуальный список файлов, прикреплённых к полученному заданию
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param taskID body dto.TaskID true "id задания"
+//
+// @Success 200  {object}  doc_structs.FileListResponse "список объектов файлов"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/file/ [post]
+func (th TaskHandler) GetFileList(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.GetFileList"
+	errorMessage := "Getting file list failed with error: "
+	failBorder := "---------------------------------- TaskHandler.GetFileList FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- TaskHandler.GetFileList ----------------------------------")
+
+	var taskID dto.TaskID
+	err := easyjson.UnmarshalFromReader(r.Body, &taskID)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	fileList, err := th.ts.GetFileList(rCtx, taskID)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("got file list", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"files": fileList,
+		},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- Getting file list SUCCESS ----------------------------------")
+}
+
+// @Summary Прикрепить файл к заданию
+// @Description Сохраняет полученный файл, возвращает оригинальное название и путь к файлу
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param newFileInfo body dto.NewFileInfo true "файл и информация о нём"
+//
+// @Success 200  {object}  doc_structs.FileResponse "объект с информацией о сохранённом файле"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/file/attach/ [post]
+func (th TaskHandler) AttachFile(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.Attach"
+	errorMessage := "Attaching file failed with error: "
+	failBorder := "---------------------------------- TaskHandler.Attach FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- TaskHandler.Attach ----------------------------------")
+
+	var newFileInfo dto.NewFileInfo
+	// err := easyjson.UnmarshalFromReader(r.Body, &newFileInfo)
+	err := json.NewDecoder(r.Body).Decode(&newFileInfo)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	user, ok := rCtx.Value(dto.UserObjKey).(*entities.User)
+	if !ok {
+		logger.Error(errorMessage + "User not found")
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.GenericUnauthorizedResponse, w, r)
+		return
+	}
+	logger.DebugFmt("User found", requestID.String(), funcName, nodeName)
+
+	newFileInfo.UserID = user.ID
+
+	fileData, err := th.ts.Attach(rCtx, newFileInfo)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("file attached", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"file": fileData,
+		},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- Attaching file SUCCESS ----------------------------------")
+}
+
+// @Summary Удалить файл из задания
+// @Description Удаляет указанный файл и открепляет его от задания
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param removeFileInfo body dto.RemoveFileInfo true "информация об удаляемом файле"
+//
+// @Success 204  {string}  string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/file/remove/ [delete]
+func (th TaskHandler) RemoveFile(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.Remove"
+	errorMessage := "Removing file failed with error: "
+	failBorder := "---------------------------------- TaskHandler.Remove FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- TaskHandler.Remove ----------------------------------")
+
+	var fileInfo dto.RemoveFileInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &fileInfo)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	_, ok := rCtx.Value(dto.UserObjKey).(*entities.User)
+	if !ok {
+		logger.Error(errorMessage + "User not found")
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.GenericUnauthorizedResponse, w, r)
+		return
+	}
+	logger.DebugFmt("User found", requestID.String(), funcName, nodeName)
+
+	err = th.ts.Remove(rCtx, fileInfo)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("file removed", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- Removing file SUCCESS ----------------------------------")
+}
+
+// @Summary Подписаться на задание
+// @Description Подписывает пользователя на изменения задания: об изменениях задания, его комментариев и чеклистов приходят уведомления. В ответ ничего не шлёт
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param taskID body dto.TaskID true "id задания"
+//
+// @Success 200  {string}  string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/watchers/add/ [post]
+func (th TaskHandler) Watch(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.Watch"
+	errorMessage := "Watching task failed with error: "
+	failBorder := "---------------------------------- TaskHandler.Watch FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- TaskHandler.Watch ----------------------------------")
+
+	var id dto.TaskID
+	err := easyjson.UnmarshalFromReader(r.Body, &id)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	err = th.ts.Watch(rCtx, id)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("watching task done", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- TaskHandler.Watch SUCCESS ----------------------------------")
+}
+
+// @Summary Отписаться от задания
+// @Description Отписывает пользователя от изменений задания. В ответ ничего не шлёт
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param taskID body dto.TaskID true "id задания"
+//
+// @Success 200  {string}  string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/watchers/remove/ [post]
+func (th TaskHandler) Unwatch(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.Unwatch"
+	errorMessage := "Unwatching task failed with error: "
+	failBorder := "---------------------------------- TaskHandler.Unwatch FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- TaskHandler.Unwatch ----------------------------------")
+
+	var id dto.TaskID
+	err := easyjson.UnmarshalFromReader(r.Body, &id)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	err = th.ts.Unwatch(rCtx, id)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("unwatching task done", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- TaskHandler.Unwatch SUCCESS ----------------------------------")
+}
+
+// @Summary Получить наблюдателей задания
+// @Description Возвращает пользователей, подписанных на изменения задания. Исполнители, комментаторы и упомянутые в комментариях через @email участники доски подписываются автоматически
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param taskID body dto.TaskID true "id задания"
+//
+// @Success 200  {object}  doc_structs.WatchersResponse "наблюдатели в порядке подписки"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/watchers/ [post]
+func (th TaskHandler) GetWatchers(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.GetWatchers"
+	errorMessage := "Getting task watchers failed with error: "
+	failBorder := "---------------------------------- TaskHandler.GetWatchers FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- TaskHandler.GetWatchers ----------------------------------")
+
+	var id dto.TaskID
+	err := easyjson.UnmarshalFromReader(r.Body, &id)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	watchers, err := th.ts.GetWatchers(rCtx, id)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("getting task watchers done", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"watchers": watchers,
+		},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- TaskHandler.GetWatchers SUCCESS ----------------------------------")
+}
+
+// @Summary Запустить таймер
+// @Description Запускает таймер текущего пользователя на задании. У пользователя может быть запущен только один таймер: запущенный ранее останавливается и сохраняется в учёт времени
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param taskID body dto.TaskID true "id задания"
+//
+// @Success 200  {object}  doc_structs.WorklogResponse "запущенный таймер"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/timer/start/ [post]
+func (th TaskHandler) StartTimer(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.StartTimer"
+	errorMessage := "Starting timer failed with error: "
+	failBorder := "---------------------------------- TaskHandler.StartTimer FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- TaskHandler.StartTimer ----------------------------------")
+
+	var info dto.TaskID
+	err := easyjson.UnmarshalFromReader(r.Body, &info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	result, err := th.ts.StartTimer(rCtx, info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("starting timer done", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"entry": result,
+		},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- TaskHandler.StartTimer SUCCESS ----------------------------------")
+}
+
+// @Summary Остановить таймер
+// @Description Останавливает запущенный таймер текущего пользователя; заметка, если передана, сохраняется в запись учёта времени
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param info body dto.TimerStopInfo true "заметка к записи"
+//
+// @Success 200  {object}  doc_structs.WorklogResponse "сохранённая запись учёта времени"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/timer/stop/ [post]
+func (th TaskHandler) StopTimer(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.StopTimer"
+	errorMessage := "Stopping timer failed with error: "
+	failBorder := "---------------------------------- TaskHandler.StopTimer FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- TaskHandler.StopTimer ----------------------------------")
+
+	var info dto.TimerStopInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	result, err := th.ts.StopTimer(rCtx, info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("stopping timer done", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"entry": result,
+		},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- TaskHandler.StopTimer SUCCESS ----------------------------------")
+}
+
+// @Summary Получить запущенный таймер
+// @Description Возвращает запущенный таймер текущего пользователя или null, если таймер не запущен
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Success 200  {object}  doc_structs.TimerResponse "запущенный таймер"
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/timer/ [get]
+func (th TaskHandler) GetTimer(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.GetTimer"
+	errorMessage := "Getting timer failed with error: "
+	failBorder := "---------------------------------- TaskHandler.GetTimer FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- TaskHandler.GetTimer ----------------------------------")
+
+	result, err := th.ts.GetTimer(rCtx)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("getting timer done", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"timer": result,
+		},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- TaskHandler.GetTimer SUCCESS ----------------------------------")
+}
+
+// @Summary Получить учёт времени по заданию
+// @Description Возвращает записи учёта времени по заданию в порядке начала, суммарное время и время по пользователям. Запущенные таймеры в суммы не входят
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param taskID body dto.TaskID true "id задания"
+//
+// @Success 200  {object}  doc_structs.TaskTimeResponse "учёт времени по заданию"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/worklog/ [post]
+func (th TaskHandler) GetWorklogs(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.GetWorklogs"
+	errorMessage := "Getting worklogs failed with error: "
+	failBorder := "---------------------------------- TaskHandler.GetWorklogs FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- TaskHandler.GetWorklogs ----------------------------------")
+
+	var info dto.TaskID
+	err := easyjson.UnmarshalFromReader(r.Body, &info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	result, err := th.ts.GetWorklogs(rCtx, info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("getting worklogs done", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"time": result,
+		},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- TaskHandler.GetWorklogs SUCCESS ----------------------------------")
+}
+
+// @Summary Внести время вручную
+// @Description Вносит запись учёта времени по заданию длительностью от секунды до суток. Без начала запись заканчивается в момент внесения; запись не может заканчиваться в будущем
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param info body dto.NewWorklogInfo true "запись учёта времени"
+//
+// @Success 200  {object}  doc_structs.WorklogResponse "сохранённая запись учёта времени"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/worklog/add/ [post]
+func (th TaskHandler) AddWorklog(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.AddWorklog"
+	errorMessage := "Adding worklog failed with error: "
+	failBorder := "---------------------------------- TaskHandler.AddWorklog FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- TaskHandler.AddWorklog ----------------------------------")
+
+	var info dto.NewWorklogInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	result, err := th.ts.AddWorklog(rCtx, info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("adding worklog done", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"entry": result,
+		},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- TaskHandler.AddWorklog SUCCESS ----------------------------------")
+}
+
+// @Summary Удалить запись учёта времени
+// @Description Удаляет запись учёта времени; удалить можно только свою запись
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param info body dto.WorklogDeleteInfo true "id записи"
+//
+// @Success 200  {string}  string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/worklog/delete/ [post]
+func (th TaskHandler) DeleteWorklog(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.DeleteWorklog"
+	errorMessage := "Deleting worklog failed with error: "
+	failBorder := "---------------------------------- TaskHandler.DeleteWorklog FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- TaskHandler.DeleteWorklog ----------------------------------")
+
+	var info dto.WorklogDeleteInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	err = th.ts.DeleteWorklog(rCtx, info)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("deleting worklog done", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- TaskHandler.DeleteWorklog SUCCESS ----------------------------------")
+}
+
+// @Summary Получить ленту активности задания
+// @Description Возвращает страницу ленты активности задания в хронологическом порядке. События собираются из истории доски, которую записывает сервер: создание, перенос между списками, переименование, изменение описания и сроков, исполнители, тэги, пункты чек-листов и файлы. Комментарии задания вставляются между событиями по времени создания. По умолчанию страница -- 50 событий, не больше 200
+// @Tags tasks
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param request body dto.TaskActivityRequest true "id задания и пагинация"
+//
+// @Success 200  {object}  doc_structs.TaskActivityResponse "страница ленты активности"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 403  {object}  apperrors.ErrorResponse
+// @Failure 404  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /task/activity/ [post]
+func (th TaskHandler) GetActivity(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "TaskHandler.GetActivity"
+	errorMessage := "Getting task activity failed with error: "
+	failBorder := "---------------------------------- TaskHandler.GetActivity FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- TaskHandler.GetActivity ----------------------------------")
+
+	var request dto.TaskActivityRequest
+	err := easyjson.UnmarshalFromReader(r.Body, &request)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("request struct decoded", requestID.String(), funcName, nodeName)
+
+	activity, err := th.ts.GetActivity(rCtx, request)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("getting task activity done", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"activity": activity,
+		},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- TaskHandler.GetActivity SUCCESS ----------------------------------")
 }
@@ -33,10 +33,14 @@ func createTaskMux(
 			r.Post("/", TaskHandler.Read)
 			r.Post("/create/", TaskHandler.Create)
 			r.Post("/edit/", TaskHandler.Update)
+			r.Post("/bulk/", TaskHandler.Bulk)
 			r.Route("/user", func(r chi.Router) {
 				r.Post("/add/", TaskHandler.AddUser)
 				r.Post("/remove/", TaskHandler.RemoveUser)
 			})
+			r.Route("/custom_field", func(r chi.Router) {
+				r.Post("/set/", TaskHandler.SetCustomField)
+			})
 			r.Delete("/delete/", TaskHandler.Delete)
 		})
 	})
@@ -94,7 +98,7 @@ func TestTaskHandler_Unit_Create(t *testing.T) {
 					bs.
 						EXPECT().
 						Create(gomock.Any(), info).
-						Return(&args.resultTask, nil)
+						Return(&args.resultTask, nil, nil)
 
 					body := bytes.NewReader([]byte(fmt.Sprintf(`{"name":"%s", "list_id":%v, "list_position":%v}`,
 						args.newTask.Name, args.newTask.ListID, args.newTask.ListPosition)))
@@ -171,7 +175,7 @@ func TestTaskHandler_Unit_Create(t *testing.T) {
 					bs.
 						EXPECT().
 						Create(gomock.Any(), info).
-						Return(&entities.Task{}, apperrors.ErrTaskNotCreated)
+						Return(&entities.Task{}, nil, apperrors.ErrTaskNotCreated)
 
 					body := bytes.NewReader([]byte(fmt.Sprintf(`{"name":"%s", "list_id":%v, "list_position":%v}`,
 						args.newTask.Name, args.newTask.ListID, args.newTask.ListPosition)))
@@ -433,7 +437,7 @@ func TestTaskHandler_Unit_Update(t *testing.T) {
 					bs.
 						EXPECT().
 						Update(gomock.Any(), args.updatedTask).
-						Return(nil)
+						Return(&dto.SingleTaskInfo{ID: args.updatedTask.ID, Name: args.updatedTask.Name, RowVersion: 2}, nil)
 
 					body := bytes.NewReader([]byte(fmt.Sprintf(`{"name":"%s", "id":%v}`, args.updatedTask.Name, args.updatedTask.ID)))
 
@@ -503,7 +507,7 @@ func TestTaskHandler_Unit_Update(t *testing.T) {
 					bs.
 						EXPECT().
 						Update(gomock.Any(), args.updatedTask).
-						Return(apperrors.ErrTaskNotUpdated)
+						Return(nil, apperrors.ErrTaskNotUpdated)
 
 					body := bytes.NewReader([]byte(fmt.Sprintf(`{"name":"%s", "id":%v}`, args.updatedTask.Name, args.updatedTask.ID)))
 
@@ -523,6 +527,49 @@ func TestTaskHandler_Unit_Update(t *testing.T) {
 			wantErr:      true,
 			expectedCode: http.StatusInternalServerError,
 		},
+		{
+			name: "Conflict (stale row version)",
+			args: args{
+				session: dto.SessionToken{
+					ID: "Mock session",
+				},
+				updatedTask: dto.UpdatedTaskInfo{
+					Name: "Mock new Task name",
+					ID:   uint64(1),
+				},
+				expectations: func(bs *mock_service.MockITaskService, args args) *http.Request {
+					cookie := &http.Cookie{
+						Name:     "tabula_user",
+						Value:    args.session.ID,
+						HttpOnly: true,
+						SameSite: http.SameSiteLaxMode,
+						Expires:  args.session.ExpirationDate,
+						Path:     "/api/v2/",
+					}
+
+					bs.
+						EXPECT().
+						Update(gomock.Any(), args.updatedTask).
+						Return(&dto.SingleTaskInfo{ID: args.updatedTask.ID, Name: args.updatedTask.Name, RowVersion: 2}, apperrors.ErrRowVersionConflict)
+
+					body := bytes.NewReader([]byte(fmt.Sprintf(`{"name":"%s", "id":%v}`, args.updatedTask.Name, args.updatedTask.ID)))
+
+					r := httptest.
+						NewRequest("POST", "/api/v2/task/edit/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+					r.AddCookie(cookie)
+
+					return r
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusConflict,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -586,7 +633,7 @@ func TestTaskHandler_Unit_Delete(t *testing.T) {
 
 					bs.
 						EXPECT().
-						Delete(gomock.Any(), args.TaskID).
+						Delete(gomock.Any(), dto.TaskCascadeInfo{ID: args.TaskID.Value}).
 						Return(nil)
 
 					body := bytes.NewReader([]byte(fmt.Sprintf(`{"id":%v}`, args.TaskID.Value)))
@@ -653,7 +700,7 @@ func TestTaskHandler_Unit_Delete(t *testing.T) {
 
 					bs.
 						EXPECT().
-						Delete(gomock.Any(), args.TaskID).
+						Delete(gomock.Any(), dto.TaskCascadeInfo{ID: args.TaskID.Value}).
 						Return(apperrors.ErrTaskNotDeleted)
 
 					body := bytes.NewReader([]byte(fmt.Sprintf(`{"id":%v}`, args.TaskID.Value)))
@@ -1121,3 +1168,308 @@ func TestTaskHandler_Unit_RemoveUser(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskHandler_Unit_SetCustomField(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		session      dto.SessionToken
+		info         dto.CustomFieldValueInfo
+		expectations func(bs *mock_service.MockITaskService, args args) *http.Request
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		expectedCode int
+	}{
+		{
+			name: "Successful value set",
+			args: args{
+				session: dto.SessionToken{
+					ID: "Mock session",
+				},
+				info: dto.CustomFieldValueInfo{
+					TaskID:  uint64(1),
+					FieldID: uint64(2),
+					Value:   "high",
+				},
+				expectations: func(bs *mock_service.MockITaskService, args args) *http.Request {
+					cookie := &http.Cookie{
+						Name:     "tabula_user",
+						Value:    args.session.ID,
+						HttpOnly: true,
+						SameSite: http.SameSiteLaxMode,
+						Expires:  args.session.ExpirationDate,
+						Path:     "/api/v2/",
+					}
+
+					bs.
+						EXPECT().
+						SetCustomFieldValue(gomock.Any(), args.info).
+						Return(nil)
+
+					body := bytes.NewReader([]byte(fmt.Sprintf(
+						`{"task_id":%v, "field_id":%v, "value":"high"}`,
+						args.info.TaskID, args.info.FieldID,
+					)))
+
+					r := httptest.
+						NewRequest("POST", "/api/v2/task/custom_field/set/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+					r.AddCookie(cookie)
+
+					return r
+				},
+			},
+			wantErr:      false,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Bad request (invalid JSON)",
+			args: args{
+				session: dto.SessionToken{
+					ID: "Mock session",
+				},
+				expectations: func(bs *mock_service.MockITaskService, args args) *http.Request {
+					cookie := &http.Cookie{}
+
+					body := bytes.NewReader([]byte(""))
+
+					r := httptest.
+						NewRequest("POST", "/api/v2/task/custom_field/set/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+					r.AddCookie(cookie)
+
+					return r
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Bad request (value does not match field type)",
+			args: args{
+				session: dto.SessionToken{
+					ID: "Mock session",
+				},
+				info: dto.CustomFieldValueInfo{
+					TaskID:  uint64(1),
+					FieldID: uint64(2),
+					Value:   true,
+				},
+				expectations: func(bs *mock_service.MockITaskService, args args) *http.Request {
+					cookie := &http.Cookie{
+						Name:     "tabula_user",
+						Value:    args.session.ID,
+						HttpOnly: true,
+						SameSite: http.SameSiteLaxMode,
+						Expires:  args.session.ExpirationDate,
+						Path:     "/api/v2/",
+					}
+
+					bs.
+						EXPECT().
+						SetCustomFieldValue(gomock.Any(), args.info).
+						Return(apperrors.ErrInvalidCustomFieldValue)
+
+					body := bytes.NewReader([]byte(fmt.Sprintf(
+						`{"task_id":%v, "field_id":%v, "value":true}`,
+						args.info.TaskID, args.info.FieldID,
+					)))
+
+					r := httptest.
+						NewRequest("POST", "/api/v2/task/custom_field/set/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+					r.AddCookie(cookie)
+
+					return r
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Not found (no such field)",
+			args: args{
+				session: dto.SessionToken{
+					ID: "Mock session",
+				},
+				info: dto.CustomFieldValueInfo{
+					TaskID:  uint64(1),
+					FieldID: uint64(2),
+					Value:   float64(3),
+				},
+				expectations: func(bs *mock_service.MockITaskService, args args) *http.Request {
+					cookie := &http.Cookie{
+						Name:     "tabula_user",
+						Value:    args.session.ID,
+						HttpOnly: true,
+						SameSite: http.SameSiteLaxMode,
+						Expires:  args.session.ExpirationDate,
+						Path:     "/api/v2/",
+					}
+
+					bs.
+						EXPECT().
+						SetCustomFieldValue(gomock.Any(), args.info).
+						Return(apperrors.ErrCustomFieldNotFound)
+
+					body := bytes.NewReader([]byte(fmt.Sprintf(
+						`{"task_id":%v, "field_id":%v, "value":3}`,
+						args.info.TaskID, args.info.FieldID,
+					)))
+
+					r := httptest.
+						NewRequest("POST", "/api/v2/task/custom_field/set/", body).
+						WithContext(
+							context.WithValue(
+								context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+								dto.RequestIDKey, uuid.New(),
+							),
+						)
+					r.AddCookie(cookie)
+
+					return r
+				},
+			},
+			wantErr:      true,
+			expectedCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockTaskService := mock_service.NewMockITaskService(ctrl)
+
+			testRequest := tt.args.expectations(mockTaskService, tt.args)
+
+			mux, err := createTaskMux(mockTaskService)
+			require.Equal(t, nil, err)
+
+			testRequest.Header.Add("Access-Control-Request-Headers", "content-type")
+			testRequest.Header.Add("Origin", "localhost:8081")
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, testRequest)
+
+			status := w.Result().StatusCode
+
+			require.EqualValuesf(t, tt.expectedCode, status,
+				"Expected code %d (%s), received code %d (%s)",
+				tt.expectedCode, http.StatusText(tt.expectedCode),
+				w.Code, http.StatusText(w.Code))
+		})
+	}
+}
+
+func TestTaskHandler_Unit_Bulk(t *testing.T) {
+	t.Parallel()
+
+	listID := uint64(3)
+	type args struct {
+		body         string
+		expectations func(ts *mock_service.MockITaskService)
+	}
+	tests := []struct {
+		name         string
+		args         args
+		expectedCode int
+	}{
+		{
+			name: "Successful move over soft limit",
+			args: args{
+				body: `{"task_ids":[1,2],"operation":"move","list_id":3}`,
+				expectations: func(ts *mock_service.MockITaskService) {
+					ts.
+						EXPECT().
+						Bulk(gomock.Any(), dto.TaskBulkRequest{TaskIDs: []uint64{1, 2}, Operation: dto.TaskBulkMove, ListID: &listID}).
+						Return(&dto.WipLimitWarning{ListID: listID, WipLimit: 2, TaskCount: 3}, nil)
+				},
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Bad request (invalid JSON)",
+			args: args{
+				body:         "",
+				expectations: func(ts *mock_service.MockITaskService) {},
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Bad request (tasks on different boards)",
+			args: args{
+				body: `{"task_ids":[1,2],"operation":"archive"}`,
+				expectations: func(ts *mock_service.MockITaskService) {
+					ts.
+						EXPECT().
+						Bulk(gomock.Any(), dto.TaskBulkRequest{TaskIDs: []uint64{1, 2}, Operation: dto.TaskBulkArchive}).
+						Return(nil, apperrors.ErrBulkTasksOnDifferentBoards)
+				},
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Forbidden (no access to one of the tasks)",
+			args: args{
+				body: `{"task_ids":[1,2],"operation":"delete"}`,
+				expectations: func(ts *mock_service.MockITaskService) {
+					ts.
+						EXPECT().
+						Bulk(gomock.Any(), dto.TaskBulkRequest{TaskIDs: []uint64{1, 2}, Operation: dto.TaskBulkDelete}).
+						Return(nil, apperrors.ErrNoBoardAccess)
+				},
+			},
+			expectedCode: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockTaskService := mock_service.NewMockITaskService(ctrl)
+			tt.args.expectations(mockTaskService)
+
+			mux, err := createTaskMux(mockTaskService)
+			require.Equal(t, nil, err)
+
+			testRequest := httptest.
+				NewRequest("POST", "/api/v2/task/bulk/", bytes.NewReader([]byte(tt.args.body))).
+				WithContext(
+					context.WithValue(
+						context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+						dto.RequestIDKey, uuid.New(),
+					),
+				)
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, testRequest)
+
+			require.EqualValuesf(t, tt.expectedCode, w.Code,
+				"Expected code %d (%s), received code %d (%s)",
+				tt.expectedCode, http.StatusText(tt.expectedCode),
+				w.Code, http.StatusText(w.Code))
+		})
+	}
+}
@@ -175,6 +175,147 @@ func (uh UserHandler) ChangeProfile(w http.ResponseWriter, r *http.Request) {
 	logger.Info("---------------------------------- Changing user's profile SUCCESS ----------------------------------")
 }
 
+// @Summary Поменять часовой пояс
+// @Description Сроки заданий показываются пользователю в выбранном часовом поясе. В ответ ничего не шлёт
+// @Tags auth
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param timezoneInfo body dto.UserTimezoneInfo true "Название часового пояса из базы IANA, например Europe/Moscow"
+//
+// @Success 200  {string} string "no content"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /user/edit/timezone/ [post]
+func (uh UserHandler) ChangeTimezone(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "UserHandler.ChangeTimezone"
+	errorMessage := "Changing user's timezone failed with error: "
+	failBorder := "---------------------------------- Changing user's timezone FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- Changing user's timezone ----------------------------------")
+
+	var timezoneInfo dto.UserTimezoneInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &timezoneInfo)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("JSON decoded", requestID.String(), funcName, nodeName)
+
+	user, ok := rCtx.Value(dto.UserObjKey).(*entities.User)
+	if !ok {
+		logger.Error(errorMessage + "No user object found")
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.GenericUnauthorizedResponse, w, r)
+		return
+	}
+	logger.DebugFmt("User object acquired from context", requestID.String(), funcName, nodeName)
+
+	timezoneInfo.UserID = user.ID
+	err = uh.us.UpdateTimezone(rCtx, timezoneInfo)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("User timezone updated", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- Changing user's timezone SUCCESS ----------------------------------")
+}
+
+// @Summary Поменять напоминания по умолчанию
+// @Description Смещения напоминаний о сроке в минутах (от 1 минуты до 30 дней, не больше 5) для заданий, у которых свои напоминания не заданы. Пустой список отключает такие напоминания
+// @Tags auth
+//
+// @Accept  json
+// @Produce  json
+//
+// @Param remindersInfo body dto.UserRemindersInfo true "смещения напоминаний в минутах до срока"
+//
+// @Success 200  {object}  doc_structs.RemindersResponse "сохранённые смещения"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 401  {object}  apperrors.ErrorResponse
+// @Failure 500  {object}  apperrors.ErrorResponse
+//
+// @Router /user/edit/reminders/ [post]
+func (uh UserHandler) ChangeReminders(w http.ResponseWriter, r *http.Request) {
+	rCtx := r.Context()
+	funcName := "UserHandler.ChangeReminders"
+	errorMessage := "Changing user's reminders failed with error: "
+	failBorder := "---------------------------------- Changing user's reminders FAIL ----------------------------------"
+
+	logger := rCtx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := rCtx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	logger.Info("---------------------------------- Changing user's reminders ----------------------------------")
+
+	var remindersInfo dto.UserRemindersInfo
+	err := easyjson.UnmarshalFromReader(r.Body, &remindersInfo)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.BadRequestResponse, w, r)
+		return
+	}
+	logger.DebugFmt("JSON decoded", requestID.String(), funcName, nodeName)
+
+	user, ok := rCtx.Value(dto.UserObjKey).(*entities.User)
+	if !ok {
+		logger.Error(errorMessage + "No user object found")
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.GenericUnauthorizedResponse, w, r)
+		return
+	}
+	logger.DebugFmt("User object acquired from context", requestID.String(), funcName, nodeName)
+
+	remindersInfo.UserID = user.ID
+	offsets, err := uh.us.UpdateReminders(rCtx, remindersInfo)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.ErrorMap[err], w, r)
+		return
+	}
+	logger.DebugFmt("User reminders updated", requestID.String(), funcName, nodeName)
+
+	response := dto.JSONResponse{
+		Body: dto.JSONMap{
+			"reminders": offsets,
+		},
+	}
+	err = WriteResponse(response, w, r)
+	if err != nil {
+		logger.Error(errorMessage + err.Error())
+		logger.Info(failBorder)
+		apperrors.ReturnError(apperrors.InternalServerErrorResponse, w, r)
+		return
+	}
+	logger.DebugFmt("response written", requestID.String(), funcName, nodeName)
+
+	logger.Info("---------------------------------- Changing user's reminders SUCCESS ----------------------------------")
+}
+
 // @Summary Поменять аватарку
 // @Description В ответ шлёт ссылку на файл
 // @Tags user
@@ -185,6 +326,8 @@ func (uh UserHandler) ChangeProfile(w http.ResponseWriter, r *http.Request) {
 // @Param avatarChangeInfo body dto.AvatarChangeInfo true "id пользователя, изображение"
 //
 // @Success 200  {object}  doc_structs.AvatarUploadResponse "Ссылка на новую аватарку"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 413  {object}  apperrors.ErrorResponse
 // @Failure 500  {object}  apperrors.ErrorResponse
 //
 // @Router /user/edit/change_avatar/ [post]
@@ -276,6 +419,8 @@ func (uh UserHandler) ChangeAvatar(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 //
 // @Success 200  {object}  doc_structs.AvatarUploadResponse "Ссылка на новую аватарку"
+// @Failure 400  {object}  apperrors.ErrorResponse
+// @Failure 413  {object}  apperrors.ErrorResponse
 // @Failure 500  {object}  apperrors.ErrorResponse
 //
 // @Router /user/edit/delete_avatar/ [delete]
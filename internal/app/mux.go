@@ -53,6 +53,12 @@ func GetChiMux(manager handlers.Handlers, config config.Config, logger logging.I
 			r.Post("/edit/", metricsMiddleware.WrapHandler(
 				"/user/edit/", http.HandlerFunc(manager.UserHandler.ChangeProfile)),
 			)
+			r.Post("/edit/timezone/", metricsMiddleware.WrapHandler(
+				"/user/edit/timezone/", http.HandlerFunc(manager.UserHandler.ChangeTimezone)),
+			)
+			r.Post("/edit/reminders/", metricsMiddleware.WrapHandler(
+				"/user/edit/reminders/", http.HandlerFunc(manager.UserHandler.ChangeReminders)),
+			)
 			r.Post("/edit/change_password/", metricsMiddleware.WrapHandler(
 				"/user/edit/change_password/", http.HandlerFunc(manager.UserHandler.ChangePassword)),
 			)
@@ -62,6 +68,12 @@ func GetChiMux(manager handlers.Handlers, config config.Config, logger logging.I
 			r.Delete("/edit/delete_avatar/", metricsMiddleware.WrapHandler(
 				"/user/edit/detele_avatar/", http.HandlerFunc(manager.UserHandler.DeleteAvatar)),
 			)
+			r.Post("/notifications/", metricsMiddleware.WrapHandler(
+				"/user/notifications/", http.HandlerFunc(manager.NotificationHandler.GetNotifications)),
+			)
+			r.Post("/notifications/read/", metricsMiddleware.WrapHandler(
+				"/user/notifications/read/", http.HandlerFunc(manager.NotificationHandler.MarkRead)),
+			)
 		})
 		r.Route("/workspace", func(r chi.Router) {
 			r.Use(middleware.AuthMiddleware(manager.AuthHandler.GetAuthService(), manager.AuthHandler.GetUserService()))
@@ -99,12 +111,48 @@ func GetChiMux(manager handlers.Handlers, config config.Config, logger logging.I
 					"/board/user/remove/", http.HandlerFunc(manager.BoardHandler.RemoveUser)),
 				)
 			})
+			r.Route("/watchers", func(r chi.Router) {
+				r.Post("/", metricsMiddleware.WrapHandler(
+					"/board/watchers/", http.HandlerFunc(manager.BoardHandler.GetWatchers)),
+				)
+				r.Post("/add/", metricsMiddleware.WrapHandler(
+					"/board/watchers/add/", http.HandlerFunc(manager.BoardHandler.Watch)),
+				)
+				r.Post("/remove/", metricsMiddleware.WrapHandler(
+					"/board/watchers/remove/", http.HandlerFunc(manager.BoardHandler.Unwatch)),
+				)
+			})
+			r.Route("/time", func(r chi.Router) {
+				r.Post("/report/", metricsMiddleware.WrapHandler(
+					"/board/time/report/", http.HandlerFunc(manager.BoardHandler.GetTimeReport)),
+				)
+				r.Post("/report/csv/", metricsMiddleware.WrapHandler(
+					"/board/time/report/csv/", http.HandlerFunc(manager.BoardHandler.ExportTimeReportCSV)),
+				)
+			})
 			r.Route("/history", func(r chi.Router) {
 				r.Post("/", metricsMiddleware.WrapHandler(
 					"/board/history/", http.HandlerFunc(manager.BoardHandler.GetHistory)),
 				)
-				r.Post("/submit/", metricsMiddleware.WrapHandler(
-					"/board/history/submit/", http.HandlerFunc(manager.BoardHandler.SubmitEdit)),
+				r.Post("/revert/", metricsMiddleware.WrapHandler(
+					"/board/history/revert/", http.HandlerFunc(manager.BoardHandler.RevertHistory)),
+				)
+			})
+			r.Post("/stats/", metricsMiddleware.WrapHandler(
+				"/board/stats/", http.HandlerFunc(manager.BoardHandler.GetStats)),
+			)
+			r.Post("/archive/", metricsMiddleware.WrapHandler(
+				"/board/archive/", http.HandlerFunc(manager.BoardHandler.GetArchive)),
+			)
+			r.Get("/changes/", metricsMiddleware.WrapHandler(
+				"/board/changes/", http.HandlerFunc(manager.BoardHandler.GetChanges)),
+			)
+			r.Route("/export", func(r chi.Router) {
+				r.Post("/csv/", metricsMiddleware.WrapHandler(
+					"/board/export/csv/", http.HandlerFunc(manager.BoardHandler.ExportCSV)),
+				)
+				r.Post("/markdown/", metricsMiddleware.WrapHandler(
+					"/board/export/markdown/", http.HandlerFunc(manager.BoardHandler.ExportMarkdown)),
 				)
 			})
 			r.Delete("/delete/", metricsMiddleware.WrapHandler(
@@ -126,6 +174,26 @@ func GetChiMux(manager handlers.Handlers, config config.Config, logger logging.I
 			r.Post("/reorder/", metricsMiddleware.WrapHandler(
 				"/list/reorder/", http.HandlerFunc(manager.ListHandler.UpdateOrder)),
 			)
+			r.Post("/sort/", metricsMiddleware.WrapHandler(
+				"/list/sort/", http.HandlerFunc(manager.ListHandler.SortTasks)),
+			)
+			r.Post("/move/", metricsMiddleware.WrapHandler(
+				"/list/move/", http.HandlerFunc(manager.ListHandler.Move)),
+			)
+			r.Post("/copy/", metricsMiddleware.WrapHandler(
+				"/list/copy/", http.HandlerFunc(manager.ListHandler.Copy)),
+			)
+			r.Route("/archive", func(r chi.Router) {
+				r.Post("/", metricsMiddleware.WrapHandler(
+					"/list/archive/", http.HandlerFunc(manager.ListHandler.Archive)),
+				)
+				r.Post("/tasks/", metricsMiddleware.WrapHandler(
+					"/list/archive/tasks/", http.HandlerFunc(manager.ListHandler.ArchiveTasks)),
+				)
+			})
+			r.Post("/unarchive/", metricsMiddleware.WrapHandler(
+				"/list/unarchive/", http.HandlerFunc(manager.ListHandler.Unarchive)),
+			)
 		})
 		r.Route("/task", func(r chi.Router) {
 			r.Use(middleware.AuthMiddleware(manager.AuthHandler.GetAuthService(), manager.AuthHandler.GetUserService()))
@@ -142,6 +210,84 @@ func GetChiMux(manager handlers.Handlers, config config.Config, logger logging.I
 			r.Post("/move/", metricsMiddleware.WrapHandler(
 				"/task/move/", http.HandlerFunc(manager.TaskHandler.Move)),
 			)
+			r.Post("/move/board/", metricsMiddleware.WrapHandler(
+				"/task/move/board/", http.HandlerFunc(manager.TaskHandler.MoveToBoard)),
+			)
+			r.Post("/archive/", metricsMiddleware.WrapHandler(
+				"/task/archive/", http.HandlerFunc(manager.TaskHandler.Archive)),
+			)
+			r.Post("/unarchive/", metricsMiddleware.WrapHandler(
+				"/task/unarchive/", http.HandlerFunc(manager.TaskHandler.Unarchive)),
+			)
+			r.Post("/copy/", metricsMiddleware.WrapHandler(
+				"/task/copy/", http.HandlerFunc(manager.TaskHandler.Copy)),
+			)
+			r.Post("/bulk/", metricsMiddleware.WrapHandler(
+				"/task/bulk/", http.HandlerFunc(manager.TaskHandler.Bulk)),
+			)
+			r.Post("/reminders/", metricsMiddleware.WrapHandler(
+				"/task/reminders/", http.HandlerFunc(manager.TaskHandler.SetReminders)),
+			)
+			r.Route("/recurrence", func(r chi.Router) {
+				r.Post("/set/", metricsMiddleware.WrapHandler(
+					"/task/recurrence/set/", http.HandlerFunc(manager.TaskHandler.SetRecurrence)),
+				)
+				r.Post("/stop/", metricsMiddleware.WrapHandler(
+					"/task/recurrence/stop/", http.HandlerFunc(manager.TaskHandler.StopRecurrence)),
+				)
+			})
+			r.Route("/dependency", func(r chi.Router) {
+				r.Post("/add/", metricsMiddleware.WrapHandler(
+					"/task/dependency/add/", http.HandlerFunc(manager.TaskHandler.Link)),
+				)
+				r.Post("/remove/", metricsMiddleware.WrapHandler(
+					"/task/dependency/remove/", http.HandlerFunc(manager.TaskHandler.Unlink)),
+				)
+			})
+			r.Route("/watchers", func(r chi.Router) {
+				r.Post("/", metricsMiddleware.WrapHandler(
+					"/task/watchers/", http.HandlerFunc(manager.TaskHandler.GetWatchers)),
+				)
+				r.Post("/add/", metricsMiddleware.WrapHandler(
+					"/task/watchers/add/", http.HandlerFunc(manager.TaskHandler.Watch)),
+				)
+				r.Post("/remove/", metricsMiddleware.WrapHandler(
+					"/task/watchers/remove/", http.HandlerFunc(manager.TaskHandler.Unwatch)),
+				)
+			})
+			r.Post("/activity/", metricsMiddleware.WrapHandler(
+				"/task/activity/", http.HandlerFunc(manager.TaskHandler.GetActivity)),
+			)
+			r.Route("/timer", func(r chi.Router) {
+				r.Get("/", metricsMiddleware.WrapHandler(
+					"/task/timer/", http.HandlerFunc(manager.TaskHandler.GetTimer)),
+				)
+				r.Post("/start/", metricsMiddleware.WrapHandler(
+					"/task/timer/start/", http.HandlerFunc(manager.TaskHandler.StartTimer)),
+				)
+				r.Post("/stop/", metricsMiddleware.WrapHandler(
+					"/task/timer/stop/", http.HandlerFunc(manager.TaskHandler.StopTimer)),
+				)
+			})
+			r.Route("/worklog", func(r chi.Router) {
+				r.Post("/", metricsMiddleware.WrapHandler(
+					"/task/worklog/", http.HandlerFunc(manager.TaskHandler.GetWorklogs)),
+				)
+				r.Post("/add/", metricsMiddleware.WrapHandler(
+					"/task/worklog/add/", http.HandlerFunc(manager.TaskHandler.AddWorklog)),
+				)
+				r.Post("/delete/", metricsMiddleware.WrapHandler(
+					"/task/worklog/delete/", http.HandlerFunc(manager.TaskHandler.DeleteWorklog)),
+				)
+			})
+			r.Route("/subtask", func(r chi.Router) {
+				r.Post("/attach/", metricsMiddleware.WrapHandler(
+					"/task/subtask/attach/", http.HandlerFunc(manager.TaskHandler.AttachSubtask)),
+				)
+				r.Post("/detach/", metricsMiddleware.WrapHandler(
+					"/task/subtask/detach/", http.HandlerFunc(manager.TaskHandler.DetachSubtask)),
+				)
+			})
 			r.Route("/user", func(r chi.Router) {
 				r.Post("/add/", metricsMiddleware.WrapHandler(
 					"/task/user/add/", http.HandlerFunc(manager.TaskHandler.AddUser)),
@@ -150,6 +296,11 @@ func GetChiMux(manager handlers.Handlers, config config.Config, logger logging.I
 					"/task/user/remove/", http.HandlerFunc(manager.TaskHandler.RemoveUser)),
 				)
 			})
+			r.Route("/custom_field", func(r chi.Router) {
+				r.Post("/set/", metricsMiddleware.WrapHandler(
+					"/task/custom_field/set/", http.HandlerFunc(manager.TaskHandler.SetCustomField)),
+				)
+			})
 			r.Route("/file", func(r chi.Router) {
 				r.Post("/attach/", metricsMiddleware.WrapHandler(
 					"/task/file/attach/", http.HandlerFunc(manager.TaskHandler.AttachFile)),
@@ -218,6 +369,19 @@ func GetChiMux(manager handlers.Handlers, config config.Config, logger logging.I
 				"/tag/delete/", http.HandlerFunc(manager.TagHandler.Delete)),
 			)
 		})
+		r.Route("/custom_field", func(r chi.Router) {
+			r.Use(middleware.AuthMiddleware(manager.AuthHandler.GetAuthService(), manager.AuthHandler.GetUserService()))
+			r.Use(middleware.CSRFMiddleware(manager.AuthHandler.GetCSRFService()))
+			r.Post("/create/", metricsMiddleware.WrapHandler(
+				"/custom_field/create/", http.HandlerFunc(manager.CustomFieldHandler.Create)),
+			)
+			r.Post("/edit/", metricsMiddleware.WrapHandler(
+				"/custom_field/edit/", http.HandlerFunc(manager.CustomFieldHandler.Update)),
+			)
+			r.Delete("/delete/", metricsMiddleware.WrapHandler(
+				"/custom_field/delete/", http.HandlerFunc(manager.CustomFieldHandler.Delete)),
+			)
+		})
 	})
 	mux.Route("/swagger/", func(r chi.Router) {
 		r.Get("/*", metricsMiddleware.WrapHandler(
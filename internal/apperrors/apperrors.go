@@ -50,6 +50,8 @@ var (
 	ErrCouldNotGetUser = errors.New("couldn't get User")
 	// ErrAvatarGone ошибка: удаление пустого аватара
 	ErrAvatarGone = errors.New("deleting an empty avatar")
+	// ErrInvalidTimezone ошибка: неизвестный часовой пояс
+	ErrInvalidTimezone = errors.New("unknown timezone")
 )
 
 // Ошибки, связанные с CSAT
@@ -116,6 +118,10 @@ var (
 	ErrCouldNotRollback = errors.New("couldn't rollback a transaction")
 	// ErrCouldNotCommit ошибка: не удалось коммитнуть изменения в бд
 	ErrCouldNotCommit = errors.New("failed to commit database changes")
+	// ErrRowVersionMissing ошибка: в запросе на изменение нет версии строки, которую видел клиент
+	ErrRowVersionMissing = errors.New("row version is required")
+	// ErrRowVersionConflict ошибка: строку изменили после того, как клиент её получил
+	ErrRowVersionConflict = errors.New("row was changed since it was read")
 )
 
 // Ошибки, связанные с сервером
@@ -132,6 +138,14 @@ var (
 	ErrNoRequestIDFound = errors.New("no request id in context")
 )
 
+// Ошибки, связанные с изображениями
+var (
+	// ErrInvalidImage ошибка: файл не является изображением поддерживаемого формата
+	ErrInvalidImage = errors.New("file is not a supported image")
+	// ErrImageTooLarge ошибка: размер файла или изображения превышает допустимый
+	ErrImageTooLarge = errors.New("image is too large")
+)
+
 // Ошибки, связанные с BoardService
 var (
 	// ErrWorkspaceNotDeleted ошибка: не удалось создать рабочее прострнство в БД
@@ -156,6 +170,12 @@ var (
 	ErrCouldNotAddBoardUser = errors.New("couldn't add user to board")
 	// ErrCouldNotRemoveBoardUser ошибка: не удалось добавить пользователя на доску
 	ErrCouldNotRemoveBoardUser = errors.New("couldn't remove user from board")
+	// ErrCouldNotExportBoard ошибка: не удалось выгрузить доску
+	ErrCouldNotExportBoard = errors.New("couldn't export board")
+	// ErrCouldNotGetBoardStats ошибка: не удалось посчитать статистику доски
+	ErrCouldNotGetBoardStats = errors.New("couldn't compute board stats")
+	// ErrInvalidBoardFilter ошибка: начало диапазона сроков позже его конца
+	ErrInvalidBoardFilter = errors.New("invalid board filter")
 )
 
 // Ошибки, связанные с WorkspaceService
@@ -182,6 +202,8 @@ var (
 	ErrBoardTagConnectionNotCreated = errors.New("could not create connection between tag and board")
 	// ErrTaskTagConnectionNotCreated ошибка: не удалось создать связь между тэгом и заданием
 	ErrTaskTagConnectionNotCreated = errors.New("could not create connection between tag and task")
+	// ErrTagNotInBoard ошибка: тэг не относится к доске задания
+	ErrTagNotInBoard = errors.New("tag doesn't belong to the task's board")
 )
 
 // Ошибки, связанные с ListService
@@ -196,6 +218,44 @@ var (
 	ErrListNotUpdated = errors.New("list couldn't be updated")
 	// ErrListNotDeleted ошибка: не удалось удалить список в БД
 	ErrListNotDeleted = errors.New("list couldn't be deleted")
+	// ErrListNotFound ошибка: список не найден в БД
+	ErrListNotFound = errors.New("list not found")
+	// ErrInvalidWipLimit ошибка: неверные настройки лимита задач в списке
+	ErrInvalidWipLimit = errors.New("invalid wip limit settings")
+	// ErrWipLimitExceeded ошибка: в списке уже достигнут жёсткий лимит задач
+	ErrWipLimitExceeded = errors.New("list wip limit exceeded")
+	// ErrInvalidListSort ошибка: неизвестный ключ сортировки заданий списка
+	ErrInvalidListSort = errors.New("invalid list sort key")
+	// ErrListAlreadyOnBoard ошибка: список переносится на доску, на которой он уже находится
+	ErrListAlreadyOnBoard = errors.New("list is already on this board")
+	// ErrListNotMoved ошибка: не удалось перенести список на другую доску
+	ErrListNotMoved = errors.New("list couldn't be moved")
+	// ErrListNotCopied ошибка: не удалось скопировать список
+	ErrListNotCopied = errors.New("list couldn't be copied")
+	// ErrListNotArchived ошибка: не удалось перенести список в архив или вернуть из него
+	ErrListNotArchived = errors.New("list couldn't be archived")
+)
+
+// Ошибки, связанные с пользовательскими полями
+var (
+	// ErrInvalidCustomField ошибка: неверное название, тип или варианты пользовательского поля
+	ErrInvalidCustomField = errors.New("invalid custom field definition")
+	// ErrInvalidCustomFieldValue ошибка: значение не подходит к типу пользовательского поля
+	ErrInvalidCustomFieldValue = errors.New("invalid custom field value")
+	// ErrCustomFieldNotFound ошибка: пользовательское поле не найдено в БД
+	ErrCustomFieldNotFound = errors.New("custom field not found")
+	// ErrCustomFieldNotInBoard ошибка: пользовательское поле и задание относятся к разным доскам
+	ErrCustomFieldNotInBoard = errors.New("custom field doesn't belong to the task's board")
+	// ErrCouldNotGetCustomField ошибка: не удалось получить пользовательские поля из БД
+	ErrCouldNotGetCustomField = errors.New("could not get custom fields")
+	// ErrCustomFieldNotCreated ошибка: не удалось создать пользовательское поле в БД
+	ErrCustomFieldNotCreated = errors.New("custom field couldn't be created")
+	// ErrCustomFieldNotUpdated ошибка: не удалось обновить пользовательское поле в БД
+	ErrCustomFieldNotUpdated = errors.New("custom field couldn't be updated")
+	// ErrCustomFieldNotDeleted ошибка: не удалось удалить пользовательское поле в БД
+	ErrCustomFieldNotDeleted = errors.New("custom field couldn't be deleted")
+	// ErrCouldNotSetCustomFieldValue ошибка: не удалось сохранить значение пользовательского поля в БД
+	ErrCouldNotSetCustomFieldValue = errors.New("could not set custom field value")
 )
 
 // Ошибки, связанные с ChecklistService
@@ -220,6 +280,8 @@ var (
 	ErrChecklistItemNotUpdated = errors.New("checklist item couldn't be updated")
 	// ErrChecklistItemNotDeleted ошибка: не удалось удалить элемент чеклиста в БД
 	ErrChecklistItemNotDeleted = errors.New("checklist item couldn't be deleted")
+	// ErrChecklistItemNotFound ошибка: элемент чеклиста не найден в БД
+	ErrChecklistItemNotFound = errors.New("checklist item not found")
 )
 
 // Ошибки, связанные с TaskService
@@ -234,6 +296,8 @@ var (
 	ErrTaskNotDeleted = errors.New("task couldn't be deleted")
 	// ErrCouldNotGetTask ошибка: не удалось получить задание в БД
 	ErrCouldNotGetTask = errors.New("couldn't get task")
+	// ErrTaskNotFound ошибка: задание не найдено в БД
+	ErrTaskNotFound = errors.New("task not found")
 	// ErrCouldNotGetTaskFiles ошибка: не удалось получить задание в БД
 	ErrCouldNotGetTaskFiles = errors.New("couldn't get task files")
 	// ErrCouldNotAddTaskUser ошибка: не удалось добавить пользователя на карточку
@@ -244,6 +308,90 @@ var (
 	ErrUserAlreadyInTask = errors.New("user already in task")
 	// ErrUserNotInTask ошибка: пользователь уже есть в задании
 	ErrUserNotInTask = errors.New("user not in task")
+	// ErrTaskNotArchived ошибка: не удалось перенести задание в архив или вернуть из него
+	ErrTaskNotArchived = errors.New("task couldn't be archived")
+	// ErrTaskAlreadyOnBoard ошибка: задание переносится на доску, на которой оно уже находится
+	ErrTaskAlreadyOnBoard = errors.New("task is already on this board")
+	// ErrTaskNotMoved ошибка: не удалось перенести задание на другую доску
+	ErrTaskNotMoved = errors.New("task couldn't be moved")
+	// ErrInvalidBulkOperation ошибка: неизвестная массовая операция, нет заданий или параметра операции
+	ErrInvalidBulkOperation = errors.New("invalid bulk task operation")
+	// ErrBulkTasksOnDifferentBoards ошибка: задания массовой операции лежат на разных досках
+	ErrBulkTasksOnDifferentBoards = errors.New("bulk tasks belong to different boards")
+	// ErrBulkOperationFailed ошибка: не удалось выполнить массовую операцию над заданиями
+	ErrBulkOperationFailed = errors.New("bulk task operation failed")
+	// ErrInvalidTaskSchedule ошибка: начало задания не раньше его окончания
+	ErrInvalidTaskSchedule = errors.New("task start must be before its end")
+	// ErrInvalidReminderOffsets ошибка: смещений напоминаний слишком много или смещение вне допустимого диапазона
+	ErrInvalidReminderOffsets = errors.New("invalid reminder offsets")
+	// ErrInvalidRecurrenceRule ошибка: правило повторения не разобрано или не поддерживается
+	ErrInvalidRecurrenceRule = errors.New("invalid recurrence rule")
+	// ErrRecurrenceWithoutDueDate ошибка: повторение задаётся заданию без срока
+	ErrRecurrenceWithoutDueDate = errors.New("recurring task must have a due date")
+	// ErrRecurrenceNotFound ошибка: задание не входит в действующую серию повторений
+	ErrRecurrenceNotFound = errors.New("task recurrence not found")
+	// ErrRecurrenceNotUpdated ошибка: не удалось сохранить или остановить серию повторений
+	ErrRecurrenceNotUpdated = errors.New("task recurrence couldn't be updated")
+	// ErrRecurrenceNotGenerated ошибка: не удалось создать следующее задание серии повторений
+	ErrRecurrenceNotGenerated = errors.New("next recurring task couldn't be created")
+	// ErrSelfDependency ошибка: задание связывается зависимостью само с собой
+	ErrSelfDependency = errors.New("task can't depend on itself")
+	// ErrDependencyCycle ошибка: новая зависимость замыкает цикл блокировок
+	ErrDependencyCycle = errors.New("task dependency creates a cycle")
+	// ErrDependencyAcrossWorkspaces ошибка: связываемые задания лежат в разных рабочих пространствах
+	ErrDependencyAcrossWorkspaces = errors.New("dependent tasks must be in the same workspace")
+	// ErrDependencyNotFound ошибка: зависимости между заданиями нет
+	ErrDependencyNotFound = errors.New("task dependency not found")
+	// ErrDependencyNotUpdated ошибка: не удалось сохранить или удалить зависимость между заданиями
+	ErrDependencyNotUpdated = errors.New("task dependency couldn't be updated")
+	// ErrTaskBlocked ошибка: в завершающий список переносится задание с невыполненными блокирующими заданиями
+	ErrTaskBlocked = errors.New("task has open blockers")
+	// ErrSubtaskCycle ошибка: задание привязывается подзадачей к себе или к своей подзадаче
+	ErrSubtaskCycle = errors.New("subtask hierarchy can't contain a cycle")
+	// ErrSubtaskOnOtherBoard ошибка: подзадача и родительское задание лежат на разных досках
+	ErrSubtaskOnOtherBoard = errors.New("subtask must be on the parent task board")
+	// ErrNotSubtask ошибка: у задания нет родительского задания
+	ErrNotSubtask = errors.New("task is not a subtask")
+	// ErrSubtaskNotUpdated ошибка: не удалось привязать или отвязать подзадачу
+	ErrSubtaskNotUpdated = errors.New("subtask couldn't be updated")
+	// ErrInvalidTaskPriority ошибка: неизвестный приоритет задания
+	ErrInvalidTaskPriority = errors.New("invalid task priority")
+	// ErrInvalidTaskEstimate ошибка: оценка трудозатрат задания отрицательная
+	ErrInvalidTaskEstimate = errors.New("task estimate must not be negative")
+)
+
+// Ошибки, связанные с уведомлениями
+var (
+	// ErrCouldNotGetNotifications ошибка: не удалось получить уведомления пользователя
+	ErrCouldNotGetNotifications = errors.New("couldn't get notifications")
+	// ErrNotificationsNotUpdated ошибка: не удалось отметить уведомления прочитанными
+	ErrNotificationsNotUpdated = errors.New("notifications couldn't be updated")
+	// ErrRemindersNotCreated ошибка: не удалось создать напоминания о сроках заданий
+	ErrRemindersNotCreated = errors.New("due reminders couldn't be created")
+	// ErrNotWatching ошибка: пользователь не наблюдает за заданием или доской
+	ErrNotWatching = errors.New("user is not watching")
+	// ErrWatchersNotUpdated ошибка: не удалось подписать или отписать наблюдателя
+	ErrWatchersNotUpdated = errors.New("watchers couldn't be updated")
+	// ErrCouldNotGetWatchers ошибка: не удалось получить наблюдателей задания или доски
+	ErrCouldNotGetWatchers = errors.New("couldn't get watchers")
+)
+
+// Ошибки, связанные с учётом времени
+var (
+	// ErrTimerNotRunning ошибка: у пользователя нет запущенного таймера
+	ErrTimerNotRunning = errors.New("no running timer")
+	// ErrInvalidWorklog ошибка: длительность записи учёта времени вне допустимого диапазона или запись заканчивается в будущем
+	ErrInvalidWorklog = errors.New("invalid worklog entry")
+	// ErrWorklogNotFound ошибка: записи учёта времени нет или она внесена другим пользователем
+	ErrWorklogNotFound = errors.New("worklog entry not found")
+	// ErrWorklogNotUpdated ошибка: не удалось сохранить или удалить запись учёта времени
+	ErrWorklogNotUpdated = errors.New("worklog couldn't be updated")
+	// ErrCouldNotGetWorklogs ошибка: не удалось получить записи учёта времени
+	ErrCouldNotGetWorklogs = errors.New("couldn't get worklogs")
+	// ErrInvalidTimeReportRange ошибка: период отчёта о времени пуст или слишком длинный
+	ErrInvalidTimeReportRange = errors.New("invalid time report range")
+	// ErrCouldNotExportTimeReport ошибка: не удалось выгрузить отчёт о времени
+	ErrCouldNotExportTimeReport = errors.New("couldn't export time report")
 )
 
 // Ошибки, связанные с CommentService
@@ -252,6 +400,34 @@ var (
 	ErrCommentNotCreated = errors.New("comment couldn't be created")
 )
 
+// Ошибки, связанные с историей изменений
+var (
+	// ErrHistoryEntityNotFound ошибка: сущность, изменение которой пишется в историю, не найдена
+	ErrHistoryEntityNotFound = errors.New("history entity not found")
+	// ErrUnknownHistoryEntity ошибка: неизвестный тип сущности в истории
+	ErrUnknownHistoryEntity = errors.New("unknown history entity type")
+	// ErrCouldNotGetHistory ошибка: не удалось получить историю изменений
+	ErrCouldNotGetHistory = errors.New("couldn't get history")
+	// ErrCouldNotRecordHistory ошибка: не удалось записать изменение в историю
+	ErrCouldNotRecordHistory = errors.New("couldn't record history entry")
+	// ErrHistoryEntryNotFound ошибка: записи в истории с полученным ID не существует
+	ErrHistoryEntryNotFound = errors.New("history entry not found")
+	// ErrHistoryNotRevertible ошибка: изменение такого типа нельзя отменить
+	ErrHistoryNotRevertible = errors.New("history entry can't be reverted")
+	// ErrHistoryRevertConflict ошибка: отмена изменения конфликтует с текущим состоянием доски
+	ErrHistoryRevertConflict = errors.New("history entry conflicts with current state")
+	// ErrCouldNotRevertHistory ошибка: не удалось отменить изменение
+	ErrCouldNotRevertHistory = errors.New("couldn't revert history entry")
+)
+
+// Ошибки, связанные с порядком списков, заданий и элементов чеклистов
+var (
+	// ErrInvalidPosition ошибка: элемент ставится рядом с элементом другого контейнера или рядом с самим собой
+	ErrInvalidPosition = errors.New("invalid position")
+	// ErrInvalidRankBounds ошибка: границы ключа порядка не упорядочены или содержат недопустимые символы
+	ErrInvalidRankBounds = errors.New("invalid rank bounds")
+)
+
 // ErrorResponse
 // структура для обёртки ошибок приложения в ответ бэкэнд-сервера со статусом
 type ErrorResponse struct {
@@ -301,6 +477,20 @@ var StatusConflictResponse = ErrorResponse{
 	Message: "Пользователь с таким адресом почты уже существует",
 }
 
+// NotFoundResponse
+// заглушка для ответа 404 без разглашения имплементации
+var NotFoundResponse = ErrorResponse{
+	Code:    http.StatusNotFound,
+	Message: "Ресурс не найден",
+}
+
+// StateConflictResponse
+// заглушка для ответа 409, когда запрос конфликтует с текущим состоянием данных
+var StateConflictResponse = ErrorResponse{
+	Code:    http.StatusConflict,
+	Message: "Данные уже были изменены",
+}
+
 // GoneResponse
 // заглушка для ответа 410 без разглашения имплементации
 var GoneResponse = ErrorResponse{
@@ -308,6 +498,27 @@ var GoneResponse = ErrorResponse{
 	Message: "Ресурс уже был удалён",
 }
 
+// PayloadTooLargeResponse
+// заглушка для ответа 413, когда загруженный файл превышает допустимый размер
+var PayloadTooLargeResponse = ErrorResponse{
+	Code:    http.StatusRequestEntityTooLarge,
+	Message: "Файл слишком большой",
+}
+
+// WipLimitExceededResponse
+// заглушка для ответа 409, когда в списке достигнут жёсткий лимит задач
+var WipLimitExceededResponse = ErrorResponse{
+	Code:    http.StatusConflict,
+	Message: "Превышен лимит задач в списке",
+}
+
+// TaskBlockedResponse
+// заглушка для ответа 409, когда задание с невыполненными блокирующими заданиями переносится в завершающий список
+var TaskBlockedResponse = ErrorResponse{
+	Code:    http.StatusConflict,
+	Message: "Задание заблокировано невыполненными заданиями",
+}
+
 // ErrorMap
 // карта для связи ошибок приложения и ответа бэкэнд-сервера
 var ErrorMap = map[error]ErrorResponse{
@@ -317,6 +528,7 @@ var ErrorMap = map[error]ErrorResponse{
 	ErrUserAlreadyExists:            StatusConflictResponse,
 	ErrUserNotCreated:               InternalServerErrorResponse,
 	ErrUserNotUpdated:               InternalServerErrorResponse,
+	ErrInvalidTimezone:              BadRequestResponse,
 	ErrTokenNotGenerated:            InternalServerErrorResponse,
 	ErrCSRFNotFound:                 GenericUnauthorizedResponse,
 	ErrCSRFNotCreated:               InternalServerErrorResponse,
@@ -339,16 +551,77 @@ var ErrorMap = map[error]ErrorResponse{
 	ErrNoBoardAccess:                ForbiddenResponse,
 	ErrCouldNotAddBoardUser:         InternalServerErrorResponse,
 	ErrCouldNotRemoveBoardUser:      InternalServerErrorResponse,
+	ErrCouldNotExportBoard:          InternalServerErrorResponse,
+	ErrCouldNotGetBoardStats:        InternalServerErrorResponse,
+	ErrInvalidBoardFilter:           BadRequestResponse,
 	ErrCouldNotAddTaskUser:          InternalServerErrorResponse,
 	ErrCouldNotRemoveTaskUser:       InternalServerErrorResponse,
 	ErrTaskNotCreated:               InternalServerErrorResponse,
 	ErrTaskNotUpdated:               InternalServerErrorResponse,
 	ErrTaskNotDeleted:               InternalServerErrorResponse,
+	ErrTaskNotArchived:              InternalServerErrorResponse,
+	ErrTaskAlreadyOnBoard:           BadRequestResponse,
+	ErrTaskNotMoved:                 InternalServerErrorResponse,
+	ErrInvalidTaskSchedule:          BadRequestResponse,
+	ErrInvalidReminderOffsets:       BadRequestResponse,
+	ErrInvalidRecurrenceRule:        BadRequestResponse,
+	ErrRecurrenceWithoutDueDate:     BadRequestResponse,
+	ErrRecurrenceNotFound:           NotFoundResponse,
+	ErrRecurrenceNotUpdated:         InternalServerErrorResponse,
+	ErrRecurrenceNotGenerated:       InternalServerErrorResponse,
+	ErrSelfDependency:               BadRequestResponse,
+	ErrDependencyCycle:              StateConflictResponse,
+	ErrDependencyAcrossWorkspaces:   BadRequestResponse,
+	ErrDependencyNotFound:           NotFoundResponse,
+	ErrDependencyNotUpdated:         InternalServerErrorResponse,
+	ErrTaskBlocked:                  TaskBlockedResponse,
+	ErrSubtaskCycle:                 StateConflictResponse,
+	ErrSubtaskOnOtherBoard:          BadRequestResponse,
+	ErrNotSubtask:                   NotFoundResponse,
+	ErrSubtaskNotUpdated:            InternalServerErrorResponse,
+	ErrInvalidTaskPriority:          BadRequestResponse,
+	ErrInvalidTaskEstimate:          BadRequestResponse,
+	ErrCouldNotGetNotifications:     InternalServerErrorResponse,
+	ErrNotificationsNotUpdated:      InternalServerErrorResponse,
+	ErrRemindersNotCreated:          InternalServerErrorResponse,
+	ErrNotWatching:                  NotFoundResponse,
+	ErrWatchersNotUpdated:           InternalServerErrorResponse,
+	ErrCouldNotGetWatchers:          InternalServerErrorResponse,
+	ErrTimerNotRunning:              NotFoundResponse,
+	ErrInvalidWorklog:               BadRequestResponse,
+	ErrWorklogNotFound:              NotFoundResponse,
+	ErrWorklogNotUpdated:            InternalServerErrorResponse,
+	ErrCouldNotGetWorklogs:          InternalServerErrorResponse,
+	ErrInvalidTimeReportRange:       BadRequestResponse,
+	ErrCouldNotExportTimeReport:     InternalServerErrorResponse,
+	ErrInvalidBulkOperation:         BadRequestResponse,
+	ErrBulkTasksOnDifferentBoards:   BadRequestResponse,
+	ErrBulkOperationFailed:          InternalServerErrorResponse,
+	ErrTagNotInBoard:                BadRequestResponse,
 	ErrCouldNotGetTask:              InternalServerErrorResponse,
+	ErrTaskNotFound:                 NotFoundResponse,
 	ErrCouldNotGetTaskFiles:         InternalServerErrorResponse,
 	ErrListNotCreated:               InternalServerErrorResponse,
 	ErrListNotUpdated:               InternalServerErrorResponse,
 	ErrListNotDeleted:               InternalServerErrorResponse,
+	ErrCouldNotGetList:              InternalServerErrorResponse,
+	ErrListNotFound:                 NotFoundResponse,
+	ErrListAlreadyOnBoard:           BadRequestResponse,
+	ErrListNotMoved:                 InternalServerErrorResponse,
+	ErrListNotCopied:                InternalServerErrorResponse,
+	ErrListNotArchived:              InternalServerErrorResponse,
+	ErrInvalidWipLimit:              BadRequestResponse,
+	ErrWipLimitExceeded:             WipLimitExceededResponse,
+	ErrInvalidListSort:              BadRequestResponse,
+	ErrInvalidCustomField:           BadRequestResponse,
+	ErrInvalidCustomFieldValue:      BadRequestResponse,
+	ErrCustomFieldNotFound:          NotFoundResponse,
+	ErrCustomFieldNotInBoard:        BadRequestResponse,
+	ErrCouldNotGetCustomField:       InternalServerErrorResponse,
+	ErrCustomFieldNotCreated:        InternalServerErrorResponse,
+	ErrCustomFieldNotUpdated:        InternalServerErrorResponse,
+	ErrCustomFieldNotDeleted:        InternalServerErrorResponse,
+	ErrCouldNotSetCustomFieldValue:  InternalServerErrorResponse,
 	ErrCouldNotGetChecklist:         InternalServerErrorResponse,
 	ErrChecklistNotCreated:          InternalServerErrorResponse,
 	ErrChecklistNotUpdated:          InternalServerErrorResponse,
@@ -357,7 +630,20 @@ var ErrorMap = map[error]ErrorResponse{
 	ErrChecklistItemNotCreated:      InternalServerErrorResponse,
 	ErrChecklistItemNotUpdated:      InternalServerErrorResponse,
 	ErrChecklistItemNotDeleted:      InternalServerErrorResponse,
+	ErrChecklistItemNotFound:        NotFoundResponse,
 	ErrCommentNotCreated:            InternalServerErrorResponse,
+	ErrHistoryEntityNotFound:        InternalServerErrorResponse,
+	ErrUnknownHistoryEntity:         BadRequestResponse,
+	ErrCouldNotGetHistory:           InternalServerErrorResponse,
+	ErrCouldNotRecordHistory:        InternalServerErrorResponse,
+	ErrHistoryEntryNotFound:         NotFoundResponse,
+	ErrHistoryNotRevertible:         BadRequestResponse,
+	ErrHistoryRevertConflict:        StateConflictResponse,
+	ErrRowVersionMissing:            BadRequestResponse,
+	ErrRowVersionConflict:           StateConflictResponse,
+	ErrCouldNotRevertHistory:        InternalServerErrorResponse,
+	ErrInvalidPosition:              BadRequestResponse,
+	ErrInvalidRankBounds:            InternalServerErrorResponse,
 	ErrUserAlreadyInBoard:           StatusConflictResponse,
 	ErrUserNotInBoard:               StatusConflictResponse,
 	ErrUserAlreadyInTask:            StatusConflictResponse,
@@ -365,6 +651,8 @@ var ErrorMap = map[error]ErrorResponse{
 	ErrFailedToCreateFile:           InternalServerErrorResponse,
 	ErrFailedToSaveFile:             InternalServerErrorResponse,
 	ErrFailedToDeleteFile:           InternalServerErrorResponse,
+	ErrInvalidImage:                 BadRequestResponse,
+	ErrImageTooLarge:                PayloadTooLargeResponse,
 	ErrAnswerRatingTooBig:           BadRequestResponse,
 	ErrCouldNotChangeTaskOrder:      BadRequestResponse,
 	ErrCouldNotChangeListOrder:      BadRequestResponse,
@@ -14,11 +14,13 @@ import (
 // ServerConfig
 // структура для хранения параметров сервера
 type Config struct {
-	Session  *SessionConfig  `yaml:"-"`
-	Server   *ServerConfig   `yaml:"server"`
-	CORS     *CORSConfig     `yaml:"cors"`
-	Database *DatabaseConfig `yaml:"db"`
-	Logging  *LoggingConfig  `yaml:"logging"`
+	Session    *SessionConfig    `yaml:"-"`
+	Server     *ServerConfig     `yaml:"server"`
+	CORS       *CORSConfig       `yaml:"cors"`
+	Database   *DatabaseConfig   `yaml:"db"`
+	Logging    *LoggingConfig    `yaml:"logging"`
+	Reminders  *RemindersConfig  `yaml:"reminders"`
+	Recurrence *RecurrenceConfig `yaml:"recurrence"`
 }
 
 type ServerConfig struct {
@@ -63,6 +65,14 @@ type LoggingConfig struct {
 	ReportCaller           bool   `yaml:"report_caller"`
 }
 
+type RemindersConfig struct {
+	Interval time.Duration `yaml:"interval"`
+}
+
+type RecurrenceConfig struct {
+	Interval time.Duration `yaml:"interval"`
+}
+
 // LoadConfig
 // создаёт конфиг из .env файла, находящегося по полученному пути
 func LoadConfig(envPath string, configPath string) (*Config, error) {
@@ -104,6 +114,22 @@ func LoadConfig(envPath string, configPath string) (*Config, error) {
 
 	config.Database.Host = GetDBConnectionHost()
 
+	if config.Reminders == nil {
+		config.Reminders = &RemindersConfig{}
+	}
+	if config.Reminders.Interval <= 0 {
+		log.Println("WARNING: reminders interval is not set, defaulting to 1 minute")
+		config.Reminders.Interval = time.Minute
+	}
+
+	if config.Recurrence == nil {
+		config.Recurrence = &RecurrenceConfig{}
+	}
+	if config.Recurrence.Interval <= 0 {
+		log.Println("WARNING: recurrence interval is not set, defaulting to 1 minute")
+		config.Recurrence.Interval = time.Minute
+	}
+
 	return &config, nil
 }
 
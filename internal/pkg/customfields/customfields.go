@@ -0,0 +1,270 @@
+package customfields
+
+import (
+	"math"
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MaxNameLength
+	// максимальная длина названия пользовательского поля
+	MaxNameLength = 50
+	// MaxTextLength
+	// максимальная длина значения текстового поля
+	MaxTextLength = 1000
+	// MaxOptions
+	// максимальное количество вариантов у поля с выбором
+	MaxOptions = 100
+	// DateLayout
+	// формат, в котором хранятся значения полей с датой
+	DateLayout = "2006-01-02"
+)
+
+var fieldTypes = map[string]struct{}{
+	dto.CustomFieldText:         {},
+	dto.CustomFieldNumber:       {},
+	dto.CustomFieldDate:         {},
+	dto.CustomFieldCheckbox:     {},
+	dto.CustomFieldSingleSelect: {},
+	dto.CustomFieldMultiSelect:  {},
+}
+
+// HasOptions
+// проверяет, задаются ли у поля данного типа варианты выбора
+func HasOptions(fieldType string) bool {
+	return fieldType == dto.CustomFieldSingleSelect || fieldType == dto.CustomFieldMultiSelect
+}
+
+// ValidateDefinition
+// проверяет название, тип и варианты выбора поля и возвращает варианты без повторов и пробелов по краям
+// или возвращает ошибку apperrors.ErrInvalidCustomField
+func ValidateDefinition(name string, fieldType string, options []string) ([]string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > MaxNameLength {
+		return nil, apperrors.ErrInvalidCustomField
+	}
+	if _, ok := fieldTypes[fieldType]; !ok {
+		return nil, apperrors.ErrInvalidCustomField
+	}
+
+	if !HasOptions(fieldType) {
+		if len(options) != 0 {
+			return nil, apperrors.ErrInvalidCustomField
+		}
+		return []string{}, nil
+	}
+
+	if len(options) == 0 || len(options) > MaxOptions {
+		return nil, apperrors.ErrInvalidCustomField
+	}
+	seen := make(map[string]struct{}, len(options))
+	normalized := make([]string, 0, len(options))
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+		if option == "" || utf8.RuneCountInString(option) > MaxNameLength {
+			return nil, apperrors.ErrInvalidCustomField
+		}
+		if _, ok := seen[option]; ok {
+			return nil, apperrors.ErrInvalidCustomField
+		}
+		seen[option] = struct{}{}
+		normalized = append(normalized, option)
+	}
+	return normalized, nil
+}
+
+// NormalizeValue
+// проверяет значение по типу поля и приводит его к виду, в котором оно хранится.
+// Пустое значение (nil, пустая строка, пустой список) возвращается как nil и означает удаление значения
+// или возвращает ошибку apperrors.ErrInvalidCustomFieldValue
+func NormalizeValue(field dto.CustomFieldInfo, value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch field.Type {
+	case dto.CustomFieldText:
+		text, ok := value.(string)
+		if !ok || utf8.RuneCountInString(text) > MaxTextLength {
+			return nil, apperrors.ErrInvalidCustomFieldValue
+		}
+		if strings.TrimSpace(text) == "" {
+			return nil, nil
+		}
+		return text, nil
+	case dto.CustomFieldNumber:
+		return number(value)
+	case dto.CustomFieldDate:
+		return date(value)
+	case dto.CustomFieldCheckbox:
+		checked, ok := value.(bool)
+		if !ok {
+			return nil, apperrors.ErrInvalidCustomFieldValue
+		}
+		return checked, nil
+	case dto.CustomFieldSingleSelect:
+		option, ok := value.(string)
+		if !ok {
+			return nil, apperrors.ErrInvalidCustomFieldValue
+		}
+		if option == "" {
+			return nil, nil
+		}
+		if !hasOption(field.Options, option) {
+			return nil, apperrors.ErrInvalidCustomFieldValue
+		}
+		return option, nil
+	case dto.CustomFieldMultiSelect:
+		selected, err := selection(field.Options, value)
+		if err != nil || len(selected) == 0 {
+			return nil, err
+		}
+		return selected, nil
+	}
+	return nil, apperrors.ErrInvalidCustomFieldValue
+}
+
+// NormalizeFilter
+// проверяет условие фильтрации по типу поля и приводит значения к виду, в котором они хранятся
+// или возвращает ошибку apperrors.ErrInvalidCustomFieldValue
+func NormalizeFilter(field dto.CustomFieldInfo, filter dto.CustomFieldFilter) (dto.CustomFieldFilter, error) {
+	normalized := dto.CustomFieldFilter{
+		FieldID: field.ID,
+		Type:    field.Type,
+	}
+
+	var err error
+	switch field.Type {
+	case dto.CustomFieldNumber, dto.CustomFieldDate:
+		convert := number
+		if field.Type == dto.CustomFieldDate {
+			convert = date
+		}
+		if filter.Value != nil {
+			if normalized.Value, err = convert(filter.Value); err != nil {
+				return dto.CustomFieldFilter{}, err
+			}
+		}
+		if filter.From != nil {
+			if normalized.From, err = convert(filter.From); err != nil {
+				return dto.CustomFieldFilter{}, err
+			}
+		}
+		if filter.To != nil {
+			if normalized.To, err = convert(filter.To); err != nil {
+				return dto.CustomFieldFilter{}, err
+			}
+		}
+	case dto.CustomFieldMultiSelect:
+		if option, ok := filter.Value.(string); ok {
+			filter.Value = []interface{}{option}
+		}
+		selected, err := selection(field.Options, filter.Value)
+		if err != nil {
+			return dto.CustomFieldFilter{}, err
+		}
+		if len(selected) != 0 {
+			normalized.Value = selected
+		}
+	default:
+		if filter.From != nil || filter.To != nil {
+			return dto.CustomFieldFilter{}, apperrors.ErrInvalidCustomFieldValue
+		}
+		if normalized.Value, err = NormalizeValue(field, filter.Value); err != nil {
+			return dto.CustomFieldFilter{}, err
+		}
+	}
+
+	if normalized.Value == nil && normalized.From == nil && normalized.To == nil {
+		return dto.CustomFieldFilter{}, apperrors.ErrInvalidCustomFieldValue
+	}
+	return normalized, nil
+}
+
+func number(value interface{}) (interface{}, error) {
+	var result float64
+	switch v := value.(type) {
+	case float64:
+		result = v
+	case float32:
+		result = float64(v)
+	case int:
+		result = float64(v)
+	case int64:
+		result = float64(v)
+	case uint64:
+		result = float64(v)
+	default:
+		return nil, apperrors.ErrInvalidCustomFieldValue
+	}
+	if math.IsNaN(result) || math.IsInf(result, 0) {
+		return nil, apperrors.ErrInvalidCustomFieldValue
+	}
+	return result, nil
+}
+
+func date(value interface{}) (interface{}, error) {
+	text, ok := value.(string)
+	if !ok {
+		return nil, apperrors.ErrInvalidCustomFieldValue
+	}
+	if parsed, err := time.Parse(DateLayout, text); err == nil {
+		return parsed.Format(DateLayout), nil
+	}
+	parsed, err := time.Parse(time.RFC3339, text)
+	if err != nil {
+		return nil, apperrors.ErrInvalidCustomFieldValue
+	}
+	return parsed.UTC().Format(DateLayout), nil
+}
+
+func selection(options []string, value interface{}) ([]string, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	var values []string
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			option, ok := item.(string)
+			if !ok {
+				return nil, apperrors.ErrInvalidCustomFieldValue
+			}
+			values = append(values, option)
+		}
+	case []string:
+		values = v
+	default:
+		return nil, apperrors.ErrInvalidCustomFieldValue
+	}
+
+	chosen := make(map[string]struct{}, len(values))
+	for _, option := range values {
+		if !hasOption(options, option) {
+			return nil, apperrors.ErrInvalidCustomFieldValue
+		}
+		chosen[option] = struct{}{}
+	}
+
+	selected := make([]string, 0, len(chosen))
+	for _, option := range options {
+		if _, ok := chosen[option]; ok {
+			selected = append(selected, option)
+		}
+	}
+	return selected, nil
+}
+
+func hasOption(options []string, option string) bool {
+	for _, o := range options {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,243 @@
+package customfields
+
+import (
+	"errors"
+	"reflect"
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"strings"
+	"testing"
+)
+
+func TestValidateDefinition(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		fieldName string
+		fieldType string
+		options   []string
+		want      []string
+		wantErr   bool
+	}{
+		{
+			name:      "Text field",
+			fieldName: "Notes",
+			fieldType: dto.CustomFieldText,
+			want:      []string{},
+		},
+		{
+			name:      "Select options are trimmed",
+			fieldName: "Priority",
+			fieldType: dto.CustomFieldSingleSelect,
+			options:   []string{" low", "high "},
+			want:      []string{"low", "high"},
+		},
+		{
+			name:      "Unknown type",
+			fieldName: "Priority",
+			fieldType: "color",
+			wantErr:   true,
+		},
+		{
+			name:      "Empty name",
+			fieldName: "  ",
+			fieldType: dto.CustomFieldNumber,
+			wantErr:   true,
+		},
+		{
+			name:      "Too long name",
+			fieldName: strings.Repeat("я", MaxNameLength+1),
+			fieldType: dto.CustomFieldNumber,
+			wantErr:   true,
+		},
+		{
+			name:      "Options on a number field",
+			fieldName: "Estimate",
+			fieldType: dto.CustomFieldNumber,
+			options:   []string{"1"},
+			wantErr:   true,
+		},
+		{
+			name:      "Select without options",
+			fieldName: "Stage",
+			fieldType: dto.CustomFieldMultiSelect,
+			wantErr:   true,
+		},
+		{
+			name:      "Duplicate options",
+			fieldName: "Stage",
+			fieldType: dto.CustomFieldMultiSelect,
+			options:   []string{"a", " a"},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ValidateDefinition(tt.fieldName, tt.fieldType, tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateDefinition() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, apperrors.ErrInvalidCustomField) {
+				t.Errorf("ValidateDefinition() error = %v, want %v", err, apperrors.ErrInvalidCustomField)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateDefinition() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeValue(t *testing.T) {
+	t.Parallel()
+	options := []string{"low", "medium", "high"}
+	tests := []struct {
+		name    string
+		field   dto.CustomFieldInfo
+		value   interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:  "Null clears value",
+			field: dto.CustomFieldInfo{Type: dto.CustomFieldNumber},
+			value: nil,
+			want:  nil,
+		},
+		{
+			name:  "Text",
+			field: dto.CustomFieldInfo{Type: dto.CustomFieldText},
+			value: "some text",
+			want:  "some text",
+		},
+		{
+			name:  "Blank text clears value",
+			field: dto.CustomFieldInfo{Type: dto.CustomFieldText},
+			value: "   ",
+			want:  nil,
+		},
+		{
+			name:    "Number given as string",
+			field:   dto.CustomFieldInfo{Type: dto.CustomFieldNumber},
+			value:   "5",
+			wantErr: true,
+		},
+		{
+			name:  "Number",
+			field: dto.CustomFieldInfo{Type: dto.CustomFieldNumber},
+			value: float64(2.5),
+			want:  float64(2.5),
+		},
+		{
+			name:  "Date in RFC3339 is cut to a day",
+			field: dto.CustomFieldInfo{Type: dto.CustomFieldDate},
+			value: "2024-03-01T23:30:00-02:00",
+			want:  "2024-03-02",
+		},
+		{
+			name:    "Invalid date",
+			field:   dto.CustomFieldInfo{Type: dto.CustomFieldDate},
+			value:   "2024-02-30",
+			wantErr: true,
+		},
+		{
+			name:    "Checkbox given as number",
+			field:   dto.CustomFieldInfo{Type: dto.CustomFieldCheckbox},
+			value:   float64(1),
+			wantErr: true,
+		},
+		{
+			name:  "Single select",
+			field: dto.CustomFieldInfo{Type: dto.CustomFieldSingleSelect, Options: options},
+			value: "high",
+			want:  "high",
+		},
+		{
+			name:    "Single select with unknown option",
+			field:   dto.CustomFieldInfo{Type: dto.CustomFieldSingleSelect, Options: options},
+			value:   "urgent",
+			wantErr: true,
+		},
+		{
+			name:  "Multi select keeps option order",
+			field: dto.CustomFieldInfo{Type: dto.CustomFieldMultiSelect, Options: options},
+			value: []interface{}{"high", "low", "high"},
+			want:  []string{"low", "high"},
+		},
+		{
+			name:  "Empty multi select clears value",
+			field: dto.CustomFieldInfo{Type: dto.CustomFieldMultiSelect, Options: options},
+			value: []interface{}{},
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NormalizeValue(tt.field, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NormalizeValue() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeFilter(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		field   dto.CustomFieldInfo
+		filter  dto.CustomFieldFilter
+		want    dto.CustomFieldFilter
+		wantErr bool
+	}{
+		{
+			name:   "Date range",
+			field:  dto.CustomFieldInfo{ID: 1, Type: dto.CustomFieldDate},
+			filter: dto.CustomFieldFilter{FieldID: 1, From: "2024-01-01", To: "2024-01-31"},
+			want:   dto.CustomFieldFilter{FieldID: 1, Type: dto.CustomFieldDate, From: "2024-01-01", To: "2024-01-31"},
+		},
+		{
+			name:   "Single option in multi select",
+			field:  dto.CustomFieldInfo{ID: 1, Type: dto.CustomFieldMultiSelect, Options: []string{"a", "b"}},
+			filter: dto.CustomFieldFilter{FieldID: 1, Value: "b"},
+			want:   dto.CustomFieldFilter{FieldID: 1, Type: dto.CustomFieldMultiSelect, Value: []string{"b"}},
+		},
+		{
+			name:   "Unchecked checkbox",
+			field:  dto.CustomFieldInfo{ID: 1, Type: dto.CustomFieldCheckbox},
+			filter: dto.CustomFieldFilter{FieldID: 1, Value: false},
+			want:   dto.CustomFieldFilter{FieldID: 1, Type: dto.CustomFieldCheckbox, Value: false},
+		},
+		{
+			name:    "Range on a text field",
+			field:   dto.CustomFieldInfo{ID: 1, Type: dto.CustomFieldText},
+			filter:  dto.CustomFieldFilter{FieldID: 1, From: "a"},
+			wantErr: true,
+		},
+		{
+			name:    "No condition",
+			field:   dto.CustomFieldInfo{ID: 1, Type: dto.CustomFieldNumber},
+			filter:  dto.CustomFieldFilter{FieldID: 1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NormalizeFilter(tt.field, tt.filter)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NormalizeFilter() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
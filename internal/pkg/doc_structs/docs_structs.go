@@ -32,16 +32,33 @@ type URLResponse struct {
 }
 
 type TaskResponse struct {
-	Task entities.Task `json:"task"`
+	Task       entities.Task        `json:"task"`
+	WipWarning *dto.WipLimitWarning `json:"wip_warning,omitempty"`
+}
+
+type WipWarningResponse struct {
+	WipWarning *dto.WipLimitWarning `json:"wip_warning,omitempty"`
 }
 
 type TagResponse struct {
 	Tag entities.Tag `json:"tag"`
 }
 
+type CustomFieldResponse struct {
+	CustomField dto.CustomFieldInfo `json:"custom_field"`
+}
+
 type BoardResponse struct {
 	Board dto.FullBoardResult `json:"board"`
 }
+
+type BoardStatsResponse struct {
+	Stats dto.BoardStats `json:"stats"`
+}
+
+type BoardArchiveResponse struct {
+	Archive dto.BoardArchive `json:"archive"`
+}
 type WorkspaceResponse struct {
 	Workspace entities.Workspace `json:"workspace"`
 }
@@ -85,3 +102,79 @@ type FileListResponse struct {
 type GetHistoryResponse struct {
 	Entries []dto.BoardHistoryEntry `json:"history"`
 }
+
+type BoardChangesResponse struct {
+	Changes dto.BoardChanges `json:"changes"`
+}
+
+type TaskUpdateResponse struct {
+	Task dto.SingleTaskInfo `json:"task"`
+}
+
+type TaskConflictResponse struct {
+	Message string             `json:"error_response"`
+	Task    dto.SingleTaskInfo `json:"task"`
+}
+
+type ListUpdateResponse struct {
+	List dto.SingleListInfo `json:"list"`
+}
+
+type ListConflictResponse struct {
+	Message string             `json:"error_response"`
+	List    dto.SingleListInfo `json:"list"`
+}
+
+type ListTransferResponse struct {
+	Report dto.ListTransferReport `json:"report"`
+}
+
+type TaskTransferResponse struct {
+	Report     dto.TaskTransferReport `json:"report"`
+	WipWarning *dto.WipLimitWarning   `json:"wip_warning,omitempty"`
+}
+
+type BoardUpdateResponse struct {
+	Board dto.SingleBoardInfo `json:"board"`
+}
+
+type BoardConflictResponse struct {
+	Message string              `json:"error_response"`
+	Board   dto.SingleBoardInfo `json:"board"`
+}
+
+type RemindersResponse struct {
+	Reminders []uint64 `json:"reminders"`
+}
+
+type NotificationsResponse struct {
+	Notifications []dto.NotificationInfo `json:"notifications"`
+}
+
+type RecurrenceResponse struct {
+	Rule string `json:"rule"`
+}
+
+type WatchersResponse struct {
+	Watchers []dto.UserPublicInfo `json:"watchers"`
+}
+
+type WorklogResponse struct {
+	Entry dto.WorklogInfo `json:"entry"`
+}
+
+type TimerResponse struct {
+	Timer *dto.WorklogInfo `json:"timer"`
+}
+
+type TaskTimeResponse struct {
+	Time dto.TaskTimeInfo `json:"time"`
+}
+
+type TimeReportResponse struct {
+	Report dto.TimeReport `json:"report"`
+}
+
+type TaskActivityResponse struct {
+	Activity dto.TaskActivity `json:"activity"`
+}
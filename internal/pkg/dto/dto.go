@@ -155,10 +155,33 @@ type RoleInWorkspace struct {
 }
 
 // IndividualBoardRequest
-// структура для запроса данных доски
+// структура для запроса данных доски; пустые фильтры не применяются,
+// комментарии и чеклисты по умолчанию включаются в ответ
 type IndividualBoardRequest struct {
-	BoardID uint64 `json:"board_id"`
-	UserID  uint64 `json:"user_id"`
+	BoardID           uint64              `json:"board_id"`
+	UserID            uint64              `json:"user_id"`
+	CustomFields      []CustomFieldFilter `json:"custom_fields"`
+	AssigneeIDs       []uint64            `json:"assignees"`
+	TagIDs            []uint64            `json:"tags"`
+	DueFrom           *time.Time          `json:"due_from"`
+	DueTo             *time.Time          `json:"due_to"`
+	Text              string              `json:"text"`
+	IncludeComments   *bool               `json:"include_comments"`
+	IncludeChecklists *bool               `json:"include_checklists"`
+}
+
+// TaskFilter
+// DTO для чтения заданий по id с условиями отбора: задание подходит, если у него есть
+// хотя бы один из исполнителей, хотя бы один из тегов, срок в диапазоне и текст в названии или описании
+//
+//easyjson:skip
+type TaskFilter struct {
+	TaskIDs     []string
+	AssigneeIDs []uint64
+	TagIDs      []uint64
+	DueFrom     *time.Time
+	DueTo       *time.Time
+	Text        string
 }
 
 // AvatarChangeInfo
@@ -223,6 +246,13 @@ type UserProfileInfo struct {
 	Description string `json:"description,omitempty" valid:"optional,stringlength(0|256)"`
 }
 
+// UserTimezoneInfo
+// DTO для изменения часового пояса пользователя, в котором ему показываются сроки заданий
+type UserTimezoneInfo struct {
+	UserID   uint64 `json:"-"`
+	Timezone string `json:"timezone"`
+}
+
 // UserProfileInfo
 // DTO для изменения профиля
 type UserPublicInfo struct {
@@ -336,14 +366,24 @@ type SingleBoardInfo struct {
 	WorkspaceOwnerID uint64    `json:"owner_id"`
 	ThumbnailURL     *string   `json:"thumbnail_url"`
 	DateCreated      time.Time `json:"date_created"`
+	Version          uint64    `json:"version"`
+	RowVersion       uint64    `json:"row_version"`
 }
 
 type SingleListInfo struct {
-	ID           uint64   `json:"id"`
-	BoardID      uint64   `json:"board_id"`
-	Name         string   `json:"name"`
-	ListPosition uint64   `json:"list_position"`
-	TaskIDs      []string `json:"cards"`
+	ID           uint64  `json:"id"`
+	BoardID      uint64  `json:"board_id"`
+	Name         string  `json:"name"`
+	ListPosition uint64  `json:"list_position"`
+	WipLimit     *uint64 `json:"wip_limit"`
+	WipMode      string  `json:"wip_mode"`
+	IsDone       bool    `json:"is_done"`
+	// RequireUnblocked -- в завершающий список нельзя перенести задание с невыполненными блокирующими заданиями
+	RequireUnblocked bool       `json:"require_unblocked"`
+	RowVersion       uint64     `json:"row_version"`
+	DateArchived     *time.Time `json:"date_archived"`
+	TaskCount        uint64     `json:"task_count"`
+	TaskIDs          []string   `json:"cards"`
 }
 
 type TagInfo struct {
@@ -361,22 +401,59 @@ type SingleTaskInfo struct {
 	ListPosition uint64     `json:"list_position"`
 	Start        *time.Time `json:"start"`
 	End          *time.Time `json:"end"`
+	AllDay       bool       `json:"all_day"`
+	Priority     string     `json:"priority"`
+	Estimate     *float64   `json:"estimate"`
+	RowVersion   uint64     `json:"row_version"`
+	DateArchived *time.Time `json:"date_archived"`
 	UserIDs      []string   `json:"users"`
 	CommentIDs   []string   `json:"comments"`
 	ChecklistIDs []string   `json:"checklists"`
 	TagIDs       []string   `json:"tags"`
+	Reminders    []string   `json:"reminders"`
+	Recurrence   *string    `json:"recurrence"`
+	// Blockers -- задания, которые блокируют это задание, Blocking -- задания, которые блокирует оно;
+	// Blocked -- среди блокирующих есть невыполненные
+	Blockers []string `json:"blockers"`
+	Blocking []string `json:"blocking"`
+	Blocked  bool     `json:"blocked"`
+	// ParentID -- родительское задание; Subtasks -- подзадачи, SubtasksDone -- сколько из них выполнено
+	ParentID     *uint64  `json:"parent_id"`
+	Subtasks     []string `json:"subtasks"`
+	SubtasksDone uint64   `json:"subtasks_done"`
 	// Checklists
 }
 
+// BoardArchive
+// DTO архива доски: архивные списки и задания, скрытые с доски, — архивные сами или лежащие в архивных списках
+type BoardArchive struct {
+	BoardID uint64           `json:"board_id"`
+	Lists   []SingleListInfo `json:"lists"`
+	Tasks   []SingleTaskInfo `json:"cards"`
+}
+
 type FullBoardResult struct {
-	Board          SingleBoardInfo     `json:"board"`
-	Lists          []SingleListInfo    `json:"lists"`
-	Tasks          []SingleTaskInfo    `json:"cards"`
-	Users          []UserPublicInfo    `json:"users"`
-	Comments       []CommentInfo       `json:"comments"`
-	Checklists     []ChecklistInfo     `json:"checklists"`
-	ChecklistItems []ChecklistItemInfo `json:"checklist_items"`
-	Tags           []TagInfo           `json:"tags"`
+	Board             SingleBoardInfo        `json:"board"`
+	Lists             []SingleListInfo       `json:"lists"`
+	Tasks             []SingleTaskInfo       `json:"cards"`
+	Users             []UserPublicInfo       `json:"users"`
+	Comments          []CommentInfo          `json:"comments"`
+	Checklists        []ChecklistInfo        `json:"checklists"`
+	ChecklistItems    []ChecklistItemInfo    `json:"checklist_items"`
+	Tags              []TagInfo              `json:"tags"`
+	CustomFields      []CustomFieldInfo      `json:"custom_fields"`
+	CustomFieldValues []CustomFieldValueInfo `json:"custom_field_values"`
+	Timezone          string                 `json:"-"`
+}
+
+// ExportedBoard
+// DTO с выгруженным содержимым доски
+//
+//easyjson:skip
+type ExportedBoard struct {
+	Filename    string
+	ContentType string
+	Content     []byte
 }
 
 //easyjson:skip
@@ -416,6 +493,13 @@ type UserAndWorkspaceIDs struct {
 	UserID      uint64 `json:"user_id"`
 }
 
+// BoardVersion
+// DTO версии доски и часового пояса пользователя, в котором возвращаются даты её заданий
+type BoardVersion struct {
+	Version  uint64 `json:"version"`
+	Timezone string `json:"timezone"`
+}
+
 // BoardID
 // DTO для id доски
 type BoardID struct {
@@ -487,6 +571,15 @@ type NewWorkspaceInfo struct {
 	OwnerID     uint64 `json:"owner_id"`
 }
 
+const (
+	// WipModeSoft
+	// при превышении лимита задача добавляется в список с предупреждением
+	WipModeSoft = "soft"
+	// WipModeHard
+	// при превышении лимита задача не добавляется в список
+	WipModeHard = "hard"
+)
+
 // ListID
 // DTO для id списка задач
 type ListID struct {
@@ -499,17 +592,171 @@ type ListIDs struct {
 	Values []uint64 `json:"ids"`
 }
 
+// Приоритеты заданий в порядке возрастания важности
+const (
+	TaskPriorityNone   = "none"
+	TaskPriorityLow    = "low"
+	TaskPriorityMedium = "medium"
+	TaskPriorityHigh   = "high"
+	TaskPriorityUrgent = "urgent"
+)
+
+// Ключи сортировки заданий списка
+const (
+	ListSortPriority = "priority"
+	ListSortDue      = "due"
+	ListSortName     = "name"
+	ListSortCreated  = "created"
+)
+
+// ListSortInfo
+// DTO для сортировки заданий списка по ключу By: priority -- сначала самые важные, due -- сначала ближайшие сроки,
+// name -- по алфавиту, created -- сначала старые. Reverse переворачивает порядок; задания без срока всегда в конце
+type ListSortInfo struct {
+	ListID  uint64 `json:"list_id"`
+	By      string `json:"by"`
+	Reverse bool   `json:"reverse"`
+}
+
+// ListMoveInfo
+// DTO для перестановки списка: список ставится сразу после списка AfterID той же доски,
+// без AfterID — в начало доски
+type ListMoveInfo struct {
+	ListID  uint64  `json:"list_id"`
+	AfterID *uint64 `json:"after_id"`
+}
+
+// ListTransferInfo
+// DTO для переноса или копирования списка на доску BoardID: список ставится сразу после списка AfterID
+// этой доски, без AfterID — в начало доски
+type ListTransferInfo struct {
+	ListID  uint64  `json:"list_id"`
+	BoardID uint64  `json:"board_id"`
+	AfterID *uint64 `json:"after_id"`
+}
+
+// TransferredTag
+// DTO тэга, заменённого при переносе на тэг целевой доски с тем же названием
+type TransferredTag struct {
+	FromTagID uint64 `json:"from_tag_id"`
+	ToTagID   uint64 `json:"to_tag_id"`
+	Name      string `json:"name"`
+	Created   bool   `json:"created"`
+}
+
+// DroppedAssignee
+// DTO исполнителя, снятого с задания, потому что он не участник целевой доски
+type DroppedAssignee struct {
+	TaskID uint64 `json:"task_id"`
+	UserID uint64 `json:"user_id"`
+}
+
+// DroppedFieldValue
+// DTO значения пользовательского поля, удалённого, потому что на целевой доске этого поля нет
+type DroppedFieldValue struct {
+	TaskID  uint64 `json:"task_id"`
+	FieldID uint64 `json:"field_id"`
+}
+
+// TransferChanges
+// DTO изменений в заданиях, сделанных при переносе или копировании на другую доску
+type TransferChanges struct {
+	RemappedTags       []TransferredTag    `json:"remapped_tags"`
+	DroppedAssignees   []DroppedAssignee   `json:"dropped_assignees"`
+	DroppedFieldValues []DroppedFieldValue `json:"dropped_field_values"`
+	// DetachedSubtasks -- задания, связь которых с родителем или подзадачей оказалась между досками и была снята
+	DetachedSubtasks []uint64 `json:"detached_subtasks"`
+}
+
+// ListTransferReport
+// DTO результата переноса или копирования списка: для копии ListID — id нового списка
+type ListTransferReport struct {
+	ListID  uint64          `json:"list_id"`
+	BoardID uint64          `json:"board_id"`
+	Changes TransferChanges `json:"changes"`
+}
+
 // TaskMoveInfo
-// DTO для переноса задания из одного списка в другой
+// DTO для переноса задания: задание ставится в список ListID сразу после задания AfterID этого списка,
+// без AfterID — в начало списка
 type TaskMoveInfo struct {
-	TaskID  uint64           `json:"task_id"`
-	OldList TaskMoveListInfo `json:"old_list"`
-	NewList TaskMoveListInfo `json:"new_list"`
+	TaskID  uint64  `json:"task_id"`
+	ListID  uint64  `json:"list_id"`
+	AfterID *uint64 `json:"after_id"`
+}
+
+// TaskTransferInfo
+// DTO для переноса задания на другую доску: задание ставится в список ListID этой доски
+// сразу после задания AfterID, без AfterID — в начало списка
+type TaskTransferInfo struct {
+	TaskID  uint64  `json:"task_id"`
+	ListID  uint64  `json:"list_id"`
+	AfterID *uint64 `json:"after_id"`
+}
+
+// TaskTransferReport
+// DTO результата переноса или копирования задания: для копии TaskID — id нового задания
+type TaskTransferReport struct {
+	TaskID  uint64          `json:"task_id"`
+	ListID  uint64          `json:"list_id"`
+	BoardID uint64          `json:"board_id"`
+	Changes TransferChanges `json:"changes"`
+}
+
+// TaskCopyParts
+// DTO частей задания, которые копируются вместе с ним; название копируется всегда.
+// Файлы копируются как новые ссылки на те же файлы
+type TaskCopyParts struct {
+	Description bool `json:"description"`
+	Checklists  bool `json:"checklists"`
+	Tags        bool `json:"tags"`
+	Assignees   bool `json:"assignees"`
+	Dates       bool `json:"dates"`
+	Attachments bool `json:"attachments"`
+	Comments    bool `json:"comments"`
+}
+
+// TaskCopyInfo
+// DTO для копирования задания: копия ставится в список ListID сразу после задания AfterID этого списка,
+// без AfterID — в начало списка
+type TaskCopyInfo struct {
+	TaskID  uint64        `json:"task_id"`
+	ListID  uint64        `json:"list_id"`
+	AfterID *uint64       `json:"after_id"`
+	Parts   TaskCopyParts `json:"parts"`
+}
+
+// Операции над несколькими заданиями сразу
+const (
+	TaskBulkMove      = "move"
+	TaskBulkAssign    = "assign"
+	TaskBulkUnassign  = "unassign"
+	TaskBulkAddTag    = "add_tag"
+	TaskBulkRemoveTag = "remove_tag"
+	TaskBulkSetDue    = "set_due"
+	TaskBulkArchive   = "archive"
+	TaskBulkDelete    = "delete"
+)
+
+// TaskBulkRequest
+// DTO операции над несколькими заданиями одной доски: ListID нужен для move (задания встают в конец списка),
+// UserID -- для assign и unassign, TagID -- для add_tag и remove_tag, Due -- для set_due (без него срок снимается)
+type TaskBulkRequest struct {
+	TaskIDs   []uint64   `json:"task_ids"`
+	Operation string     `json:"operation"`
+	ListID    *uint64    `json:"list_id"`
+	UserID    *uint64    `json:"user_id"`
+	TagID     *uint64    `json:"tag_id"`
+	Due       *time.Time `json:"due"`
 }
 
-type TaskMoveListInfo struct {
-	ListID  uint64   `json:"id"`
-	TaskIDs []uint64 `json:"task_ids"`
+// TaskBulkInfo
+// DTO массовой операции вместе с пользователем, который её выполняет
+//
+//easyjson:skip
+type TaskBulkInfo struct {
+	TaskBulkRequest
+	RequesterID uint64
 }
 
 // CommentIDs
@@ -556,6 +803,8 @@ type NewCommentInfo struct {
 	UserID uint64 `json:"user_id"`
 	TaskID uint64 `json:"task_id"`
 	Text   string `json:"text"`
+	// Mentions email упомянутых в тексте пользователей, заполняется сервисом
+	Mentions []string `json:"-"`
 }
 
 // NewListInfo
@@ -626,10 +875,12 @@ type ChecklistItemStringIDs struct {
 	Values []string `json:"ids"`
 }
 
-// ChecklistItemID
-// DTO для ID элементов чеклиста
-type ChecklistItemIDs struct {
-	Values []uint64 `json:"ids"`
+// ChecklistItemMoveInfo
+// DTO для перестановки элемента чеклиста: элемент ставится сразу после элемента AfterID того же чеклиста,
+// без AfterID — в начало чеклиста
+type ChecklistItemMoveInfo struct {
+	ItemID  uint64  `json:"item_id"`
+	AfterID *uint64 `json:"after_id"`
 }
 
 // NewChecklistItemInfo
@@ -654,9 +905,10 @@ type UpdatedChecklistItemInfo struct {
 // NewTaskInfo
 // DTO для новой задачи
 type NewTaskInfo struct {
-	ListID       uint64 `json:"list_id"`
-	Name         string `json:"name"`
-	ListPosition uint64 `json:"list_position"`
+	ListID       uint64  `json:"list_id"`
+	Name         string  `json:"name"`
+	ListPosition uint64  `json:"list_position"`
+	ParentID     *uint64 `json:"parent_id"`
 }
 
 // NewTagInfo
@@ -690,21 +942,27 @@ type TagID struct {
 }
 
 // UpdatedTaskInfo
-// DTO для обновленной задачи
+// DTO для обновленной задачи; RowVersion -- последняя известная клиенту версия строки задания.
+// Пустые Start и End снимают сроки, AllDay -- задание на весь день без времени.
+// Пустой Priority -- без приоритета, пустой Estimate снимает оценку
 type UpdatedTaskInfo struct {
-	ID           uint64     `json:"id"`
-	Name         string     `json:"name"`
-	Description  *string    `json:"description"`
-	Start        *time.Time `json:"start"`
-	End          *time.Time `json:"end"`
-	ListPosition uint64     `json:"list_position"`
+	ID          uint64     `json:"id"`
+	Name        string     `json:"name"`
+	Description *string    `json:"description"`
+	Start       *time.Time `json:"start"`
+	End         *time.Time `json:"end"`
+	AllDay      bool       `json:"all_day"`
+	Priority    string     `json:"priority"`
+	Estimate    *float64   `json:"estimate"`
+	RowVersion  uint64     `json:"row_version"`
 }
 
 // UpdatedBoardInfo
-// DTO для обновленной доски
+// DTO для обновленной доски; RowVersion -- последняя известная клиенту версия строки доски
 type UpdatedBoardInfo struct {
-	ID   uint64 `json:"id"`
-	Name string `json:"name"`
+	ID         uint64 `json:"id"`
+	Name       string `json:"name"`
+	RowVersion uint64 `json:"row_version"`
 }
 
 // UpdatedBoardThumbnailInfo
@@ -717,12 +975,36 @@ type UpdatedBoardThumbnailInfo struct {
 }
 
 // UpdatedListInfo
-// DTO для обновленного списка задач
+// DTO для обновленного списка задач; RowVersion -- последняя известная клиенту версия строки списка
 type UpdatedListInfo struct {
-	ID           uint64  `json:"id"`
-	Name         string  `json:"name"`
-	Description  *string `json:"description"`
-	ListPosition uint64  `json:"list_position"`
+	ID               uint64  `json:"id"`
+	Name             string  `json:"name"`
+	Description      *string `json:"description"`
+	ListPosition     uint64  `json:"list_position"`
+	WipLimit         *uint64 `json:"wip_limit"`
+	WipMode          string  `json:"wip_mode"`
+	IsDone           bool    `json:"is_done"`
+	RequireUnblocked bool    `json:"require_unblocked"`
+	RowVersion       uint64  `json:"row_version"`
+}
+
+// ListWipInfo
+// DTO для лимита задач в списке и текущего количества задач в нём
+//
+//easyjson:skip
+type ListWipInfo struct {
+	ListID    uint64
+	WipLimit  *uint64
+	WipMode   string
+	TaskCount uint64
+}
+
+// WipLimitWarning
+// DTO для предупреждения о превышении мягкого лимита задач в списке
+type WipLimitWarning struct {
+	ListID    uint64 `json:"list_id"`
+	WipLimit  uint64 `json:"wip_limit"`
+	TaskCount uint64 `json:"task_count"`
 }
 
 // UpdatedWorkspaceInfo
@@ -822,16 +1104,594 @@ type RemoveFileInfo struct {
 	FilePath     string `json:"file_path" valid:"-"`
 }
 
-type BoardHistoryEntry struct {
-	User       UserPublicInfo `json:"user" valid:"-"`
-	DateEdited time.Time      `json:"timestamp" valid:"-"`
-	Actions    string         `json:"actions" valid:"-"`
+// Типы сущностей, изменения которых пишутся в историю
+const (
+	HistoryEntityBoard         = "board"
+	HistoryEntityList          = "list"
+	HistoryEntityTask          = "task"
+	HistoryEntityChecklist     = "checklist"
+	HistoryEntityChecklistItem = "checklist_item"
+	HistoryEntityComment       = "comment"
+	HistoryEntityTag           = "tag"
+	HistoryEntityCustomField   = "custom_field"
+)
+
+// Действия, которые пишутся в историю
+const (
+	HistoryActionCreate     = "create"
+	HistoryActionUpdate     = "update"
+	HistoryActionDelete     = "delete"
+	HistoryActionMove       = "move"
+	HistoryActionReorder    = "reorder"
+	HistoryActionAddUser    = "add_user"
+	HistoryActionRemoveUser = "remove_user"
+	HistoryActionAddTag     = "add_tag"
+	HistoryActionRemoveTag  = "remove_tag"
+	HistoryActionAttachFile = "attach_file"
+	HistoryActionRemoveFile = "remove_file"
+	HistoryActionLink       = "link"
+	HistoryActionUnlink     = "unlink"
+	HistoryActionSetField   = "set_field"
+	HistoryActionArchive    = "archive"
+	HistoryActionUnarchive  = "unarchive"
+)
+
+// HistoryChildrenField
+// поле записи об удалении сущности с числом удалённых вместе с ней зависимых строк:
+// такую сущность нельзя восстановить отменой, если зависимые строки были
+const HistoryChildrenField = "children"
+
+// HistoryFieldChange
+// DTO изменения одного поля сущности: значение до и после. В записи о массовом изменении
+// EntityID указывает, к какой из сущностей относится изменение
+type HistoryFieldChange struct {
+	EntityID uint64      `json:"entity_id,omitempty" valid:"-"`
+	Field    string      `json:"field" valid:"-"`
+	Before   interface{} `json:"before" valid:"-"`
+	After    interface{} `json:"after" valid:"-"`
 }
 
+// BoardHistoryEntry
+// DTO записи в истории изменений доски
+type BoardHistoryEntry struct {
+	ID         uint64               `json:"id" valid:"-"`
+	BoardID    uint64               `json:"board_id" valid:"-"`
+	User       UserPublicInfo       `json:"user" valid:"-"`
+	DateEdited time.Time            `json:"timestamp" valid:"-"`
+	Actions    string               `json:"actions" valid:"-"`
+	EntityType string               `json:"entity_type" valid:"-"`
+	EntityID   uint64               `json:"entity_id" valid:"-"`
+	Action     string               `json:"action" valid:"-"`
+	Changes    []HistoryFieldChange `json:"changes" valid:"-"`
+	RevertedID *uint64              `json:"reverted_id" valid:"-"`
+	TaskID     *uint64              `json:"task_id" valid:"-"`
+}
+
+// NewHistoryEntry
+// DTO новой записи в истории изменений доски
+//
+//easyjson:skip
 type NewHistoryEntry struct {
-	UserID  uint64 `json:"-" valid:"-"`
+	UserID     uint64
+	BoardID    uint64
+	EntityType string
+	EntityID   uint64
+	Action     string
+	Changes    []HistoryFieldChange
+	RevertedID *uint64
+	TaskID     *uint64
+	// Watchers наблюдатели удалённых заданий: их подписки удаляются вместе с заданием,
+	// поэтому уведомление об удалении получают наблюдатели, бывшие у задания до него
+	Watchers []uint64
+}
+
+// HistoryEntryID
+// DTO для ID записи в истории изменений
+type HistoryEntryID struct {
+	Value uint64 `json:"entry_id" valid:"-"`
+}
+
+// HistoryRevertInfo
+// DTO отмены записи в истории: отменяемая запись и запись о самой отмене
+//
+//easyjson:skip
+type HistoryRevertInfo struct {
+	Original BoardHistoryEntry
+	Revert   NewHistoryEntry
+}
+
+// BoardHistoryRequest
+// DTO запроса истории изменений доски с фильтрами и пагинацией
+type BoardHistoryRequest struct {
+	BoardID    uint64     `json:"board_id" valid:"-"`
+	EntityType *string    `json:"entity_type" valid:"-"`
+	EntityID   *uint64    `json:"entity_id" valid:"-"`
+	UserID     *uint64    `json:"user_id" valid:"-"`
+	From       *time.Time `json:"from" valid:"-"`
+	To         *time.Time `json:"to" valid:"-"`
+	Limit      uint64     `json:"limit" valid:"-"`
+	Offset     uint64     `json:"offset" valid:"-"`
+}
+
+// BoardChangesRequest
+// DTO запроса изменений доски после версии Since
+//
+//easyjson:skip
+type BoardChangesRequest struct {
+	BoardID uint64
+	Since   uint64
+}
+
+// BoardChange
+// DTO последнего изменения сущности доски после запрошенной версии
+//
+//easyjson:skip
+type BoardChange struct {
+	EntityType string
+	EntityID   uint64
+	Action     string
+	Version    uint64
+}
+
+// DeletedEntity
+// DTO удалённой сущности в ответе с изменениями доски
+type DeletedEntity struct {
+	EntityType string `json:"entity_type" valid:"-"`
+	EntityID   uint64 `json:"entity_id" valid:"-"`
+	Version    uint64 `json:"version" valid:"-"`
+}
+
+// BoardChanges
+// DTO изменений доски после запрошенной версии: текущее состояние созданных и изменённых сущностей
+// и удалённые сущности; доска и её участники возвращаются, только если менялись
+type BoardChanges struct {
+	Version           uint64                 `json:"version"`
+	Board             *SingleBoardInfo       `json:"board"`
+	Users             []UserPublicInfo       `json:"users"`
+	Lists             []SingleListInfo       `json:"lists"`
+	Tasks             []SingleTaskInfo       `json:"cards"`
+	Comments          []CommentInfo          `json:"comments"`
+	Checklists        []ChecklistInfo        `json:"checklists"`
+	ChecklistItems    []ChecklistItemInfo    `json:"checklist_items"`
+	Tags              []TagInfo              `json:"tags"`
+	CustomFields      []CustomFieldInfo      `json:"custom_fields"`
+	CustomFieldValues []CustomFieldValueInfo `json:"custom_field_values"`
+	Deleted           []DeletedEntity        `json:"deleted"`
+}
+
+// HistoryEntityRef
+// DTO ссылки на сущность, изменения которой пишутся в историю
+//
+//easyjson:skip
+type HistoryEntityRef struct {
+	EntityType string
+	EntityID   uint64
+}
+
+// EntitySnapshot
+// DTO снимка строки сущности, ID доски и задания, к которым она относится;
+// у сущностей вне задания TaskID -- nil
+//
+//easyjson:skip
+type EntitySnapshot struct {
+	BoardID uint64
+	TaskID  *uint64
+	Fields  map[string]interface{}
+	// Children число зависимых строк, удаляемых вместе с сущностью; nil у сущностей без таких строк
+	Children *uint64
+	// Watchers наблюдатели задания, к которому относится сущность
+	Watchers []uint64
+}
+
+// Типы пользовательских полей
+const (
+	CustomFieldText         = "text"
+	CustomFieldNumber       = "number"
+	CustomFieldDate         = "date"
+	CustomFieldCheckbox     = "checkbox"
+	CustomFieldSingleSelect = "single_select"
+	CustomFieldMultiSelect  = "multi_select"
+)
+
+// CustomFieldInfo
+// DTO пользовательского поля доски
+type CustomFieldInfo struct {
+	ID           uint64   `json:"id"`
+	BoardID      uint64   `json:"board_id"`
+	Name         string   `json:"name"`
+	Type         string   `json:"type"`
+	Options      []string `json:"options"`
+	ListPosition uint64   `json:"list_position"`
+}
+
+// NewCustomFieldInfo
+// DTO для нового пользовательского поля доски
+type NewCustomFieldInfo struct {
+	BoardID      uint64   `json:"board_id"`
+	Name         string   `json:"name"`
+	Type         string   `json:"type"`
+	Options      []string `json:"options"`
+	ListPosition uint64   `json:"list_position"`
+}
+
+// UpdatedCustomFieldInfo
+// DTO для обновления пользовательского поля; тип поля не меняется
+type UpdatedCustomFieldInfo struct {
+	ID           uint64   `json:"id"`
+	Name         string   `json:"name"`
+	Options      []string `json:"options"`
+	ListPosition uint64   `json:"list_position"`
+}
+
+// CustomFieldID
+// DTO для id пользовательского поля
+type CustomFieldID struct {
+	Value uint64 `json:"id"`
+}
+
+// CustomFieldValueInfo
+// DTO значения пользовательского поля у задания; пустое значение удаляет его
+type CustomFieldValueInfo struct {
+	TaskID  uint64      `json:"task_id"`
+	FieldID uint64      `json:"field_id"`
+	Value   interface{} `json:"value"`
+}
+
+// CustomFieldFilter
+// DTO условия фильтрации заданий по значению пользовательского поля.
+// Value сравнивается на равенство (для текста -- вхождение подстроки, для множественного выбора -- наличие всех вариантов),
+// From и To задают диапазон для чисел и дат
+type CustomFieldFilter struct {
+	FieldID uint64      `json:"field_id"`
+	Value   interface{} `json:"value"`
+	From    interface{} `json:"from"`
+	To      interface{} `json:"to"`
+	Type    string      `json:"-"`
+}
+
+// CustomFieldTaskFilter
+// DTO для отбора заданий, подходящих под все условия по пользовательским полям
+//
+//easyjson:skip
+type CustomFieldTaskFilter struct {
+	TaskIDs []string
+	Filters []CustomFieldFilter
+}
+
+// BoardStatsRequest
+// DTO запроса статистики доски; Weeks -- за сколько последних недель считать созданные и выполненные задания
+type BoardStatsRequest struct {
 	BoardID uint64 `json:"board_id" valid:"-"`
-	Actions string `json:"actions" valid:"-"`
+	Weeks   uint64 `json:"weeks" valid:"-"`
+}
+
+// ListTaskCount
+// DTO количества заданий в списке
+type ListTaskCount struct {
+	ListID    uint64 `json:"list_id"`
+	Name      string `json:"name"`
+	IsDone    bool   `json:"is_done"`
+	TaskCount uint64 `json:"task_count"`
+}
+
+// AssigneeTaskCount
+// DTO количества заданий, назначенных пользователю
+type AssigneeTaskCount struct {
+	UserID    uint64 `json:"user_id"`
+	Email     string `json:"email"`
+	TaskCount uint64 `json:"task_count"`
+}
+
+// TagTaskCount
+// DTO количества заданий с тэгом
+type TagTaskCount struct {
+	TagID     uint64 `json:"tag_id"`
+	Name      string `json:"name"`
+	Color     string `json:"color"`
+	TaskCount uint64 `json:"task_count"`
+}
+
+// WeeklyTaskCount
+// DTO количества созданных и выполненных за неделю заданий
+type WeeklyTaskCount struct {
+	WeekStart time.Time `json:"week_start"`
+	Created   uint64    `json:"created"`
+	Completed uint64    `json:"completed"`
+}
+
+// BoardStats
+// DTO статистики доски.
+// Выполненными считаются задания в завершающих списках, просроченными и сроком на этой неделе -- только невыполненные
+type BoardStats struct {
+	BoardID             uint64              `json:"board_id"`
+	TaskCount           uint64              `json:"task_count"`
+	TasksPerList        []ListTaskCount     `json:"tasks_per_list"`
+	TasksPerAssignee    []AssigneeTaskCount `json:"tasks_per_assignee"`
+	Unassigned          uint64              `json:"unassigned"`
+	Overdue             uint64              `json:"overdue"`
+	DueThisWeek         uint64              `json:"due_this_week"`
+	ChecklistItems      uint64              `json:"checklist_items"`
+	ChecklistItemsDone  uint64              `json:"checklist_items_done"`
+	ChecklistCompletion float64             `json:"checklist_completion"`
+	Tags                []TagTaskCount      `json:"tags"`
+	Weekly              []WeeklyTaskCount   `json:"weekly"`
+}
+
+// TaskRemindersInfo
+// DTO для смещений напоминаний о сроке задания в минутах до срока;
+// пустой список означает напоминания по умолчанию каждого из исполнителей
+type TaskRemindersInfo struct {
+	TaskID  uint64   `json:"task_id"`
+	Offsets []uint64 `json:"offsets"`
+}
+
+// UserRemindersInfo
+// DTO для смещений напоминаний пользователя по умолчанию в минутах до срока задания
+type UserRemindersInfo struct {
+	UserID  uint64   `json:"-"`
+	Offsets []uint64 `json:"offsets"`
+}
+
+// TaskRecurrenceRequest
+// DTO для задания или изменения правила повторения задания в виде подмножества RRULE,
+// например "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH"
+type TaskRecurrenceRequest struct {
+	TaskID uint64 `json:"task_id"`
+	Rule   string `json:"rule"`
+}
+
+// TaskRecurrenceInfo
+// DTO серии повторений для хранилища: правило в каноническом виде, часовой пояс, в котором считаются сроки,
+// список, в который встают новые задания серии, срок текущего задания по правилу и пользователь, задавший правило
+type TaskRecurrenceInfo struct {
+	TaskID   uint64    `json:"task_id"`
+	ListID   uint64    `json:"list_id"`
+	UserID   uint64    `json:"user_id"`
+	Rule     string    `json:"rule"`
+	Timezone string    `json:"timezone"`
+	DueAt    time.Time `json:"due_at"`
+}
+
+// DueRecurrence
+// DTO серии повторений, которой пора создать следующее задание, вместе со сроками её текущего задания;
+// UserID -- пользователь, от имени которого задания серии записываются в историю
+type DueRecurrence struct {
+	ID        uint64     `json:"id"`
+	TaskID    uint64     `json:"task_id"`
+	UserID    uint64     `json:"user_id"`
+	Rule      string     `json:"rule"`
+	Timezone  string     `json:"timezone"`
+	DueAt     time.Time  `json:"due_at"`
+	TaskStart *time.Time `json:"task_start"`
+	TaskEnd   *time.Time `json:"task_end"`
+	AllDay    bool       `json:"all_day"`
+}
+
+// RecurrenceInstanceInfo
+// DTO следующего задания серии: оно создаётся, только если текущее задание TaskID и его срок DueAt не изменились
+type RecurrenceInstanceInfo struct {
+	RecurrenceID uint64     `json:"recurrence_id"`
+	TaskID       uint64     `json:"task_id"`
+	DueAt        time.Time  `json:"due_at"`
+	NextStart    *time.Time `json:"next_start"`
+	NextDueAt    time.Time  `json:"next_due_at"`
+}
+
+// SubtaskInfo
+// DTO для привязки задания TaskID подзадачей к заданию ParentID
+type SubtaskInfo struct {
+	ParentID uint64 `json:"parent_id"`
+	TaskID   uint64 `json:"task_id"`
+}
+
+// TaskCascadeInfo
+// DTO для удаления или архивации задания; при Cascade то же происходит со всеми его подзадачами
+type TaskCascadeInfo struct {
+	ID      uint64 `json:"id"`
+	Cascade bool   `json:"cascade"`
+}
+
+// TaskDependencyInfo
+// DTO зависимости между заданиями: BlockerID блокирует BlockedID, пока не выполнено
+type TaskDependencyInfo struct {
+	BlockerID uint64 `json:"blocker_id"`
+	BlockedID uint64 `json:"blocked_id"`
+}
+
+// TaskWatcherInfo
+// DTO наблюдателя задания
+type TaskWatcherInfo struct {
+	TaskID uint64 `json:"task_id"`
+	UserID uint64 `json:"user_id"`
+}
+
+// BoardWatcherInfo
+// DTO наблюдателя доски
+type BoardWatcherInfo struct {
+	BoardID uint64 `json:"board_id"`
+	UserID  uint64 `json:"user_id"`
+}
+
+// WorklogInfo
+// DTO записи учёта времени по заданию: запись без End -- запущенный таймер,
+// его DurationSeconds -- время с запуска до текущего момента
+type WorklogInfo struct {
+	ID              uint64     `json:"id"`
+	TaskID          uint64     `json:"task_id"`
+	UserID          uint64     `json:"user_id"`
+	Start           time.Time  `json:"start"`
+	End             *time.Time `json:"end"`
+	DurationSeconds uint64     `json:"duration_seconds"`
+	Note            *string    `json:"note"`
+}
+
+// TimerInfo
+// DTO для запуска таймера пользователя на задании
+type TimerInfo struct {
+	TaskID uint64 `json:"task_id"`
+	UserID uint64 `json:"user_id"`
+}
+
+// TimerStopInfo
+// DTO для остановки запущенного таймера пользователя; Note заменяет заметку записи, если задана
+type TimerStopInfo struct {
+	UserID uint64  `json:"-"`
+	Note   *string `json:"note"`
+}
+
+// NewWorklogInfo
+// DTO записи учёта времени, вносимой вручную; без Start запись заканчивается в момент внесения
+type NewWorklogInfo struct {
+	TaskID          uint64     `json:"task_id"`
+	UserID          uint64     `json:"-"`
+	Start           *time.Time `json:"start"`
+	DurationSeconds uint64     `json:"duration_seconds"`
+	Note            *string    `json:"note"`
+}
+
+// WorklogDeleteInfo
+// DTO для удаления записи учёта времени её автором
+type WorklogDeleteInfo struct {
+	ID     uint64 `json:"id"`
+	UserID uint64 `json:"-"`
+}
+
+// UserTimeTotal
+// DTO суммарного времени пользователя
+type UserTimeTotal struct {
+	UserID  uint64 `json:"user_id"`
+	Seconds uint64 `json:"seconds"`
+}
+
+// TaskTimeInfo
+// DTO учёта времени по заданию: записи в порядке начала и суммы по завершённым записям -- всего и по пользователям
+type TaskTimeInfo struct {
+	TaskID       uint64          `json:"task_id"`
+	Entries      []WorklogInfo   `json:"entries"`
+	TotalSeconds uint64          `json:"total_seconds"`
+	Users        []UserTimeTotal `json:"users"`
+}
+
+// TimeReportRequest
+// DTO запроса отчёта о времени по доске за период [From, To), при заданном UserID -- только по этому пользователю.
+// Timezone -- часовой пояс, в котором записи раскладываются по дням
+type TimeReportRequest struct {
+	BoardID  uint64    `json:"board_id"`
+	UserID   *uint64   `json:"user_id"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	Timezone string    `json:"-"`
+}
+
+// TimeReportRow
+// DTO строки отчёта о времени: время пользователя на задании за день Date (ГГГГ-ММ-ДД)
+type TimeReportRow struct {
+	Date     string  `json:"date"`
+	UserID   uint64  `json:"user_id"`
+	Email    string  `json:"email"`
+	Name     *string `json:"name"`
+	Surname  *string `json:"surname"`
+	TaskID   uint64  `json:"task_id"`
+	TaskName string  `json:"task_name"`
+	Seconds  uint64  `json:"seconds"`
+}
+
+// TimeReport
+// DTO отчёта о времени по доске: строки по дням, пользователям и заданиям и суммы -- всего и по пользователям
+type TimeReport struct {
+	BoardID      uint64          `json:"board_id"`
+	From         time.Time       `json:"from"`
+	To           time.Time       `json:"to"`
+	Rows         []TimeReportRow `json:"rows"`
+	TotalSeconds uint64          `json:"total_seconds"`
+	Users        []UserTimeTotal `json:"users"`
+}
+
+// Виды событий в ленте активности задания
+const (
+	TaskActivityCreated              = "created"
+	TaskActivityMoved                = "moved"
+	TaskActivityRenamed              = "renamed"
+	TaskActivityDescriptionEdited    = "description_edited"
+	TaskActivityDatesChanged         = "dates_changed"
+	TaskActivityAssigneeAdded        = "assignee_added"
+	TaskActivityAssigneeRemoved      = "assignee_removed"
+	TaskActivityTagAdded             = "tag_added"
+	TaskActivityTagRemoved           = "tag_removed"
+	TaskActivityChecklistItemAdded   = "checklist_item_added"
+	TaskActivityChecklistItemRemoved = "checklist_item_removed"
+	TaskActivityAttachmentAdded      = "attachment_added"
+	TaskActivityAttachmentRemoved    = "attachment_removed"
+	TaskActivityComment              = "comment"
+)
+
+// TaskActivityRequest
+// DTO запроса ленты активности задания с пагинацией; BoardID -- доска задания, заполняется сервисом
+type TaskActivityRequest struct {
+	TaskID  uint64 `json:"task_id"`
+	BoardID uint64 `json:"-"`
+	Limit   uint64 `json:"limit"`
+	Offset  uint64 `json:"offset"`
+}
+
+// TaskActivityItem
+// DTO события в ленте активности задания: HistoryID и Changes -- запись истории, из которой получено событие,
+// и относящиеся к событию изменения; у комментария вместо них Comment
+type TaskActivityItem struct {
+	Type      string               `json:"type"`
+	Timestamp time.Time            `json:"timestamp"`
+	UserID    uint64               `json:"user_id"`
+	HistoryID *uint64              `json:"history_id"`
+	Changes   []HistoryFieldChange `json:"changes"`
+	Comment   *CommentInfo         `json:"comment"`
+}
+
+// TaskActivity
+// DTO страницы ленты активности задания в хронологическом порядке; Total -- число событий во всей ленте
+type TaskActivity struct {
+	TaskID uint64             `json:"task_id"`
+	Items  []TaskActivityItem `json:"items"`
+	Total  uint64             `json:"total"`
+}
+
+// Виды уведомлений
+const (
+	NotificationDueReminder = "due_reminder"
+	NotificationChange      = "change"
+)
+
+// NotificationInfo
+// DTO уведомления пользователя; для напоминания о сроке DueAt -- срок задания,
+// OffsetMinutes -- за сколько минут до срока сработало напоминание.
+// Для уведомления об изменении HistoryID -- запись истории доски, EntityType и Action -- что изменилось
+type NotificationInfo struct {
+	ID            uint64     `json:"id"`
+	Kind          string     `json:"kind"`
+	TaskID        *uint64    `json:"task_id"`
+	TaskName      *string    `json:"task_name"`
+	BoardID       *uint64    `json:"board_id"`
+	DueAt         *time.Time `json:"due_at"`
+	OffsetMinutes *uint64    `json:"offset_minutes"`
+	HistoryID     *uint64    `json:"history_id"`
+	EntityType    *string    `json:"entity_type"`
+	Action        *string    `json:"action"`
+	DateCreated   time.Time  `json:"date_created"`
+	DateRead      *time.Time `json:"date_read"`
+}
+
+// NotificationsRequest
+// DTO запроса уведомлений пользователя, начиная с новых
+type NotificationsRequest struct {
+	UserID     uint64 `json:"-"`
+	UnreadOnly bool   `json:"unread_only"`
+	Limit      uint64 `json:"limit"`
+}
+
+// NotificationsReadInfo
+// DTO для отметки уведомлений прочитанными; пустой список отмечает все уведомления пользователя
+type NotificationsReadInfo struct {
+	UserID uint64   `json:"-"`
+	IDs    []uint64 `json:"ids"`
 }
 
 type JSONMap map[string]interface{}
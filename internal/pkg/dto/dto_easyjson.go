@@ -195,7 +195,7 @@ func (v *WorkspaceBoardInfo) UnmarshalJSON(data []byte) error {
 func (v *WorkspaceBoardInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto1(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto2(in *jlexer.Lexer, out *VerifiedAuthInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto2(in *jlexer.Lexer, out *WorklogInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -214,8 +214,40 @@ func easyjson56de76c1DecodeServerInternalPkgDto2(in *jlexer.Lexer, out *Verified
 			continue
 		}
 		switch key {
-		case "UserID":
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "user_id":
 			out.UserID = uint64(in.Uint64())
+		case "start":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Start).UnmarshalJSON(data))
+			}
+		case "end":
+			if in.IsNull() {
+				in.Skip()
+				out.End = nil
+			} else {
+				if out.End == nil {
+					out.End = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.End).UnmarshalJSON(data))
+				}
+			}
+		case "duration_seconds":
+			out.DurationSeconds = uint64(in.Uint64())
+		case "note":
+			if in.IsNull() {
+				in.Skip()
+				out.Note = nil
+			} else {
+				if out.Note == nil {
+					out.Note = new(string)
+				}
+				*out.Note = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -226,42 +258,80 @@ func easyjson56de76c1DecodeServerInternalPkgDto2(in *jlexer.Lexer, out *Verified
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto2(out *jwriter.Writer, in VerifiedAuthInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto2(out *jwriter.Writer, in WorklogInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"UserID\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TaskID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
 		out.Uint64(uint64(in.UserID))
 	}
+	{
+		const prefix string = ",\"start\":"
+		out.RawString(prefix)
+		out.Raw((in.Start).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"end\":"
+		out.RawString(prefix)
+		if in.End == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.End).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"duration_seconds\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.DurationSeconds))
+	}
+	{
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		if in.Note == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Note))
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v VerifiedAuthInfo) MarshalJSON() ([]byte, error) {
+func (v WorklogInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v VerifiedAuthInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v WorklogInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *VerifiedAuthInfo) UnmarshalJSON(data []byte) error {
+func (v *WorklogInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *VerifiedAuthInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *WorklogInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto2(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto3(in *jlexer.Lexer, out *UsersAndRoles) {
+func easyjson56de76c1DecodeServerInternalPkgDto3(in *jlexer.Lexer, out *WorklogDeleteInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -280,52 +350,8 @@ func easyjson56de76c1DecodeServerInternalPkgDto3(in *jlexer.Lexer, out *UsersAnd
 			continue
 		}
 		switch key {
-		case "users":
-			if in.IsNull() {
-				in.Skip()
-				out.Users = nil
-			} else {
-				in.Delim('[')
-				if out.Users == nil {
-					if !in.IsDelim(']') {
-						out.Users = make([]UserInWorkspace, 0, 1)
-					} else {
-						out.Users = []UserInWorkspace{}
-					}
-				} else {
-					out.Users = (out.Users)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v1 UserInWorkspace
-					(v1).UnmarshalEasyJSON(in)
-					out.Users = append(out.Users, v1)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "roles":
-			if in.IsNull() {
-				in.Skip()
-				out.Roles = nil
-			} else {
-				in.Delim('[')
-				if out.Roles == nil {
-					if !in.IsDelim(']') {
-						out.Roles = make([]RoleInWorkspace, 0, 2)
-					} else {
-						out.Roles = []RoleInWorkspace{}
-					}
-				} else {
-					out.Roles = (out.Roles)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v2 RoleInWorkspace
-					(v2).UnmarshalEasyJSON(in)
-					out.Roles = append(out.Roles, v2)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "id":
+			out.ID = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -336,69 +362,42 @@ func easyjson56de76c1DecodeServerInternalPkgDto3(in *jlexer.Lexer, out *UsersAnd
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto3(out *jwriter.Writer, in UsersAndRoles) {
+func easyjson56de76c1EncodeServerInternalPkgDto3(out *jwriter.Writer, in WorklogDeleteInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"users\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v3, v4 := range in.Users {
-				if v3 > 0 {
-					out.RawByte(',')
-				}
-				(v4).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"roles\":"
-		out.RawString(prefix)
-		if in.Roles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v5, v6 := range in.Roles {
-				if v5 > 0 {
-					out.RawByte(',')
-				}
-				(v6).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.Uint64(uint64(in.ID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UsersAndRoles) MarshalJSON() ([]byte, error) {
+func (v WorklogDeleteInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UsersAndRoles) MarshalEasyJSON(w *jwriter.Writer) {
+func (v WorklogDeleteInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UsersAndRoles) UnmarshalJSON(data []byte) error {
+func (v *WorklogDeleteInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UsersAndRoles) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *WorklogDeleteInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto3(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto4(in *jlexer.Lexer, out *UserPublicInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto4(in *jlexer.Lexer, out *WipLimitWarning) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -417,50 +416,12 @@ func easyjson56de76c1DecodeServerInternalPkgDto4(in *jlexer.Lexer, out *UserPubl
 			continue
 		}
 		switch key {
-		case "user_id":
-			out.ID = uint64(in.Uint64())
-		case "email":
-			out.Email = string(in.String())
-		case "name":
-			if in.IsNull() {
-				in.Skip()
-				out.Name = nil
-			} else {
-				if out.Name == nil {
-					out.Name = new(string)
-				}
-				*out.Name = string(in.String())
-			}
-		case "surname":
-			if in.IsNull() {
-				in.Skip()
-				out.Surname = nil
-			} else {
-				if out.Surname == nil {
-					out.Surname = new(string)
-				}
-				*out.Surname = string(in.String())
-			}
-		case "description":
-			if in.IsNull() {
-				in.Skip()
-				out.Description = nil
-			} else {
-				if out.Description == nil {
-					out.Description = new(string)
-				}
-				*out.Description = string(in.String())
-			}
-		case "avatar_url":
-			if in.IsNull() {
-				in.Skip()
-				out.AvatarURL = nil
-			} else {
-				if out.AvatarURL == nil {
-					out.AvatarURL = new(string)
-				}
-				*out.AvatarURL = string(in.String())
-			}
+		case "list_id":
+			out.ListID = uint64(in.Uint64())
+		case "wip_limit":
+			out.WipLimit = uint64(in.Uint64())
+		case "task_count":
+			out.TaskCount = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -471,83 +432,52 @@ func easyjson56de76c1DecodeServerInternalPkgDto4(in *jlexer.Lexer, out *UserPubl
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto4(out *jwriter.Writer, in UserPublicInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto4(out *jwriter.Writer, in WipLimitWarning) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"list_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
-	}
-	{
-		const prefix string = ",\"email\":"
-		out.RawString(prefix)
-		out.String(string(in.Email))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		if in.Name == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Name))
-		}
-	}
-	{
-		const prefix string = ",\"surname\":"
-		out.RawString(prefix)
-		if in.Surname == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Surname))
-		}
+		out.Uint64(uint64(in.ListID))
 	}
 	{
-		const prefix string = ",\"description\":"
+		const prefix string = ",\"wip_limit\":"
 		out.RawString(prefix)
-		if in.Description == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Description))
-		}
+		out.Uint64(uint64(in.WipLimit))
 	}
 	{
-		const prefix string = ",\"avatar_url\":"
+		const prefix string = ",\"task_count\":"
 		out.RawString(prefix)
-		if in.AvatarURL == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.AvatarURL))
-		}
+		out.Uint64(uint64(in.TaskCount))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserPublicInfo) MarshalJSON() ([]byte, error) {
+func (v WipLimitWarning) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserPublicInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v WipLimitWarning) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserPublicInfo) UnmarshalJSON(data []byte) error {
+func (v *WipLimitWarning) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserPublicInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *WipLimitWarning) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto4(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto5(in *jlexer.Lexer, out *UserProfileInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto5(in *jlexer.Lexer, out *WeeklyTaskCount) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -566,12 +496,14 @@ func easyjson56de76c1DecodeServerInternalPkgDto5(in *jlexer.Lexer, out *UserProf
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "surname":
-			out.Surname = string(in.String())
-		case "description":
-			out.Description = string(in.String())
+		case "week_start":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.WeekStart).UnmarshalJSON(data))
+			}
+		case "created":
+			out.Created = uint64(in.Uint64())
+		case "completed":
+			out.Completed = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -582,57 +514,52 @@ func easyjson56de76c1DecodeServerInternalPkgDto5(in *jlexer.Lexer, out *UserProf
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto5(out *jwriter.Writer, in UserProfileInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto5(out *jwriter.Writer, in WeeklyTaskCount) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.Name))
+		const prefix string = ",\"week_start\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.WeekStart).MarshalJSON())
 	}
-	if in.Surname != "" {
-		const prefix string = ",\"surname\":"
+	{
+		const prefix string = ",\"created\":"
 		out.RawString(prefix)
-		out.String(string(in.Surname))
+		out.Uint64(uint64(in.Created))
 	}
-	if in.Description != "" {
-		const prefix string = ",\"description\":"
+	{
+		const prefix string = ",\"completed\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		out.Uint64(uint64(in.Completed))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserProfileInfo) MarshalJSON() ([]byte, error) {
+func (v WeeklyTaskCount) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserProfileInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v WeeklyTaskCount) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserProfileInfo) UnmarshalJSON(data []byte) error {
+func (v *WeeklyTaskCount) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserProfileInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *WeeklyTaskCount) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto5(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto6(in *jlexer.Lexer, out *UserPasswordHash) {
+func easyjson56de76c1DecodeServerInternalPkgDto6(in *jlexer.Lexer, out *VerifiedAuthInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -651,8 +578,8 @@ func easyjson56de76c1DecodeServerInternalPkgDto6(in *jlexer.Lexer, out *UserPass
 			continue
 		}
 		switch key {
-		case "Value":
-			out.Value = string(in.String())
+		case "UserID":
+			out.UserID = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -663,42 +590,42 @@ func easyjson56de76c1DecodeServerInternalPkgDto6(in *jlexer.Lexer, out *UserPass
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto6(out *jwriter.Writer, in UserPasswordHash) {
+func easyjson56de76c1EncodeServerInternalPkgDto6(out *jwriter.Writer, in VerifiedAuthInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Value\":"
+		const prefix string = ",\"UserID\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Value))
+		out.Uint64(uint64(in.UserID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserPasswordHash) MarshalJSON() ([]byte, error) {
+func (v VerifiedAuthInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserPasswordHash) MarshalEasyJSON(w *jwriter.Writer) {
+func (v VerifiedAuthInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserPasswordHash) UnmarshalJSON(data []byte) error {
+func (v *VerifiedAuthInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserPasswordHash) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *VerifiedAuthInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto6(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto7(in *jlexer.Lexer, out *UserOwnerInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto7(in *jlexer.Lexer, out *UsersAndRoles) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -717,29 +644,51 @@ func easyjson56de76c1DecodeServerInternalPkgDto7(in *jlexer.Lexer, out *UserOwne
 			continue
 		}
 		switch key {
-		case "owner_id":
-			out.ID = uint64(in.Uint64())
-		case "owner_email":
-			out.Email = string(in.String())
-		case "owner_name":
+		case "users":
 			if in.IsNull() {
 				in.Skip()
-				out.Name = nil
+				out.Users = nil
 			} else {
-				if out.Name == nil {
-					out.Name = new(string)
+				in.Delim('[')
+				if out.Users == nil {
+					if !in.IsDelim(']') {
+						out.Users = make([]UserInWorkspace, 0, 1)
+					} else {
+						out.Users = []UserInWorkspace{}
+					}
+				} else {
+					out.Users = (out.Users)[:0]
 				}
-				*out.Name = string(in.String())
+				for !in.IsDelim(']') {
+					var v1 UserInWorkspace
+					(v1).UnmarshalEasyJSON(in)
+					out.Users = append(out.Users, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "owner_surname":
+		case "roles":
 			if in.IsNull() {
 				in.Skip()
-				out.Surname = nil
+				out.Roles = nil
 			} else {
-				if out.Surname == nil {
-					out.Surname = new(string)
+				in.Delim('[')
+				if out.Roles == nil {
+					if !in.IsDelim(']') {
+						out.Roles = make([]RoleInWorkspace, 0, 2)
+					} else {
+						out.Roles = []RoleInWorkspace{}
+					}
+				} else {
+					out.Roles = (out.Roles)[:0]
 				}
-				*out.Surname = string(in.String())
+				for !in.IsDelim(']') {
+					var v2 RoleInWorkspace
+					(v2).UnmarshalEasyJSON(in)
+					out.Roles = append(out.Roles, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -751,65 +700,69 @@ func easyjson56de76c1DecodeServerInternalPkgDto7(in *jlexer.Lexer, out *UserOwne
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto7(out *jwriter.Writer, in UserOwnerInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto7(out *jwriter.Writer, in UsersAndRoles) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"owner_id\":"
+		const prefix string = ",\"users\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
-	}
-	{
-		const prefix string = ",\"owner_email\":"
-		out.RawString(prefix)
-		out.String(string(in.Email))
-	}
-	{
-		const prefix string = ",\"owner_name\":"
-		out.RawString(prefix)
-		if in.Name == nil {
+		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			out.String(string(*in.Name))
+			out.RawByte('[')
+			for v3, v4 := range in.Users {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				(v4).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"owner_surname\":"
+		const prefix string = ",\"roles\":"
 		out.RawString(prefix)
-		if in.Surname == nil {
+		if in.Roles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			out.String(string(*in.Surname))
+			out.RawByte('[')
+			for v5, v6 := range in.Roles {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserOwnerInfo) MarshalJSON() ([]byte, error) {
+func (v UsersAndRoles) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserOwnerInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UsersAndRoles) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserOwnerInfo) UnmarshalJSON(data []byte) error {
+func (v *UsersAndRoles) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserOwnerInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UsersAndRoles) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto7(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto8(in *jlexer.Lexer, out *UserOwnedWorkspaceInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto8(in *jlexer.Lexer, out *UserTimezoneInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -828,33 +781,8 @@ func easyjson56de76c1DecodeServerInternalPkgDto8(in *jlexer.Lexer, out *UserOwne
 			continue
 		}
 		switch key {
-		case "workspace_id":
-			out.ID = uint64(in.Uint64())
-		case "workspace_name":
-			out.Name = string(in.String())
-		case "boards":
-			if in.IsNull() {
-				in.Skip()
-				out.Boards = nil
-			} else {
-				in.Delim('[')
-				if out.Boards == nil {
-					if !in.IsDelim(']') {
-						out.Boards = make([]WorkspaceBoardInfo, 0, 1)
-					} else {
-						out.Boards = []WorkspaceBoardInfo{}
-					}
-				} else {
-					out.Boards = (out.Boards)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v7 WorkspaceBoardInfo
-					(v7).UnmarshalEasyJSON(in)
-					out.Boards = append(out.Boards, v7)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "timezone":
+			out.Timezone = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -865,63 +793,47 @@ func easyjson56de76c1DecodeServerInternalPkgDto8(in *jlexer.Lexer, out *UserOwne
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto8(out *jwriter.Writer, in UserOwnedWorkspaceInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto8(out *jwriter.Writer, in UserTimezoneInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"workspace_id\":"
-		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
-	}
-	{
-		const prefix string = ",\"workspace_name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"boards\":"
-		out.RawString(prefix)
-		if in.Boards == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
+		const prefix string = ",\"timezone\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
 		} else {
-			out.RawByte('[')
-			for v8, v9 := range in.Boards {
-				if v8 > 0 {
-					out.RawByte(',')
-				}
-				(v9).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
+			out.RawString(prefix)
 		}
+		out.String(string(in.Timezone))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserOwnedWorkspaceInfo) MarshalJSON() ([]byte, error) {
+func (v UserTimezoneInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserOwnedWorkspaceInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserTimezoneInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserOwnedWorkspaceInfo) UnmarshalJSON(data []byte) error {
+func (v *UserTimezoneInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserOwnedWorkspaceInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserTimezoneInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto8(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto9(in *jlexer.Lexer, out *UserLogin) {
+func easyjson56de76c1DecodeServerInternalPkgDto9(in *jlexer.Lexer, out *UserTimeTotal) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -940,8 +852,10 @@ func easyjson56de76c1DecodeServerInternalPkgDto9(in *jlexer.Lexer, out *UserLogi
 			continue
 		}
 		switch key {
-		case "email":
-			out.Value = string(in.String())
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
+		case "seconds":
+			out.Seconds = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -952,42 +866,47 @@ func easyjson56de76c1DecodeServerInternalPkgDto9(in *jlexer.Lexer, out *UserLogi
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto9(out *jwriter.Writer, in UserLogin) {
+func easyjson56de76c1EncodeServerInternalPkgDto9(out *jwriter.Writer, in UserTimeTotal) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"email\":"
+		const prefix string = ",\"user_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Value))
+		out.Uint64(uint64(in.UserID))
+	}
+	{
+		const prefix string = ",\"seconds\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Seconds))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserLogin) MarshalJSON() ([]byte, error) {
+func (v UserTimeTotal) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserLogin) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserTimeTotal) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserLogin) UnmarshalJSON(data []byte) error {
+func (v *UserTimeTotal) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserLogin) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserTimeTotal) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto9(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto10(in *jlexer.Lexer, out *UserInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto10(in *jlexer.Lexer, out *UserRemindersInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1006,10 +925,29 @@ func easyjson56de76c1DecodeServerInternalPkgDto10(in *jlexer.Lexer, out *UserInf
 			continue
 		}
 		switch key {
-		case "ID":
-			out.ID = uint64(in.Uint64())
-		case "Email":
-			out.Email = string(in.String())
+		case "offsets":
+			if in.IsNull() {
+				in.Skip()
+				out.Offsets = nil
+			} else {
+				in.Delim('[')
+				if out.Offsets == nil {
+					if !in.IsDelim(']') {
+						out.Offsets = make([]uint64, 0, 8)
+					} else {
+						out.Offsets = []uint64{}
+					}
+				} else {
+					out.Offsets = (out.Offsets)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 uint64
+					v7 = uint64(in.Uint64())
+					out.Offsets = append(out.Offsets, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1020,47 +958,58 @@ func easyjson56de76c1DecodeServerInternalPkgDto10(in *jlexer.Lexer, out *UserInf
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto10(out *jwriter.Writer, in UserInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto10(out *jwriter.Writer, in UserRemindersInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"ID\":"
-		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
-	}
-	{
-		const prefix string = ",\"Email\":"
-		out.RawString(prefix)
-		out.String(string(in.Email))
+		const prefix string = ",\"offsets\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		if in.Offsets == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Offsets {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v9))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserInfo) MarshalJSON() ([]byte, error) {
+func (v UserRemindersInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserRemindersInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserInfo) UnmarshalJSON(data []byte) error {
+func (v *UserRemindersInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserRemindersInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto10(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto11(in *jlexer.Lexer, out *UserInWorkspace) {
+func easyjson56de76c1DecodeServerInternalPkgDto11(in *jlexer.Lexer, out *UserPublicInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1103,25 +1052,25 @@ func easyjson56de76c1DecodeServerInternalPkgDto11(in *jlexer.Lexer, out *UserInW
 				}
 				*out.Surname = string(in.String())
 			}
-		case "avatar_url":
+		case "description":
 			if in.IsNull() {
 				in.Skip()
-				out.AvatarURL = nil
+				out.Description = nil
 			} else {
-				if out.AvatarURL == nil {
-					out.AvatarURL = new(string)
+				if out.Description == nil {
+					out.Description = new(string)
 				}
-				*out.AvatarURL = string(in.String())
+				*out.Description = string(in.String())
 			}
-		case "role_id":
+		case "avatar_url":
 			if in.IsNull() {
 				in.Skip()
-				out.RoleID = nil
+				out.AvatarURL = nil
 			} else {
-				if out.RoleID == nil {
-					out.RoleID = new(uint64)
+				if out.AvatarURL == nil {
+					out.AvatarURL = new(string)
 				}
-				*out.RoleID = uint64(in.Uint64())
+				*out.AvatarURL = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -1133,7 +1082,7 @@ func easyjson56de76c1DecodeServerInternalPkgDto11(in *jlexer.Lexer, out *UserInW
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto11(out *jwriter.Writer, in UserInWorkspace) {
+func easyjson56de76c1EncodeServerInternalPkgDto11(out *jwriter.Writer, in UserPublicInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1166,50 +1115,50 @@ func easyjson56de76c1EncodeServerInternalPkgDto11(out *jwriter.Writer, in UserIn
 		}
 	}
 	{
-		const prefix string = ",\"avatar_url\":"
+		const prefix string = ",\"description\":"
 		out.RawString(prefix)
-		if in.AvatarURL == nil {
+		if in.Description == nil {
 			out.RawString("null")
 		} else {
-			out.String(string(*in.AvatarURL))
+			out.String(string(*in.Description))
 		}
 	}
 	{
-		const prefix string = ",\"role_id\":"
+		const prefix string = ",\"avatar_url\":"
 		out.RawString(prefix)
-		if in.RoleID == nil {
+		if in.AvatarURL == nil {
 			out.RawString("null")
 		} else {
-			out.Uint64(uint64(*in.RoleID))
+			out.String(string(*in.AvatarURL))
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserInWorkspace) MarshalJSON() ([]byte, error) {
+func (v UserPublicInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserInWorkspace) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserPublicInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserInWorkspace) UnmarshalJSON(data []byte) error {
+func (v *UserPublicInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserInWorkspace) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserPublicInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto11(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto12(in *jlexer.Lexer, out *UserImageUrlInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto12(in *jlexer.Lexer, out *UserProfileInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1228,10 +1177,12 @@ func easyjson56de76c1DecodeServerInternalPkgDto12(in *jlexer.Lexer, out *UserIma
 			continue
 		}
 		switch key {
-		case "user_id":
-			out.ID = uint64(in.Uint64())
-		case "avatar_url":
-			out.Url = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "surname":
+			out.Surname = string(in.String())
+		case "description":
+			out.Description = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1242,47 +1193,57 @@ func easyjson56de76c1DecodeServerInternalPkgDto12(in *jlexer.Lexer, out *UserIma
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto12(out *jwriter.Writer, in UserImageUrlInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto12(out *jwriter.Writer, in UserProfileInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user_id\":"
-		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
 	}
-	{
-		const prefix string = ",\"avatar_url\":"
+	if in.Surname != "" {
+		const prefix string = ",\"surname\":"
 		out.RawString(prefix)
-		out.String(string(in.Url))
+		out.String(string(in.Surname))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserImageUrlInfo) MarshalJSON() ([]byte, error) {
+func (v UserProfileInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserImageUrlInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserProfileInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserImageUrlInfo) UnmarshalJSON(data []byte) error {
+func (v *UserProfileInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserImageUrlInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserProfileInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto12(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto13(in *jlexer.Lexer, out *UserID) {
+func easyjson56de76c1DecodeServerInternalPkgDto13(in *jlexer.Lexer, out *UserPasswordHash) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1301,8 +1262,8 @@ func easyjson56de76c1DecodeServerInternalPkgDto13(in *jlexer.Lexer, out *UserID)
 			continue
 		}
 		switch key {
-		case "user_id":
-			out.Value = uint64(in.Uint64())
+		case "Value":
+			out.Value = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1313,42 +1274,42 @@ func easyjson56de76c1DecodeServerInternalPkgDto13(in *jlexer.Lexer, out *UserID)
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto13(out *jwriter.Writer, in UserID) {
+func easyjson56de76c1EncodeServerInternalPkgDto13(out *jwriter.Writer, in UserPasswordHash) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"Value\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.Value))
+		out.String(string(in.Value))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserID) MarshalJSON() ([]byte, error) {
+func (v UserPasswordHash) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto13(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserID) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserPasswordHash) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto13(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserID) UnmarshalJSON(data []byte) error {
+func (v *UserPasswordHash) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto13(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserPasswordHash) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto13(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto14(in *jlexer.Lexer, out *UserGuestWorkspaceInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto14(in *jlexer.Lexer, out *UserOwnerInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1367,34 +1328,29 @@ func easyjson56de76c1DecodeServerInternalPkgDto14(in *jlexer.Lexer, out *UserGue
 			continue
 		}
 		switch key {
-		case "workspace_id":
+		case "owner_id":
 			out.ID = uint64(in.Uint64())
-		case "workspace_name":
-			out.Name = string(in.String())
-		case "workspace_owner":
-			(out.Owner).UnmarshalEasyJSON(in)
-		case "boards":
+		case "owner_email":
+			out.Email = string(in.String())
+		case "owner_name":
 			if in.IsNull() {
 				in.Skip()
-				out.Boards = nil
+				out.Name = nil
 			} else {
-				in.Delim('[')
-				if out.Boards == nil {
-					if !in.IsDelim(']') {
-						out.Boards = make([]WorkspaceBoardInfo, 0, 1)
-					} else {
-						out.Boards = []WorkspaceBoardInfo{}
-					}
-				} else {
-					out.Boards = (out.Boards)[:0]
+				if out.Name == nil {
+					out.Name = new(string)
 				}
-				for !in.IsDelim(']') {
-					var v10 WorkspaceBoardInfo
-					(v10).UnmarshalEasyJSON(in)
-					out.Boards = append(out.Boards, v10)
-					in.WantComma()
+				*out.Name = string(in.String())
+			}
+		case "owner_surname":
+			if in.IsNull() {
+				in.Skip()
+				out.Surname = nil
+			} else {
+				if out.Surname == nil {
+					out.Surname = new(string)
 				}
-				in.Delim(']')
+				*out.Surname = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -1406,68 +1362,65 @@ func easyjson56de76c1DecodeServerInternalPkgDto14(in *jlexer.Lexer, out *UserGue
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto14(out *jwriter.Writer, in UserGuestWorkspaceInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto14(out *jwriter.Writer, in UserOwnerInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"workspace_id\":"
+		const prefix string = ",\"owner_id\":"
 		out.RawString(prefix[1:])
 		out.Uint64(uint64(in.ID))
 	}
 	{
-		const prefix string = ",\"workspace_name\":"
+		const prefix string = ",\"owner_email\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		out.String(string(in.Email))
 	}
 	{
-		const prefix string = ",\"workspace_owner\":"
+		const prefix string = ",\"owner_name\":"
 		out.RawString(prefix)
-		(in.Owner).MarshalEasyJSON(out)
+		if in.Name == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Name))
+		}
 	}
 	{
-		const prefix string = ",\"boards\":"
+		const prefix string = ",\"owner_surname\":"
 		out.RawString(prefix)
-		if in.Boards == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Surname == nil {
 			out.RawString("null")
 		} else {
-			out.RawByte('[')
-			for v11, v12 := range in.Boards {
-				if v11 > 0 {
-					out.RawByte(',')
-				}
-				(v12).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
+			out.String(string(*in.Surname))
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserGuestWorkspaceInfo) MarshalJSON() ([]byte, error) {
+func (v UserOwnerInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto14(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserGuestWorkspaceInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserOwnerInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto14(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserGuestWorkspaceInfo) UnmarshalJSON(data []byte) error {
+func (v *UserOwnerInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto14(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserGuestWorkspaceInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserOwnerInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto14(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto15(in *jlexer.Lexer, out *UserEmail) {
+func easyjson56de76c1DecodeServerInternalPkgDto15(in *jlexer.Lexer, out *UserOwnedWorkspaceInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1486,8 +1439,33 @@ func easyjson56de76c1DecodeServerInternalPkgDto15(in *jlexer.Lexer, out *UserEma
 			continue
 		}
 		switch key {
-		case "email":
-			out.Email = string(in.String())
+		case "workspace_id":
+			out.ID = uint64(in.Uint64())
+		case "workspace_name":
+			out.Name = string(in.String())
+		case "boards":
+			if in.IsNull() {
+				in.Skip()
+				out.Boards = nil
+			} else {
+				in.Delim('[')
+				if out.Boards == nil {
+					if !in.IsDelim(']') {
+						out.Boards = make([]WorkspaceBoardInfo, 0, 1)
+					} else {
+						out.Boards = []WorkspaceBoardInfo{}
+					}
+				} else {
+					out.Boards = (out.Boards)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 WorkspaceBoardInfo
+					(v10).UnmarshalEasyJSON(in)
+					out.Boards = append(out.Boards, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1498,42 +1476,63 @@ func easyjson56de76c1DecodeServerInternalPkgDto15(in *jlexer.Lexer, out *UserEma
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto15(out *jwriter.Writer, in UserEmail) {
+func easyjson56de76c1EncodeServerInternalPkgDto15(out *jwriter.Writer, in UserOwnedWorkspaceInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"email\":"
+		const prefix string = ",\"workspace_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Email))
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"workspace_name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"boards\":"
+		out.RawString(prefix)
+		if in.Boards == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Boards {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				(v12).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserEmail) MarshalJSON() ([]byte, error) {
+func (v UserOwnedWorkspaceInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto15(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserEmail) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserOwnedWorkspaceInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto15(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserEmail) UnmarshalJSON(data []byte) error {
+func (v *UserOwnedWorkspaceInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto15(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserEmail) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserOwnedWorkspaceInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto15(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto16(in *jlexer.Lexer, out *UserAndWorkspaceIDs) {
+func easyjson56de76c1DecodeServerInternalPkgDto16(in *jlexer.Lexer, out *UserLogin) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1552,10 +1551,8 @@ func easyjson56de76c1DecodeServerInternalPkgDto16(in *jlexer.Lexer, out *UserAnd
 			continue
 		}
 		switch key {
-		case "workspace_id":
-			out.WorkspaceID = uint64(in.Uint64())
-		case "user_id":
-			out.UserID = uint64(in.Uint64())
+		case "email":
+			out.Value = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1566,47 +1563,42 @@ func easyjson56de76c1DecodeServerInternalPkgDto16(in *jlexer.Lexer, out *UserAnd
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto16(out *jwriter.Writer, in UserAndWorkspaceIDs) {
+func easyjson56de76c1EncodeServerInternalPkgDto16(out *jwriter.Writer, in UserLogin) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"workspace_id\":"
+		const prefix string = ",\"email\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.WorkspaceID))
-	}
-	{
-		const prefix string = ",\"user_id\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.UserID))
+		out.String(string(in.Value))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserAndWorkspaceIDs) MarshalJSON() ([]byte, error) {
+func (v UserLogin) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto16(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserAndWorkspaceIDs) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserLogin) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto16(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserAndWorkspaceIDs) UnmarshalJSON(data []byte) error {
+func (v *UserLogin) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto16(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserAndWorkspaceIDs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserLogin) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto16(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto17(in *jlexer.Lexer, out *UrlObj) {
+func easyjson56de76c1DecodeServerInternalPkgDto17(in *jlexer.Lexer, out *UserInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1625,8 +1617,10 @@ func easyjson56de76c1DecodeServerInternalPkgDto17(in *jlexer.Lexer, out *UrlObj)
 			continue
 		}
 		switch key {
-		case "url":
-			out.Value = string(in.String())
+		case "ID":
+			out.ID = uint64(in.Uint64())
+		case "Email":
+			out.Email = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1637,42 +1631,47 @@ func easyjson56de76c1DecodeServerInternalPkgDto17(in *jlexer.Lexer, out *UrlObj)
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto17(out *jwriter.Writer, in UrlObj) {
+func easyjson56de76c1EncodeServerInternalPkgDto17(out *jwriter.Writer, in UserInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"url\":"
+		const prefix string = ",\"ID\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Value))
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"Email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UrlObj) MarshalJSON() ([]byte, error) {
+func (v UserInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto17(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UrlObj) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto17(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UrlObj) UnmarshalJSON(data []byte) error {
+func (v *UserInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto17(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UrlObj) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto17(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto18(in *jlexer.Lexer, out *UpdatedWorkspaceInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto18(in *jlexer.Lexer, out *UserInWorkspace) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1691,19 +1690,49 @@ func easyjson56de76c1DecodeServerInternalPkgDto18(in *jlexer.Lexer, out *Updated
 			continue
 		}
 		switch key {
-		case "id":
+		case "user_id":
 			out.ID = uint64(in.Uint64())
-		case "name":
-			out.Name = string(in.String())
-		case "description":
+		case "email":
+			out.Email = string(in.String())
+		case "name":
 			if in.IsNull() {
 				in.Skip()
-				out.Description = nil
+				out.Name = nil
 			} else {
-				if out.Description == nil {
-					out.Description = new(string)
+				if out.Name == nil {
+					out.Name = new(string)
 				}
-				*out.Description = string(in.String())
+				*out.Name = string(in.String())
+			}
+		case "surname":
+			if in.IsNull() {
+				in.Skip()
+				out.Surname = nil
+			} else {
+				if out.Surname == nil {
+					out.Surname = new(string)
+				}
+				*out.Surname = string(in.String())
+			}
+		case "avatar_url":
+			if in.IsNull() {
+				in.Skip()
+				out.AvatarURL = nil
+			} else {
+				if out.AvatarURL == nil {
+					out.AvatarURL = new(string)
+				}
+				*out.AvatarURL = string(in.String())
+			}
+		case "role_id":
+			if in.IsNull() {
+				in.Skip()
+				out.RoleID = nil
+			} else {
+				if out.RoleID == nil {
+					out.RoleID = new(uint64)
+				}
+				*out.RoleID = uint64(in.Uint64())
 			}
 		default:
 			in.SkipRecursive()
@@ -1715,56 +1744,83 @@ func easyjson56de76c1DecodeServerInternalPkgDto18(in *jlexer.Lexer, out *Updated
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto18(out *jwriter.Writer, in UpdatedWorkspaceInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto18(out *jwriter.Writer, in UserInWorkspace) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"user_id\":"
 		out.RawString(prefix[1:])
 		out.Uint64(uint64(in.ID))
 	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
 	{
 		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		if in.Name == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Name))
+		}
 	}
 	{
-		const prefix string = ",\"description\":"
+		const prefix string = ",\"surname\":"
 		out.RawString(prefix)
-		if in.Description == nil {
+		if in.Surname == nil {
 			out.RawString("null")
 		} else {
-			out.String(string(*in.Description))
+			out.String(string(*in.Surname))
+		}
+	}
+	{
+		const prefix string = ",\"avatar_url\":"
+		out.RawString(prefix)
+		if in.AvatarURL == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.AvatarURL))
+		}
+	}
+	{
+		const prefix string = ",\"role_id\":"
+		out.RawString(prefix)
+		if in.RoleID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.RoleID))
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UpdatedWorkspaceInfo) MarshalJSON() ([]byte, error) {
+func (v UserInWorkspace) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto18(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UpdatedWorkspaceInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserInWorkspace) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto18(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UpdatedWorkspaceInfo) UnmarshalJSON(data []byte) error {
+func (v *UserInWorkspace) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto18(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UpdatedWorkspaceInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserInWorkspace) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto18(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto19(in *jlexer.Lexer, out *UpdatedUserInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto19(in *jlexer.Lexer, out *UserImageUrlInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1783,30 +1839,10 @@ func easyjson56de76c1DecodeServerInternalPkgDto19(in *jlexer.Lexer, out *Updated
 			continue
 		}
 		switch key {
-		case "email":
-			out.Email = string(in.String())
-		case "name":
-			if in.IsNull() {
-				in.Skip()
-				out.Name = nil
-			} else {
-				if out.Name == nil {
-					out.Name = new(string)
-				}
-				*out.Name = string(in.String())
-			}
-		case "surname":
-			if in.IsNull() {
-				in.Skip()
-				out.Surname = nil
-			} else {
-				if out.Surname == nil {
-					out.Surname = new(string)
-				}
-				*out.Surname = string(in.String())
-			}
+		case "user_id":
+			out.ID = uint64(in.Uint64())
 		case "avatar_url":
-			out.AvatarURL = string(in.String())
+			out.Url = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1817,65 +1853,47 @@ func easyjson56de76c1DecodeServerInternalPkgDto19(in *jlexer.Lexer, out *Updated
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto19(out *jwriter.Writer, in UpdatedUserInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto19(out *jwriter.Writer, in UserImageUrlInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"email\":"
+		const prefix string = ",\"user_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Email))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		if in.Name == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Name))
-		}
-	}
-	{
-		const prefix string = ",\"surname\":"
-		out.RawString(prefix)
-		if in.Surname == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Surname))
-		}
+		out.Uint64(uint64(in.ID))
 	}
 	{
 		const prefix string = ",\"avatar_url\":"
 		out.RawString(prefix)
-		out.String(string(in.AvatarURL))
+		out.String(string(in.Url))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UpdatedUserInfo) MarshalJSON() ([]byte, error) {
+func (v UserImageUrlInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto19(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UpdatedUserInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserImageUrlInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto19(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UpdatedUserInfo) UnmarshalJSON(data []byte) error {
+func (v *UserImageUrlInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto19(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UpdatedUserInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserImageUrlInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto19(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto20(in *jlexer.Lexer, out *UpdatedTaskInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto20(in *jlexer.Lexer, out *UserID) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1894,46 +1912,8 @@ func easyjson56de76c1DecodeServerInternalPkgDto20(in *jlexer.Lexer, out *Updated
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = uint64(in.Uint64())
-		case "name":
-			out.Name = string(in.String())
-		case "description":
-			if in.IsNull() {
-				in.Skip()
-				out.Description = nil
-			} else {
-				if out.Description == nil {
-					out.Description = new(string)
-				}
-				*out.Description = string(in.String())
-			}
-		case "start":
-			if in.IsNull() {
-				in.Skip()
-				out.Start = nil
-			} else {
-				if out.Start == nil {
-					out.Start = new(time.Time)
-				}
-				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.Start).UnmarshalJSON(data))
-				}
-			}
-		case "end":
-			if in.IsNull() {
-				in.Skip()
-				out.End = nil
-			} else {
-				if out.End == nil {
-					out.End = new(time.Time)
-				}
-				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.End).UnmarshalJSON(data))
-				}
-			}
-		case "list_position":
-			out.ListPosition = uint64(in.Uint64())
+		case "user_id":
+			out.Value = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -1944,79 +1924,42 @@ func easyjson56de76c1DecodeServerInternalPkgDto20(in *jlexer.Lexer, out *Updated
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto20(out *jwriter.Writer, in UpdatedTaskInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto20(out *jwriter.Writer, in UserID) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"user_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		if in.Description == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Description))
-		}
-	}
-	{
-		const prefix string = ",\"start\":"
-		out.RawString(prefix)
-		if in.Start == nil {
-			out.RawString("null")
-		} else {
-			out.Raw((*in.Start).MarshalJSON())
-		}
-	}
-	{
-		const prefix string = ",\"end\":"
-		out.RawString(prefix)
-		if in.End == nil {
-			out.RawString("null")
-		} else {
-			out.Raw((*in.End).MarshalJSON())
-		}
-	}
-	{
-		const prefix string = ",\"list_position\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.ListPosition))
+		out.Uint64(uint64(in.Value))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UpdatedTaskInfo) MarshalJSON() ([]byte, error) {
+func (v UserID) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto20(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UpdatedTaskInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserID) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto20(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UpdatedTaskInfo) UnmarshalJSON(data []byte) error {
+func (v *UserID) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto20(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UpdatedTaskInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserID) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto20(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto21(in *jlexer.Lexer, out *UpdatedTagInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto21(in *jlexer.Lexer, out *UserGuestWorkspaceInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2035,68 +1978,107 @@ func easyjson56de76c1DecodeServerInternalPkgDto21(in *jlexer.Lexer, out *Updated
 			continue
 		}
 		switch key {
-		case "id":
+		case "workspace_id":
 			out.ID = uint64(in.Uint64())
-		case "name":
+		case "workspace_name":
 			out.Name = string(in.String())
-		case "color":
-			out.Color = string(in.String())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson56de76c1EncodeServerInternalPkgDto21(out *jwriter.Writer, in UpdatedTagInfo) {
-	out.RawByte('{')
-	first := true
-	_ = first
+		case "workspace_owner":
+			(out.Owner).UnmarshalEasyJSON(in)
+		case "boards":
+			if in.IsNull() {
+				in.Skip()
+				out.Boards = nil
+			} else {
+				in.Delim('[')
+				if out.Boards == nil {
+					if !in.IsDelim(']') {
+						out.Boards = make([]WorkspaceBoardInfo, 0, 1)
+					} else {
+						out.Boards = []WorkspaceBoardInfo{}
+					}
+				} else {
+					out.Boards = (out.Boards)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 WorkspaceBoardInfo
+					(v13).UnmarshalEasyJSON(in)
+					out.Boards = append(out.Boards, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto21(out *jwriter.Writer, in UserGuestWorkspaceInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"workspace_id\":"
 		out.RawString(prefix[1:])
 		out.Uint64(uint64(in.ID))
 	}
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"workspace_name\":"
 		out.RawString(prefix)
 		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"color\":"
+		const prefix string = ",\"workspace_owner\":"
 		out.RawString(prefix)
-		out.String(string(in.Color))
+		(in.Owner).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"boards\":"
+		out.RawString(prefix)
+		if in.Boards == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.Boards {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				(v15).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UpdatedTagInfo) MarshalJSON() ([]byte, error) {
+func (v UserGuestWorkspaceInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto21(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UpdatedTagInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserGuestWorkspaceInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto21(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UpdatedTagInfo) UnmarshalJSON(data []byte) error {
+func (v *UserGuestWorkspaceInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto21(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UpdatedTagInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserGuestWorkspaceInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto21(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto22(in *jlexer.Lexer, out *UpdatedListInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto22(in *jlexer.Lexer, out *UserEmail) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2115,22 +2097,8 @@ func easyjson56de76c1DecodeServerInternalPkgDto22(in *jlexer.Lexer, out *Updated
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = uint64(in.Uint64())
-		case "name":
-			out.Name = string(in.String())
-		case "description":
-			if in.IsNull() {
-				in.Skip()
-				out.Description = nil
-			} else {
-				if out.Description == nil {
-					out.Description = new(string)
-				}
-				*out.Description = string(in.String())
-			}
-		case "list_position":
-			out.ListPosition = uint64(in.Uint64())
+		case "email":
+			out.Email = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2141,61 +2109,42 @@ func easyjson56de76c1DecodeServerInternalPkgDto22(in *jlexer.Lexer, out *Updated
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto22(out *jwriter.Writer, in UpdatedListInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto22(out *jwriter.Writer, in UserEmail) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"email\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		if in.Description == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Description))
-		}
-	}
-	{
-		const prefix string = ",\"list_position\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.ListPosition))
+		out.String(string(in.Email))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UpdatedListInfo) MarshalJSON() ([]byte, error) {
+func (v UserEmail) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto22(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UpdatedListInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserEmail) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto22(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UpdatedListInfo) UnmarshalJSON(data []byte) error {
+func (v *UserEmail) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto22(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UpdatedListInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserEmail) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto22(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto23(in *jlexer.Lexer, out *UpdatedChecklistItemInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto23(in *jlexer.Lexer, out *UserAndWorkspaceIDs) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2214,14 +2163,10 @@ func easyjson56de76c1DecodeServerInternalPkgDto23(in *jlexer.Lexer, out *Updated
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = uint64(in.Uint64())
-		case "name":
-			out.Name = string(in.String())
-		case "done":
-			out.Done = bool(in.Bool())
-		case "list_position":
-			out.ListPosition = uint64(in.Uint64())
+		case "workspace_id":
+			out.WorkspaceID = uint64(in.Uint64())
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -2232,57 +2177,47 @@ func easyjson56de76c1DecodeServerInternalPkgDto23(in *jlexer.Lexer, out *Updated
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto23(out *jwriter.Writer, in UpdatedChecklistItemInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto23(out *jwriter.Writer, in UserAndWorkspaceIDs) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"workspace_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"done\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Done))
+		out.Uint64(uint64(in.WorkspaceID))
 	}
 	{
-		const prefix string = ",\"list_position\":"
+		const prefix string = ",\"user_id\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.ListPosition))
+		out.Uint64(uint64(in.UserID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UpdatedChecklistItemInfo) MarshalJSON() ([]byte, error) {
+func (v UserAndWorkspaceIDs) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto23(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UpdatedChecklistItemInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserAndWorkspaceIDs) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto23(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UpdatedChecklistItemInfo) UnmarshalJSON(data []byte) error {
+func (v *UserAndWorkspaceIDs) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto23(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UpdatedChecklistItemInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserAndWorkspaceIDs) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto23(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto24(in *jlexer.Lexer, out *UpdatedChecklistInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto24(in *jlexer.Lexer, out *UrlObj) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2301,12 +2236,8 @@ func easyjson56de76c1DecodeServerInternalPkgDto24(in *jlexer.Lexer, out *Updated
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = uint64(in.Uint64())
-		case "name":
-			out.Name = string(in.String())
-		case "list_position":
-			out.ListPosition = uint64(in.Uint64())
+		case "url":
+			out.Value = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2317,52 +2248,42 @@ func easyjson56de76c1DecodeServerInternalPkgDto24(in *jlexer.Lexer, out *Updated
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto24(out *jwriter.Writer, in UpdatedChecklistInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto24(out *jwriter.Writer, in UrlObj) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"url\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"list_position\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.ListPosition))
+		out.String(string(in.Value))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UpdatedChecklistInfo) MarshalJSON() ([]byte, error) {
+func (v UrlObj) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto24(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UpdatedChecklistInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UrlObj) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto24(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UpdatedChecklistInfo) UnmarshalJSON(data []byte) error {
+func (v *UrlObj) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto24(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UpdatedChecklistInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UrlObj) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto24(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto25(in *jlexer.Lexer, out *UpdatedCSATQuestionInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto25(in *jlexer.Lexer, out *UpdatedWorkspaceInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2383,10 +2304,18 @@ func easyjson56de76c1DecodeServerInternalPkgDto25(in *jlexer.Lexer, out *Updated
 		switch key {
 		case "id":
 			out.ID = uint64(in.Uint64())
-		case "content":
-			out.Content = string(in.String())
-		case "type":
-			out.Type = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			if in.IsNull() {
+				in.Skip()
+				out.Description = nil
+			} else {
+				if out.Description == nil {
+					out.Description = new(string)
+				}
+				*out.Description = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2397,7 +2326,7 @@ func easyjson56de76c1DecodeServerInternalPkgDto25(in *jlexer.Lexer, out *Updated
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto25(out *jwriter.Writer, in UpdatedCSATQuestionInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto25(out *jwriter.Writer, in UpdatedWorkspaceInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2407,42 +2336,46 @@ func easyjson56de76c1EncodeServerInternalPkgDto25(out *jwriter.Writer, in Update
 		out.Uint64(uint64(in.ID))
 	}
 	{
-		const prefix string = ",\"content\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.String(string(in.Content))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"description\":"
 		out.RawString(prefix)
-		out.String(string(in.Type))
+		if in.Description == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Description))
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UpdatedCSATQuestionInfo) MarshalJSON() ([]byte, error) {
+func (v UpdatedWorkspaceInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto25(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UpdatedCSATQuestionInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UpdatedWorkspaceInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto25(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UpdatedCSATQuestionInfo) UnmarshalJSON(data []byte) error {
+func (v *UpdatedWorkspaceInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto25(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UpdatedCSATQuestionInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UpdatedWorkspaceInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto25(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto26(in *jlexer.Lexer, out *UpdatedCSATQuestion) {
+func easyjson56de76c1DecodeServerInternalPkgDto26(in *jlexer.Lexer, out *UpdatedUserInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2461,12 +2394,30 @@ func easyjson56de76c1DecodeServerInternalPkgDto26(in *jlexer.Lexer, out *Updated
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = uint64(in.Uint64())
-		case "content":
-			out.Content = string(in.String())
-		case "type":
-			out.Type = uint64(in.Uint64())
+		case "email":
+			out.Email = string(in.String())
+		case "name":
+			if in.IsNull() {
+				in.Skip()
+				out.Name = nil
+			} else {
+				if out.Name == nil {
+					out.Name = new(string)
+				}
+				*out.Name = string(in.String())
+			}
+		case "surname":
+			if in.IsNull() {
+				in.Skip()
+				out.Surname = nil
+			} else {
+				if out.Surname == nil {
+					out.Surname = new(string)
+				}
+				*out.Surname = string(in.String())
+			}
+		case "avatar_url":
+			out.AvatarURL = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2477,52 +2428,65 @@ func easyjson56de76c1DecodeServerInternalPkgDto26(in *jlexer.Lexer, out *Updated
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto26(out *jwriter.Writer, in UpdatedCSATQuestion) {
+func easyjson56de76c1EncodeServerInternalPkgDto26(out *jwriter.Writer, in UpdatedUserInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"email\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
+		out.String(string(in.Email))
 	}
 	{
-		const prefix string = ",\"content\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.String(string(in.Content))
-	}
+		if in.Name == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Name))
+		}
+	}
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"surname\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.Type))
+		if in.Surname == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Surname))
+		}
+	}
+	{
+		const prefix string = ",\"avatar_url\":"
+		out.RawString(prefix)
+		out.String(string(in.AvatarURL))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UpdatedCSATQuestion) MarshalJSON() ([]byte, error) {
+func (v UpdatedUserInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto26(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UpdatedCSATQuestion) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UpdatedUserInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto26(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UpdatedCSATQuestion) UnmarshalJSON(data []byte) error {
+func (v *UpdatedUserInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto26(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UpdatedCSATQuestion) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UpdatedUserInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto26(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto27(in *jlexer.Lexer, out *UpdatedBoardInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto27(in *jlexer.Lexer, out *UpdatedTaskInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2545,6 +2509,56 @@ func easyjson56de76c1DecodeServerInternalPkgDto27(in *jlexer.Lexer, out *Updated
 			out.ID = uint64(in.Uint64())
 		case "name":
 			out.Name = string(in.String())
+		case "description":
+			if in.IsNull() {
+				in.Skip()
+				out.Description = nil
+			} else {
+				if out.Description == nil {
+					out.Description = new(string)
+				}
+				*out.Description = string(in.String())
+			}
+		case "start":
+			if in.IsNull() {
+				in.Skip()
+				out.Start = nil
+			} else {
+				if out.Start == nil {
+					out.Start = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.Start).UnmarshalJSON(data))
+				}
+			}
+		case "end":
+			if in.IsNull() {
+				in.Skip()
+				out.End = nil
+			} else {
+				if out.End == nil {
+					out.End = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.End).UnmarshalJSON(data))
+				}
+			}
+		case "all_day":
+			out.AllDay = bool(in.Bool())
+		case "priority":
+			out.Priority = string(in.String())
+		case "estimate":
+			if in.IsNull() {
+				in.Skip()
+				out.Estimate = nil
+			} else {
+				if out.Estimate == nil {
+					out.Estimate = new(float64)
+				}
+				*out.Estimate = float64(in.Float64())
+			}
+		case "row_version":
+			out.RowVersion = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -2555,7 +2569,7 @@ func easyjson56de76c1DecodeServerInternalPkgDto27(in *jlexer.Lexer, out *Updated
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto27(out *jwriter.Writer, in UpdatedBoardInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto27(out *jwriter.Writer, in UpdatedTaskInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2569,33 +2583,84 @@ func easyjson56de76c1EncodeServerInternalPkgDto27(out *jwriter.Writer, in Update
 		out.RawString(prefix)
 		out.String(string(in.Name))
 	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		if in.Description == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Description))
+		}
+	}
+	{
+		const prefix string = ",\"start\":"
+		out.RawString(prefix)
+		if in.Start == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.Start).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"end\":"
+		out.RawString(prefix)
+		if in.End == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.End).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"all_day\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AllDay))
+	}
+	{
+		const prefix string = ",\"priority\":"
+		out.RawString(prefix)
+		out.String(string(in.Priority))
+	}
+	{
+		const prefix string = ",\"estimate\":"
+		out.RawString(prefix)
+		if in.Estimate == nil {
+			out.RawString("null")
+		} else {
+			out.Float64(float64(*in.Estimate))
+		}
+	}
+	{
+		const prefix string = ",\"row_version\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.RowVersion))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UpdatedBoardInfo) MarshalJSON() ([]byte, error) {
+func (v UpdatedTaskInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto27(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UpdatedBoardInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UpdatedTaskInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto27(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UpdatedBoardInfo) UnmarshalJSON(data []byte) error {
+func (v *UpdatedTaskInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto27(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UpdatedBoardInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UpdatedTaskInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto27(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto28(in *jlexer.Lexer, out *TaskMoveListInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto28(in *jlexer.Lexer, out *UpdatedTagInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2615,30 +2680,11 @@ func easyjson56de76c1DecodeServerInternalPkgDto28(in *jlexer.Lexer, out *TaskMov
 		}
 		switch key {
 		case "id":
-			out.ListID = uint64(in.Uint64())
-		case "task_ids":
-			if in.IsNull() {
-				in.Skip()
-				out.TaskIDs = nil
-			} else {
-				in.Delim('[')
-				if out.TaskIDs == nil {
-					if !in.IsDelim(']') {
-						out.TaskIDs = make([]uint64, 0, 8)
-					} else {
-						out.TaskIDs = []uint64{}
-					}
-				} else {
-					out.TaskIDs = (out.TaskIDs)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v13 uint64
-					v13 = uint64(in.Uint64())
-					out.TaskIDs = append(out.TaskIDs, v13)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+			out.ID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "color":
+			out.Color = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2649,58 +2695,52 @@ func easyjson56de76c1DecodeServerInternalPkgDto28(in *jlexer.Lexer, out *TaskMov
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto28(out *jwriter.Writer, in TaskMoveListInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto28(out *jwriter.Writer, in UpdatedTagInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
 		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ListID))
+		out.Uint64(uint64(in.ID))
 	}
 	{
-		const prefix string = ",\"task_ids\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		if in.TaskIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v14, v15 := range in.TaskIDs {
-				if v14 > 0 {
-					out.RawByte(',')
-				}
-				out.Uint64(uint64(v15))
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"color\":"
+		out.RawString(prefix)
+		out.String(string(in.Color))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TaskMoveListInfo) MarshalJSON() ([]byte, error) {
+func (v UpdatedTagInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto28(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TaskMoveListInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UpdatedTagInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto28(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TaskMoveListInfo) UnmarshalJSON(data []byte) error {
+func (v *UpdatedTagInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto28(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TaskMoveListInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UpdatedTagInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto28(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto29(in *jlexer.Lexer, out *TaskMoveInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto29(in *jlexer.Lexer, out *UpdatedListInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2719,12 +2759,40 @@ func easyjson56de76c1DecodeServerInternalPkgDto29(in *jlexer.Lexer, out *TaskMov
 			continue
 		}
 		switch key {
-		case "task_id":
-			out.TaskID = uint64(in.Uint64())
-		case "old_list":
-			(out.OldList).UnmarshalEasyJSON(in)
-		case "new_list":
-			(out.NewList).UnmarshalEasyJSON(in)
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			if in.IsNull() {
+				in.Skip()
+				out.Description = nil
+			} else {
+				if out.Description == nil {
+					out.Description = new(string)
+				}
+				*out.Description = string(in.String())
+			}
+		case "list_position":
+			out.ListPosition = uint64(in.Uint64())
+		case "wip_limit":
+			if in.IsNull() {
+				in.Skip()
+				out.WipLimit = nil
+			} else {
+				if out.WipLimit == nil {
+					out.WipLimit = new(uint64)
+				}
+				*out.WipLimit = uint64(in.Uint64())
+			}
+		case "wip_mode":
+			out.WipMode = string(in.String())
+		case "is_done":
+			out.IsDone = bool(in.Bool())
+		case "require_unblocked":
+			out.RequireUnblocked = bool(in.Bool())
+		case "row_version":
+			out.RowVersion = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -2735,52 +2803,90 @@ func easyjson56de76c1DecodeServerInternalPkgDto29(in *jlexer.Lexer, out *TaskMov
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto29(out *jwriter.Writer, in TaskMoveInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto29(out *jwriter.Writer, in UpdatedListInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"task_id\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.TaskID))
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		if in.Description == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Description))
+		}
+	}
+	{
+		const prefix string = ",\"list_position\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ListPosition))
+	}
+	{
+		const prefix string = ",\"wip_limit\":"
+		out.RawString(prefix)
+		if in.WipLimit == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.WipLimit))
+		}
+	}
+	{
+		const prefix string = ",\"wip_mode\":"
+		out.RawString(prefix)
+		out.String(string(in.WipMode))
+	}
+	{
+		const prefix string = ",\"is_done\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsDone))
 	}
 	{
-		const prefix string = ",\"old_list\":"
+		const prefix string = ",\"require_unblocked\":"
 		out.RawString(prefix)
-		(in.OldList).MarshalEasyJSON(out)
+		out.Bool(bool(in.RequireUnblocked))
 	}
 	{
-		const prefix string = ",\"new_list\":"
+		const prefix string = ",\"row_version\":"
 		out.RawString(prefix)
-		(in.NewList).MarshalEasyJSON(out)
+		out.Uint64(uint64(in.RowVersion))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TaskMoveInfo) MarshalJSON() ([]byte, error) {
+func (v UpdatedListInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto29(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TaskMoveInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UpdatedListInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto29(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TaskMoveInfo) UnmarshalJSON(data []byte) error {
+func (v *UpdatedListInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto29(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TaskMoveInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UpdatedListInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto29(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto30(in *jlexer.Lexer, out *TaskIDs) {
+func easyjson56de76c1DecodeServerInternalPkgDto30(in *jlexer.Lexer, out *UpdatedCustomFieldInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2799,29 +2905,35 @@ func easyjson56de76c1DecodeServerInternalPkgDto30(in *jlexer.Lexer, out *TaskIDs
 			continue
 		}
 		switch key {
-		case "ids":
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "options":
 			if in.IsNull() {
 				in.Skip()
-				out.Values = nil
+				out.Options = nil
 			} else {
 				in.Delim('[')
-				if out.Values == nil {
+				if out.Options == nil {
 					if !in.IsDelim(']') {
-						out.Values = make([]string, 0, 4)
+						out.Options = make([]string, 0, 4)
 					} else {
-						out.Values = []string{}
+						out.Options = []string{}
 					}
 				} else {
-					out.Values = (out.Values)[:0]
+					out.Options = (out.Options)[:0]
 				}
 				for !in.IsDelim(']') {
 					var v16 string
 					v16 = string(in.String())
-					out.Values = append(out.Values, v16)
+					out.Options = append(out.Options, v16)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "list_position":
+			out.ListPosition = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -2832,18 +2944,28 @@ func easyjson56de76c1DecodeServerInternalPkgDto30(in *jlexer.Lexer, out *TaskIDs
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto30(out *jwriter.Writer, in TaskIDs) {
+func easyjson56de76c1EncodeServerInternalPkgDto30(out *jwriter.Writer, in UpdatedCustomFieldInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"ids\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		if in.Values == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		if in.Options == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v17, v18 := range in.Values {
+			for v17, v18 := range in.Options {
 				if v17 > 0 {
 					out.RawByte(',')
 				}
@@ -2852,33 +2974,38 @@ func easyjson56de76c1EncodeServerInternalPkgDto30(out *jwriter.Writer, in TaskID
 			out.RawByte(']')
 		}
 	}
+	{
+		const prefix string = ",\"list_position\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ListPosition))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TaskIDs) MarshalJSON() ([]byte, error) {
+func (v UpdatedCustomFieldInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto30(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TaskIDs) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UpdatedCustomFieldInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto30(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TaskIDs) UnmarshalJSON(data []byte) error {
+func (v *UpdatedCustomFieldInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto30(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TaskIDs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UpdatedCustomFieldInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto30(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto31(in *jlexer.Lexer, out *TaskID) {
+func easyjson56de76c1DecodeServerInternalPkgDto31(in *jlexer.Lexer, out *UpdatedChecklistItemInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2898,7 +3025,13 @@ func easyjson56de76c1DecodeServerInternalPkgDto31(in *jlexer.Lexer, out *TaskID)
 		}
 		switch key {
 		case "id":
-			out.Value = uint64(in.Uint64())
+			out.ID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "done":
+			out.Done = bool(in.Bool())
+		case "list_position":
+			out.ListPosition = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -2909,42 +3042,57 @@ func easyjson56de76c1DecodeServerInternalPkgDto31(in *jlexer.Lexer, out *TaskID)
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto31(out *jwriter.Writer, in TaskID) {
+func easyjson56de76c1EncodeServerInternalPkgDto31(out *jwriter.Writer, in UpdatedChecklistItemInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
 		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.Value))
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"done\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Done))
+	}
+	{
+		const prefix string = ",\"list_position\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ListPosition))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TaskID) MarshalJSON() ([]byte, error) {
+func (v UpdatedChecklistItemInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto31(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TaskID) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UpdatedChecklistItemInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto31(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TaskID) UnmarshalJSON(data []byte) error {
+func (v *UpdatedChecklistItemInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto31(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TaskID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UpdatedChecklistItemInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto31(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto32(in *jlexer.Lexer, out *TagInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto32(in *jlexer.Lexer, out *UpdatedChecklistInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2967,8 +3115,8 @@ func easyjson56de76c1DecodeServerInternalPkgDto32(in *jlexer.Lexer, out *TagInfo
 			out.ID = uint64(in.Uint64())
 		case "name":
 			out.Name = string(in.String())
-		case "color":
-			out.Color = string(in.String())
+		case "list_position":
+			out.ListPosition = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -2979,7 +3127,7 @@ func easyjson56de76c1DecodeServerInternalPkgDto32(in *jlexer.Lexer, out *TagInfo
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto32(out *jwriter.Writer, in TagInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto32(out *jwriter.Writer, in UpdatedChecklistInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2994,37 +3142,37 @@ func easyjson56de76c1EncodeServerInternalPkgDto32(out *jwriter.Writer, in TagInf
 		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"color\":"
+		const prefix string = ",\"list_position\":"
 		out.RawString(prefix)
-		out.String(string(in.Color))
+		out.Uint64(uint64(in.ListPosition))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TagInfo) MarshalJSON() ([]byte, error) {
+func (v UpdatedChecklistInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto32(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TagInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UpdatedChecklistInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto32(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TagInfo) UnmarshalJSON(data []byte) error {
+func (v *UpdatedChecklistInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto32(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TagInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UpdatedChecklistInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto32(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto33(in *jlexer.Lexer, out *TagID) {
+func easyjson56de76c1DecodeServerInternalPkgDto33(in *jlexer.Lexer, out *UpdatedCSATQuestionInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3043,8 +3191,12 @@ func easyjson56de76c1DecodeServerInternalPkgDto33(in *jlexer.Lexer, out *TagID)
 			continue
 		}
 		switch key {
-		case "tag_id":
-			out.Value = uint64(in.Uint64())
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "content":
+			out.Content = string(in.String())
+		case "type":
+			out.Type = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3055,42 +3207,52 @@ func easyjson56de76c1DecodeServerInternalPkgDto33(in *jlexer.Lexer, out *TagID)
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto33(out *jwriter.Writer, in TagID) {
+func easyjson56de76c1EncodeServerInternalPkgDto33(out *jwriter.Writer, in UpdatedCSATQuestionInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"tag_id\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.Value))
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix)
+		out.String(string(in.Content))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TagID) MarshalJSON() ([]byte, error) {
+func (v UpdatedCSATQuestionInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto33(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TagID) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UpdatedCSATQuestionInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto33(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TagID) UnmarshalJSON(data []byte) error {
+func (v *UpdatedCSATQuestionInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto33(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TagID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UpdatedCSATQuestionInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto33(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto34(in *jlexer.Lexer, out *TagAndTaskIDs) {
+func easyjson56de76c1DecodeServerInternalPkgDto34(in *jlexer.Lexer, out *UpdatedCSATQuestion) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3109,10 +3271,12 @@ func easyjson56de76c1DecodeServerInternalPkgDto34(in *jlexer.Lexer, out *TagAndT
 			continue
 		}
 		switch key {
-		case "tag_id":
-			out.TagID = uint64(in.Uint64())
-		case "task_id":
-			out.TaskID = uint64(in.Uint64())
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "content":
+			out.Content = string(in.String())
+		case "type":
+			out.Type = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -3123,47 +3287,52 @@ func easyjson56de76c1DecodeServerInternalPkgDto34(in *jlexer.Lexer, out *TagAndT
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto34(out *jwriter.Writer, in TagAndTaskIDs) {
+func easyjson56de76c1EncodeServerInternalPkgDto34(out *jwriter.Writer, in UpdatedCSATQuestion) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"tag_id\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.TagID))
+		out.Uint64(uint64(in.ID))
 	}
 	{
-		const prefix string = ",\"task_id\":"
+		const prefix string = ",\"content\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.TaskID))
+		out.String(string(in.Content))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Type))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TagAndTaskIDs) MarshalJSON() ([]byte, error) {
+func (v UpdatedCSATQuestion) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto34(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TagAndTaskIDs) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UpdatedCSATQuestion) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto34(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TagAndTaskIDs) UnmarshalJSON(data []byte) error {
+func (v *UpdatedCSATQuestion) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto34(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TagAndTaskIDs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UpdatedCSATQuestion) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto34(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto35(in *jlexer.Lexer, out *SingleTaskInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto35(in *jlexer.Lexer, out *UpdatedBoardInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3184,142 +3353,10 @@ func easyjson56de76c1DecodeServerInternalPkgDto35(in *jlexer.Lexer, out *SingleT
 		switch key {
 		case "id":
 			out.ID = uint64(in.Uint64())
-		case "list_id":
-			out.ListID = uint64(in.Uint64())
-		case "date_created":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.DateCreated).UnmarshalJSON(data))
-			}
 		case "name":
 			out.Name = string(in.String())
-		case "description":
-			if in.IsNull() {
-				in.Skip()
-				out.Description = nil
-			} else {
-				if out.Description == nil {
-					out.Description = new(string)
-				}
-				*out.Description = string(in.String())
-			}
-		case "list_position":
-			out.ListPosition = uint64(in.Uint64())
-		case "start":
-			if in.IsNull() {
-				in.Skip()
-				out.Start = nil
-			} else {
-				if out.Start == nil {
-					out.Start = new(time.Time)
-				}
-				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.Start).UnmarshalJSON(data))
-				}
-			}
-		case "end":
-			if in.IsNull() {
-				in.Skip()
-				out.End = nil
-			} else {
-				if out.End == nil {
-					out.End = new(time.Time)
-				}
-				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.End).UnmarshalJSON(data))
-				}
-			}
-		case "users":
-			if in.IsNull() {
-				in.Skip()
-				out.UserIDs = nil
-			} else {
-				in.Delim('[')
-				if out.UserIDs == nil {
-					if !in.IsDelim(']') {
-						out.UserIDs = make([]string, 0, 4)
-					} else {
-						out.UserIDs = []string{}
-					}
-				} else {
-					out.UserIDs = (out.UserIDs)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v19 string
-					v19 = string(in.String())
-					out.UserIDs = append(out.UserIDs, v19)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "comments":
-			if in.IsNull() {
-				in.Skip()
-				out.CommentIDs = nil
-			} else {
-				in.Delim('[')
-				if out.CommentIDs == nil {
-					if !in.IsDelim(']') {
-						out.CommentIDs = make([]string, 0, 4)
-					} else {
-						out.CommentIDs = []string{}
-					}
-				} else {
-					out.CommentIDs = (out.CommentIDs)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v20 string
-					v20 = string(in.String())
-					out.CommentIDs = append(out.CommentIDs, v20)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "checklists":
-			if in.IsNull() {
-				in.Skip()
-				out.ChecklistIDs = nil
-			} else {
-				in.Delim('[')
-				if out.ChecklistIDs == nil {
-					if !in.IsDelim(']') {
-						out.ChecklistIDs = make([]string, 0, 4)
-					} else {
-						out.ChecklistIDs = []string{}
-					}
-				} else {
-					out.ChecklistIDs = (out.ChecklistIDs)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v21 string
-					v21 = string(in.String())
-					out.ChecklistIDs = append(out.ChecklistIDs, v21)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "tags":
-			if in.IsNull() {
-				in.Skip()
-				out.TagIDs = nil
-			} else {
-				in.Delim('[')
-				if out.TagIDs == nil {
-					if !in.IsDelim(']') {
-						out.TagIDs = make([]string, 0, 4)
-					} else {
-						out.TagIDs = []string{}
-					}
-				} else {
-					out.TagIDs = (out.TagIDs)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v22 string
-					v22 = string(in.String())
-					out.TagIDs = append(out.TagIDs, v22)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "row_version":
+			out.RowVersion = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -3330,7 +3367,7 @@ func easyjson56de76c1DecodeServerInternalPkgDto35(in *jlexer.Lexer, out *SingleT
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto35(out *jwriter.Writer, in SingleTaskInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto35(out *jwriter.Writer, in UpdatedBoardInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3339,144 +3376,43 @@ func easyjson56de76c1EncodeServerInternalPkgDto35(out *jwriter.Writer, in Single
 		out.RawString(prefix[1:])
 		out.Uint64(uint64(in.ID))
 	}
-	{
-		const prefix string = ",\"list_id\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.ListID))
-	}
-	{
-		const prefix string = ",\"date_created\":"
-		out.RawString(prefix)
-		out.Raw((in.DateCreated).MarshalJSON())
-	}
 	{
 		const prefix string = ",\"name\":"
 		out.RawString(prefix)
 		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"description\":"
+		const prefix string = ",\"row_version\":"
 		out.RawString(prefix)
-		if in.Description == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Description))
-		}
-	}
-	{
-		const prefix string = ",\"list_position\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.ListPosition))
-	}
-	{
-		const prefix string = ",\"start\":"
-		out.RawString(prefix)
-		if in.Start == nil {
-			out.RawString("null")
-		} else {
-			out.Raw((*in.Start).MarshalJSON())
-		}
-	}
-	{
-		const prefix string = ",\"end\":"
-		out.RawString(prefix)
-		if in.End == nil {
-			out.RawString("null")
-		} else {
-			out.Raw((*in.End).MarshalJSON())
-		}
-	}
-	{
-		const prefix string = ",\"users\":"
-		out.RawString(prefix)
-		if in.UserIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v23, v24 := range in.UserIDs {
-				if v23 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v24))
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"comments\":"
-		out.RawString(prefix)
-		if in.CommentIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v25, v26 := range in.CommentIDs {
-				if v25 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v26))
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"checklists\":"
-		out.RawString(prefix)
-		if in.ChecklistIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v27, v28 := range in.ChecklistIDs {
-				if v27 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v28))
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"tags\":"
-		out.RawString(prefix)
-		if in.TagIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v29, v30 := range in.TagIDs {
-				if v29 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v30))
-			}
-			out.RawByte(']')
-		}
+		out.Uint64(uint64(in.RowVersion))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SingleTaskInfo) MarshalJSON() ([]byte, error) {
+func (v UpdatedBoardInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto35(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SingleTaskInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UpdatedBoardInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto35(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SingleTaskInfo) UnmarshalJSON(data []byte) error {
+func (v *UpdatedBoardInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto35(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SingleTaskInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UpdatedBoardInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto35(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto36(in *jlexer.Lexer, out *SingleListInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto36(in *jlexer.Lexer, out *TransferredTag) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3495,37 +3431,14 @@ func easyjson56de76c1DecodeServerInternalPkgDto36(in *jlexer.Lexer, out *SingleL
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = uint64(in.Uint64())
-		case "board_id":
-			out.BoardID = uint64(in.Uint64())
+		case "from_tag_id":
+			out.FromTagID = uint64(in.Uint64())
+		case "to_tag_id":
+			out.ToTagID = uint64(in.Uint64())
 		case "name":
 			out.Name = string(in.String())
-		case "list_position":
-			out.ListPosition = uint64(in.Uint64())
-		case "cards":
-			if in.IsNull() {
-				in.Skip()
-				out.TaskIDs = nil
-			} else {
-				in.Delim('[')
-				if out.TaskIDs == nil {
-					if !in.IsDelim(']') {
-						out.TaskIDs = make([]string, 0, 4)
-					} else {
-						out.TaskIDs = []string{}
-					}
-				} else {
-					out.TaskIDs = (out.TaskIDs)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v31 string
-					v31 = string(in.String())
-					out.TaskIDs = append(out.TaskIDs, v31)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "created":
+			out.Created = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -3536,19 +3449,19 @@ func easyjson56de76c1DecodeServerInternalPkgDto36(in *jlexer.Lexer, out *SingleL
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto36(out *jwriter.Writer, in SingleListInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto36(out *jwriter.Writer, in TransferredTag) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"from_tag_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
+		out.Uint64(uint64(in.FromTagID))
 	}
 	{
-		const prefix string = ",\"board_id\":"
+		const prefix string = ",\"to_tag_id\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.BoardID))
+		out.Uint64(uint64(in.ToTagID))
 	}
 	{
 		const prefix string = ",\"name\":"
@@ -3556,53 +3469,37 @@ func easyjson56de76c1EncodeServerInternalPkgDto36(out *jwriter.Writer, in Single
 		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"list_position\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.ListPosition))
-	}
-	{
-		const prefix string = ",\"cards\":"
+		const prefix string = ",\"created\":"
 		out.RawString(prefix)
-		if in.TaskIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v32, v33 := range in.TaskIDs {
-				if v32 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v33))
-			}
-			out.RawByte(']')
-		}
+		out.Bool(bool(in.Created))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SingleListInfo) MarshalJSON() ([]byte, error) {
+func (v TransferredTag) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto36(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SingleListInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TransferredTag) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto36(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SingleListInfo) UnmarshalJSON(data []byte) error {
+func (v *TransferredTag) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto36(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SingleListInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TransferredTag) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto36(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto37(in *jlexer.Lexer, out *SingleBoardInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto37(in *jlexer.Lexer, out *TransferChanges) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3621,27 +3518,97 @@ func easyjson56de76c1DecodeServerInternalPkgDto37(in *jlexer.Lexer, out *SingleB
 			continue
 		}
 		switch key {
-		case "board_id":
-			out.ID = uint64(in.Uint64())
-		case "name":
-			out.Name = string(in.String())
-		case "workspace_id":
-			out.WorkspaceID = uint64(in.Uint64())
-		case "owner_id":
-			out.WorkspaceOwnerID = uint64(in.Uint64())
-		case "thumbnail_url":
+		case "remapped_tags":
 			if in.IsNull() {
 				in.Skip()
-				out.ThumbnailURL = nil
+				out.RemappedTags = nil
 			} else {
-				if out.ThumbnailURL == nil {
-					out.ThumbnailURL = new(string)
+				in.Delim('[')
+				if out.RemappedTags == nil {
+					if !in.IsDelim(']') {
+						out.RemappedTags = make([]TransferredTag, 0, 1)
+					} else {
+						out.RemappedTags = []TransferredTag{}
+					}
+				} else {
+					out.RemappedTags = (out.RemappedTags)[:0]
 				}
-				*out.ThumbnailURL = string(in.String())
+				for !in.IsDelim(']') {
+					var v19 TransferredTag
+					(v19).UnmarshalEasyJSON(in)
+					out.RemappedTags = append(out.RemappedTags, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "date_created":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.DateCreated).UnmarshalJSON(data))
+		case "dropped_assignees":
+			if in.IsNull() {
+				in.Skip()
+				out.DroppedAssignees = nil
+			} else {
+				in.Delim('[')
+				if out.DroppedAssignees == nil {
+					if !in.IsDelim(']') {
+						out.DroppedAssignees = make([]DroppedAssignee, 0, 4)
+					} else {
+						out.DroppedAssignees = []DroppedAssignee{}
+					}
+				} else {
+					out.DroppedAssignees = (out.DroppedAssignees)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v20 DroppedAssignee
+					(v20).UnmarshalEasyJSON(in)
+					out.DroppedAssignees = append(out.DroppedAssignees, v20)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "dropped_field_values":
+			if in.IsNull() {
+				in.Skip()
+				out.DroppedFieldValues = nil
+			} else {
+				in.Delim('[')
+				if out.DroppedFieldValues == nil {
+					if !in.IsDelim(']') {
+						out.DroppedFieldValues = make([]DroppedFieldValue, 0, 4)
+					} else {
+						out.DroppedFieldValues = []DroppedFieldValue{}
+					}
+				} else {
+					out.DroppedFieldValues = (out.DroppedFieldValues)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v21 DroppedFieldValue
+					(v21).UnmarshalEasyJSON(in)
+					out.DroppedFieldValues = append(out.DroppedFieldValues, v21)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "detached_subtasks":
+			if in.IsNull() {
+				in.Skip()
+				out.DetachedSubtasks = nil
+			} else {
+				in.Delim('[')
+				if out.DetachedSubtasks == nil {
+					if !in.IsDelim(']') {
+						out.DetachedSubtasks = make([]uint64, 0, 8)
+					} else {
+						out.DetachedSubtasks = []uint64{}
+					}
+				} else {
+					out.DetachedSubtasks = (out.DetachedSubtasks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 uint64
+					v22 = uint64(in.Uint64())
+					out.DetachedSubtasks = append(out.DetachedSubtasks, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -3653,71 +3620,101 @@ func easyjson56de76c1DecodeServerInternalPkgDto37(in *jlexer.Lexer, out *SingleB
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto37(out *jwriter.Writer, in SingleBoardInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto37(out *jwriter.Writer, in TransferChanges) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"board_id\":"
+		const prefix string = ",\"remapped_tags\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"workspace_id\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.WorkspaceID))
+		if in.RemappedTags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v23, v24 := range in.RemappedTags {
+				if v23 > 0 {
+					out.RawByte(',')
+				}
+				(v24).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"owner_id\":"
+		const prefix string = ",\"dropped_assignees\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.WorkspaceOwnerID))
+		if in.DroppedAssignees == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v25, v26 := range in.DroppedAssignees {
+				if v25 > 0 {
+					out.RawByte(',')
+				}
+				(v26).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"thumbnail_url\":"
+		const prefix string = ",\"dropped_field_values\":"
 		out.RawString(prefix)
-		if in.ThumbnailURL == nil {
+		if in.DroppedFieldValues == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			out.String(string(*in.ThumbnailURL))
+			out.RawByte('[')
+			for v27, v28 := range in.DroppedFieldValues {
+				if v27 > 0 {
+					out.RawByte(',')
+				}
+				(v28).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"date_created\":"
+		const prefix string = ",\"detached_subtasks\":"
 		out.RawString(prefix)
-		out.Raw((in.DateCreated).MarshalJSON())
+		if in.DetachedSubtasks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v29, v30 := range in.DetachedSubtasks {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v30))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SingleBoardInfo) MarshalJSON() ([]byte, error) {
+func (v TransferChanges) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto37(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SingleBoardInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TransferChanges) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto37(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SingleBoardInfo) UnmarshalJSON(data []byte) error {
+func (v *TransferChanges) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto37(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SingleBoardInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TransferChanges) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto37(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto38(in *jlexer.Lexer, out *SignupInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto38(in *jlexer.Lexer, out *TimerStopInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3736,8 +3733,16 @@ func easyjson56de76c1DecodeServerInternalPkgDto38(in *jlexer.Lexer, out *SignupI
 			continue
 		}
 		switch key {
-		case "email":
-			out.Email = string(in.String())
+		case "note":
+			if in.IsNull() {
+				in.Skip()
+				out.Note = nil
+			} else {
+				if out.Note == nil {
+					out.Note = new(string)
+				}
+				*out.Note = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -3748,42 +3753,51 @@ func easyjson56de76c1DecodeServerInternalPkgDto38(in *jlexer.Lexer, out *SignupI
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto38(out *jwriter.Writer, in SignupInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto38(out *jwriter.Writer, in TimerStopInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"email\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.Email))
+		const prefix string = ",\"note\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		if in.Note == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Note))
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SignupInfo) MarshalJSON() ([]byte, error) {
+func (v TimerStopInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto38(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SignupInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TimerStopInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto38(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SignupInfo) UnmarshalJSON(data []byte) error {
+func (v *TimerStopInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto38(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SignupInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TimerStopInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto38(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto39(in *jlexer.Lexer, out *SessionToken) {
+func easyjson56de76c1DecodeServerInternalPkgDto39(in *jlexer.Lexer, out *TimerInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3802,12 +3816,10 @@ func easyjson56de76c1DecodeServerInternalPkgDto39(in *jlexer.Lexer, out *Session
 			continue
 		}
 		switch key {
-		case "session_token":
-			out.ID = string(in.String())
-		case "expiration_date":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.ExpirationDate).UnmarshalJSON(data))
-			}
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -3818,47 +3830,47 @@ func easyjson56de76c1DecodeServerInternalPkgDto39(in *jlexer.Lexer, out *Session
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto39(out *jwriter.Writer, in SessionToken) {
+func easyjson56de76c1EncodeServerInternalPkgDto39(out *jwriter.Writer, in TimerInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"session_token\":"
+		const prefix string = ",\"task_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ID))
+		out.Uint64(uint64(in.TaskID))
 	}
 	{
-		const prefix string = ",\"expiration_date\":"
+		const prefix string = ",\"user_id\":"
 		out.RawString(prefix)
-		out.Raw((in.ExpirationDate).MarshalJSON())
+		out.Uint64(uint64(in.UserID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SessionToken) MarshalJSON() ([]byte, error) {
+func (v TimerInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto39(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SessionToken) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TimerInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto39(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SessionToken) UnmarshalJSON(data []byte) error {
+func (v *TimerInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto39(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SessionToken) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TimerInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto39(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto40(in *jlexer.Lexer, out *RoleInWorkspace) {
+func easyjson56de76c1DecodeServerInternalPkgDto40(in *jlexer.Lexer, out *TimeReportRow) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3877,10 +3889,38 @@ func easyjson56de76c1DecodeServerInternalPkgDto40(in *jlexer.Lexer, out *RoleInW
 			continue
 		}
 		switch key {
+		case "date":
+			out.Date = string(in.String())
 		case "user_id":
-			out.ID = uint64(in.Uint64())
+			out.UserID = uint64(in.Uint64())
+		case "email":
+			out.Email = string(in.String())
 		case "name":
-			out.Name = string(in.String())
+			if in.IsNull() {
+				in.Skip()
+				out.Name = nil
+			} else {
+				if out.Name == nil {
+					out.Name = new(string)
+				}
+				*out.Name = string(in.String())
+			}
+		case "surname":
+			if in.IsNull() {
+				in.Skip()
+				out.Surname = nil
+			} else {
+				if out.Surname == nil {
+					out.Surname = new(string)
+				}
+				*out.Surname = string(in.String())
+			}
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "task_name":
+			out.TaskName = string(in.String())
+		case "seconds":
+			out.Seconds = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -3891,47 +3931,85 @@ func easyjson56de76c1DecodeServerInternalPkgDto40(in *jlexer.Lexer, out *RoleInW
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto40(out *jwriter.Writer, in RoleInWorkspace) {
+func easyjson56de76c1EncodeServerInternalPkgDto40(out *jwriter.Writer, in TimeReportRow) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"date\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
+		out.String(string(in.Date))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.UserID))
+	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
 	}
 	{
 		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		if in.Name == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Name))
+		}
+	}
+	{
+		const prefix string = ",\"surname\":"
+		out.RawString(prefix)
+		if in.Surname == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Surname))
+		}
+	}
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TaskID))
+	}
+	{
+		const prefix string = ",\"task_name\":"
+		out.RawString(prefix)
+		out.String(string(in.TaskName))
+	}
+	{
+		const prefix string = ",\"seconds\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Seconds))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v RoleInWorkspace) MarshalJSON() ([]byte, error) {
+func (v TimeReportRow) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto40(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RoleInWorkspace) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TimeReportRow) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto40(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RoleInWorkspace) UnmarshalJSON(data []byte) error {
+func (v *TimeReportRow) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto40(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RoleInWorkspace) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TimeReportRow) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto40(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto41(in *jlexer.Lexer, out *ReplyInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto41(in *jlexer.Lexer, out *TimeReportRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3950,17 +4028,25 @@ func easyjson56de76c1DecodeServerInternalPkgDto41(in *jlexer.Lexer, out *ReplyIn
 			continue
 		}
 		switch key {
-		case "original_id":
-			out.OriginalID = uint64(in.Uint64())
-		case "id":
-			out.ID = uint64(in.Uint64())
+		case "board_id":
+			out.BoardID = uint64(in.Uint64())
 		case "user_id":
-			out.UserID = uint64(in.Uint64())
-		case "text":
-			out.Text = string(in.String())
-		case "date_created":
+			if in.IsNull() {
+				in.Skip()
+				out.UserID = nil
+			} else {
+				if out.UserID == nil {
+					out.UserID = new(uint64)
+				}
+				*out.UserID = uint64(in.Uint64())
+			}
+		case "from":
 			if data := in.Raw(); in.Ok() {
-				in.AddError((out.DateCreated).UnmarshalJSON(data))
+				in.AddError((out.From).UnmarshalJSON(data))
+			}
+		case "to":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.To).UnmarshalJSON(data))
 			}
 		default:
 			in.SkipRecursive()
@@ -3972,62 +4058,61 @@ func easyjson56de76c1DecodeServerInternalPkgDto41(in *jlexer.Lexer, out *ReplyIn
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto41(out *jwriter.Writer, in ReplyInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto41(out *jwriter.Writer, in TimeReportRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"original_id\":"
+		const prefix string = ",\"board_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.OriginalID))
-	}
-	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.ID))
+		out.Uint64(uint64(in.BoardID))
 	}
 	{
 		const prefix string = ",\"user_id\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.UserID))
+		if in.UserID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.UserID))
+		}
 	}
 	{
-		const prefix string = ",\"text\":"
+		const prefix string = ",\"from\":"
 		out.RawString(prefix)
-		out.String(string(in.Text))
+		out.Raw((in.From).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"date_created\":"
+		const prefix string = ",\"to\":"
 		out.RawString(prefix)
-		out.Raw((in.DateCreated).MarshalJSON())
+		out.Raw((in.To).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReplyInfo) MarshalJSON() ([]byte, error) {
+func (v TimeReportRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto41(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReplyInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TimeReportRequest) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto41(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReplyInfo) UnmarshalJSON(data []byte) error {
+func (v *TimeReportRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto41(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReplyInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TimeReportRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto41(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto42(in *jlexer.Lexer, out *RemoveTaskUserInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto42(in *jlexer.Lexer, out *TimeReport) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4046,10 +4131,64 @@ func easyjson56de76c1DecodeServerInternalPkgDto42(in *jlexer.Lexer, out *RemoveT
 			continue
 		}
 		switch key {
-		case "user_id":
-			out.UserID = uint64(in.Uint64())
-		case "task_id":
-			out.TaskID = uint64(in.Uint64())
+		case "board_id":
+			out.BoardID = uint64(in.Uint64())
+		case "from":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.From).UnmarshalJSON(data))
+			}
+		case "to":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.To).UnmarshalJSON(data))
+			}
+		case "rows":
+			if in.IsNull() {
+				in.Skip()
+				out.Rows = nil
+			} else {
+				in.Delim('[')
+				if out.Rows == nil {
+					if !in.IsDelim(']') {
+						out.Rows = make([]TimeReportRow, 0, 0)
+					} else {
+						out.Rows = []TimeReportRow{}
+					}
+				} else {
+					out.Rows = (out.Rows)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 TimeReportRow
+					(v31).UnmarshalEasyJSON(in)
+					out.Rows = append(out.Rows, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "total_seconds":
+			out.TotalSeconds = uint64(in.Uint64())
+		case "users":
+			if in.IsNull() {
+				in.Skip()
+				out.Users = nil
+			} else {
+				in.Delim('[')
+				if out.Users == nil {
+					if !in.IsDelim(']') {
+						out.Users = make([]UserTimeTotal, 0, 4)
+					} else {
+						out.Users = []UserTimeTotal{}
+					}
+				} else {
+					out.Users = (out.Users)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v32 UserTimeTotal
+					(v32).UnmarshalEasyJSON(in)
+					out.Users = append(out.Users, v32)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4060,47 +4199,89 @@ func easyjson56de76c1DecodeServerInternalPkgDto42(in *jlexer.Lexer, out *RemoveT
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto42(out *jwriter.Writer, in RemoveTaskUserInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto42(out *jwriter.Writer, in TimeReport) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"board_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.UserID))
+		out.Uint64(uint64(in.BoardID))
 	}
 	{
-		const prefix string = ",\"task_id\":"
+		const prefix string = ",\"from\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.TaskID))
+		out.Raw((in.From).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"to\":"
+		out.RawString(prefix)
+		out.Raw((in.To).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"rows\":"
+		out.RawString(prefix)
+		if in.Rows == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v33, v34 := range in.Rows {
+				if v33 > 0 {
+					out.RawByte(',')
+				}
+				(v34).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"total_seconds\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TotalSeconds))
+	}
+	{
+		const prefix string = ",\"users\":"
+		out.RawString(prefix)
+		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v35, v36 := range in.Users {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				(v36).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v RemoveTaskUserInfo) MarshalJSON() ([]byte, error) {
+func (v TimeReport) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto42(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RemoveTaskUserInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TimeReport) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto42(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RemoveTaskUserInfo) UnmarshalJSON(data []byte) error {
+func (v *TimeReport) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto42(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RemoveTaskUserInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TimeReport) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto42(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto43(in *jlexer.Lexer, out *RemoveFileInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto43(in *jlexer.Lexer, out *TaskWatcherInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4121,10 +4302,8 @@ func easyjson56de76c1DecodeServerInternalPkgDto43(in *jlexer.Lexer, out *RemoveF
 		switch key {
 		case "task_id":
 			out.TaskID = uint64(in.Uint64())
-		case "original_name":
-			out.OriginalName = string(in.String())
-		case "file_path":
-			out.FilePath = string(in.String())
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -4135,7 +4314,7 @@ func easyjson56de76c1DecodeServerInternalPkgDto43(in *jlexer.Lexer, out *RemoveF
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto43(out *jwriter.Writer, in RemoveFileInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto43(out *jwriter.Writer, in TaskWatcherInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4145,42 +4324,37 @@ func easyjson56de76c1EncodeServerInternalPkgDto43(out *jwriter.Writer, in Remove
 		out.Uint64(uint64(in.TaskID))
 	}
 	{
-		const prefix string = ",\"original_name\":"
-		out.RawString(prefix)
-		out.String(string(in.OriginalName))
-	}
-	{
-		const prefix string = ",\"file_path\":"
+		const prefix string = ",\"user_id\":"
 		out.RawString(prefix)
-		out.String(string(in.FilePath))
+		out.Uint64(uint64(in.UserID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v RemoveFileInfo) MarshalJSON() ([]byte, error) {
+func (v TaskWatcherInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto43(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RemoveFileInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TaskWatcherInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto43(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RemoveFileInfo) UnmarshalJSON(data []byte) error {
+func (v *TaskWatcherInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto43(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RemoveFileInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TaskWatcherInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto43(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto44(in *jlexer.Lexer, out *RemoveBoardUserInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto44(in *jlexer.Lexer, out *TaskTransferReport) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4199,12 +4373,14 @@ func easyjson56de76c1DecodeServerInternalPkgDto44(in *jlexer.Lexer, out *RemoveB
 			continue
 		}
 		switch key {
-		case "user_id":
-			out.UserID = uint64(in.Uint64())
-		case "workspace_id":
-			out.WorkspaceID = uint64(in.Uint64())
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "list_id":
+			out.ListID = uint64(in.Uint64())
 		case "board_id":
 			out.BoardID = uint64(in.Uint64())
+		case "changes":
+			(out.Changes).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -4215,52 +4391,57 @@ func easyjson56de76c1DecodeServerInternalPkgDto44(in *jlexer.Lexer, out *RemoveB
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto44(out *jwriter.Writer, in RemoveBoardUserInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto44(out *jwriter.Writer, in TaskTransferReport) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"task_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.UserID))
+		out.Uint64(uint64(in.TaskID))
 	}
 	{
-		const prefix string = ",\"workspace_id\":"
+		const prefix string = ",\"list_id\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.WorkspaceID))
+		out.Uint64(uint64(in.ListID))
 	}
 	{
 		const prefix string = ",\"board_id\":"
 		out.RawString(prefix)
 		out.Uint64(uint64(in.BoardID))
 	}
+	{
+		const prefix string = ",\"changes\":"
+		out.RawString(prefix)
+		(in.Changes).MarshalEasyJSON(out)
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v RemoveBoardUserInfo) MarshalJSON() ([]byte, error) {
+func (v TaskTransferReport) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto44(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RemoveBoardUserInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TaskTransferReport) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto44(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RemoveBoardUserInfo) UnmarshalJSON(data []byte) error {
+func (v *TaskTransferReport) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto44(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RemoveBoardUserInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TaskTransferReport) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto44(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto45(in *jlexer.Lexer, out *RatingStatsWithQuestionID) {
+func easyjson56de76c1DecodeServerInternalPkgDto45(in *jlexer.Lexer, out *TaskTransferInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4279,14 +4460,20 @@ func easyjson56de76c1DecodeServerInternalPkgDto45(in *jlexer.Lexer, out *RatingS
 			continue
 		}
 		switch key {
-		case "question_id":
-			out.QuestionID = uint64(in.Uint64())
-		case "rating":
-			out.Rating = uint64(in.Uint64())
-		case "count":
-			out.Count = uint64(in.Uint64())
-		case "average":
-			out.Average = uint64(in.Uint64())
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "list_id":
+			out.ListID = uint64(in.Uint64())
+		case "after_id":
+			if in.IsNull() {
+				in.Skip()
+				out.AfterID = nil
+			} else {
+				if out.AfterID == nil {
+					out.AfterID = new(uint64)
+				}
+				*out.AfterID = uint64(in.Uint64())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4297,57 +4484,56 @@ func easyjson56de76c1DecodeServerInternalPkgDto45(in *jlexer.Lexer, out *RatingS
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto45(out *jwriter.Writer, in RatingStatsWithQuestionID) {
+func easyjson56de76c1EncodeServerInternalPkgDto45(out *jwriter.Writer, in TaskTransferInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"question_id\":"
+		const prefix string = ",\"task_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.QuestionID))
-	}
-	{
-		const prefix string = ",\"rating\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.Rating))
+		out.Uint64(uint64(in.TaskID))
 	}
 	{
-		const prefix string = ",\"count\":"
+		const prefix string = ",\"list_id\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.Count))
+		out.Uint64(uint64(in.ListID))
 	}
 	{
-		const prefix string = ",\"average\":"
+		const prefix string = ",\"after_id\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.Average))
+		if in.AfterID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.AfterID))
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v RatingStatsWithQuestionID) MarshalJSON() ([]byte, error) {
+func (v TaskTransferInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto45(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RatingStatsWithQuestionID) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TaskTransferInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto45(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RatingStatsWithQuestionID) UnmarshalJSON(data []byte) error {
+func (v *TaskTransferInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto45(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RatingStatsWithQuestionID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TaskTransferInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto45(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto46(in *jlexer.Lexer, out *RatingStats) {
+func easyjson56de76c1DecodeServerInternalPkgDto46(in *jlexer.Lexer, out *TaskTimeInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4366,12 +4552,56 @@ func easyjson56de76c1DecodeServerInternalPkgDto46(in *jlexer.Lexer, out *RatingS
 			continue
 		}
 		switch key {
-		case "rating":
-			out.Rating = uint64(in.Uint64())
-		case "count":
-			out.Count = uint64(in.Uint64())
-		case "average":
-			out.Average = uint64(in.Uint64())
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "entries":
+			if in.IsNull() {
+				in.Skip()
+				out.Entries = nil
+			} else {
+				in.Delim('[')
+				if out.Entries == nil {
+					if !in.IsDelim(']') {
+						out.Entries = make([]WorklogInfo, 0, 0)
+					} else {
+						out.Entries = []WorklogInfo{}
+					}
+				} else {
+					out.Entries = (out.Entries)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v37 WorklogInfo
+					(v37).UnmarshalEasyJSON(in)
+					out.Entries = append(out.Entries, v37)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "total_seconds":
+			out.TotalSeconds = uint64(in.Uint64())
+		case "users":
+			if in.IsNull() {
+				in.Skip()
+				out.Users = nil
+			} else {
+				in.Delim('[')
+				if out.Users == nil {
+					if !in.IsDelim(']') {
+						out.Users = make([]UserTimeTotal, 0, 4)
+					} else {
+						out.Users = []UserTimeTotal{}
+					}
+				} else {
+					out.Users = (out.Users)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v38 UserTimeTotal
+					(v38).UnmarshalEasyJSON(in)
+					out.Users = append(out.Users, v38)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4382,52 +4612,79 @@ func easyjson56de76c1DecodeServerInternalPkgDto46(in *jlexer.Lexer, out *RatingS
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto46(out *jwriter.Writer, in RatingStats) {
+func easyjson56de76c1EncodeServerInternalPkgDto46(out *jwriter.Writer, in TaskTimeInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"rating\":"
+		const prefix string = ",\"task_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.Rating))
+		out.Uint64(uint64(in.TaskID))
 	}
 	{
-		const prefix string = ",\"count\":"
+		const prefix string = ",\"entries\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.Count))
+		if in.Entries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v39, v40 := range in.Entries {
+				if v39 > 0 {
+					out.RawByte(',')
+				}
+				(v40).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"average\":"
+		const prefix string = ",\"total_seconds\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.Average))
+		out.Uint64(uint64(in.TotalSeconds))
+	}
+	{
+		const prefix string = ",\"users\":"
+		out.RawString(prefix)
+		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v41, v42 := range in.Users {
+				if v41 > 0 {
+					out.RawByte(',')
+				}
+				(v42).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v RatingStats) MarshalJSON() ([]byte, error) {
+func (v TaskTimeInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto46(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RatingStats) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TaskTimeInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto46(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RatingStats) UnmarshalJSON(data []byte) error {
+func (v *TaskTimeInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto46(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RatingStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TaskTimeInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto46(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto47(in *jlexer.Lexer, out *QuestionWithStats) {
+func easyjson56de76c1DecodeServerInternalPkgDto47(in *jlexer.Lexer, out *TaskRemindersInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4446,31 +4703,27 @@ func easyjson56de76c1DecodeServerInternalPkgDto47(in *jlexer.Lexer, out *Questio
 			continue
 		}
 		switch key {
-		case "question_id":
-			out.ID = uint64(in.Uint64())
-		case "content":
-			out.Content = string(in.String())
-		case "type":
-			out.Type = string(in.String())
-		case "stats":
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "offsets":
 			if in.IsNull() {
 				in.Skip()
-				out.Stats = nil
+				out.Offsets = nil
 			} else {
 				in.Delim('[')
-				if out.Stats == nil {
+				if out.Offsets == nil {
 					if !in.IsDelim(']') {
-						out.Stats = make([]RatingStats, 0, 2)
+						out.Offsets = make([]uint64, 0, 8)
 					} else {
-						out.Stats = []RatingStats{}
+						out.Offsets = []uint64{}
 					}
 				} else {
-					out.Stats = (out.Stats)[:0]
+					out.Offsets = (out.Offsets)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v34 RatingStats
-					(v34).UnmarshalEasyJSON(in)
-					out.Stats = append(out.Stats, v34)
+					var v43 uint64
+					v43 = uint64(in.Uint64())
+					out.Offsets = append(out.Offsets, v43)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4485,37 +4738,27 @@ func easyjson56de76c1DecodeServerInternalPkgDto47(in *jlexer.Lexer, out *Questio
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto47(out *jwriter.Writer, in QuestionWithStats) {
+func easyjson56de76c1EncodeServerInternalPkgDto47(out *jwriter.Writer, in TaskRemindersInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"question_id\":"
+		const prefix string = ",\"task_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
-	}
-	{
-		const prefix string = ",\"content\":"
-		out.RawString(prefix)
-		out.String(string(in.Content))
-	}
-	{
-		const prefix string = ",\"type\":"
-		out.RawString(prefix)
-		out.String(string(in.Type))
+		out.Uint64(uint64(in.TaskID))
 	}
 	{
-		const prefix string = ",\"stats\":"
+		const prefix string = ",\"offsets\":"
 		out.RawString(prefix)
-		if in.Stats == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Offsets == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v35, v36 := range in.Stats {
-				if v35 > 0 {
+			for v44, v45 := range in.Offsets {
+				if v44 > 0 {
 					out.RawByte(',')
 				}
-				(v36).MarshalEasyJSON(out)
+				out.Uint64(uint64(v45))
 			}
 			out.RawByte(']')
 		}
@@ -4524,29 +4767,29 @@ func easyjson56de76c1EncodeServerInternalPkgDto47(out *jwriter.Writer, in Questi
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v QuestionWithStats) MarshalJSON() ([]byte, error) {
+func (v TaskRemindersInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto47(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v QuestionWithStats) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TaskRemindersInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto47(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QuestionWithStats) UnmarshalJSON(data []byte) error {
+func (v *TaskRemindersInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto47(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *QuestionWithStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TaskRemindersInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto47(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto48(in *jlexer.Lexer, out *PasswordHashesInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto48(in *jlexer.Lexer, out *TaskRecurrenceRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4565,10 +4808,10 @@ func easyjson56de76c1DecodeServerInternalPkgDto48(in *jlexer.Lexer, out *Passwor
 			continue
 		}
 		switch key {
-		case "user_id":
-			out.UserID = uint64(in.Uint64())
-		case "NewPasswordHash":
-			out.NewPasswordHash = string(in.String())
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "rule":
+			out.Rule = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -4579,47 +4822,47 @@ func easyjson56de76c1DecodeServerInternalPkgDto48(in *jlexer.Lexer, out *Passwor
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto48(out *jwriter.Writer, in PasswordHashesInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto48(out *jwriter.Writer, in TaskRecurrenceRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"task_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.UserID))
+		out.Uint64(uint64(in.TaskID))
 	}
 	{
-		const prefix string = ",\"NewPasswordHash\":"
+		const prefix string = ",\"rule\":"
 		out.RawString(prefix)
-		out.String(string(in.NewPasswordHash))
+		out.String(string(in.Rule))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PasswordHashesInfo) MarshalJSON() ([]byte, error) {
+func (v TaskRecurrenceRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto48(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PasswordHashesInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TaskRecurrenceRequest) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto48(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PasswordHashesInfo) UnmarshalJSON(data []byte) error {
+func (v *TaskRecurrenceRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto48(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PasswordHashesInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TaskRecurrenceRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto48(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto49(in *jlexer.Lexer, out *PasswordChangeInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto49(in *jlexer.Lexer, out *TaskRecurrenceInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4638,10 +4881,20 @@ func easyjson56de76c1DecodeServerInternalPkgDto49(in *jlexer.Lexer, out *Passwor
 			continue
 		}
 		switch key {
-		case "old_password":
-			out.OldPassword = string(in.String())
-		case "new_password":
-			out.NewPassword = string(in.String())
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "list_id":
+			out.ListID = uint64(in.Uint64())
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
+		case "rule":
+			out.Rule = string(in.String())
+		case "timezone":
+			out.Timezone = string(in.String())
+		case "due_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DueAt).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4652,52 +4905,67 @@ func easyjson56de76c1DecodeServerInternalPkgDto49(in *jlexer.Lexer, out *Passwor
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto49(out *jwriter.Writer, in PasswordChangeInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto49(out *jwriter.Writer, in TaskRecurrenceInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"old_password\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.OldPassword))
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.TaskID))
 	}
 	{
-		const prefix string = ",\"new_password\":"
+		const prefix string = ",\"list_id\":"
 		out.RawString(prefix)
-		out.String(string(in.NewPassword))
+		out.Uint64(uint64(in.ListID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.UserID))
+	}
+	{
+		const prefix string = ",\"rule\":"
+		out.RawString(prefix)
+		out.String(string(in.Rule))
+	}
+	{
+		const prefix string = ",\"timezone\":"
+		out.RawString(prefix)
+		out.String(string(in.Timezone))
+	}
+	{
+		const prefix string = ",\"due_at\":"
+		out.RawString(prefix)
+		out.Raw((in.DueAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PasswordChangeInfo) MarshalJSON() ([]byte, error) {
+func (v TaskRecurrenceInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto49(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PasswordChangeInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TaskRecurrenceInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto49(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PasswordChangeInfo) UnmarshalJSON(data []byte) error {
+func (v *TaskRecurrenceInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto49(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PasswordChangeInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TaskRecurrenceInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto49(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto50(in *jlexer.Lexer, out *NewWorkspaceInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto50(in *jlexer.Lexer, out *TaskMoveInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4716,12 +4984,20 @@ func easyjson56de76c1DecodeServerInternalPkgDto50(in *jlexer.Lexer, out *NewWork
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "owner_id":
-			out.OwnerID = uint64(in.Uint64())
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "list_id":
+			out.ListID = uint64(in.Uint64())
+		case "after_id":
+			if in.IsNull() {
+				in.Skip()
+				out.AfterID = nil
+			} else {
+				if out.AfterID == nil {
+					out.AfterID = new(uint64)
+				}
+				*out.AfterID = uint64(in.Uint64())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4732,52 +5008,56 @@ func easyjson56de76c1DecodeServerInternalPkgDto50(in *jlexer.Lexer, out *NewWork
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto50(out *jwriter.Writer, in NewWorkspaceInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto50(out *jwriter.Writer, in TaskMoveInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"task_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
+		out.Uint64(uint64(in.TaskID))
 	}
 	{
-		const prefix string = ",\"description\":"
+		const prefix string = ",\"list_id\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		out.Uint64(uint64(in.ListID))
 	}
 	{
-		const prefix string = ",\"owner_id\":"
+		const prefix string = ",\"after_id\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.OwnerID))
+		if in.AfterID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.AfterID))
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NewWorkspaceInfo) MarshalJSON() ([]byte, error) {
+func (v TaskMoveInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto50(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NewWorkspaceInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TaskMoveInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto50(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NewWorkspaceInfo) UnmarshalJSON(data []byte) error {
+func (v *TaskMoveInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto50(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NewWorkspaceInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TaskMoveInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto50(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto51(in *jlexer.Lexer, out *NewTaskInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto51(in *jlexer.Lexer, out *TaskIDs) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4796,12 +5076,29 @@ func easyjson56de76c1DecodeServerInternalPkgDto51(in *jlexer.Lexer, out *NewTask
 			continue
 		}
 		switch key {
-		case "list_id":
-			out.ListID = uint64(in.Uint64())
-		case "name":
-			out.Name = string(in.String())
-		case "list_position":
-			out.ListPosition = uint64(in.Uint64())
+		case "ids":
+			if in.IsNull() {
+				in.Skip()
+				out.Values = nil
+			} else {
+				in.Delim('[')
+				if out.Values == nil {
+					if !in.IsDelim(']') {
+						out.Values = make([]string, 0, 4)
+					} else {
+						out.Values = []string{}
+					}
+				} else {
+					out.Values = (out.Values)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v46 string
+					v46 = string(in.String())
+					out.Values = append(out.Values, v46)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4812,52 +5109,53 @@ func easyjson56de76c1DecodeServerInternalPkgDto51(in *jlexer.Lexer, out *NewTask
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto51(out *jwriter.Writer, in NewTaskInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto51(out *jwriter.Writer, in TaskIDs) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"list_id\":"
+		const prefix string = ",\"ids\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ListID))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"list_position\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.ListPosition))
+		if in.Values == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v47, v48 := range in.Values {
+				if v47 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v48))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NewTaskInfo) MarshalJSON() ([]byte, error) {
+func (v TaskIDs) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto51(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NewTaskInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TaskIDs) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto51(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NewTaskInfo) UnmarshalJSON(data []byte) error {
+func (v *TaskIDs) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto51(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NewTaskInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TaskIDs) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto51(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto52(in *jlexer.Lexer, out *NewTagInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto52(in *jlexer.Lexer, out *TaskID) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4876,14 +5174,8 @@ func easyjson56de76c1DecodeServerInternalPkgDto52(in *jlexer.Lexer, out *NewTagI
 			continue
 		}
 		switch key {
-		case "task_id":
-			out.TaskID = uint64(in.Uint64())
-		case "board_id":
-			out.BoardID = uint64(in.Uint64())
-		case "name":
-			out.Name = string(in.String())
-		case "color":
-			out.Color = string(in.String())
+		case "id":
+			out.Value = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -4894,57 +5186,42 @@ func easyjson56de76c1DecodeServerInternalPkgDto52(in *jlexer.Lexer, out *NewTagI
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto52(out *jwriter.Writer, in NewTagInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto52(out *jwriter.Writer, in TaskID) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"task_id\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.TaskID))
-	}
-	{
-		const prefix string = ",\"board_id\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.BoardID))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"color\":"
-		out.RawString(prefix)
-		out.String(string(in.Color))
+		out.Uint64(uint64(in.Value))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NewTagInfo) MarshalJSON() ([]byte, error) {
+func (v TaskID) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto52(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NewTagInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TaskID) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto52(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NewTagInfo) UnmarshalJSON(data []byte) error {
+func (v *TaskID) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto52(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NewTagInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TaskID) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto52(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto53(in *jlexer.Lexer, out *NewListInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto53(in *jlexer.Lexer, out *TaskDependencyInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4963,22 +5240,10 @@ func easyjson56de76c1DecodeServerInternalPkgDto53(in *jlexer.Lexer, out *NewList
 			continue
 		}
 		switch key {
-		case "board_id":
-			out.BoardID = uint64(in.Uint64())
-		case "name":
-			out.Name = string(in.String())
-		case "description":
-			if in.IsNull() {
-				in.Skip()
-				out.Description = nil
-			} else {
-				if out.Description == nil {
-					out.Description = new(string)
-				}
-				*out.Description = string(in.String())
-			}
-		case "list_position":
-			out.ListPosition = uint64(in.Uint64())
+		case "blocker_id":
+			out.BlockerID = uint64(in.Uint64())
+		case "blocked_id":
+			out.BlockedID = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -4989,61 +5254,47 @@ func easyjson56de76c1DecodeServerInternalPkgDto53(in *jlexer.Lexer, out *NewList
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto53(out *jwriter.Writer, in NewListInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto53(out *jwriter.Writer, in TaskDependencyInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"board_id\":"
+		const prefix string = ",\"blocker_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.BoardID))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		if in.Description == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Description))
-		}
+		out.Uint64(uint64(in.BlockerID))
 	}
 	{
-		const prefix string = ",\"list_position\":"
+		const prefix string = ",\"blocked_id\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.ListPosition))
+		out.Uint64(uint64(in.BlockedID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NewListInfo) MarshalJSON() ([]byte, error) {
+func (v TaskDependencyInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto53(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NewListInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TaskDependencyInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto53(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NewListInfo) UnmarshalJSON(data []byte) error {
+func (v *TaskDependencyInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto53(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NewListInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TaskDependencyInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto53(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto54(in *jlexer.Lexer, out *NewHistoryEntry) {
+func easyjson56de76c1DecodeServerInternalPkgDto54(in *jlexer.Lexer, out *TaskCopyParts) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5062,10 +5313,20 @@ func easyjson56de76c1DecodeServerInternalPkgDto54(in *jlexer.Lexer, out *NewHist
 			continue
 		}
 		switch key {
-		case "board_id":
-			out.BoardID = uint64(in.Uint64())
-		case "actions":
-			out.Actions = string(in.String())
+		case "description":
+			out.Description = bool(in.Bool())
+		case "checklists":
+			out.Checklists = bool(in.Bool())
+		case "tags":
+			out.Tags = bool(in.Bool())
+		case "assignees":
+			out.Assignees = bool(in.Bool())
+		case "dates":
+			out.Dates = bool(in.Bool())
+		case "attachments":
+			out.Attachments = bool(in.Bool())
+		case "comments":
+			out.Comments = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -5076,52 +5337,72 @@ func easyjson56de76c1DecodeServerInternalPkgDto54(in *jlexer.Lexer, out *NewHist
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto54(out *jwriter.Writer, in NewHistoryEntry) {
+func easyjson56de76c1EncodeServerInternalPkgDto54(out *jwriter.Writer, in TaskCopyParts) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"board_id\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.Uint64(uint64(in.BoardID))
+		const prefix string = ",\"description\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Description))
 	}
 	{
-		const prefix string = ",\"actions\":"
+		const prefix string = ",\"checklists\":"
 		out.RawString(prefix)
-		out.String(string(in.Actions))
+		out.Bool(bool(in.Checklists))
+	}
+	{
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Tags))
+	}
+	{
+		const prefix string = ",\"assignees\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Assignees))
+	}
+	{
+		const prefix string = ",\"dates\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Dates))
+	}
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Attachments))
+	}
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Comments))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NewHistoryEntry) MarshalJSON() ([]byte, error) {
+func (v TaskCopyParts) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto54(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NewHistoryEntry) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TaskCopyParts) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto54(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NewHistoryEntry) UnmarshalJSON(data []byte) error {
+func (v *TaskCopyParts) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto54(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NewHistoryEntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TaskCopyParts) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto54(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto55(in *jlexer.Lexer, out *NewCommentInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto55(in *jlexer.Lexer, out *TaskCopyInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5140,12 +5421,22 @@ func easyjson56de76c1DecodeServerInternalPkgDto55(in *jlexer.Lexer, out *NewComm
 			continue
 		}
 		switch key {
-		case "user_id":
-			out.UserID = uint64(in.Uint64())
 		case "task_id":
 			out.TaskID = uint64(in.Uint64())
-		case "text":
-			out.Text = string(in.String())
+		case "list_id":
+			out.ListID = uint64(in.Uint64())
+		case "after_id":
+			if in.IsNull() {
+				in.Skip()
+				out.AfterID = nil
+			} else {
+				if out.AfterID == nil {
+					out.AfterID = new(uint64)
+				}
+				*out.AfterID = uint64(in.Uint64())
+			}
+		case "parts":
+			(out.Parts).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -5156,52 +5447,61 @@ func easyjson56de76c1DecodeServerInternalPkgDto55(in *jlexer.Lexer, out *NewComm
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto55(out *jwriter.Writer, in NewCommentInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto55(out *jwriter.Writer, in TaskCopyInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"task_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.UserID))
+		out.Uint64(uint64(in.TaskID))
 	}
 	{
-		const prefix string = ",\"task_id\":"
+		const prefix string = ",\"list_id\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.TaskID))
+		out.Uint64(uint64(in.ListID))
 	}
 	{
-		const prefix string = ",\"text\":"
+		const prefix string = ",\"after_id\":"
 		out.RawString(prefix)
-		out.String(string(in.Text))
+		if in.AfterID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.AfterID))
+		}
+	}
+	{
+		const prefix string = ",\"parts\":"
+		out.RawString(prefix)
+		(in.Parts).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NewCommentInfo) MarshalJSON() ([]byte, error) {
+func (v TaskCopyInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto55(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NewCommentInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TaskCopyInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto55(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NewCommentInfo) UnmarshalJSON(data []byte) error {
+func (v *TaskCopyInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto55(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NewCommentInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TaskCopyInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto55(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto56(in *jlexer.Lexer, out *NewChecklistItemInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto56(in *jlexer.Lexer, out *TaskCascadeInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5220,14 +5520,10 @@ func easyjson56de76c1DecodeServerInternalPkgDto56(in *jlexer.Lexer, out *NewChec
 			continue
 		}
 		switch key {
-		case "checklist_id":
-			out.ChecklistID = uint64(in.Uint64())
-		case "name":
-			out.Name = string(in.String())
-		case "done":
-			out.Done = bool(in.Bool())
-		case "list_position":
-			out.ListPosition = uint64(in.Uint64())
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "cascade":
+			out.Cascade = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -5238,57 +5534,47 @@ func easyjson56de76c1DecodeServerInternalPkgDto56(in *jlexer.Lexer, out *NewChec
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto56(out *jwriter.Writer, in NewChecklistItemInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto56(out *jwriter.Writer, in TaskCascadeInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"checklist_id\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ChecklistID))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"done\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Done))
+		out.Uint64(uint64(in.ID))
 	}
 	{
-		const prefix string = ",\"list_position\":"
+		const prefix string = ",\"cascade\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.ListPosition))
+		out.Bool(bool(in.Cascade))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NewChecklistItemInfo) MarshalJSON() ([]byte, error) {
+func (v TaskCascadeInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto56(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NewChecklistItemInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TaskCascadeInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto56(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NewChecklistItemInfo) UnmarshalJSON(data []byte) error {
+func (v *TaskCascadeInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto56(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NewChecklistItemInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TaskCascadeInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto56(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto57(in *jlexer.Lexer, out *NewChecklistInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto57(in *jlexer.Lexer, out *TaskBulkRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5307,68 +5593,171 @@ func easyjson56de76c1DecodeServerInternalPkgDto57(in *jlexer.Lexer, out *NewChec
 			continue
 		}
 		switch key {
-		case "task_id":
-			out.TaskID = uint64(in.Uint64())
-		case "name":
-			out.Name = string(in.String())
-		case "list_position":
-			out.ListPosition = uint64(in.Uint64())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
+		case "task_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.TaskIDs = nil
+			} else {
+				in.Delim('[')
+				if out.TaskIDs == nil {
+					if !in.IsDelim(']') {
+						out.TaskIDs = make([]uint64, 0, 8)
+					} else {
+						out.TaskIDs = []uint64{}
+					}
+				} else {
+					out.TaskIDs = (out.TaskIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v49 uint64
+					v49 = uint64(in.Uint64())
+					out.TaskIDs = append(out.TaskIDs, v49)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "operation":
+			out.Operation = string(in.String())
+		case "list_id":
+			if in.IsNull() {
+				in.Skip()
+				out.ListID = nil
+			} else {
+				if out.ListID == nil {
+					out.ListID = new(uint64)
+				}
+				*out.ListID = uint64(in.Uint64())
+			}
+		case "user_id":
+			if in.IsNull() {
+				in.Skip()
+				out.UserID = nil
+			} else {
+				if out.UserID == nil {
+					out.UserID = new(uint64)
+				}
+				*out.UserID = uint64(in.Uint64())
+			}
+		case "tag_id":
+			if in.IsNull() {
+				in.Skip()
+				out.TagID = nil
+			} else {
+				if out.TagID == nil {
+					out.TagID = new(uint64)
+				}
+				*out.TagID = uint64(in.Uint64())
+			}
+		case "due":
+			if in.IsNull() {
+				in.Skip()
+				out.Due = nil
+			} else {
+				if out.Due == nil {
+					out.Due = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.Due).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
 	in.Delim('}')
 	if isTopLevel {
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto57(out *jwriter.Writer, in NewChecklistInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto57(out *jwriter.Writer, in TaskBulkRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"task_id\":"
+		const prefix string = ",\"task_ids\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.TaskID))
+		if in.TaskIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v50, v51 := range in.TaskIDs {
+				if v50 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v51))
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"operation\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		out.String(string(in.Operation))
 	}
 	{
-		const prefix string = ",\"list_position\":"
+		const prefix string = ",\"list_id\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.ListPosition))
+		if in.ListID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.ListID))
+		}
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		if in.UserID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.UserID))
+		}
+	}
+	{
+		const prefix string = ",\"tag_id\":"
+		out.RawString(prefix)
+		if in.TagID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.TagID))
+		}
+	}
+	{
+		const prefix string = ",\"due\":"
+		out.RawString(prefix)
+		if in.Due == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.Due).MarshalJSON())
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NewChecklistInfo) MarshalJSON() ([]byte, error) {
+func (v TaskBulkRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto57(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NewChecklistInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TaskBulkRequest) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto57(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NewChecklistInfo) UnmarshalJSON(data []byte) error {
+func (v *TaskBulkRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto57(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NewChecklistInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TaskBulkRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto57(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto58(in *jlexer.Lexer, out *NewCSATQuestionInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto58(in *jlexer.Lexer, out *TaskActivityRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5387,10 +5776,12 @@ func easyjson56de76c1DecodeServerInternalPkgDto58(in *jlexer.Lexer, out *NewCSAT
 			continue
 		}
 		switch key {
-		case "content":
-			out.Content = string(in.String())
-		case "type":
-			out.Type = string(in.String())
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "limit":
+			out.Limit = uint64(in.Uint64())
+		case "offset":
+			out.Offset = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -5401,47 +5792,52 @@ func easyjson56de76c1DecodeServerInternalPkgDto58(in *jlexer.Lexer, out *NewCSAT
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto58(out *jwriter.Writer, in NewCSATQuestionInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto58(out *jwriter.Writer, in TaskActivityRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"content\":"
+		const prefix string = ",\"task_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Content))
+		out.Uint64(uint64(in.TaskID))
 	}
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"limit\":"
 		out.RawString(prefix)
-		out.String(string(in.Type))
+		out.Uint64(uint64(in.Limit))
+	}
+	{
+		const prefix string = ",\"offset\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Offset))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NewCSATQuestionInfo) MarshalJSON() ([]byte, error) {
+func (v TaskActivityRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto58(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NewCSATQuestionInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TaskActivityRequest) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto58(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NewCSATQuestionInfo) UnmarshalJSON(data []byte) error {
+func (v *TaskActivityRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto58(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NewCSATQuestionInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TaskActivityRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto58(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto59(in *jlexer.Lexer, out *NewCSATQuestion) {
+func easyjson56de76c1DecodeServerInternalPkgDto59(in *jlexer.Lexer, out *TaskActivityItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5460,10 +5856,57 @@ func easyjson56de76c1DecodeServerInternalPkgDto59(in *jlexer.Lexer, out *NewCSAT
 			continue
 		}
 		switch key {
-		case "content":
-			out.Content = string(in.String())
 		case "type":
-			out.TypeID = uint64(in.Uint64())
+			out.Type = string(in.String())
+		case "timestamp":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Timestamp).UnmarshalJSON(data))
+			}
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
+		case "history_id":
+			if in.IsNull() {
+				in.Skip()
+				out.HistoryID = nil
+			} else {
+				if out.HistoryID == nil {
+					out.HistoryID = new(uint64)
+				}
+				*out.HistoryID = uint64(in.Uint64())
+			}
+		case "changes":
+			if in.IsNull() {
+				in.Skip()
+				out.Changes = nil
+			} else {
+				in.Delim('[')
+				if out.Changes == nil {
+					if !in.IsDelim(']') {
+						out.Changes = make([]HistoryFieldChange, 0, 1)
+					} else {
+						out.Changes = []HistoryFieldChange{}
+					}
+				} else {
+					out.Changes = (out.Changes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v52 HistoryFieldChange
+					(v52).UnmarshalEasyJSON(in)
+					out.Changes = append(out.Changes, v52)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "comment":
+			if in.IsNull() {
+				in.Skip()
+				out.Comment = nil
+			} else {
+				if out.Comment == nil {
+					out.Comment = new(CommentInfo)
+				}
+				(*out.Comment).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -5474,47 +5917,86 @@ func easyjson56de76c1DecodeServerInternalPkgDto59(in *jlexer.Lexer, out *NewCSAT
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto59(out *jwriter.Writer, in NewCSATQuestion) {
+func easyjson56de76c1EncodeServerInternalPkgDto59(out *jwriter.Writer, in TaskActivityItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"content\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Content))
+		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"timestamp\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.TypeID))
+		out.Raw((in.Timestamp).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.UserID))
+	}
+	{
+		const prefix string = ",\"history_id\":"
+		out.RawString(prefix)
+		if in.HistoryID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.HistoryID))
+		}
+	}
+	{
+		const prefix string = ",\"changes\":"
+		out.RawString(prefix)
+		if in.Changes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v53, v54 := range in.Changes {
+				if v53 > 0 {
+					out.RawByte(',')
+				}
+				(v54).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"comment\":"
+		out.RawString(prefix)
+		if in.Comment == nil {
+			out.RawString("null")
+		} else {
+			(*in.Comment).MarshalEasyJSON(out)
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NewCSATQuestion) MarshalJSON() ([]byte, error) {
+func (v TaskActivityItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto59(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NewCSATQuestion) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TaskActivityItem) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto59(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NewCSATQuestion) UnmarshalJSON(data []byte) error {
+func (v *TaskActivityItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto59(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NewCSATQuestion) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TaskActivityItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto59(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto60(in *jlexer.Lexer, out *NewCSATAnswerInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto60(in *jlexer.Lexer, out *TaskActivity) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5533,12 +6015,33 @@ func easyjson56de76c1DecodeServerInternalPkgDto60(in *jlexer.Lexer, out *NewCSAT
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = uint64(in.Uint64())
-		case "id_question":
-			out.QuestionID = uint64(in.Uint64())
-		case "rating":
-			out.Rating = uint64(in.Uint64())
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]TaskActivityItem, 0, 0)
+					} else {
+						out.Items = []TaskActivityItem{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v55 TaskActivityItem
+					(v55).UnmarshalEasyJSON(in)
+					out.Items = append(out.Items, v55)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "total":
+			out.Total = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -5549,52 +6052,63 @@ func easyjson56de76c1DecodeServerInternalPkgDto60(in *jlexer.Lexer, out *NewCSAT
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto60(out *jwriter.Writer, in NewCSATAnswerInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto60(out *jwriter.Writer, in TaskActivity) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"task_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
+		out.Uint64(uint64(in.TaskID))
 	}
 	{
-		const prefix string = ",\"id_question\":"
+		const prefix string = ",\"items\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.QuestionID))
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v56, v57 := range in.Items {
+				if v56 > 0 {
+					out.RawByte(',')
+				}
+				(v57).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"rating\":"
+		const prefix string = ",\"total\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.Rating))
+		out.Uint64(uint64(in.Total))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NewCSATAnswerInfo) MarshalJSON() ([]byte, error) {
+func (v TaskActivity) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto60(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NewCSATAnswerInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TaskActivity) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto60(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NewCSATAnswerInfo) UnmarshalJSON(data []byte) error {
+func (v *TaskActivity) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto60(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NewCSATAnswerInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TaskActivity) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto60(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto61(in *jlexer.Lexer, out *NewCSATAnswer) {
+func easyjson56de76c1DecodeServerInternalPkgDto61(in *jlexer.Lexer, out *TagTaskCount) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5613,12 +6127,14 @@ func easyjson56de76c1DecodeServerInternalPkgDto61(in *jlexer.Lexer, out *NewCSAT
 			continue
 		}
 		switch key {
-		case "UserID":
-			out.UserID = uint64(in.Uint64())
-		case "QuestionID":
-			out.QuestionID = uint64(in.Uint64())
-		case "Rating":
-			out.Rating = uint64(in.Uint64())
+		case "tag_id":
+			out.TagID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "color":
+			out.Color = string(in.String())
+		case "task_count":
+			out.TaskCount = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -5629,52 +6145,57 @@ func easyjson56de76c1DecodeServerInternalPkgDto61(in *jlexer.Lexer, out *NewCSAT
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto61(out *jwriter.Writer, in NewCSATAnswer) {
+func easyjson56de76c1EncodeServerInternalPkgDto61(out *jwriter.Writer, in TagTaskCount) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"UserID\":"
+		const prefix string = ",\"tag_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.UserID))
+		out.Uint64(uint64(in.TagID))
 	}
 	{
-		const prefix string = ",\"QuestionID\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.QuestionID))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"Rating\":"
+		const prefix string = ",\"color\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.Rating))
+		out.String(string(in.Color))
+	}
+	{
+		const prefix string = ",\"task_count\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TaskCount))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NewCSATAnswer) MarshalJSON() ([]byte, error) {
+func (v TagTaskCount) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto61(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NewCSATAnswer) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TagTaskCount) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto61(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NewCSATAnswer) UnmarshalJSON(data []byte) error {
+func (v *TagTaskCount) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto61(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NewCSATAnswer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TagTaskCount) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto61(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto62(in *jlexer.Lexer, out *LoginInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto62(in *jlexer.Lexer, out *TagInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5693,10 +6214,12 @@ func easyjson56de76c1DecodeServerInternalPkgDto62(in *jlexer.Lexer, out *LoginIn
 			continue
 		}
 		switch key {
-		case "email":
-			out.Email = string(in.String())
-		case "password_hash":
-			out.PasswordHash = string(in.String())
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "color":
+			out.Color = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -5707,47 +6230,52 @@ func easyjson56de76c1DecodeServerInternalPkgDto62(in *jlexer.Lexer, out *LoginIn
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto62(out *jwriter.Writer, in LoginInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto62(out *jwriter.Writer, in TagInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"email\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Email))
+		out.Uint64(uint64(in.ID))
 	}
 	{
-		const prefix string = ",\"password_hash\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.String(string(in.PasswordHash))
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"color\":"
+		out.RawString(prefix)
+		out.String(string(in.Color))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v LoginInfo) MarshalJSON() ([]byte, error) {
+func (v TagInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto62(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v LoginInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TagInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto62(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *LoginInfo) UnmarshalJSON(data []byte) error {
+func (v *TagInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto62(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *LoginInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TagInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto62(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto63(in *jlexer.Lexer, out *ListIDs) {
+func easyjson56de76c1DecodeServerInternalPkgDto63(in *jlexer.Lexer, out *TagID) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5766,29 +6294,8 @@ func easyjson56de76c1DecodeServerInternalPkgDto63(in *jlexer.Lexer, out *ListIDs
 			continue
 		}
 		switch key {
-		case "ids":
-			if in.IsNull() {
-				in.Skip()
-				out.Values = nil
-			} else {
-				in.Delim('[')
-				if out.Values == nil {
-					if !in.IsDelim(']') {
-						out.Values = make([]uint64, 0, 8)
-					} else {
-						out.Values = []uint64{}
-					}
-				} else {
-					out.Values = (out.Values)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v37 uint64
-					v37 = uint64(in.Uint64())
-					out.Values = append(out.Values, v37)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "tag_id":
+			out.Value = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -5799,53 +6306,42 @@ func easyjson56de76c1DecodeServerInternalPkgDto63(in *jlexer.Lexer, out *ListIDs
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto63(out *jwriter.Writer, in ListIDs) {
+func easyjson56de76c1EncodeServerInternalPkgDto63(out *jwriter.Writer, in TagID) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"ids\":"
+		const prefix string = ",\"tag_id\":"
 		out.RawString(prefix[1:])
-		if in.Values == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v38, v39 := range in.Values {
-				if v38 > 0 {
-					out.RawByte(',')
-				}
-				out.Uint64(uint64(v39))
-			}
-			out.RawByte(']')
-		}
+		out.Uint64(uint64(in.Value))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ListIDs) MarshalJSON() ([]byte, error) {
+func (v TagID) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto63(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ListIDs) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TagID) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto63(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ListIDs) UnmarshalJSON(data []byte) error {
+func (v *TagID) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto63(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ListIDs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TagID) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto63(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto64(in *jlexer.Lexer, out *ListID) {
+func easyjson56de76c1DecodeServerInternalPkgDto64(in *jlexer.Lexer, out *TagAndTaskIDs) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5864,8 +6360,10 @@ func easyjson56de76c1DecodeServerInternalPkgDto64(in *jlexer.Lexer, out *ListID)
 			continue
 		}
 		switch key {
-		case "id":
-			out.Value = uint64(in.Uint64())
+		case "tag_id":
+			out.TagID = uint64(in.Uint64())
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -5876,42 +6374,47 @@ func easyjson56de76c1DecodeServerInternalPkgDto64(in *jlexer.Lexer, out *ListID)
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto64(out *jwriter.Writer, in ListID) {
+func easyjson56de76c1EncodeServerInternalPkgDto64(out *jwriter.Writer, in TagAndTaskIDs) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"tag_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.Value))
+		out.Uint64(uint64(in.TagID))
+	}
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TaskID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ListID) MarshalJSON() ([]byte, error) {
+func (v TagAndTaskIDs) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto64(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ListID) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TagAndTaskIDs) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto64(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ListID) UnmarshalJSON(data []byte) error {
+func (v *TagAndTaskIDs) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto64(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ListID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TagAndTaskIDs) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto64(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto65(in *jlexer.Lexer, out *JSONResponse) {
+func easyjson56de76c1DecodeServerInternalPkgDto65(in *jlexer.Lexer, out *SubtaskInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5930,14 +6433,10 @@ func easyjson56de76c1DecodeServerInternalPkgDto65(in *jlexer.Lexer, out *JSONRes
 			continue
 		}
 		switch key {
-		case "body":
-			if m, ok := out.Body.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Body.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
-			} else {
-				out.Body = in.Interface()
-			}
+		case "parent_id":
+			out.ParentID = uint64(in.Uint64())
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -5948,121 +6447,47 @@ func easyjson56de76c1DecodeServerInternalPkgDto65(in *jlexer.Lexer, out *JSONRes
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto65(out *jwriter.Writer, in JSONResponse) {
+func easyjson56de76c1EncodeServerInternalPkgDto65(out *jwriter.Writer, in SubtaskInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"body\":"
+		const prefix string = ",\"parent_id\":"
 		out.RawString(prefix[1:])
-		if m, ok := in.Body.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Body.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(in.Body))
-		}
+		out.Uint64(uint64(in.ParentID))
+	}
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TaskID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v JSONResponse) MarshalJSON() ([]byte, error) {
+func (v SubtaskInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson56de76c1EncodeServerInternalPkgDto65(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v JSONResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SubtaskInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson56de76c1EncodeServerInternalPkgDto65(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *JSONResponse) UnmarshalJSON(data []byte) error {
+func (v *SubtaskInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson56de76c1DecodeServerInternalPkgDto65(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *JSONResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SubtaskInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson56de76c1DecodeServerInternalPkgDto65(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto66(in *jlexer.Lexer, out *IndividualBoardRequest) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "board_id":
-			out.BoardID = uint64(in.Uint64())
-		case "user_id":
-			out.UserID = uint64(in.Uint64())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson56de76c1EncodeServerInternalPkgDto66(out *jwriter.Writer, in IndividualBoardRequest) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"board_id\":"
-		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.BoardID))
-	}
-	{
-		const prefix string = ",\"user_id\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.UserID))
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v IndividualBoardRequest) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto66(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v IndividualBoardRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto66(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *IndividualBoardRequest) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto66(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *IndividualBoardRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto66(l, v)
-}
-func easyjson56de76c1DecodeServerInternalPkgDto67(in *jlexer.Lexer, out *IndividualBoardInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto66(in *jlexer.Lexer, out *SingleTaskInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6081,106 +6506,7035 @@ func easyjson56de76c1DecodeServerInternalPkgDto67(in *jlexer.Lexer, out *Individ
 			continue
 		}
 		switch key {
-		case "board_id":
+		case "id":
 			out.ID = uint64(in.Uint64())
-		case "owner_id":
-			out.OwnerID = uint64(in.Uint64())
-		case "owner_email":
-			out.OwnerEmail = string(in.String())
-		case "board_name":
+		case "list_id":
+			out.ListID = uint64(in.Uint64())
+		case "date_created":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DateCreated).UnmarshalJSON(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "description":
 			if in.IsNull() {
 				in.Skip()
-				out.Name = nil
+				out.Description = nil
 			} else {
-				if out.Name == nil {
-					out.Name = new(string)
+				if out.Description == nil {
+					out.Description = new(string)
 				}
-				*out.Name = string(in.String())
+				*out.Description = string(in.String())
 			}
-		case "thumbnail_url":
+		case "list_position":
+			out.ListPosition = uint64(in.Uint64())
+		case "start":
 			if in.IsNull() {
 				in.Skip()
-				out.ThumbnailURL = nil
+				out.Start = nil
 			} else {
-				if out.ThumbnailURL == nil {
-					out.ThumbnailURL = new(string)
+				if out.Start == nil {
+					out.Start = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.Start).UnmarshalJSON(data))
 				}
-				*out.ThumbnailURL = string(in.String())
 			}
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson56de76c1EncodeServerInternalPkgDto67(out *jwriter.Writer, in IndividualBoardInfo) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
+		case "end":
+			if in.IsNull() {
+				in.Skip()
+				out.End = nil
+			} else {
+				if out.End == nil {
+					out.End = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.End).UnmarshalJSON(data))
+				}
+			}
+		case "all_day":
+			out.AllDay = bool(in.Bool())
+		case "priority":
+			out.Priority = string(in.String())
+		case "estimate":
+			if in.IsNull() {
+				in.Skip()
+				out.Estimate = nil
+			} else {
+				if out.Estimate == nil {
+					out.Estimate = new(float64)
+				}
+				*out.Estimate = float64(in.Float64())
+			}
+		case "row_version":
+			out.RowVersion = uint64(in.Uint64())
+		case "date_archived":
+			if in.IsNull() {
+				in.Skip()
+				out.DateArchived = nil
+			} else {
+				if out.DateArchived == nil {
+					out.DateArchived = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DateArchived).UnmarshalJSON(data))
+				}
+			}
+		case "users":
+			if in.IsNull() {
+				in.Skip()
+				out.UserIDs = nil
+			} else {
+				in.Delim('[')
+				if out.UserIDs == nil {
+					if !in.IsDelim(']') {
+						out.UserIDs = make([]string, 0, 4)
+					} else {
+						out.UserIDs = []string{}
+					}
+				} else {
+					out.UserIDs = (out.UserIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v58 string
+					v58 = string(in.String())
+					out.UserIDs = append(out.UserIDs, v58)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "comments":
+			if in.IsNull() {
+				in.Skip()
+				out.CommentIDs = nil
+			} else {
+				in.Delim('[')
+				if out.CommentIDs == nil {
+					if !in.IsDelim(']') {
+						out.CommentIDs = make([]string, 0, 4)
+					} else {
+						out.CommentIDs = []string{}
+					}
+				} else {
+					out.CommentIDs = (out.CommentIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v59 string
+					v59 = string(in.String())
+					out.CommentIDs = append(out.CommentIDs, v59)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "checklists":
+			if in.IsNull() {
+				in.Skip()
+				out.ChecklistIDs = nil
+			} else {
+				in.Delim('[')
+				if out.ChecklistIDs == nil {
+					if !in.IsDelim(']') {
+						out.ChecklistIDs = make([]string, 0, 4)
+					} else {
+						out.ChecklistIDs = []string{}
+					}
+				} else {
+					out.ChecklistIDs = (out.ChecklistIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v60 string
+					v60 = string(in.String())
+					out.ChecklistIDs = append(out.ChecklistIDs, v60)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.TagIDs = nil
+			} else {
+				in.Delim('[')
+				if out.TagIDs == nil {
+					if !in.IsDelim(']') {
+						out.TagIDs = make([]string, 0, 4)
+					} else {
+						out.TagIDs = []string{}
+					}
+				} else {
+					out.TagIDs = (out.TagIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v61 string
+					v61 = string(in.String())
+					out.TagIDs = append(out.TagIDs, v61)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "reminders":
+			if in.IsNull() {
+				in.Skip()
+				out.Reminders = nil
+			} else {
+				in.Delim('[')
+				if out.Reminders == nil {
+					if !in.IsDelim(']') {
+						out.Reminders = make([]string, 0, 4)
+					} else {
+						out.Reminders = []string{}
+					}
+				} else {
+					out.Reminders = (out.Reminders)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v62 string
+					v62 = string(in.String())
+					out.Reminders = append(out.Reminders, v62)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "recurrence":
+			if in.IsNull() {
+				in.Skip()
+				out.Recurrence = nil
+			} else {
+				if out.Recurrence == nil {
+					out.Recurrence = new(string)
+				}
+				*out.Recurrence = string(in.String())
+			}
+		case "blockers":
+			if in.IsNull() {
+				in.Skip()
+				out.Blockers = nil
+			} else {
+				in.Delim('[')
+				if out.Blockers == nil {
+					if !in.IsDelim(']') {
+						out.Blockers = make([]string, 0, 4)
+					} else {
+						out.Blockers = []string{}
+					}
+				} else {
+					out.Blockers = (out.Blockers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v63 string
+					v63 = string(in.String())
+					out.Blockers = append(out.Blockers, v63)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "blocking":
+			if in.IsNull() {
+				in.Skip()
+				out.Blocking = nil
+			} else {
+				in.Delim('[')
+				if out.Blocking == nil {
+					if !in.IsDelim(']') {
+						out.Blocking = make([]string, 0, 4)
+					} else {
+						out.Blocking = []string{}
+					}
+				} else {
+					out.Blocking = (out.Blocking)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v64 string
+					v64 = string(in.String())
+					out.Blocking = append(out.Blocking, v64)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "blocked":
+			out.Blocked = bool(in.Bool())
+		case "parent_id":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentID = nil
+			} else {
+				if out.ParentID == nil {
+					out.ParentID = new(uint64)
+				}
+				*out.ParentID = uint64(in.Uint64())
+			}
+		case "subtasks":
+			if in.IsNull() {
+				in.Skip()
+				out.Subtasks = nil
+			} else {
+				in.Delim('[')
+				if out.Subtasks == nil {
+					if !in.IsDelim(']') {
+						out.Subtasks = make([]string, 0, 4)
+					} else {
+						out.Subtasks = []string{}
+					}
+				} else {
+					out.Subtasks = (out.Subtasks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v65 string
+					v65 = string(in.String())
+					out.Subtasks = append(out.Subtasks, v65)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "subtasks_done":
+			out.SubtasksDone = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto66(out *jwriter.Writer, in SingleTaskInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"list_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ListID))
+	}
+	{
+		const prefix string = ",\"date_created\":"
+		out.RawString(prefix)
+		out.Raw((in.DateCreated).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		if in.Description == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Description))
+		}
+	}
+	{
+		const prefix string = ",\"list_position\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ListPosition))
+	}
+	{
+		const prefix string = ",\"start\":"
+		out.RawString(prefix)
+		if in.Start == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.Start).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"end\":"
+		out.RawString(prefix)
+		if in.End == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.End).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"all_day\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AllDay))
+	}
+	{
+		const prefix string = ",\"priority\":"
+		out.RawString(prefix)
+		out.String(string(in.Priority))
+	}
+	{
+		const prefix string = ",\"estimate\":"
+		out.RawString(prefix)
+		if in.Estimate == nil {
+			out.RawString("null")
+		} else {
+			out.Float64(float64(*in.Estimate))
+		}
+	}
+	{
+		const prefix string = ",\"row_version\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.RowVersion))
+	}
+	{
+		const prefix string = ",\"date_archived\":"
+		out.RawString(prefix)
+		if in.DateArchived == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.DateArchived).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"users\":"
+		out.RawString(prefix)
+		if in.UserIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v66, v67 := range in.UserIDs {
+				if v66 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v67))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix)
+		if in.CommentIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v68, v69 := range in.CommentIDs {
+				if v68 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v69))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"checklists\":"
+		out.RawString(prefix)
+		if in.ChecklistIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v70, v71 := range in.ChecklistIDs {
+				if v70 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v71))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		if in.TagIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v72, v73 := range in.TagIDs {
+				if v72 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v73))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"reminders\":"
+		out.RawString(prefix)
+		if in.Reminders == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v74, v75 := range in.Reminders {
+				if v74 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v75))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"recurrence\":"
+		out.RawString(prefix)
+		if in.Recurrence == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Recurrence))
+		}
+	}
+	{
+		const prefix string = ",\"blockers\":"
+		out.RawString(prefix)
+		if in.Blockers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v76, v77 := range in.Blockers {
+				if v76 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v77))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"blocking\":"
+		out.RawString(prefix)
+		if in.Blocking == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v78, v79 := range in.Blocking {
+				if v78 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v79))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"blocked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Blocked))
+	}
+	{
+		const prefix string = ",\"parent_id\":"
+		out.RawString(prefix)
+		if in.ParentID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.ParentID))
+		}
+	}
+	{
+		const prefix string = ",\"subtasks\":"
+		out.RawString(prefix)
+		if in.Subtasks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v80, v81 := range in.Subtasks {
+				if v80 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v81))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"subtasks_done\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.SubtasksDone))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SingleTaskInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto66(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SingleTaskInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto66(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SingleTaskInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto66(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SingleTaskInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto66(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto67(in *jlexer.Lexer, out *SingleListInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "board_id":
+			out.BoardID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "list_position":
+			out.ListPosition = uint64(in.Uint64())
+		case "wip_limit":
+			if in.IsNull() {
+				in.Skip()
+				out.WipLimit = nil
+			} else {
+				if out.WipLimit == nil {
+					out.WipLimit = new(uint64)
+				}
+				*out.WipLimit = uint64(in.Uint64())
+			}
+		case "wip_mode":
+			out.WipMode = string(in.String())
+		case "is_done":
+			out.IsDone = bool(in.Bool())
+		case "require_unblocked":
+			out.RequireUnblocked = bool(in.Bool())
+		case "row_version":
+			out.RowVersion = uint64(in.Uint64())
+		case "date_archived":
+			if in.IsNull() {
+				in.Skip()
+				out.DateArchived = nil
+			} else {
+				if out.DateArchived == nil {
+					out.DateArchived = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DateArchived).UnmarshalJSON(data))
+				}
+			}
+		case "task_count":
+			out.TaskCount = uint64(in.Uint64())
+		case "cards":
+			if in.IsNull() {
+				in.Skip()
+				out.TaskIDs = nil
+			} else {
+				in.Delim('[')
+				if out.TaskIDs == nil {
+					if !in.IsDelim(']') {
+						out.TaskIDs = make([]string, 0, 4)
+					} else {
+						out.TaskIDs = []string{}
+					}
+				} else {
+					out.TaskIDs = (out.TaskIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v82 string
+					v82 = string(in.String())
+					out.TaskIDs = append(out.TaskIDs, v82)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto67(out *jwriter.Writer, in SingleListInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.BoardID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"list_position\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ListPosition))
+	}
+	{
+		const prefix string = ",\"wip_limit\":"
+		out.RawString(prefix)
+		if in.WipLimit == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.WipLimit))
+		}
+	}
+	{
+		const prefix string = ",\"wip_mode\":"
+		out.RawString(prefix)
+		out.String(string(in.WipMode))
+	}
+	{
+		const prefix string = ",\"is_done\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsDone))
+	}
+	{
+		const prefix string = ",\"require_unblocked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.RequireUnblocked))
+	}
+	{
+		const prefix string = ",\"row_version\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.RowVersion))
+	}
+	{
+		const prefix string = ",\"date_archived\":"
+		out.RawString(prefix)
+		if in.DateArchived == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.DateArchived).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"task_count\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TaskCount))
+	}
+	{
+		const prefix string = ",\"cards\":"
+		out.RawString(prefix)
+		if in.TaskIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v83, v84 := range in.TaskIDs {
+				if v83 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v84))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SingleListInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto67(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SingleListInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto67(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SingleListInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto67(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SingleListInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto67(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto68(in *jlexer.Lexer, out *SingleBoardInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "board_id":
+			out.ID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "workspace_id":
+			out.WorkspaceID = uint64(in.Uint64())
+		case "owner_id":
+			out.WorkspaceOwnerID = uint64(in.Uint64())
+		case "thumbnail_url":
+			if in.IsNull() {
+				in.Skip()
+				out.ThumbnailURL = nil
+			} else {
+				if out.ThumbnailURL == nil {
+					out.ThumbnailURL = new(string)
+				}
+				*out.ThumbnailURL = string(in.String())
+			}
+		case "date_created":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DateCreated).UnmarshalJSON(data))
+			}
+		case "version":
+			out.Version = uint64(in.Uint64())
+		case "row_version":
+			out.RowVersion = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto68(out *jwriter.Writer, in SingleBoardInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"workspace_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.WorkspaceID))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.WorkspaceOwnerID))
+	}
+	{
+		const prefix string = ",\"thumbnail_url\":"
+		out.RawString(prefix)
+		if in.ThumbnailURL == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.ThumbnailURL))
+		}
+	}
+	{
+		const prefix string = ",\"date_created\":"
+		out.RawString(prefix)
+		out.Raw((in.DateCreated).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Version))
+	}
+	{
+		const prefix string = ",\"row_version\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.RowVersion))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SingleBoardInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto68(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SingleBoardInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto68(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SingleBoardInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto68(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SingleBoardInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto68(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto69(in *jlexer.Lexer, out *SignupInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "email":
+			out.Email = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto69(out *jwriter.Writer, in SignupInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Email))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SignupInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto69(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SignupInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto69(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SignupInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto69(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SignupInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto69(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto70(in *jlexer.Lexer, out *SessionToken) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "session_token":
+			out.ID = string(in.String())
+		case "expiration_date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExpirationDate).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto70(out *jwriter.Writer, in SessionToken) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"session_token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"expiration_date\":"
+		out.RawString(prefix)
+		out.Raw((in.ExpirationDate).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SessionToken) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto70(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SessionToken) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto70(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SessionToken) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto70(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SessionToken) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto70(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto71(in *jlexer.Lexer, out *RoleInWorkspace) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.ID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto71(out *jwriter.Writer, in RoleInWorkspace) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RoleInWorkspace) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto71(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RoleInWorkspace) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto71(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RoleInWorkspace) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto71(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RoleInWorkspace) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto71(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto72(in *jlexer.Lexer, out *ReplyInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "original_id":
+			out.OriginalID = uint64(in.Uint64())
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
+		case "text":
+			out.Text = string(in.String())
+		case "date_created":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DateCreated).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto72(out *jwriter.Writer, in ReplyInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"original_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.OriginalID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.UserID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"date_created\":"
+		out.RawString(prefix)
+		out.Raw((in.DateCreated).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReplyInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto72(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReplyInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto72(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReplyInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto72(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReplyInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto72(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto73(in *jlexer.Lexer, out *RemoveTaskUserInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto73(out *jwriter.Writer, in RemoveTaskUserInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.UserID))
+	}
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TaskID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RemoveTaskUserInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto73(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RemoveTaskUserInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto73(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RemoveTaskUserInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto73(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RemoveTaskUserInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto73(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto74(in *jlexer.Lexer, out *RemoveFileInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "original_name":
+			out.OriginalName = string(in.String())
+		case "file_path":
+			out.FilePath = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto74(out *jwriter.Writer, in RemoveFileInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.TaskID))
+	}
+	{
+		const prefix string = ",\"original_name\":"
+		out.RawString(prefix)
+		out.String(string(in.OriginalName))
+	}
+	{
+		const prefix string = ",\"file_path\":"
+		out.RawString(prefix)
+		out.String(string(in.FilePath))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RemoveFileInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto74(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RemoveFileInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto74(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RemoveFileInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto74(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RemoveFileInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto74(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto75(in *jlexer.Lexer, out *RemoveBoardUserInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
+		case "workspace_id":
+			out.WorkspaceID = uint64(in.Uint64())
+		case "board_id":
+			out.BoardID = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto75(out *jwriter.Writer, in RemoveBoardUserInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.UserID))
+	}
+	{
+		const prefix string = ",\"workspace_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.WorkspaceID))
+	}
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.BoardID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RemoveBoardUserInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto75(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RemoveBoardUserInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto75(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RemoveBoardUserInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto75(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RemoveBoardUserInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto75(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto76(in *jlexer.Lexer, out *RecurrenceInstanceInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "recurrence_id":
+			out.RecurrenceID = uint64(in.Uint64())
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "due_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DueAt).UnmarshalJSON(data))
+			}
+		case "next_start":
+			if in.IsNull() {
+				in.Skip()
+				out.NextStart = nil
+			} else {
+				if out.NextStart == nil {
+					out.NextStart = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.NextStart).UnmarshalJSON(data))
+				}
+			}
+		case "next_due_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.NextDueAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto76(out *jwriter.Writer, in RecurrenceInstanceInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"recurrence_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.RecurrenceID))
+	}
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TaskID))
+	}
+	{
+		const prefix string = ",\"due_at\":"
+		out.RawString(prefix)
+		out.Raw((in.DueAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"next_start\":"
+		out.RawString(prefix)
+		if in.NextStart == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.NextStart).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"next_due_at\":"
+		out.RawString(prefix)
+		out.Raw((in.NextDueAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RecurrenceInstanceInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto76(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RecurrenceInstanceInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto76(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RecurrenceInstanceInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto76(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RecurrenceInstanceInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto76(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto77(in *jlexer.Lexer, out *RatingStatsWithQuestionID) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "question_id":
+			out.QuestionID = uint64(in.Uint64())
+		case "rating":
+			out.Rating = uint64(in.Uint64())
+		case "count":
+			out.Count = uint64(in.Uint64())
+		case "average":
+			out.Average = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto77(out *jwriter.Writer, in RatingStatsWithQuestionID) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"question_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.QuestionID))
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Rating))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Count))
+	}
+	{
+		const prefix string = ",\"average\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Average))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RatingStatsWithQuestionID) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto77(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RatingStatsWithQuestionID) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto77(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RatingStatsWithQuestionID) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto77(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RatingStatsWithQuestionID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto77(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto78(in *jlexer.Lexer, out *RatingStats) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "rating":
+			out.Rating = uint64(in.Uint64())
+		case "count":
+			out.Count = uint64(in.Uint64())
+		case "average":
+			out.Average = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto78(out *jwriter.Writer, in RatingStats) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Rating))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Count))
+	}
+	{
+		const prefix string = ",\"average\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Average))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RatingStats) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto78(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RatingStats) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto78(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RatingStats) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto78(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RatingStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto78(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto79(in *jlexer.Lexer, out *QuestionWithStats) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "question_id":
+			out.ID = uint64(in.Uint64())
+		case "content":
+			out.Content = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "stats":
+			if in.IsNull() {
+				in.Skip()
+				out.Stats = nil
+			} else {
+				in.Delim('[')
+				if out.Stats == nil {
+					if !in.IsDelim(']') {
+						out.Stats = make([]RatingStats, 0, 2)
+					} else {
+						out.Stats = []RatingStats{}
+					}
+				} else {
+					out.Stats = (out.Stats)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v85 RatingStats
+					(v85).UnmarshalEasyJSON(in)
+					out.Stats = append(out.Stats, v85)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto79(out *jwriter.Writer, in QuestionWithStats) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"question_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix)
+		out.String(string(in.Content))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"stats\":"
+		out.RawString(prefix)
+		if in.Stats == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v86, v87 := range in.Stats {
+				if v86 > 0 {
+					out.RawByte(',')
+				}
+				(v87).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuestionWithStats) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto79(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuestionWithStats) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto79(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuestionWithStats) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto79(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuestionWithStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto79(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto80(in *jlexer.Lexer, out *PasswordHashesInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
+		case "NewPasswordHash":
+			out.NewPasswordHash = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto80(out *jwriter.Writer, in PasswordHashesInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.UserID))
+	}
+	{
+		const prefix string = ",\"NewPasswordHash\":"
+		out.RawString(prefix)
+		out.String(string(in.NewPasswordHash))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PasswordHashesInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto80(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PasswordHashesInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto80(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PasswordHashesInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto80(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PasswordHashesInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto80(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto81(in *jlexer.Lexer, out *PasswordChangeInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "old_password":
+			out.OldPassword = string(in.String())
+		case "new_password":
+			out.NewPassword = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto81(out *jwriter.Writer, in PasswordChangeInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"old_password\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.OldPassword))
+	}
+	{
+		const prefix string = ",\"new_password\":"
+		out.RawString(prefix)
+		out.String(string(in.NewPassword))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PasswordChangeInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto81(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PasswordChangeInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto81(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PasswordChangeInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto81(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PasswordChangeInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto81(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto82(in *jlexer.Lexer, out *NotificationsRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "unread_only":
+			out.UnreadOnly = bool(in.Bool())
+		case "limit":
+			out.Limit = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto82(out *jwriter.Writer, in NotificationsRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"unread_only\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.UnreadOnly))
+	}
+	{
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Limit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NotificationsRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto82(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto82(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NotificationsRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto82(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto82(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto83(in *jlexer.Lexer, out *NotificationsReadInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ids":
+			if in.IsNull() {
+				in.Skip()
+				out.IDs = nil
+			} else {
+				in.Delim('[')
+				if out.IDs == nil {
+					if !in.IsDelim(']') {
+						out.IDs = make([]uint64, 0, 8)
+					} else {
+						out.IDs = []uint64{}
+					}
+				} else {
+					out.IDs = (out.IDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v88 uint64
+					v88 = uint64(in.Uint64())
+					out.IDs = append(out.IDs, v88)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto83(out *jwriter.Writer, in NotificationsReadInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ids\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		if in.IDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v89, v90 := range in.IDs {
+				if v89 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v90))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NotificationsReadInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto83(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationsReadInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto83(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NotificationsReadInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto83(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationsReadInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto83(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto84(in *jlexer.Lexer, out *NotificationInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "kind":
+			out.Kind = string(in.String())
+		case "task_id":
+			if in.IsNull() {
+				in.Skip()
+				out.TaskID = nil
+			} else {
+				if out.TaskID == nil {
+					out.TaskID = new(uint64)
+				}
+				*out.TaskID = uint64(in.Uint64())
+			}
+		case "task_name":
+			if in.IsNull() {
+				in.Skip()
+				out.TaskName = nil
+			} else {
+				if out.TaskName == nil {
+					out.TaskName = new(string)
+				}
+				*out.TaskName = string(in.String())
+			}
+		case "board_id":
+			if in.IsNull() {
+				in.Skip()
+				out.BoardID = nil
+			} else {
+				if out.BoardID == nil {
+					out.BoardID = new(uint64)
+				}
+				*out.BoardID = uint64(in.Uint64())
+			}
+		case "due_at":
+			if in.IsNull() {
+				in.Skip()
+				out.DueAt = nil
+			} else {
+				if out.DueAt == nil {
+					out.DueAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DueAt).UnmarshalJSON(data))
+				}
+			}
+		case "offset_minutes":
+			if in.IsNull() {
+				in.Skip()
+				out.OffsetMinutes = nil
+			} else {
+				if out.OffsetMinutes == nil {
+					out.OffsetMinutes = new(uint64)
+				}
+				*out.OffsetMinutes = uint64(in.Uint64())
+			}
+		case "history_id":
+			if in.IsNull() {
+				in.Skip()
+				out.HistoryID = nil
+			} else {
+				if out.HistoryID == nil {
+					out.HistoryID = new(uint64)
+				}
+				*out.HistoryID = uint64(in.Uint64())
+			}
+		case "entity_type":
+			if in.IsNull() {
+				in.Skip()
+				out.EntityType = nil
+			} else {
+				if out.EntityType == nil {
+					out.EntityType = new(string)
+				}
+				*out.EntityType = string(in.String())
+			}
+		case "action":
+			if in.IsNull() {
+				in.Skip()
+				out.Action = nil
+			} else {
+				if out.Action == nil {
+					out.Action = new(string)
+				}
+				*out.Action = string(in.String())
+			}
+		case "date_created":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DateCreated).UnmarshalJSON(data))
+			}
+		case "date_read":
+			if in.IsNull() {
+				in.Skip()
+				out.DateRead = nil
+			} else {
+				if out.DateRead == nil {
+					out.DateRead = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DateRead).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto84(out *jwriter.Writer, in NotificationInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix)
+		if in.TaskID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.TaskID))
+		}
+	}
+	{
+		const prefix string = ",\"task_name\":"
+		out.RawString(prefix)
+		if in.TaskName == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.TaskName))
+		}
+	}
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix)
+		if in.BoardID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.BoardID))
+		}
+	}
+	{
+		const prefix string = ",\"due_at\":"
+		out.RawString(prefix)
+		if in.DueAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.DueAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"offset_minutes\":"
+		out.RawString(prefix)
+		if in.OffsetMinutes == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.OffsetMinutes))
+		}
+	}
+	{
+		const prefix string = ",\"history_id\":"
+		out.RawString(prefix)
+		if in.HistoryID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.HistoryID))
+		}
+	}
+	{
+		const prefix string = ",\"entity_type\":"
+		out.RawString(prefix)
+		if in.EntityType == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.EntityType))
+		}
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		if in.Action == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Action))
+		}
+	}
+	{
+		const prefix string = ",\"date_created\":"
+		out.RawString(prefix)
+		out.Raw((in.DateCreated).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"date_read\":"
+		out.RawString(prefix)
+		if in.DateRead == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.DateRead).MarshalJSON())
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NotificationInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto84(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto84(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NotificationInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto84(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto84(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto85(in *jlexer.Lexer, out *NewWorkspaceInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "owner_id":
+			out.OwnerID = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto85(out *jwriter.Writer, in NewWorkspaceInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.OwnerID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NewWorkspaceInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto85(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NewWorkspaceInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto85(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NewWorkspaceInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto85(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NewWorkspaceInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto85(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto86(in *jlexer.Lexer, out *NewWorklogInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "start":
+			if in.IsNull() {
+				in.Skip()
+				out.Start = nil
+			} else {
+				if out.Start == nil {
+					out.Start = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.Start).UnmarshalJSON(data))
+				}
+			}
+		case "duration_seconds":
+			out.DurationSeconds = uint64(in.Uint64())
+		case "note":
+			if in.IsNull() {
+				in.Skip()
+				out.Note = nil
+			} else {
+				if out.Note == nil {
+					out.Note = new(string)
+				}
+				*out.Note = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto86(out *jwriter.Writer, in NewWorklogInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.TaskID))
+	}
+	{
+		const prefix string = ",\"start\":"
+		out.RawString(prefix)
+		if in.Start == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.Start).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"duration_seconds\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.DurationSeconds))
+	}
+	{
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		if in.Note == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Note))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NewWorklogInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto86(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NewWorklogInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto86(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NewWorklogInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto86(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NewWorklogInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto86(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto87(in *jlexer.Lexer, out *NewTaskInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "list_id":
+			out.ListID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "list_position":
+			out.ListPosition = uint64(in.Uint64())
+		case "parent_id":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentID = nil
+			} else {
+				if out.ParentID == nil {
+					out.ParentID = new(uint64)
+				}
+				*out.ParentID = uint64(in.Uint64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto87(out *jwriter.Writer, in NewTaskInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"list_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ListID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"list_position\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ListPosition))
+	}
+	{
+		const prefix string = ",\"parent_id\":"
+		out.RawString(prefix)
+		if in.ParentID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.ParentID))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NewTaskInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto87(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NewTaskInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto87(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NewTaskInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto87(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NewTaskInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto87(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto88(in *jlexer.Lexer, out *NewTagInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "board_id":
+			out.BoardID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "color":
+			out.Color = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto88(out *jwriter.Writer, in NewTagInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.TaskID))
+	}
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.BoardID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"color\":"
+		out.RawString(prefix)
+		out.String(string(in.Color))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NewTagInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto88(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NewTagInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto88(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NewTagInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto88(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NewTagInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto88(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto89(in *jlexer.Lexer, out *NewListInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "board_id":
+			out.BoardID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			if in.IsNull() {
+				in.Skip()
+				out.Description = nil
+			} else {
+				if out.Description == nil {
+					out.Description = new(string)
+				}
+				*out.Description = string(in.String())
+			}
+		case "list_position":
+			out.ListPosition = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto89(out *jwriter.Writer, in NewListInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.BoardID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		if in.Description == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Description))
+		}
+	}
+	{
+		const prefix string = ",\"list_position\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ListPosition))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NewListInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto89(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NewListInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto89(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NewListInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto89(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NewListInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto89(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto90(in *jlexer.Lexer, out *NewCustomFieldInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "board_id":
+			out.BoardID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]string, 0, 4)
+					} else {
+						out.Options = []string{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v91 string
+					v91 = string(in.String())
+					out.Options = append(out.Options, v91)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "list_position":
+			out.ListPosition = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto90(out *jwriter.Writer, in NewCustomFieldInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.BoardID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		if in.Options == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v92, v93 := range in.Options {
+				if v92 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v93))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"list_position\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ListPosition))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NewCustomFieldInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto90(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NewCustomFieldInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto90(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NewCustomFieldInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto90(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NewCustomFieldInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto90(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto91(in *jlexer.Lexer, out *NewCommentInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto91(out *jwriter.Writer, in NewCommentInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.UserID))
+	}
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TaskID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NewCommentInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto91(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NewCommentInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto91(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NewCommentInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto91(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NewCommentInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto91(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto92(in *jlexer.Lexer, out *NewChecklistItemInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "checklist_id":
+			out.ChecklistID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "done":
+			out.Done = bool(in.Bool())
+		case "list_position":
+			out.ListPosition = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto92(out *jwriter.Writer, in NewChecklistItemInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"checklist_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ChecklistID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"done\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Done))
+	}
+	{
+		const prefix string = ",\"list_position\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ListPosition))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NewChecklistItemInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto92(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NewChecklistItemInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto92(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NewChecklistItemInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto92(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NewChecklistItemInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto92(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto93(in *jlexer.Lexer, out *NewChecklistInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "list_position":
+			out.ListPosition = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto93(out *jwriter.Writer, in NewChecklistInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.TaskID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"list_position\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ListPosition))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NewChecklistInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto93(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NewChecklistInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto93(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NewChecklistInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto93(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NewChecklistInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto93(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto94(in *jlexer.Lexer, out *NewCSATQuestionInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "content":
+			out.Content = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto94(out *jwriter.Writer, in NewCSATQuestionInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Content))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NewCSATQuestionInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto94(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NewCSATQuestionInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto94(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NewCSATQuestionInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto94(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NewCSATQuestionInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto94(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto95(in *jlexer.Lexer, out *NewCSATQuestion) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "content":
+			out.Content = string(in.String())
+		case "type":
+			out.TypeID = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto95(out *jwriter.Writer, in NewCSATQuestion) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Content))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TypeID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NewCSATQuestion) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto95(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NewCSATQuestion) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto95(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NewCSATQuestion) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto95(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NewCSATQuestion) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto95(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto96(in *jlexer.Lexer, out *NewCSATAnswerInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "id_question":
+			out.QuestionID = uint64(in.Uint64())
+		case "rating":
+			out.Rating = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto96(out *jwriter.Writer, in NewCSATAnswerInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"id_question\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.QuestionID))
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Rating))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NewCSATAnswerInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto96(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NewCSATAnswerInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto96(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NewCSATAnswerInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto96(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NewCSATAnswerInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto96(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto97(in *jlexer.Lexer, out *NewCSATAnswer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "UserID":
+			out.UserID = uint64(in.Uint64())
+		case "QuestionID":
+			out.QuestionID = uint64(in.Uint64())
+		case "Rating":
+			out.Rating = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto97(out *jwriter.Writer, in NewCSATAnswer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"UserID\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.UserID))
+	}
+	{
+		const prefix string = ",\"QuestionID\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.QuestionID))
+	}
+	{
+		const prefix string = ",\"Rating\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Rating))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NewCSATAnswer) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto97(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NewCSATAnswer) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto97(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NewCSATAnswer) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto97(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NewCSATAnswer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto97(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto98(in *jlexer.Lexer, out *LoginInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "email":
+			out.Email = string(in.String())
+		case "password_hash":
+			out.PasswordHash = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto98(out *jwriter.Writer, in LoginInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"password_hash\":"
+		out.RawString(prefix)
+		out.String(string(in.PasswordHash))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LoginInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto98(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LoginInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto98(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LoginInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto98(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LoginInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto98(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto99(in *jlexer.Lexer, out *ListTransferReport) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "list_id":
+			out.ListID = uint64(in.Uint64())
+		case "board_id":
+			out.BoardID = uint64(in.Uint64())
+		case "changes":
+			(out.Changes).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto99(out *jwriter.Writer, in ListTransferReport) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"list_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ListID))
+	}
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.BoardID))
+	}
+	{
+		const prefix string = ",\"changes\":"
+		out.RawString(prefix)
+		(in.Changes).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ListTransferReport) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto99(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ListTransferReport) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto99(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ListTransferReport) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto99(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ListTransferReport) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto99(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto100(in *jlexer.Lexer, out *ListTransferInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "list_id":
+			out.ListID = uint64(in.Uint64())
+		case "board_id":
+			out.BoardID = uint64(in.Uint64())
+		case "after_id":
+			if in.IsNull() {
+				in.Skip()
+				out.AfterID = nil
+			} else {
+				if out.AfterID == nil {
+					out.AfterID = new(uint64)
+				}
+				*out.AfterID = uint64(in.Uint64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto100(out *jwriter.Writer, in ListTransferInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"list_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ListID))
+	}
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.BoardID))
+	}
+	{
+		const prefix string = ",\"after_id\":"
+		out.RawString(prefix)
+		if in.AfterID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.AfterID))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ListTransferInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto100(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ListTransferInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto100(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ListTransferInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto100(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ListTransferInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto100(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto101(in *jlexer.Lexer, out *ListTaskCount) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "list_id":
+			out.ListID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "is_done":
+			out.IsDone = bool(in.Bool())
+		case "task_count":
+			out.TaskCount = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto101(out *jwriter.Writer, in ListTaskCount) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"list_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ListID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"is_done\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsDone))
+	}
+	{
+		const prefix string = ",\"task_count\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TaskCount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ListTaskCount) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto101(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ListTaskCount) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto101(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ListTaskCount) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto101(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ListTaskCount) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto101(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto102(in *jlexer.Lexer, out *ListSortInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "list_id":
+			out.ListID = uint64(in.Uint64())
+		case "by":
+			out.By = string(in.String())
+		case "reverse":
+			out.Reverse = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto102(out *jwriter.Writer, in ListSortInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"list_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ListID))
+	}
+	{
+		const prefix string = ",\"by\":"
+		out.RawString(prefix)
+		out.String(string(in.By))
+	}
+	{
+		const prefix string = ",\"reverse\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Reverse))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ListSortInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto102(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ListSortInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto102(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ListSortInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto102(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ListSortInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto102(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto103(in *jlexer.Lexer, out *ListMoveInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "list_id":
+			out.ListID = uint64(in.Uint64())
+		case "after_id":
+			if in.IsNull() {
+				in.Skip()
+				out.AfterID = nil
+			} else {
+				if out.AfterID == nil {
+					out.AfterID = new(uint64)
+				}
+				*out.AfterID = uint64(in.Uint64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto103(out *jwriter.Writer, in ListMoveInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"list_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ListID))
+	}
+	{
+		const prefix string = ",\"after_id\":"
+		out.RawString(prefix)
+		if in.AfterID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.AfterID))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ListMoveInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto103(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ListMoveInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto103(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ListMoveInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto103(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ListMoveInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto103(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto104(in *jlexer.Lexer, out *ListIDs) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ids":
+			if in.IsNull() {
+				in.Skip()
+				out.Values = nil
+			} else {
+				in.Delim('[')
+				if out.Values == nil {
+					if !in.IsDelim(']') {
+						out.Values = make([]uint64, 0, 8)
+					} else {
+						out.Values = []uint64{}
+					}
+				} else {
+					out.Values = (out.Values)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v94 uint64
+					v94 = uint64(in.Uint64())
+					out.Values = append(out.Values, v94)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto104(out *jwriter.Writer, in ListIDs) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ids\":"
+		out.RawString(prefix[1:])
+		if in.Values == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v95, v96 := range in.Values {
+				if v95 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v96))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ListIDs) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto104(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ListIDs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto104(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ListIDs) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto104(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ListIDs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto104(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto105(in *jlexer.Lexer, out *ListID) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Value = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto105(out *jwriter.Writer, in ListID) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Value))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ListID) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto105(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ListID) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto105(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ListID) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto105(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ListID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto105(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto106(in *jlexer.Lexer, out *JSONResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "body":
+			if m, ok := out.Body.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Body.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Body = in.Interface()
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto106(out *jwriter.Writer, in JSONResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"body\":"
+		out.RawString(prefix[1:])
+		if m, ok := in.Body.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Body.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Body))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v JSONResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto106(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v JSONResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto106(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *JSONResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto106(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *JSONResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto106(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto107(in *jlexer.Lexer, out *IndividualBoardRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "board_id":
+			out.BoardID = uint64(in.Uint64())
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
+		case "custom_fields":
+			if in.IsNull() {
+				in.Skip()
+				out.CustomFields = nil
+			} else {
+				in.Delim('[')
+				if out.CustomFields == nil {
+					if !in.IsDelim(']') {
+						out.CustomFields = make([]CustomFieldFilter, 0, 0)
+					} else {
+						out.CustomFields = []CustomFieldFilter{}
+					}
+				} else {
+					out.CustomFields = (out.CustomFields)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v97 CustomFieldFilter
+					(v97).UnmarshalEasyJSON(in)
+					out.CustomFields = append(out.CustomFields, v97)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "assignees":
+			if in.IsNull() {
+				in.Skip()
+				out.AssigneeIDs = nil
+			} else {
+				in.Delim('[')
+				if out.AssigneeIDs == nil {
+					if !in.IsDelim(']') {
+						out.AssigneeIDs = make([]uint64, 0, 8)
+					} else {
+						out.AssigneeIDs = []uint64{}
+					}
+				} else {
+					out.AssigneeIDs = (out.AssigneeIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v98 uint64
+					v98 = uint64(in.Uint64())
+					out.AssigneeIDs = append(out.AssigneeIDs, v98)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.TagIDs = nil
+			} else {
+				in.Delim('[')
+				if out.TagIDs == nil {
+					if !in.IsDelim(']') {
+						out.TagIDs = make([]uint64, 0, 8)
+					} else {
+						out.TagIDs = []uint64{}
+					}
+				} else {
+					out.TagIDs = (out.TagIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v99 uint64
+					v99 = uint64(in.Uint64())
+					out.TagIDs = append(out.TagIDs, v99)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "due_from":
+			if in.IsNull() {
+				in.Skip()
+				out.DueFrom = nil
+			} else {
+				if out.DueFrom == nil {
+					out.DueFrom = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DueFrom).UnmarshalJSON(data))
+				}
+			}
+		case "due_to":
+			if in.IsNull() {
+				in.Skip()
+				out.DueTo = nil
+			} else {
+				if out.DueTo == nil {
+					out.DueTo = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DueTo).UnmarshalJSON(data))
+				}
+			}
+		case "text":
+			out.Text = string(in.String())
+		case "include_comments":
+			if in.IsNull() {
+				in.Skip()
+				out.IncludeComments = nil
+			} else {
+				if out.IncludeComments == nil {
+					out.IncludeComments = new(bool)
+				}
+				*out.IncludeComments = bool(in.Bool())
+			}
+		case "include_checklists":
+			if in.IsNull() {
+				in.Skip()
+				out.IncludeChecklists = nil
+			} else {
+				if out.IncludeChecklists == nil {
+					out.IncludeChecklists = new(bool)
+				}
+				*out.IncludeChecklists = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto107(out *jwriter.Writer, in IndividualBoardRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.BoardID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.UserID))
+	}
+	{
+		const prefix string = ",\"custom_fields\":"
+		out.RawString(prefix)
+		if in.CustomFields == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v100, v101 := range in.CustomFields {
+				if v100 > 0 {
+					out.RawByte(',')
+				}
+				(v101).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"assignees\":"
+		out.RawString(prefix)
+		if in.AssigneeIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v102, v103 := range in.AssigneeIDs {
+				if v102 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v103))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		if in.TagIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v104, v105 := range in.TagIDs {
+				if v104 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v105))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"due_from\":"
+		out.RawString(prefix)
+		if in.DueFrom == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.DueFrom).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"due_to\":"
+		out.RawString(prefix)
+		if in.DueTo == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.DueTo).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"include_comments\":"
+		out.RawString(prefix)
+		if in.IncludeComments == nil {
+			out.RawString("null")
+		} else {
+			out.Bool(bool(*in.IncludeComments))
+		}
+	}
+	{
+		const prefix string = ",\"include_checklists\":"
+		out.RawString(prefix)
+		if in.IncludeChecklists == nil {
+			out.RawString("null")
+		} else {
+			out.Bool(bool(*in.IncludeChecklists))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IndividualBoardRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto107(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IndividualBoardRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto107(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IndividualBoardRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto107(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IndividualBoardRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto107(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto108(in *jlexer.Lexer, out *IndividualBoardInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "board_id":
+			out.ID = uint64(in.Uint64())
+		case "owner_id":
+			out.OwnerID = uint64(in.Uint64())
+		case "owner_email":
+			out.OwnerEmail = string(in.String())
+		case "board_name":
+			if in.IsNull() {
+				in.Skip()
+				out.Name = nil
+			} else {
+				if out.Name == nil {
+					out.Name = new(string)
+				}
+				*out.Name = string(in.String())
+			}
+		case "thumbnail_url":
+			if in.IsNull() {
+				in.Skip()
+				out.ThumbnailURL = nil
+			} else {
+				if out.ThumbnailURL == nil {
+					out.ThumbnailURL = new(string)
+				}
+				*out.ThumbnailURL = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto108(out *jwriter.Writer, in IndividualBoardInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"owner_email\":"
+		out.RawString(prefix)
+		out.String(string(in.OwnerEmail))
+	}
+	{
+		const prefix string = ",\"board_name\":"
+		out.RawString(prefix)
+		if in.Name == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Name))
+		}
+	}
+	{
+		const prefix string = ",\"thumbnail_url\":"
+		out.RawString(prefix)
+		if in.ThumbnailURL == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.ThumbnailURL))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IndividualBoardInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto108(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IndividualBoardInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto108(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IndividualBoardInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto108(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IndividualBoardInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto108(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto109(in *jlexer.Lexer, out *ImageUrl) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "url":
+			out.Value = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto109(out *jwriter.Writer, in ImageUrl) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Value))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImageUrl) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto109(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImageUrl) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto109(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImageUrl) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto109(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImageUrl) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto109(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto110(in *jlexer.Lexer, out *HistoryFieldChange) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "entity_id":
+			out.EntityID = uint64(in.Uint64())
+		case "field":
+			out.Field = string(in.String())
+		case "before":
+			if m, ok := out.Before.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Before.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Before = in.Interface()
+			}
+		case "after":
+			if m, ok := out.After.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.After.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.After = in.Interface()
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto110(out *jwriter.Writer, in HistoryFieldChange) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.EntityID != 0 {
+		const prefix string = ",\"entity_id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.EntityID))
+	}
+	{
+		const prefix string = ",\"field\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Field))
+	}
+	{
+		const prefix string = ",\"before\":"
+		out.RawString(prefix)
+		if m, ok := in.Before.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Before.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Before))
+		}
+	}
+	{
+		const prefix string = ",\"after\":"
+		out.RawString(prefix)
+		if m, ok := in.After.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.After.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.After))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HistoryFieldChange) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto110(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HistoryFieldChange) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto110(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HistoryFieldChange) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto110(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HistoryFieldChange) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto110(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto111(in *jlexer.Lexer, out *HistoryEntryID) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "entry_id":
+			out.Value = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto111(out *jwriter.Writer, in HistoryEntryID) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"entry_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Value))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HistoryEntryID) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto111(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HistoryEntryID) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto111(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HistoryEntryID) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto111(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HistoryEntryID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto111(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto112(in *jlexer.Lexer, out *GuestWorkspaceReturn) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "WorkspaceID":
+			out.WorkspaceID = uint64(in.Uint64())
+		case "WorkspaceName":
+			out.WorkspaceName = string(in.String())
+		case "WorkspaceDateCreated":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.WorkspaceDateCreated).UnmarshalJSON(data))
+			}
+		case "owner_id":
+			out.ID = uint64(in.Uint64())
+		case "owner_email":
+			out.Email = string(in.String())
+		case "owner_name":
+			if in.IsNull() {
+				in.Skip()
+				out.Name = nil
+			} else {
+				if out.Name == nil {
+					out.Name = new(string)
+				}
+				*out.Name = string(in.String())
+			}
+		case "owner_surname":
+			if in.IsNull() {
+				in.Skip()
+				out.Surname = nil
+			} else {
+				if out.Surname == nil {
+					out.Surname = new(string)
+				}
+				*out.Surname = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto112(out *jwriter.Writer, in GuestWorkspaceReturn) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"WorkspaceID\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.WorkspaceID))
+	}
+	{
+		const prefix string = ",\"WorkspaceName\":"
+		out.RawString(prefix)
+		out.String(string(in.WorkspaceName))
+	}
+	{
+		const prefix string = ",\"WorkspaceDateCreated\":"
+		out.RawString(prefix)
+		out.Raw((in.WorkspaceDateCreated).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"owner_email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"owner_name\":"
+		out.RawString(prefix)
+		if in.Name == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Name))
+		}
+	}
+	{
+		const prefix string = ",\"owner_surname\":"
+		out.RawString(prefix)
+		if in.Surname == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Surname))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GuestWorkspaceReturn) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto112(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GuestWorkspaceReturn) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto112(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GuestWorkspaceReturn) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto112(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GuestWorkspaceReturn) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto112(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto113(in *jlexer.Lexer, out *FullBoardResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "board":
+			(out.Board).UnmarshalEasyJSON(in)
+		case "lists":
+			if in.IsNull() {
+				in.Skip()
+				out.Lists = nil
+			} else {
+				in.Delim('[')
+				if out.Lists == nil {
+					if !in.IsDelim(']') {
+						out.Lists = make([]SingleListInfo, 0, 0)
+					} else {
+						out.Lists = []SingleListInfo{}
+					}
+				} else {
+					out.Lists = (out.Lists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v106 SingleListInfo
+					(v106).UnmarshalEasyJSON(in)
+					out.Lists = append(out.Lists, v106)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "cards":
+			if in.IsNull() {
+				in.Skip()
+				out.Tasks = nil
+			} else {
+				in.Delim('[')
+				if out.Tasks == nil {
+					if !in.IsDelim(']') {
+						out.Tasks = make([]SingleTaskInfo, 0, 0)
+					} else {
+						out.Tasks = []SingleTaskInfo{}
+					}
+				} else {
+					out.Tasks = (out.Tasks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v107 SingleTaskInfo
+					(v107).UnmarshalEasyJSON(in)
+					out.Tasks = append(out.Tasks, v107)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "users":
+			if in.IsNull() {
+				in.Skip()
+				out.Users = nil
+			} else {
+				in.Delim('[')
+				if out.Users == nil {
+					if !in.IsDelim(']') {
+						out.Users = make([]UserPublicInfo, 0, 1)
+					} else {
+						out.Users = []UserPublicInfo{}
+					}
+				} else {
+					out.Users = (out.Users)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v108 UserPublicInfo
+					(v108).UnmarshalEasyJSON(in)
+					out.Users = append(out.Users, v108)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "comments":
+			if in.IsNull() {
+				in.Skip()
+				out.Comments = nil
+			} else {
+				in.Delim('[')
+				if out.Comments == nil {
+					if !in.IsDelim(']') {
+						out.Comments = make([]CommentInfo, 0, 1)
+					} else {
+						out.Comments = []CommentInfo{}
+					}
+				} else {
+					out.Comments = (out.Comments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v109 CommentInfo
+					(v109).UnmarshalEasyJSON(in)
+					out.Comments = append(out.Comments, v109)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "checklists":
+			if in.IsNull() {
+				in.Skip()
+				out.Checklists = nil
+			} else {
+				in.Delim('[')
+				if out.Checklists == nil {
+					if !in.IsDelim(']') {
+						out.Checklists = make([]ChecklistInfo, 0, 1)
+					} else {
+						out.Checklists = []ChecklistInfo{}
+					}
+				} else {
+					out.Checklists = (out.Checklists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v110 ChecklistInfo
+					(v110).UnmarshalEasyJSON(in)
+					out.Checklists = append(out.Checklists, v110)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "checklist_items":
+			if in.IsNull() {
+				in.Skip()
+				out.ChecklistItems = nil
+			} else {
+				in.Delim('[')
+				if out.ChecklistItems == nil {
+					if !in.IsDelim(']') {
+						out.ChecklistItems = make([]ChecklistItemInfo, 0, 1)
+					} else {
+						out.ChecklistItems = []ChecklistItemInfo{}
+					}
+				} else {
+					out.ChecklistItems = (out.ChecklistItems)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v111 ChecklistItemInfo
+					(v111).UnmarshalEasyJSON(in)
+					out.ChecklistItems = append(out.ChecklistItems, v111)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]TagInfo, 0, 1)
+					} else {
+						out.Tags = []TagInfo{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v112 TagInfo
+					(v112).UnmarshalEasyJSON(in)
+					out.Tags = append(out.Tags, v112)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "custom_fields":
+			if in.IsNull() {
+				in.Skip()
+				out.CustomFields = nil
+			} else {
+				in.Delim('[')
+				if out.CustomFields == nil {
+					if !in.IsDelim(']') {
+						out.CustomFields = make([]CustomFieldInfo, 0, 0)
+					} else {
+						out.CustomFields = []CustomFieldInfo{}
+					}
+				} else {
+					out.CustomFields = (out.CustomFields)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v113 CustomFieldInfo
+					(v113).UnmarshalEasyJSON(in)
+					out.CustomFields = append(out.CustomFields, v113)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "custom_field_values":
+			if in.IsNull() {
+				in.Skip()
+				out.CustomFieldValues = nil
+			} else {
+				in.Delim('[')
+				if out.CustomFieldValues == nil {
+					if !in.IsDelim(']') {
+						out.CustomFieldValues = make([]CustomFieldValueInfo, 0, 2)
+					} else {
+						out.CustomFieldValues = []CustomFieldValueInfo{}
+					}
+				} else {
+					out.CustomFieldValues = (out.CustomFieldValues)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v114 CustomFieldValueInfo
+					(v114).UnmarshalEasyJSON(in)
+					out.CustomFieldValues = append(out.CustomFieldValues, v114)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto113(out *jwriter.Writer, in FullBoardResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"board\":"
+		out.RawString(prefix[1:])
+		(in.Board).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"lists\":"
+		out.RawString(prefix)
+		if in.Lists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v115, v116 := range in.Lists {
+				if v115 > 0 {
+					out.RawByte(',')
+				}
+				(v116).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"cards\":"
+		out.RawString(prefix)
+		if in.Tasks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v117, v118 := range in.Tasks {
+				if v117 > 0 {
+					out.RawByte(',')
+				}
+				(v118).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"users\":"
+		out.RawString(prefix)
+		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v119, v120 := range in.Users {
+				if v119 > 0 {
+					out.RawByte(',')
+				}
+				(v120).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix)
+		if in.Comments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v121, v122 := range in.Comments {
+				if v121 > 0 {
+					out.RawByte(',')
+				}
+				(v122).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"checklists\":"
+		out.RawString(prefix)
+		if in.Checklists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v123, v124 := range in.Checklists {
+				if v123 > 0 {
+					out.RawByte(',')
+				}
+				(v124).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"checklist_items\":"
+		out.RawString(prefix)
+		if in.ChecklistItems == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v125, v126 := range in.ChecklistItems {
+				if v125 > 0 {
+					out.RawByte(',')
+				}
+				(v126).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v127, v128 := range in.Tags {
+				if v127 > 0 {
+					out.RawByte(',')
+				}
+				(v128).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"custom_fields\":"
+		out.RawString(prefix)
+		if in.CustomFields == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v129, v130 := range in.CustomFields {
+				if v129 > 0 {
+					out.RawByte(',')
+				}
+				(v130).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"custom_field_values\":"
+		out.RawString(prefix)
+		if in.CustomFieldValues == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v131, v132 := range in.CustomFieldValues {
+				if v131 > 0 {
+					out.RawByte(',')
+				}
+				(v132).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FullBoardResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto113(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FullBoardResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto113(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FullBoardResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto113(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FullBoardResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto113(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto114(in *jlexer.Lexer, out *DueRecurrence) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
+		case "rule":
+			out.Rule = string(in.String())
+		case "timezone":
+			out.Timezone = string(in.String())
+		case "due_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DueAt).UnmarshalJSON(data))
+			}
+		case "task_start":
+			if in.IsNull() {
+				in.Skip()
+				out.TaskStart = nil
+			} else {
+				if out.TaskStart == nil {
+					out.TaskStart = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.TaskStart).UnmarshalJSON(data))
+				}
+			}
+		case "task_end":
+			if in.IsNull() {
+				in.Skip()
+				out.TaskEnd = nil
+			} else {
+				if out.TaskEnd == nil {
+					out.TaskEnd = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.TaskEnd).UnmarshalJSON(data))
+				}
+			}
+		case "all_day":
+			out.AllDay = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto114(out *jwriter.Writer, in DueRecurrence) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TaskID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.UserID))
+	}
+	{
+		const prefix string = ",\"rule\":"
+		out.RawString(prefix)
+		out.String(string(in.Rule))
+	}
+	{
+		const prefix string = ",\"timezone\":"
+		out.RawString(prefix)
+		out.String(string(in.Timezone))
+	}
+	{
+		const prefix string = ",\"due_at\":"
+		out.RawString(prefix)
+		out.Raw((in.DueAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"task_start\":"
+		out.RawString(prefix)
+		if in.TaskStart == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.TaskStart).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"task_end\":"
+		out.RawString(prefix)
+		if in.TaskEnd == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.TaskEnd).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"all_day\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AllDay))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DueRecurrence) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto114(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DueRecurrence) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto114(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DueRecurrence) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto114(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DueRecurrence) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto114(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto115(in *jlexer.Lexer, out *DroppedFieldValue) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "field_id":
+			out.FieldID = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto115(out *jwriter.Writer, in DroppedFieldValue) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.TaskID))
+	}
+	{
+		const prefix string = ",\"field_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.FieldID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DroppedFieldValue) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto115(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DroppedFieldValue) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto115(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DroppedFieldValue) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto115(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DroppedFieldValue) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto115(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto116(in *jlexer.Lexer, out *DroppedAssignee) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto116(out *jwriter.Writer, in DroppedAssignee) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.TaskID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.UserID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DroppedAssignee) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto116(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DroppedAssignee) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto116(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DroppedAssignee) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto116(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DroppedAssignee) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto116(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto117(in *jlexer.Lexer, out *DeletedEntity) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "entity_type":
+			out.EntityType = string(in.String())
+		case "entity_id":
+			out.EntityID = uint64(in.Uint64())
+		case "version":
+			out.Version = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto117(out *jwriter.Writer, in DeletedEntity) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"entity_type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.EntityType))
+	}
+	{
+		const prefix string = ",\"entity_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.EntityID))
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeletedEntity) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto117(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeletedEntity) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto117(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeletedEntity) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto117(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeletedEntity) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto117(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto118(in *jlexer.Lexer, out *CustomFieldValueInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "field_id":
+			out.FieldID = uint64(in.Uint64())
+		case "value":
+			if m, ok := out.Value.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Value.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Value = in.Interface()
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto118(out *jwriter.Writer, in CustomFieldValueInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.TaskID))
+	}
+	{
+		const prefix string = ",\"field_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.FieldID))
+	}
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix)
+		if m, ok := in.Value.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Value.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Value))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CustomFieldValueInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto118(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CustomFieldValueInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto118(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CustomFieldValueInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto118(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CustomFieldValueInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto118(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto119(in *jlexer.Lexer, out *CustomFieldInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "board_id":
+			out.BoardID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]string, 0, 4)
+					} else {
+						out.Options = []string{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v133 string
+					v133 = string(in.String())
+					out.Options = append(out.Options, v133)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "list_position":
+			out.ListPosition = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto119(out *jwriter.Writer, in CustomFieldInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
 		const prefix string = ",\"board_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.BoardID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		if in.Options == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v134, v135 := range in.Options {
+				if v134 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v135))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"list_position\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ListPosition))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CustomFieldInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto119(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CustomFieldInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto119(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CustomFieldInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto119(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CustomFieldInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto119(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto120(in *jlexer.Lexer, out *CustomFieldID) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Value = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto120(out *jwriter.Writer, in CustomFieldID) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Value))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CustomFieldID) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto120(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CustomFieldID) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto120(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CustomFieldID) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto120(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CustomFieldID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto120(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto121(in *jlexer.Lexer, out *CustomFieldFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "field_id":
+			out.FieldID = uint64(in.Uint64())
+		case "value":
+			if m, ok := out.Value.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Value.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Value = in.Interface()
+			}
+		case "from":
+			if m, ok := out.From.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.From.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.From = in.Interface()
+			}
+		case "to":
+			if m, ok := out.To.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.To.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.To = in.Interface()
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto121(out *jwriter.Writer, in CustomFieldFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"field_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.FieldID))
+	}
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix)
+		if m, ok := in.Value.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Value.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Value))
+		}
+	}
+	{
+		const prefix string = ",\"from\":"
+		out.RawString(prefix)
+		if m, ok := in.From.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.From.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.From))
+		}
+	}
+	{
+		const prefix string = ",\"to\":"
+		out.RawString(prefix)
+		if m, ok := in.To.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.To.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.To))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CustomFieldFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto121(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CustomFieldFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto121(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CustomFieldFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto121(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CustomFieldFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto121(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto122(in *jlexer.Lexer, out *CommentInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
+		case "text":
+			out.Text = string(in.String())
+		case "date_created":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DateCreated).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto122(out *jwriter.Writer, in CommentInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.UserID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"date_created\":"
+		out.RawString(prefix)
+		out.Raw((in.DateCreated).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CommentInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto122(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CommentInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto122(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CommentInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto122(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CommentInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto122(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto123(in *jlexer.Lexer, out *CommentIDs) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ids":
+			if in.IsNull() {
+				in.Skip()
+				out.Values = nil
+			} else {
+				in.Delim('[')
+				if out.Values == nil {
+					if !in.IsDelim(']') {
+						out.Values = make([]string, 0, 4)
+					} else {
+						out.Values = []string{}
+					}
+				} else {
+					out.Values = (out.Values)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v136 string
+					v136 = string(in.String())
+					out.Values = append(out.Values, v136)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto123(out *jwriter.Writer, in CommentIDs) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ids\":"
+		out.RawString(prefix[1:])
+		if in.Values == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v137, v138 := range in.Values {
+				if v137 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v138))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CommentIDs) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto123(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CommentIDs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto123(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CommentIDs) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto123(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CommentIDs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto123(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto124(in *jlexer.Lexer, out *CommentID) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Value = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto124(out *jwriter.Writer, in CommentID) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Value))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CommentID) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto124(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CommentID) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto124(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CommentID) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto124(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CommentID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto124(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto125(in *jlexer.Lexer, out *ChecklistItemStringIDs) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ids":
+			if in.IsNull() {
+				in.Skip()
+				out.Values = nil
+			} else {
+				in.Delim('[')
+				if out.Values == nil {
+					if !in.IsDelim(']') {
+						out.Values = make([]string, 0, 4)
+					} else {
+						out.Values = []string{}
+					}
+				} else {
+					out.Values = (out.Values)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v139 string
+					v139 = string(in.String())
+					out.Values = append(out.Values, v139)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto125(out *jwriter.Writer, in ChecklistItemStringIDs) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ids\":"
+		out.RawString(prefix[1:])
+		if in.Values == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v140, v141 := range in.Values {
+				if v140 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v141))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChecklistItemStringIDs) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto125(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChecklistItemStringIDs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto125(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChecklistItemStringIDs) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto125(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChecklistItemStringIDs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto125(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto126(in *jlexer.Lexer, out *ChecklistItemMoveInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "item_id":
+			out.ItemID = uint64(in.Uint64())
+		case "after_id":
+			if in.IsNull() {
+				in.Skip()
+				out.AfterID = nil
+			} else {
+				if out.AfterID == nil {
+					out.AfterID = new(uint64)
+				}
+				*out.AfterID = uint64(in.Uint64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto126(out *jwriter.Writer, in ChecklistItemMoveInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"item_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ItemID))
+	}
+	{
+		const prefix string = ",\"after_id\":"
+		out.RawString(prefix)
+		if in.AfterID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.AfterID))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChecklistItemMoveInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto126(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChecklistItemMoveInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto126(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChecklistItemMoveInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto126(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChecklistItemMoveInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto126(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto127(in *jlexer.Lexer, out *ChecklistItemInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "checklist_id":
+			out.ChecklistID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "done":
+			out.Done = bool(in.Bool())
+		case "list_position":
+			out.ListPosition = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto127(out *jwriter.Writer, in ChecklistItemInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
 		out.Uint64(uint64(in.ID))
 	}
 	{
-		const prefix string = ",\"owner_id\":"
+		const prefix string = ",\"checklist_id\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.OwnerID))
+		out.Uint64(uint64(in.ChecklistID))
 	}
 	{
-		const prefix string = ",\"owner_email\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.String(string(in.OwnerEmail))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"board_name\":"
+		const prefix string = ",\"done\":"
 		out.RawString(prefix)
-		if in.Name == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Name))
+		out.Bool(bool(in.Done))
+	}
+	{
+		const prefix string = ",\"list_position\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ListPosition))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChecklistItemInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto127(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChecklistItemInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto127(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChecklistItemInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto127(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChecklistItemInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto127(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto128(in *jlexer.Lexer, out *ChecklistItemID) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Value = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto128(out *jwriter.Writer, in ChecklistItemID) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"thumbnail_url\":"
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Value))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChecklistItemID) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto128(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChecklistItemID) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto128(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChecklistItemID) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto128(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChecklistItemID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto128(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto129(in *jlexer.Lexer, out *ChecklistInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "list_position":
+			out.ListPosition = uint64(in.Uint64())
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]string, 0, 4)
+					} else {
+						out.Items = []string{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v142 string
+					v142 = string(in.String())
+					out.Items = append(out.Items, v142)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto129(out *jwriter.Writer, in ChecklistInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"task_id\":"
 		out.RawString(prefix)
-		if in.ThumbnailURL == nil {
+		out.Uint64(uint64(in.TaskID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"list_position\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ListPosition))
+	}
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix)
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			out.String(string(*in.ThumbnailURL))
+			out.RawByte('[')
+			for v143, v144 := range in.Items {
+				if v143 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v144))
+			}
+			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v IndividualBoardInfo) MarshalJSON() ([]byte, error) {
+func (v ChecklistInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto67(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto129(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v IndividualBoardInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto67(w, v)
+func (v ChecklistInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto129(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *IndividualBoardInfo) UnmarshalJSON(data []byte) error {
+func (v *ChecklistInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto67(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto129(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *IndividualBoardInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto67(l, v)
+func (v *ChecklistInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto129(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto68(in *jlexer.Lexer, out *ImageUrl) {
+func easyjson56de76c1DecodeServerInternalPkgDto130(in *jlexer.Lexer, out *ChecklistIDs) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6199,8 +13553,29 @@ func easyjson56de76c1DecodeServerInternalPkgDto68(in *jlexer.Lexer, out *ImageUr
 			continue
 		}
 		switch key {
-		case "url":
-			out.Value = string(in.String())
+		case "ids":
+			if in.IsNull() {
+				in.Skip()
+				out.Values = nil
+			} else {
+				in.Delim('[')
+				if out.Values == nil {
+					if !in.IsDelim(']') {
+						out.Values = make([]string, 0, 4)
+					} else {
+						out.Values = []string{}
+					}
+				} else {
+					out.Values = (out.Values)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v145 string
+					v145 = string(in.String())
+					out.Values = append(out.Values, v145)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -6211,42 +13586,53 @@ func easyjson56de76c1DecodeServerInternalPkgDto68(in *jlexer.Lexer, out *ImageUr
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto68(out *jwriter.Writer, in ImageUrl) {
+func easyjson56de76c1EncodeServerInternalPkgDto130(out *jwriter.Writer, in ChecklistIDs) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"url\":"
+		const prefix string = ",\"ids\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Value))
+		if in.Values == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v146, v147 := range in.Values {
+				if v146 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v147))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ImageUrl) MarshalJSON() ([]byte, error) {
+func (v ChecklistIDs) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto68(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto130(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ImageUrl) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto68(w, v)
+func (v ChecklistIDs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto130(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ImageUrl) UnmarshalJSON(data []byte) error {
+func (v *ChecklistIDs) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto68(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto130(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ImageUrl) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto68(l, v)
+func (v *ChecklistIDs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto130(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto69(in *jlexer.Lexer, out *GuestWorkspaceReturn) {
+func easyjson56de76c1DecodeServerInternalPkgDto131(in *jlexer.Lexer, out *ChecklistID) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6265,38 +13651,8 @@ func easyjson56de76c1DecodeServerInternalPkgDto69(in *jlexer.Lexer, out *GuestWo
 			continue
 		}
 		switch key {
-		case "WorkspaceID":
-			out.WorkspaceID = uint64(in.Uint64())
-		case "WorkspaceName":
-			out.WorkspaceName = string(in.String())
-		case "WorkspaceDateCreated":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.WorkspaceDateCreated).UnmarshalJSON(data))
-			}
-		case "owner_id":
-			out.ID = uint64(in.Uint64())
-		case "owner_email":
-			out.Email = string(in.String())
-		case "owner_name":
-			if in.IsNull() {
-				in.Skip()
-				out.Name = nil
-			} else {
-				if out.Name == nil {
-					out.Name = new(string)
-				}
-				*out.Name = string(in.String())
-			}
-		case "owner_surname":
-			if in.IsNull() {
-				in.Skip()
-				out.Surname = nil
-			} else {
-				if out.Surname == nil {
-					out.Surname = new(string)
-				}
-				*out.Surname = string(in.String())
-			}
+		case "id":
+			out.Value = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -6307,80 +13663,42 @@ func easyjson56de76c1DecodeServerInternalPkgDto69(in *jlexer.Lexer, out *GuestWo
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto69(out *jwriter.Writer, in GuestWorkspaceReturn) {
+func easyjson56de76c1EncodeServerInternalPkgDto131(out *jwriter.Writer, in ChecklistID) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"WorkspaceID\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.WorkspaceID))
-	}
-	{
-		const prefix string = ",\"WorkspaceName\":"
-		out.RawString(prefix)
-		out.String(string(in.WorkspaceName))
-	}
-	{
-		const prefix string = ",\"WorkspaceDateCreated\":"
-		out.RawString(prefix)
-		out.Raw((in.WorkspaceDateCreated).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"owner_id\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.ID))
-	}
-	{
-		const prefix string = ",\"owner_email\":"
-		out.RawString(prefix)
-		out.String(string(in.Email))
-	}
-	{
-		const prefix string = ",\"owner_name\":"
-		out.RawString(prefix)
-		if in.Name == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Name))
-		}
-	}
-	{
-		const prefix string = ",\"owner_surname\":"
-		out.RawString(prefix)
-		if in.Surname == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Surname))
-		}
+		out.Uint64(uint64(in.Value))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v GuestWorkspaceReturn) MarshalJSON() ([]byte, error) {
+func (v ChecklistID) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto69(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto131(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v GuestWorkspaceReturn) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto69(w, v)
+func (v ChecklistID) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto131(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *GuestWorkspaceReturn) UnmarshalJSON(data []byte) error {
+func (v *ChecklistID) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto69(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto131(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *GuestWorkspaceReturn) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto69(l, v)
+func (v *ChecklistID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto131(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto70(in *jlexer.Lexer, out *FullBoardResult) {
+func easyjson56de76c1DecodeServerInternalPkgDto132(in *jlexer.Lexer, out *CheckTaskAccessInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6388,180 +13706,21 @@ func easyjson56de76c1DecodeServerInternalPkgDto70(in *jlexer.Lexer, out *FullBoa
 		}
 		in.Skip()
 		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "board":
-			(out.Board).UnmarshalEasyJSON(in)
-		case "lists":
-			if in.IsNull() {
-				in.Skip()
-				out.Lists = nil
-			} else {
-				in.Delim('[')
-				if out.Lists == nil {
-					if !in.IsDelim(']') {
-						out.Lists = make([]SingleListInfo, 0, 1)
-					} else {
-						out.Lists = []SingleListInfo{}
-					}
-				} else {
-					out.Lists = (out.Lists)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v40 SingleListInfo
-					(v40).UnmarshalEasyJSON(in)
-					out.Lists = append(out.Lists, v40)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "cards":
-			if in.IsNull() {
-				in.Skip()
-				out.Tasks = nil
-			} else {
-				in.Delim('[')
-				if out.Tasks == nil {
-					if !in.IsDelim(']') {
-						out.Tasks = make([]SingleTaskInfo, 0, 0)
-					} else {
-						out.Tasks = []SingleTaskInfo{}
-					}
-				} else {
-					out.Tasks = (out.Tasks)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v41 SingleTaskInfo
-					(v41).UnmarshalEasyJSON(in)
-					out.Tasks = append(out.Tasks, v41)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "users":
-			if in.IsNull() {
-				in.Skip()
-				out.Users = nil
-			} else {
-				in.Delim('[')
-				if out.Users == nil {
-					if !in.IsDelim(']') {
-						out.Users = make([]UserPublicInfo, 0, 1)
-					} else {
-						out.Users = []UserPublicInfo{}
-					}
-				} else {
-					out.Users = (out.Users)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v42 UserPublicInfo
-					(v42).UnmarshalEasyJSON(in)
-					out.Users = append(out.Users, v42)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "comments":
-			if in.IsNull() {
-				in.Skip()
-				out.Comments = nil
-			} else {
-				in.Delim('[')
-				if out.Comments == nil {
-					if !in.IsDelim(']') {
-						out.Comments = make([]CommentInfo, 0, 1)
-					} else {
-						out.Comments = []CommentInfo{}
-					}
-				} else {
-					out.Comments = (out.Comments)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v43 CommentInfo
-					(v43).UnmarshalEasyJSON(in)
-					out.Comments = append(out.Comments, v43)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "checklists":
-			if in.IsNull() {
-				in.Skip()
-				out.Checklists = nil
-			} else {
-				in.Delim('[')
-				if out.Checklists == nil {
-					if !in.IsDelim(']') {
-						out.Checklists = make([]ChecklistInfo, 0, 1)
-					} else {
-						out.Checklists = []ChecklistInfo{}
-					}
-				} else {
-					out.Checklists = (out.Checklists)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v44 ChecklistInfo
-					(v44).UnmarshalEasyJSON(in)
-					out.Checklists = append(out.Checklists, v44)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "checklist_items":
-			if in.IsNull() {
-				in.Skip()
-				out.ChecklistItems = nil
-			} else {
-				in.Delim('[')
-				if out.ChecklistItems == nil {
-					if !in.IsDelim(']') {
-						out.ChecklistItems = make([]ChecklistItemInfo, 0, 1)
-					} else {
-						out.ChecklistItems = []ChecklistItemInfo{}
-					}
-				} else {
-					out.ChecklistItems = (out.ChecklistItems)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v45 ChecklistItemInfo
-					(v45).UnmarshalEasyJSON(in)
-					out.ChecklistItems = append(out.ChecklistItems, v45)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "tags":
-			if in.IsNull() {
-				in.Skip()
-				out.Tags = nil
-			} else {
-				in.Delim('[')
-				if out.Tags == nil {
-					if !in.IsDelim(']') {
-						out.Tags = make([]TagInfo, 0, 1)
-					} else {
-						out.Tags = []TagInfo{}
-					}
-				} else {
-					out.Tags = (out.Tags)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v46 TagInfo
-					(v46).UnmarshalEasyJSON(in)
-					out.Tags = append(out.Tags, v46)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
+		case "task_id":
+			out.TaskID = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -6572,154 +13731,47 @@ func easyjson56de76c1DecodeServerInternalPkgDto70(in *jlexer.Lexer, out *FullBoa
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto70(out *jwriter.Writer, in FullBoardResult) {
+func easyjson56de76c1EncodeServerInternalPkgDto132(out *jwriter.Writer, in CheckTaskAccessInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"board\":"
+		const prefix string = ",\"user_id\":"
 		out.RawString(prefix[1:])
-		(in.Board).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"lists\":"
-		out.RawString(prefix)
-		if in.Lists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v47, v48 := range in.Lists {
-				if v47 > 0 {
-					out.RawByte(',')
-				}
-				(v48).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"cards\":"
-		out.RawString(prefix)
-		if in.Tasks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v49, v50 := range in.Tasks {
-				if v49 > 0 {
-					out.RawByte(',')
-				}
-				(v50).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"users\":"
-		out.RawString(prefix)
-		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v51, v52 := range in.Users {
-				if v51 > 0 {
-					out.RawByte(',')
-				}
-				(v52).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"comments\":"
-		out.RawString(prefix)
-		if in.Comments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v53, v54 := range in.Comments {
-				if v53 > 0 {
-					out.RawByte(',')
-				}
-				(v54).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"checklists\":"
-		out.RawString(prefix)
-		if in.Checklists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v55, v56 := range in.Checklists {
-				if v55 > 0 {
-					out.RawByte(',')
-				}
-				(v56).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"checklist_items\":"
-		out.RawString(prefix)
-		if in.ChecklistItems == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v57, v58 := range in.ChecklistItems {
-				if v57 > 0 {
-					out.RawByte(',')
-				}
-				(v58).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.Uint64(uint64(in.UserID))
 	}
 	{
-		const prefix string = ",\"tags\":"
+		const prefix string = ",\"task_id\":"
 		out.RawString(prefix)
-		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v59, v60 := range in.Tags {
-				if v59 > 0 {
-					out.RawByte(',')
-				}
-				(v60).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.Uint64(uint64(in.TaskID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FullBoardResult) MarshalJSON() ([]byte, error) {
+func (v CheckTaskAccessInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto70(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto132(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FullBoardResult) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto70(w, v)
+func (v CheckTaskAccessInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto132(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FullBoardResult) UnmarshalJSON(data []byte) error {
+func (v *CheckTaskAccessInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto70(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto132(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FullBoardResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto70(l, v)
+func (v *CheckTaskAccessInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto132(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto71(in *jlexer.Lexer, out *CommentInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto133(in *jlexer.Lexer, out *CheckBoardAccessInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6738,16 +13790,10 @@ func easyjson56de76c1DecodeServerInternalPkgDto71(in *jlexer.Lexer, out *Comment
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = uint64(in.Uint64())
 		case "user_id":
 			out.UserID = uint64(in.Uint64())
-		case "text":
-			out.Text = string(in.String())
-		case "date_created":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.DateCreated).UnmarshalJSON(data))
-			}
+		case "board_id":
+			out.BoardID = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -6758,57 +13804,47 @@ func easyjson56de76c1DecodeServerInternalPkgDto71(in *jlexer.Lexer, out *Comment
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto71(out *jwriter.Writer, in CommentInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto133(out *jwriter.Writer, in CheckBoardAccessInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
-	}
 	{
 		const prefix string = ",\"user_id\":"
-		out.RawString(prefix)
+		out.RawString(prefix[1:])
 		out.Uint64(uint64(in.UserID))
 	}
 	{
-		const prefix string = ",\"text\":"
-		out.RawString(prefix)
-		out.String(string(in.Text))
-	}
-	{
-		const prefix string = ",\"date_created\":"
+		const prefix string = ",\"board_id\":"
 		out.RawString(prefix)
-		out.Raw((in.DateCreated).MarshalJSON())
+		out.Uint64(uint64(in.BoardID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CommentInfo) MarshalJSON() ([]byte, error) {
+func (v CheckBoardAccessInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto71(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto133(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CommentInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto71(w, v)
+func (v CheckBoardAccessInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto133(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CommentInfo) UnmarshalJSON(data []byte) error {
+func (v *CheckBoardAccessInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto71(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto133(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CommentInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto71(l, v)
+func (v *CheckBoardAccessInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto133(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto72(in *jlexer.Lexer, out *CommentIDs) {
+func easyjson56de76c1DecodeServerInternalPkgDto134(in *jlexer.Lexer, out *ChangeWorkspaceGuestsInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6827,25 +13863,27 @@ func easyjson56de76c1DecodeServerInternalPkgDto72(in *jlexer.Lexer, out *Comment
 			continue
 		}
 		switch key {
-		case "ids":
+		case "id":
+			out.WorkspaceID = uint64(in.Uint64())
+		case "guests":
 			if in.IsNull() {
 				in.Skip()
-				out.Values = nil
+				out.Guests = nil
 			} else {
 				in.Delim('[')
-				if out.Values == nil {
+				if out.Guests == nil {
 					if !in.IsDelim(']') {
-						out.Values = make([]string, 0, 4)
+						out.Guests = make([]UserID, 0, 8)
 					} else {
-						out.Values = []string{}
+						out.Guests = []UserID{}
 					}
 				} else {
-					out.Values = (out.Values)[:0]
+					out.Guests = (out.Guests)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v61 string
-					v61 = string(in.String())
-					out.Values = append(out.Values, v61)
+					var v148 UserID
+					(v148).UnmarshalEasyJSON(in)
+					out.Guests = append(out.Guests, v148)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -6860,22 +13898,27 @@ func easyjson56de76c1DecodeServerInternalPkgDto72(in *jlexer.Lexer, out *Comment
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto72(out *jwriter.Writer, in CommentIDs) {
+func easyjson56de76c1EncodeServerInternalPkgDto134(out *jwriter.Writer, in ChangeWorkspaceGuestsInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"ids\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		if in.Values == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.Uint64(uint64(in.WorkspaceID))
+	}
+	{
+		const prefix string = ",\"guests\":"
+		out.RawString(prefix)
+		if in.Guests == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v62, v63 := range in.Values {
-				if v62 > 0 {
+			for v149, v150 := range in.Guests {
+				if v149 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v63))
+				(v150).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -6884,29 +13927,29 @@ func easyjson56de76c1EncodeServerInternalPkgDto72(out *jwriter.Writer, in Commen
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CommentIDs) MarshalJSON() ([]byte, error) {
+func (v ChangeWorkspaceGuestsInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto72(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto134(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CommentIDs) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto72(w, v)
+func (v ChangeWorkspaceGuestsInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto134(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CommentIDs) UnmarshalJSON(data []byte) error {
+func (v *ChangeWorkspaceGuestsInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto72(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto134(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CommentIDs) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto72(l, v)
+func (v *ChangeWorkspaceGuestsInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto134(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto73(in *jlexer.Lexer, out *CommentID) {
+func easyjson56de76c1DecodeServerInternalPkgDto135(in *jlexer.Lexer, out *CSRFToken) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6925,8 +13968,8 @@ func easyjson56de76c1DecodeServerInternalPkgDto73(in *jlexer.Lexer, out *Comment
 			continue
 		}
 		switch key {
-		case "id":
-			out.Value = uint64(in.Uint64())
+		case "csrf_token":
+			out.Value = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -6937,42 +13980,42 @@ func easyjson56de76c1DecodeServerInternalPkgDto73(in *jlexer.Lexer, out *Comment
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto73(out *jwriter.Writer, in CommentID) {
+func easyjson56de76c1EncodeServerInternalPkgDto135(out *jwriter.Writer, in CSRFToken) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"csrf_token\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.Value))
+		out.String(string(in.Value))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CommentID) MarshalJSON() ([]byte, error) {
+func (v CSRFToken) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto73(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto135(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CommentID) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto73(w, v)
+func (v CSRFToken) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto135(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CommentID) UnmarshalJSON(data []byte) error {
+func (v *CSRFToken) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto73(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto135(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CommentID) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto73(l, v)
+func (v *CSRFToken) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto135(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto74(in *jlexer.Lexer, out *ChecklistItemStringIDs) {
+func easyjson56de76c1DecodeServerInternalPkgDto136(in *jlexer.Lexer, out *CSRFData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6991,28 +14034,11 @@ func easyjson56de76c1DecodeServerInternalPkgDto74(in *jlexer.Lexer, out *Checkli
 			continue
 		}
 		switch key {
-		case "ids":
-			if in.IsNull() {
-				in.Skip()
-				out.Values = nil
-			} else {
-				in.Delim('[')
-				if out.Values == nil {
-					if !in.IsDelim(']') {
-						out.Values = make([]string, 0, 4)
-					} else {
-						out.Values = []string{}
-					}
-				} else {
-					out.Values = (out.Values)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v64 string
-					v64 = string(in.String())
-					out.Values = append(out.Values, v64)
-					in.WantComma()
-				}
-				in.Delim(']')
+		case "csrf_token":
+			out.Token = string(in.String())
+		case "expiration_date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExpirationDate).UnmarshalJSON(data))
 			}
 		default:
 			in.SkipRecursive()
@@ -7024,53 +14050,47 @@ func easyjson56de76c1DecodeServerInternalPkgDto74(in *jlexer.Lexer, out *Checkli
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto74(out *jwriter.Writer, in ChecklistItemStringIDs) {
+func easyjson56de76c1EncodeServerInternalPkgDto136(out *jwriter.Writer, in CSRFData) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"ids\":"
+		const prefix string = ",\"csrf_token\":"
 		out.RawString(prefix[1:])
-		if in.Values == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v65, v66 := range in.Values {
-				if v65 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v66))
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Token))
+	}
+	{
+		const prefix string = ",\"expiration_date\":"
+		out.RawString(prefix)
+		out.Raw((in.ExpirationDate).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChecklistItemStringIDs) MarshalJSON() ([]byte, error) {
+func (v CSRFData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto74(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto136(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChecklistItemStringIDs) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto74(w, v)
+func (v CSRFData) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto136(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChecklistItemStringIDs) UnmarshalJSON(data []byte) error {
+func (v *CSRFData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto74(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto136(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChecklistItemStringIDs) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto74(l, v)
+func (v *CSRFData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto136(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto75(in *jlexer.Lexer, out *ChecklistItemInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto137(in *jlexer.Lexer, out *CSATRatingCheck) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7089,16 +14109,10 @@ func easyjson56de76c1DecodeServerInternalPkgDto75(in *jlexer.Lexer, out *Checkli
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = uint64(in.Uint64())
-		case "checklist_id":
-			out.ChecklistID = uint64(in.Uint64())
-		case "name":
-			out.Name = string(in.String())
-		case "done":
-			out.Done = bool(in.Bool())
-		case "list_position":
-			out.ListPosition = uint64(in.Uint64())
+		case "question_id":
+			out.QuestionID = uint64(in.Uint64())
+		case "max_rating":
+			out.MaxRating = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -7109,62 +14123,47 @@ func easyjson56de76c1DecodeServerInternalPkgDto75(in *jlexer.Lexer, out *Checkli
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto75(out *jwriter.Writer, in ChecklistItemInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto137(out *jwriter.Writer, in CSATRatingCheck) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"question_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
-	}
-	{
-		const prefix string = ",\"checklist_id\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.ChecklistID))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"done\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Done))
+		out.Uint64(uint64(in.QuestionID))
 	}
 	{
-		const prefix string = ",\"list_position\":"
+		const prefix string = ",\"max_rating\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.ListPosition))
+		out.Uint64(uint64(in.MaxRating))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChecklistItemInfo) MarshalJSON() ([]byte, error) {
+func (v CSATRatingCheck) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto75(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto137(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChecklistItemInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto75(w, v)
+func (v CSATRatingCheck) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto137(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChecklistItemInfo) UnmarshalJSON(data []byte) error {
+func (v *CSATRatingCheck) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto75(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto137(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChecklistItemInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto75(l, v)
+func (v *CSATRatingCheck) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto137(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto76(in *jlexer.Lexer, out *ChecklistItemIDs) {
+func easyjson56de76c1DecodeServerInternalPkgDto138(in *jlexer.Lexer, out *CSATQuestionTypeName) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7183,29 +14182,8 @@ func easyjson56de76c1DecodeServerInternalPkgDto76(in *jlexer.Lexer, out *Checkli
 			continue
 		}
 		switch key {
-		case "ids":
-			if in.IsNull() {
-				in.Skip()
-				out.Values = nil
-			} else {
-				in.Delim('[')
-				if out.Values == nil {
-					if !in.IsDelim(']') {
-						out.Values = make([]uint64, 0, 8)
-					} else {
-						out.Values = []uint64{}
-					}
-				} else {
-					out.Values = (out.Values)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v67 uint64
-					v67 = uint64(in.Uint64())
-					out.Values = append(out.Values, v67)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "type":
+			out.Value = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -7216,53 +14194,42 @@ func easyjson56de76c1DecodeServerInternalPkgDto76(in *jlexer.Lexer, out *Checkli
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto76(out *jwriter.Writer, in ChecklistItemIDs) {
+func easyjson56de76c1EncodeServerInternalPkgDto138(out *jwriter.Writer, in CSATQuestionTypeName) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"ids\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix[1:])
-		if in.Values == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v68, v69 := range in.Values {
-				if v68 > 0 {
-					out.RawByte(',')
-				}
-				out.Uint64(uint64(v69))
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Value))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChecklistItemIDs) MarshalJSON() ([]byte, error) {
+func (v CSATQuestionTypeName) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto76(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto138(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChecklistItemIDs) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto76(w, v)
+func (v CSATQuestionTypeName) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto138(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChecklistItemIDs) UnmarshalJSON(data []byte) error {
+func (v *CSATQuestionTypeName) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto76(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto138(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChecklistItemIDs) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto76(l, v)
+func (v *CSATQuestionTypeName) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto138(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto77(in *jlexer.Lexer, out *ChecklistItemID) {
+func easyjson56de76c1DecodeServerInternalPkgDto139(in *jlexer.Lexer, out *CSATQuestionID) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7281,7 +14248,7 @@ func easyjson56de76c1DecodeServerInternalPkgDto77(in *jlexer.Lexer, out *Checkli
 			continue
 		}
 		switch key {
-		case "id":
+		case "Value":
 			out.Value = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
@@ -7293,12 +14260,12 @@ func easyjson56de76c1DecodeServerInternalPkgDto77(in *jlexer.Lexer, out *Checkli
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto77(out *jwriter.Writer, in ChecklistItemID) {
+func easyjson56de76c1EncodeServerInternalPkgDto139(out *jwriter.Writer, in CSATQuestionID) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"Value\":"
 		out.RawString(prefix[1:])
 		out.Uint64(uint64(in.Value))
 	}
@@ -7306,29 +14273,29 @@ func easyjson56de76c1EncodeServerInternalPkgDto77(out *jwriter.Writer, in Checkl
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChecklistItemID) MarshalJSON() ([]byte, error) {
+func (v CSATQuestionID) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto77(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto139(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChecklistItemID) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto77(w, v)
+func (v CSATQuestionID) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto139(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChecklistItemID) UnmarshalJSON(data []byte) error {
+func (v *CSATQuestionID) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto77(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto139(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChecklistItemID) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto77(l, v)
+func (v *CSATQuestionID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto139(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto78(in *jlexer.Lexer, out *ChecklistInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto140(in *jlexer.Lexer, out *CSATQuestionFull) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7347,37 +14314,12 @@ func easyjson56de76c1DecodeServerInternalPkgDto78(in *jlexer.Lexer, out *Checkli
 			continue
 		}
 		switch key {
-		case "id":
+		case "question_id":
 			out.ID = uint64(in.Uint64())
-		case "task_id":
-			out.TaskID = uint64(in.Uint64())
-		case "name":
-			out.Name = string(in.String())
-		case "list_position":
-			out.ListPosition = uint64(in.Uint64())
-		case "items":
-			if in.IsNull() {
-				in.Skip()
-				out.Items = nil
-			} else {
-				in.Delim('[')
-				if out.Items == nil {
-					if !in.IsDelim(']') {
-						out.Items = make([]string, 0, 4)
-					} else {
-						out.Items = []string{}
-					}
-				} else {
-					out.Items = (out.Items)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v70 string
-					v70 = string(in.String())
-					out.Items = append(out.Items, v70)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "content":
+			out.Content = string(in.String())
+		case "type":
+			out.Type = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -7388,73 +14330,52 @@ func easyjson56de76c1DecodeServerInternalPkgDto78(in *jlexer.Lexer, out *Checkli
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto78(out *jwriter.Writer, in ChecklistInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto140(out *jwriter.Writer, in CSATQuestionFull) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"question_id\":"
 		out.RawString(prefix[1:])
 		out.Uint64(uint64(in.ID))
 	}
 	{
-		const prefix string = ",\"task_id\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.TaskID))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"list_position\":"
+		const prefix string = ",\"content\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.ListPosition))
+		out.String(string(in.Content))
 	}
 	{
-		const prefix string = ",\"items\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix)
-		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v71, v72 := range in.Items {
-				if v71 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v72))
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Type))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChecklistInfo) MarshalJSON() ([]byte, error) {
+func (v CSATQuestionFull) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto78(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto140(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChecklistInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto78(w, v)
+func (v CSATQuestionFull) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto140(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChecklistInfo) UnmarshalJSON(data []byte) error {
+func (v *CSATQuestionFull) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto78(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto140(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChecklistInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto78(l, v)
+func (v *CSATQuestionFull) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto140(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto79(in *jlexer.Lexer, out *ChecklistIDs) {
+func easyjson56de76c1DecodeServerInternalPkgDto141(in *jlexer.Lexer, out *CSATAnswerFull) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7473,29 +14394,12 @@ func easyjson56de76c1DecodeServerInternalPkgDto79(in *jlexer.Lexer, out *Checkli
 			continue
 		}
 		switch key {
-		case "ids":
-			if in.IsNull() {
-				in.Skip()
-				out.Values = nil
-			} else {
-				in.Delim('[')
-				if out.Values == nil {
-					if !in.IsDelim(']') {
-						out.Values = make([]string, 0, 4)
-					} else {
-						out.Values = []string{}
-					}
-				} else {
-					out.Values = (out.Values)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v73 string
-					v73 = string(in.String())
-					out.Values = append(out.Values, v73)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "answer_id":
+			out.ID = uint64(in.Uint64())
+		case "rating":
+			out.Content = string(in.String())
+		case "type":
+			out.Type = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -7506,53 +14410,52 @@ func easyjson56de76c1DecodeServerInternalPkgDto79(in *jlexer.Lexer, out *Checkli
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto79(out *jwriter.Writer, in ChecklistIDs) {
+func easyjson56de76c1EncodeServerInternalPkgDto141(out *jwriter.Writer, in CSATAnswerFull) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"ids\":"
+		const prefix string = ",\"answer_id\":"
 		out.RawString(prefix[1:])
-		if in.Values == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v74, v75 := range in.Values {
-				if v74 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v75))
-			}
-			out.RawByte(']')
-		}
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.String(string(in.Content))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChecklistIDs) MarshalJSON() ([]byte, error) {
+func (v CSATAnswerFull) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto79(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto141(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChecklistIDs) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto79(w, v)
+func (v CSATAnswerFull) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto141(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChecklistIDs) UnmarshalJSON(data []byte) error {
+func (v *CSATAnswerFull) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto79(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto141(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChecklistIDs) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto79(l, v)
+func (v *CSATAnswerFull) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto141(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto80(in *jlexer.Lexer, out *ChecklistID) {
+func easyjson56de76c1DecodeServerInternalPkgDto142(in *jlexer.Lexer, out *BoardWatcherInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7571,8 +14474,10 @@ func easyjson56de76c1DecodeServerInternalPkgDto80(in *jlexer.Lexer, out *Checkli
 			continue
 		}
 		switch key {
-		case "id":
-			out.Value = uint64(in.Uint64())
+		case "board_id":
+			out.BoardID = uint64(in.Uint64())
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -7583,42 +14488,47 @@ func easyjson56de76c1DecodeServerInternalPkgDto80(in *jlexer.Lexer, out *Checkli
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto80(out *jwriter.Writer, in ChecklistID) {
+func easyjson56de76c1EncodeServerInternalPkgDto142(out *jwriter.Writer, in BoardWatcherInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"board_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.Value))
+		out.Uint64(uint64(in.BoardID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.UserID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChecklistID) MarshalJSON() ([]byte, error) {
+func (v BoardWatcherInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto80(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto142(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChecklistID) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto80(w, v)
+func (v BoardWatcherInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto142(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChecklistID) UnmarshalJSON(data []byte) error {
+func (v *BoardWatcherInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto80(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto142(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChecklistID) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto80(l, v)
+func (v *BoardWatcherInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto142(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto81(in *jlexer.Lexer, out *CheckTaskAccessInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto143(in *jlexer.Lexer, out *BoardVersion) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7637,10 +14547,10 @@ func easyjson56de76c1DecodeServerInternalPkgDto81(in *jlexer.Lexer, out *CheckTa
 			continue
 		}
 		switch key {
-		case "user_id":
-			out.UserID = uint64(in.Uint64())
-		case "task_id":
-			out.TaskID = uint64(in.Uint64())
+		case "version":
+			out.Version = uint64(in.Uint64())
+		case "timezone":
+			out.Timezone = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -7651,47 +14561,47 @@ func easyjson56de76c1DecodeServerInternalPkgDto81(in *jlexer.Lexer, out *CheckTa
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto81(out *jwriter.Writer, in CheckTaskAccessInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto143(out *jwriter.Writer, in BoardVersion) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"version\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.UserID))
+		out.Uint64(uint64(in.Version))
 	}
 	{
-		const prefix string = ",\"task_id\":"
+		const prefix string = ",\"timezone\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.TaskID))
+		out.String(string(in.Timezone))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CheckTaskAccessInfo) MarshalJSON() ([]byte, error) {
+func (v BoardVersion) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto81(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto143(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CheckTaskAccessInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto81(w, v)
+func (v BoardVersion) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto143(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CheckTaskAccessInfo) UnmarshalJSON(data []byte) error {
+func (v *BoardVersion) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto81(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto143(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CheckTaskAccessInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto81(l, v)
+func (v *BoardVersion) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto143(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto82(in *jlexer.Lexer, out *CheckBoardAccessInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto144(in *jlexer.Lexer, out *BoardStatsRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7710,10 +14620,10 @@ func easyjson56de76c1DecodeServerInternalPkgDto82(in *jlexer.Lexer, out *CheckBo
 			continue
 		}
 		switch key {
-		case "user_id":
-			out.UserID = uint64(in.Uint64())
 		case "board_id":
 			out.BoardID = uint64(in.Uint64())
+		case "weeks":
+			out.Weeks = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -7724,47 +14634,47 @@ func easyjson56de76c1DecodeServerInternalPkgDto82(in *jlexer.Lexer, out *CheckBo
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto82(out *jwriter.Writer, in CheckBoardAccessInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto144(out *jwriter.Writer, in BoardStatsRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"board_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.UserID))
+		out.Uint64(uint64(in.BoardID))
 	}
 	{
-		const prefix string = ",\"board_id\":"
+		const prefix string = ",\"weeks\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.BoardID))
+		out.Uint64(uint64(in.Weeks))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CheckBoardAccessInfo) MarshalJSON() ([]byte, error) {
+func (v BoardStatsRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto82(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto144(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CheckBoardAccessInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto82(w, v)
+func (v BoardStatsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto144(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CheckBoardAccessInfo) UnmarshalJSON(data []byte) error {
+func (v *BoardStatsRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto82(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto144(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CheckBoardAccessInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto82(l, v)
+func (v *BoardStatsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto144(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto83(in *jlexer.Lexer, out *ChangeWorkspaceGuestsInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto145(in *jlexer.Lexer, out *BoardStats) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7783,27 +14693,110 @@ func easyjson56de76c1DecodeServerInternalPkgDto83(in *jlexer.Lexer, out *ChangeW
 			continue
 		}
 		switch key {
-		case "id":
-			out.WorkspaceID = uint64(in.Uint64())
-		case "guests":
+		case "board_id":
+			out.BoardID = uint64(in.Uint64())
+		case "task_count":
+			out.TaskCount = uint64(in.Uint64())
+		case "tasks_per_list":
 			if in.IsNull() {
 				in.Skip()
-				out.Guests = nil
+				out.TasksPerList = nil
 			} else {
 				in.Delim('[')
-				if out.Guests == nil {
+				if out.TasksPerList == nil {
 					if !in.IsDelim(']') {
-						out.Guests = make([]UserID, 0, 8)
+						out.TasksPerList = make([]ListTaskCount, 0, 1)
 					} else {
-						out.Guests = []UserID{}
+						out.TasksPerList = []ListTaskCount{}
 					}
 				} else {
-					out.Guests = (out.Guests)[:0]
+					out.TasksPerList = (out.TasksPerList)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v151 ListTaskCount
+					(v151).UnmarshalEasyJSON(in)
+					out.TasksPerList = append(out.TasksPerList, v151)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "tasks_per_assignee":
+			if in.IsNull() {
+				in.Skip()
+				out.TasksPerAssignee = nil
+			} else {
+				in.Delim('[')
+				if out.TasksPerAssignee == nil {
+					if !in.IsDelim(']') {
+						out.TasksPerAssignee = make([]AssigneeTaskCount, 0, 2)
+					} else {
+						out.TasksPerAssignee = []AssigneeTaskCount{}
+					}
+				} else {
+					out.TasksPerAssignee = (out.TasksPerAssignee)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v152 AssigneeTaskCount
+					(v152).UnmarshalEasyJSON(in)
+					out.TasksPerAssignee = append(out.TasksPerAssignee, v152)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "unassigned":
+			out.Unassigned = uint64(in.Uint64())
+		case "overdue":
+			out.Overdue = uint64(in.Uint64())
+		case "due_this_week":
+			out.DueThisWeek = uint64(in.Uint64())
+		case "checklist_items":
+			out.ChecklistItems = uint64(in.Uint64())
+		case "checklist_items_done":
+			out.ChecklistItemsDone = uint64(in.Uint64())
+		case "checklist_completion":
+			out.ChecklistCompletion = float64(in.Float64())
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]TagTaskCount, 0, 1)
+					} else {
+						out.Tags = []TagTaskCount{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v153 TagTaskCount
+					(v153).UnmarshalEasyJSON(in)
+					out.Tags = append(out.Tags, v153)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "weekly":
+			if in.IsNull() {
+				in.Skip()
+				out.Weekly = nil
+			} else {
+				in.Delim('[')
+				if out.Weekly == nil {
+					if !in.IsDelim(']') {
+						out.Weekly = make([]WeeklyTaskCount, 0, 1)
+					} else {
+						out.Weekly = []WeeklyTaskCount{}
+					}
+				} else {
+					out.Weekly = (out.Weekly)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v76 UserID
-					(v76).UnmarshalEasyJSON(in)
-					out.Guests = append(out.Guests, v76)
+					var v154 WeeklyTaskCount
+					(v154).UnmarshalEasyJSON(in)
+					out.Weekly = append(out.Weekly, v154)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -7818,124 +14811,141 @@ func easyjson56de76c1DecodeServerInternalPkgDto83(in *jlexer.Lexer, out *ChangeW
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto83(out *jwriter.Writer, in ChangeWorkspaceGuestsInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto145(out *jwriter.Writer, in BoardStats) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"board_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.WorkspaceID))
+		out.Uint64(uint64(in.BoardID))
 	}
 	{
-		const prefix string = ",\"guests\":"
+		const prefix string = ",\"task_count\":"
 		out.RawString(prefix)
-		if in.Guests == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.Uint64(uint64(in.TaskCount))
+	}
+	{
+		const prefix string = ",\"tasks_per_list\":"
+		out.RawString(prefix)
+		if in.TasksPerList == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v77, v78 := range in.Guests {
-				if v77 > 0 {
+			for v155, v156 := range in.TasksPerList {
+				if v155 > 0 {
 					out.RawByte(',')
 				}
-				(v78).MarshalEasyJSON(out)
+				(v156).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v ChangeWorkspaceGuestsInfo) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto83(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChangeWorkspaceGuestsInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto83(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChangeWorkspaceGuestsInfo) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto83(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChangeWorkspaceGuestsInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto83(l, v)
-}
-func easyjson56de76c1DecodeServerInternalPkgDto84(in *jlexer.Lexer, out *CSRFToken) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
+	{
+		const prefix string = ",\"tasks_per_assignee\":"
+		out.RawString(prefix)
+		if in.TasksPerAssignee == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v157, v158 := range in.TasksPerAssignee {
+				if v157 > 0 {
+					out.RawByte(',')
+				}
+				(v158).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
-		in.Skip()
-		return
 	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "csrf_token":
-			out.Value = string(in.String())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
+	{
+		const prefix string = ",\"unassigned\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Unassigned))
 	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
+	{
+		const prefix string = ",\"overdue\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Overdue))
 	}
-}
-func easyjson56de76c1EncodeServerInternalPkgDto84(out *jwriter.Writer, in CSRFToken) {
-	out.RawByte('{')
-	first := true
-	_ = first
 	{
-		const prefix string = ",\"csrf_token\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.Value))
+		const prefix string = ",\"due_this_week\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.DueThisWeek))
+	}
+	{
+		const prefix string = ",\"checklist_items\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ChecklistItems))
+	}
+	{
+		const prefix string = ",\"checklist_items_done\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ChecklistItemsDone))
+	}
+	{
+		const prefix string = ",\"checklist_completion\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ChecklistCompletion))
+	}
+	{
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v159, v160 := range in.Tags {
+				if v159 > 0 {
+					out.RawByte(',')
+				}
+				(v160).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"weekly\":"
+		out.RawString(prefix)
+		if in.Weekly == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v161, v162 := range in.Weekly {
+				if v161 > 0 {
+					out.RawByte(',')
+				}
+				(v162).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CSRFToken) MarshalJSON() ([]byte, error) {
+func (v BoardStats) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto84(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto145(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CSRFToken) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto84(w, v)
+func (v BoardStats) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto145(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CSRFToken) UnmarshalJSON(data []byte) error {
+func (v *BoardStats) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto84(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto145(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CSRFToken) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto84(l, v)
+func (v *BoardStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto145(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto85(in *jlexer.Lexer, out *CSRFData) {
+func easyjson56de76c1DecodeServerInternalPkgDto146(in *jlexer.Lexer, out *BoardReturn) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7954,11 +14964,31 @@ func easyjson56de76c1DecodeServerInternalPkgDto85(in *jlexer.Lexer, out *CSRFDat
 			continue
 		}
 		switch key {
-		case "csrf_token":
-			out.Token = string(in.String())
-		case "expiration_date":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.ExpirationDate).UnmarshalJSON(data))
+		case "WorkspaceID":
+			out.WorkspaceID = uint64(in.Uint64())
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			if in.IsNull() {
+				in.Skip()
+				out.Description = nil
+			} else {
+				if out.Description == nil {
+					out.Description = new(string)
+				}
+				*out.Description = string(in.String())
+			}
+		case "thumbnail_url":
+			if in.IsNull() {
+				in.Skip()
+				out.ThumbnailURL = nil
+			} else {
+				if out.ThumbnailURL == nil {
+					out.ThumbnailURL = new(string)
+				}
+				*out.ThumbnailURL = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -7970,47 +15000,70 @@ func easyjson56de76c1DecodeServerInternalPkgDto85(in *jlexer.Lexer, out *CSRFDat
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto85(out *jwriter.Writer, in CSRFData) {
+func easyjson56de76c1EncodeServerInternalPkgDto146(out *jwriter.Writer, in BoardReturn) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"csrf_token\":"
+		const prefix string = ",\"WorkspaceID\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Token))
+		out.Uint64(uint64(in.WorkspaceID))
 	}
 	{
-		const prefix string = ",\"expiration_date\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix)
-		out.Raw((in.ExpirationDate).MarshalJSON())
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		if in.Description == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Description))
+		}
+	}
+	{
+		const prefix string = ",\"thumbnail_url\":"
+		out.RawString(prefix)
+		if in.ThumbnailURL == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.ThumbnailURL))
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CSRFData) MarshalJSON() ([]byte, error) {
+func (v BoardReturn) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto85(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto146(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CSRFData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto85(w, v)
+func (v BoardReturn) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto146(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CSRFData) UnmarshalJSON(data []byte) error {
+func (v *BoardReturn) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto85(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto146(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CSRFData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto85(l, v)
+func (v *BoardReturn) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto146(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto86(in *jlexer.Lexer, out *CSATRatingCheck) {
+func easyjson56de76c1DecodeServerInternalPkgDto147(in *jlexer.Lexer, out *BoardImageUrlInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8029,10 +15082,10 @@ func easyjson56de76c1DecodeServerInternalPkgDto86(in *jlexer.Lexer, out *CSATRat
 			continue
 		}
 		switch key {
-		case "question_id":
-			out.QuestionID = uint64(in.Uint64())
-		case "max_rating":
-			out.MaxRating = uint64(in.Uint64())
+		case "board_id":
+			out.ID = uint64(in.Uint64())
+		case "avatar_url":
+			out.Url = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -8043,47 +15096,47 @@ func easyjson56de76c1DecodeServerInternalPkgDto86(in *jlexer.Lexer, out *CSATRat
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto86(out *jwriter.Writer, in CSATRatingCheck) {
+func easyjson56de76c1EncodeServerInternalPkgDto147(out *jwriter.Writer, in BoardImageUrlInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"question_id\":"
+		const prefix string = ",\"board_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.QuestionID))
+		out.Uint64(uint64(in.ID))
 	}
 	{
-		const prefix string = ",\"max_rating\":"
+		const prefix string = ",\"avatar_url\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.MaxRating))
+		out.String(string(in.Url))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CSATRatingCheck) MarshalJSON() ([]byte, error) {
+func (v BoardImageUrlInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto86(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto147(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CSATRatingCheck) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto86(w, v)
+func (v BoardImageUrlInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto147(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CSATRatingCheck) UnmarshalJSON(data []byte) error {
+func (v *BoardImageUrlInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto86(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto147(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CSATRatingCheck) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto86(l, v)
+func (v *BoardImageUrlInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto147(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto87(in *jlexer.Lexer, out *CSATQuestionTypeName) {
+func easyjson56de76c1DecodeServerInternalPkgDto148(in *jlexer.Lexer, out *BoardID) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8102,8 +15155,8 @@ func easyjson56de76c1DecodeServerInternalPkgDto87(in *jlexer.Lexer, out *CSATQue
 			continue
 		}
 		switch key {
-		case "type":
-			out.Value = string(in.String())
+		case "board_id":
+			out.Value = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -8114,42 +15167,42 @@ func easyjson56de76c1DecodeServerInternalPkgDto87(in *jlexer.Lexer, out *CSATQue
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto87(out *jwriter.Writer, in CSATQuestionTypeName) {
+func easyjson56de76c1EncodeServerInternalPkgDto148(out *jwriter.Writer, in BoardID) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"board_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Value))
+		out.Uint64(uint64(in.Value))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CSATQuestionTypeName) MarshalJSON() ([]byte, error) {
+func (v BoardID) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto87(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto148(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CSATQuestionTypeName) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto87(w, v)
+func (v BoardID) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto148(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CSATQuestionTypeName) UnmarshalJSON(data []byte) error {
+func (v *BoardID) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto87(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto148(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CSATQuestionTypeName) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto87(l, v)
+func (v *BoardID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto148(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto88(in *jlexer.Lexer, out *CSATQuestionID) {
+func easyjson56de76c1DecodeServerInternalPkgDto149(in *jlexer.Lexer, out *BoardHistoryRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8168,8 +15221,66 @@ func easyjson56de76c1DecodeServerInternalPkgDto88(in *jlexer.Lexer, out *CSATQue
 			continue
 		}
 		switch key {
-		case "Value":
-			out.Value = uint64(in.Uint64())
+		case "board_id":
+			out.BoardID = uint64(in.Uint64())
+		case "entity_type":
+			if in.IsNull() {
+				in.Skip()
+				out.EntityType = nil
+			} else {
+				if out.EntityType == nil {
+					out.EntityType = new(string)
+				}
+				*out.EntityType = string(in.String())
+			}
+		case "entity_id":
+			if in.IsNull() {
+				in.Skip()
+				out.EntityID = nil
+			} else {
+				if out.EntityID == nil {
+					out.EntityID = new(uint64)
+				}
+				*out.EntityID = uint64(in.Uint64())
+			}
+		case "user_id":
+			if in.IsNull() {
+				in.Skip()
+				out.UserID = nil
+			} else {
+				if out.UserID == nil {
+					out.UserID = new(uint64)
+				}
+				*out.UserID = uint64(in.Uint64())
+			}
+		case "from":
+			if in.IsNull() {
+				in.Skip()
+				out.From = nil
+			} else {
+				if out.From == nil {
+					out.From = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.From).UnmarshalJSON(data))
+				}
+			}
+		case "to":
+			if in.IsNull() {
+				in.Skip()
+				out.To = nil
+			} else {
+				if out.To == nil {
+					out.To = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.To).UnmarshalJSON(data))
+				}
+			}
+		case "limit":
+			out.Limit = uint64(in.Uint64())
+		case "offset":
+			out.Offset = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -8180,42 +15291,97 @@ func easyjson56de76c1DecodeServerInternalPkgDto88(in *jlexer.Lexer, out *CSATQue
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto88(out *jwriter.Writer, in CSATQuestionID) {
+func easyjson56de76c1EncodeServerInternalPkgDto149(out *jwriter.Writer, in BoardHistoryRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Value\":"
+		const prefix string = ",\"board_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.Value))
+		out.Uint64(uint64(in.BoardID))
+	}
+	{
+		const prefix string = ",\"entity_type\":"
+		out.RawString(prefix)
+		if in.EntityType == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.EntityType))
+		}
+	}
+	{
+		const prefix string = ",\"entity_id\":"
+		out.RawString(prefix)
+		if in.EntityID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.EntityID))
+		}
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		if in.UserID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.UserID))
+		}
+	}
+	{
+		const prefix string = ",\"from\":"
+		out.RawString(prefix)
+		if in.From == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.From).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"to\":"
+		out.RawString(prefix)
+		if in.To == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.To).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Limit))
+	}
+	{
+		const prefix string = ",\"offset\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Offset))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CSATQuestionID) MarshalJSON() ([]byte, error) {
+func (v BoardHistoryRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto88(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto149(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CSATQuestionID) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto88(w, v)
+func (v BoardHistoryRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto149(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CSATQuestionID) UnmarshalJSON(data []byte) error {
+func (v *BoardHistoryRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto88(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto149(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CSATQuestionID) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto88(l, v)
+func (v *BoardHistoryRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto149(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto89(in *jlexer.Lexer, out *CSATQuestionFull) {
+func easyjson56de76c1DecodeServerInternalPkgDto150(in *jlexer.Lexer, out *BoardHistoryEntry) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8234,68 +15400,182 @@ func easyjson56de76c1DecodeServerInternalPkgDto89(in *jlexer.Lexer, out *CSATQue
 			continue
 		}
 		switch key {
-		case "question_id":
+		case "id":
 			out.ID = uint64(in.Uint64())
-		case "content":
-			out.Content = string(in.String())
-		case "type":
-			out.Type = string(in.String())
+		case "board_id":
+			out.BoardID = uint64(in.Uint64())
+		case "user":
+			(out.User).UnmarshalEasyJSON(in)
+		case "timestamp":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DateEdited).UnmarshalJSON(data))
+			}
+		case "actions":
+			out.Actions = string(in.String())
+		case "entity_type":
+			out.EntityType = string(in.String())
+		case "entity_id":
+			out.EntityID = uint64(in.Uint64())
+		case "action":
+			out.Action = string(in.String())
+		case "changes":
+			if in.IsNull() {
+				in.Skip()
+				out.Changes = nil
+			} else {
+				in.Delim('[')
+				if out.Changes == nil {
+					if !in.IsDelim(']') {
+						out.Changes = make([]HistoryFieldChange, 0, 1)
+					} else {
+						out.Changes = []HistoryFieldChange{}
+					}
+				} else {
+					out.Changes = (out.Changes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v163 HistoryFieldChange
+					(v163).UnmarshalEasyJSON(in)
+					out.Changes = append(out.Changes, v163)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "reverted_id":
+			if in.IsNull() {
+				in.Skip()
+				out.RevertedID = nil
+			} else {
+				if out.RevertedID == nil {
+					out.RevertedID = new(uint64)
+				}
+				*out.RevertedID = uint64(in.Uint64())
+			}
+		case "task_id":
+			if in.IsNull() {
+				in.Skip()
+				out.TaskID = nil
+			} else {
+				if out.TaskID == nil {
+					out.TaskID = new(uint64)
+				}
+				*out.TaskID = uint64(in.Uint64())
+			}
 		default:
 			in.SkipRecursive()
 		}
 		in.WantComma()
 	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto150(out *jwriter.Writer, in BoardHistoryEntry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.BoardID))
+	}
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		(in.User).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"timestamp\":"
+		out.RawString(prefix)
+		out.Raw((in.DateEdited).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"actions\":"
+		out.RawString(prefix)
+		out.String(string(in.Actions))
 	}
-}
-func easyjson56de76c1EncodeServerInternalPkgDto89(out *jwriter.Writer, in CSATQuestionFull) {
-	out.RawByte('{')
-	first := true
-	_ = first
 	{
-		const prefix string = ",\"question_id\":"
-		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
+		const prefix string = ",\"entity_type\":"
+		out.RawString(prefix)
+		out.String(string(in.EntityType))
 	}
 	{
-		const prefix string = ",\"content\":"
+		const prefix string = ",\"entity_id\":"
 		out.RawString(prefix)
-		out.String(string(in.Content))
+		out.Uint64(uint64(in.EntityID))
 	}
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"action\":"
 		out.RawString(prefix)
-		out.String(string(in.Type))
+		out.String(string(in.Action))
+	}
+	{
+		const prefix string = ",\"changes\":"
+		out.RawString(prefix)
+		if in.Changes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v164, v165 := range in.Changes {
+				if v164 > 0 {
+					out.RawByte(',')
+				}
+				(v165).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"reverted_id\":"
+		out.RawString(prefix)
+		if in.RevertedID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.RevertedID))
+		}
+	}
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix)
+		if in.TaskID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.TaskID))
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CSATQuestionFull) MarshalJSON() ([]byte, error) {
+func (v BoardHistoryEntry) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto89(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto150(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CSATQuestionFull) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto89(w, v)
+func (v BoardHistoryEntry) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto150(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CSATQuestionFull) UnmarshalJSON(data []byte) error {
+func (v *BoardHistoryEntry) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto89(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto150(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CSATQuestionFull) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto89(l, v)
+func (v *BoardHistoryEntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto150(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto90(in *jlexer.Lexer, out *CSATAnswerFull) {
+func easyjson56de76c1DecodeServerInternalPkgDto151(in *jlexer.Lexer, out *BoardDeleteRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8314,12 +15594,10 @@ func easyjson56de76c1DecodeServerInternalPkgDto90(in *jlexer.Lexer, out *CSATAns
 			continue
 		}
 		switch key {
-		case "answer_id":
-			out.ID = uint64(in.Uint64())
-		case "rating":
-			out.Content = string(in.String())
-		case "type":
-			out.Type = string(in.String())
+		case "board_id":
+			out.BoardID = uint64(in.Uint64())
+		case "workspace_id":
+			out.WorkspaceID = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -8330,52 +15608,47 @@ func easyjson56de76c1DecodeServerInternalPkgDto90(in *jlexer.Lexer, out *CSATAns
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto90(out *jwriter.Writer, in CSATAnswerFull) {
+func easyjson56de76c1EncodeServerInternalPkgDto151(out *jwriter.Writer, in BoardDeleteRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"answer_id\":"
+		const prefix string = ",\"board_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
-	}
-	{
-		const prefix string = ",\"rating\":"
-		out.RawString(prefix)
-		out.String(string(in.Content))
+		out.Uint64(uint64(in.BoardID))
 	}
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"workspace_id\":"
 		out.RawString(prefix)
-		out.String(string(in.Type))
+		out.Uint64(uint64(in.WorkspaceID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CSATAnswerFull) MarshalJSON() ([]byte, error) {
+func (v BoardDeleteRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto90(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto151(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CSATAnswerFull) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto90(w, v)
+func (v BoardDeleteRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto151(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CSATAnswerFull) UnmarshalJSON(data []byte) error {
+func (v *BoardDeleteRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto90(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto151(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CSATAnswerFull) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto90(l, v)
+func (v *BoardDeleteRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto151(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto91(in *jlexer.Lexer, out *BoardReturn) {
+func easyjson56de76c1DecodeServerInternalPkgDto152(in *jlexer.Lexer, out *BoardChanges) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8394,31 +15667,247 @@ func easyjson56de76c1DecodeServerInternalPkgDto91(in *jlexer.Lexer, out *BoardRe
 			continue
 		}
 		switch key {
-		case "WorkspaceID":
-			out.WorkspaceID = uint64(in.Uint64())
-		case "id":
-			out.ID = uint64(in.Uint64())
-		case "name":
-			out.Name = string(in.String())
-		case "description":
+		case "version":
+			out.Version = uint64(in.Uint64())
+		case "board":
+			if in.IsNull() {
+				in.Skip()
+				out.Board = nil
+			} else {
+				if out.Board == nil {
+					out.Board = new(SingleBoardInfo)
+				}
+				(*out.Board).UnmarshalEasyJSON(in)
+			}
+		case "users":
+			if in.IsNull() {
+				in.Skip()
+				out.Users = nil
+			} else {
+				in.Delim('[')
+				if out.Users == nil {
+					if !in.IsDelim(']') {
+						out.Users = make([]UserPublicInfo, 0, 1)
+					} else {
+						out.Users = []UserPublicInfo{}
+					}
+				} else {
+					out.Users = (out.Users)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v166 UserPublicInfo
+					(v166).UnmarshalEasyJSON(in)
+					out.Users = append(out.Users, v166)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "lists":
+			if in.IsNull() {
+				in.Skip()
+				out.Lists = nil
+			} else {
+				in.Delim('[')
+				if out.Lists == nil {
+					if !in.IsDelim(']') {
+						out.Lists = make([]SingleListInfo, 0, 0)
+					} else {
+						out.Lists = []SingleListInfo{}
+					}
+				} else {
+					out.Lists = (out.Lists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v167 SingleListInfo
+					(v167).UnmarshalEasyJSON(in)
+					out.Lists = append(out.Lists, v167)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "cards":
+			if in.IsNull() {
+				in.Skip()
+				out.Tasks = nil
+			} else {
+				in.Delim('[')
+				if out.Tasks == nil {
+					if !in.IsDelim(']') {
+						out.Tasks = make([]SingleTaskInfo, 0, 0)
+					} else {
+						out.Tasks = []SingleTaskInfo{}
+					}
+				} else {
+					out.Tasks = (out.Tasks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v168 SingleTaskInfo
+					(v168).UnmarshalEasyJSON(in)
+					out.Tasks = append(out.Tasks, v168)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "comments":
+			if in.IsNull() {
+				in.Skip()
+				out.Comments = nil
+			} else {
+				in.Delim('[')
+				if out.Comments == nil {
+					if !in.IsDelim(']') {
+						out.Comments = make([]CommentInfo, 0, 1)
+					} else {
+						out.Comments = []CommentInfo{}
+					}
+				} else {
+					out.Comments = (out.Comments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v169 CommentInfo
+					(v169).UnmarshalEasyJSON(in)
+					out.Comments = append(out.Comments, v169)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "checklists":
+			if in.IsNull() {
+				in.Skip()
+				out.Checklists = nil
+			} else {
+				in.Delim('[')
+				if out.Checklists == nil {
+					if !in.IsDelim(']') {
+						out.Checklists = make([]ChecklistInfo, 0, 1)
+					} else {
+						out.Checklists = []ChecklistInfo{}
+					}
+				} else {
+					out.Checklists = (out.Checklists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v170 ChecklistInfo
+					(v170).UnmarshalEasyJSON(in)
+					out.Checklists = append(out.Checklists, v170)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "checklist_items":
+			if in.IsNull() {
+				in.Skip()
+				out.ChecklistItems = nil
+			} else {
+				in.Delim('[')
+				if out.ChecklistItems == nil {
+					if !in.IsDelim(']') {
+						out.ChecklistItems = make([]ChecklistItemInfo, 0, 1)
+					} else {
+						out.ChecklistItems = []ChecklistItemInfo{}
+					}
+				} else {
+					out.ChecklistItems = (out.ChecklistItems)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v171 ChecklistItemInfo
+					(v171).UnmarshalEasyJSON(in)
+					out.ChecklistItems = append(out.ChecklistItems, v171)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]TagInfo, 0, 1)
+					} else {
+						out.Tags = []TagInfo{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v172 TagInfo
+					(v172).UnmarshalEasyJSON(in)
+					out.Tags = append(out.Tags, v172)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "custom_fields":
+			if in.IsNull() {
+				in.Skip()
+				out.CustomFields = nil
+			} else {
+				in.Delim('[')
+				if out.CustomFields == nil {
+					if !in.IsDelim(']') {
+						out.CustomFields = make([]CustomFieldInfo, 0, 0)
+					} else {
+						out.CustomFields = []CustomFieldInfo{}
+					}
+				} else {
+					out.CustomFields = (out.CustomFields)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v173 CustomFieldInfo
+					(v173).UnmarshalEasyJSON(in)
+					out.CustomFields = append(out.CustomFields, v173)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "custom_field_values":
 			if in.IsNull() {
 				in.Skip()
-				out.Description = nil
+				out.CustomFieldValues = nil
 			} else {
-				if out.Description == nil {
-					out.Description = new(string)
+				in.Delim('[')
+				if out.CustomFieldValues == nil {
+					if !in.IsDelim(']') {
+						out.CustomFieldValues = make([]CustomFieldValueInfo, 0, 2)
+					} else {
+						out.CustomFieldValues = []CustomFieldValueInfo{}
+					}
+				} else {
+					out.CustomFieldValues = (out.CustomFieldValues)[:0]
 				}
-				*out.Description = string(in.String())
+				for !in.IsDelim(']') {
+					var v174 CustomFieldValueInfo
+					(v174).UnmarshalEasyJSON(in)
+					out.CustomFieldValues = append(out.CustomFieldValues, v174)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "thumbnail_url":
+		case "deleted":
 			if in.IsNull() {
 				in.Skip()
-				out.ThumbnailURL = nil
+				out.Deleted = nil
 			} else {
-				if out.ThumbnailURL == nil {
-					out.ThumbnailURL = new(string)
+				in.Delim('[')
+				if out.Deleted == nil {
+					if !in.IsDelim(']') {
+						out.Deleted = make([]DeletedEntity, 0, 2)
+					} else {
+						out.Deleted = []DeletedEntity{}
+					}
+				} else {
+					out.Deleted = (out.Deleted)[:0]
 				}
-				*out.ThumbnailURL = string(in.String())
+				for !in.IsDelim(']') {
+					var v175 DeletedEntity
+					(v175).UnmarshalEasyJSON(in)
+					out.Deleted = append(out.Deleted, v175)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -8430,291 +15919,211 @@ func easyjson56de76c1DecodeServerInternalPkgDto91(in *jlexer.Lexer, out *BoardRe
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto91(out *jwriter.Writer, in BoardReturn) {
+func easyjson56de76c1EncodeServerInternalPkgDto152(out *jwriter.Writer, in BoardChanges) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"WorkspaceID\":"
+		const prefix string = ",\"version\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.WorkspaceID))
+		out.Uint64(uint64(in.Version))
 	}
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"board\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.ID))
+		if in.Board == nil {
+			out.RawString("null")
+		} else {
+			(*in.Board).MarshalEasyJSON(out)
+		}
 	}
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"users\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v176, v177 := range in.Users {
+				if v176 > 0 {
+					out.RawByte(',')
+				}
+				(v177).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"description\":"
+		const prefix string = ",\"lists\":"
 		out.RawString(prefix)
-		if in.Description == nil {
+		if in.Lists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			out.String(string(*in.Description))
+			out.RawByte('[')
+			for v178, v179 := range in.Lists {
+				if v178 > 0 {
+					out.RawByte(',')
+				}
+				(v179).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"thumbnail_url\":"
+		const prefix string = ",\"cards\":"
 		out.RawString(prefix)
-		if in.ThumbnailURL == nil {
+		if in.Tasks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			out.String(string(*in.ThumbnailURL))
-		}
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v BoardReturn) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto91(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v BoardReturn) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto91(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *BoardReturn) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto91(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *BoardReturn) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto91(l, v)
-}
-func easyjson56de76c1DecodeServerInternalPkgDto92(in *jlexer.Lexer, out *BoardImageUrlInfo) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "board_id":
-			out.ID = uint64(in.Uint64())
-		case "avatar_url":
-			out.Url = string(in.String())
-		default:
-			in.SkipRecursive()
+			out.RawByte('[')
+			for v180, v181 := range in.Tasks {
+				if v180 > 0 {
+					out.RawByte(',')
+				}
+				(v181).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson56de76c1EncodeServerInternalPkgDto92(out *jwriter.Writer, in BoardImageUrlInfo) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"board_id\":"
-		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.ID))
 	}
 	{
-		const prefix string = ",\"avatar_url\":"
+		const prefix string = ",\"comments\":"
 		out.RawString(prefix)
-		out.String(string(in.Url))
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v BoardImageUrlInfo) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto92(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v BoardImageUrlInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto92(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *BoardImageUrlInfo) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto92(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *BoardImageUrlInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto92(l, v)
-}
-func easyjson56de76c1DecodeServerInternalPkgDto93(in *jlexer.Lexer, out *BoardID) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "board_id":
-			out.Value = uint64(in.Uint64())
-		default:
-			in.SkipRecursive()
+		if in.Comments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v182, v183 := range in.Comments {
+				if v182 > 0 {
+					out.RawByte(',')
+				}
+				(v183).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
 	}
-}
-func easyjson56de76c1EncodeServerInternalPkgDto93(out *jwriter.Writer, in BoardID) {
-	out.RawByte('{')
-	first := true
-	_ = first
 	{
-		const prefix string = ",\"board_id\":"
-		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.Value))
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v BoardID) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto93(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v BoardID) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto93(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *BoardID) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto93(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *BoardID) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto93(l, v)
-}
-func easyjson56de76c1DecodeServerInternalPkgDto94(in *jlexer.Lexer, out *BoardHistoryEntry) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
+		const prefix string = ",\"checklists\":"
+		out.RawString(prefix)
+		if in.Checklists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v184, v185 := range in.Checklists {
+				if v184 > 0 {
+					out.RawByte(',')
+				}
+				(v185).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
-		switch key {
-		case "user":
-			(out.User).UnmarshalEasyJSON(in)
-		case "timestamp":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.DateEdited).UnmarshalJSON(data))
+	}
+	{
+		const prefix string = ",\"checklist_items\":"
+		out.RawString(prefix)
+		if in.ChecklistItems == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v186, v187 := range in.ChecklistItems {
+				if v186 > 0 {
+					out.RawByte(',')
+				}
+				(v187).MarshalEasyJSON(out)
 			}
-		case "actions":
-			out.Actions = string(in.String())
-		default:
-			in.SkipRecursive()
+			out.RawByte(']')
 		}
-		in.WantComma()
 	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
+	{
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v188, v189 := range in.Tags {
+				if v188 > 0 {
+					out.RawByte(',')
+				}
+				(v189).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
-}
-func easyjson56de76c1EncodeServerInternalPkgDto94(out *jwriter.Writer, in BoardHistoryEntry) {
-	out.RawByte('{')
-	first := true
-	_ = first
 	{
-		const prefix string = ",\"user\":"
-		out.RawString(prefix[1:])
-		(in.User).MarshalEasyJSON(out)
+		const prefix string = ",\"custom_fields\":"
+		out.RawString(prefix)
+		if in.CustomFields == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v190, v191 := range in.CustomFields {
+				if v190 > 0 {
+					out.RawByte(',')
+				}
+				(v191).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"timestamp\":"
+		const prefix string = ",\"custom_field_values\":"
 		out.RawString(prefix)
-		out.Raw((in.DateEdited).MarshalJSON())
+		if in.CustomFieldValues == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v192, v193 := range in.CustomFieldValues {
+				if v192 > 0 {
+					out.RawByte(',')
+				}
+				(v193).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"actions\":"
+		const prefix string = ",\"deleted\":"
 		out.RawString(prefix)
-		out.String(string(in.Actions))
+		if in.Deleted == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v194, v195 := range in.Deleted {
+				if v194 > 0 {
+					out.RawByte(',')
+				}
+				(v195).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v BoardHistoryEntry) MarshalJSON() ([]byte, error) {
+func (v BoardChanges) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto94(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto152(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v BoardHistoryEntry) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto94(w, v)
+func (v BoardChanges) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto152(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *BoardHistoryEntry) UnmarshalJSON(data []byte) error {
+func (v *BoardChanges) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto94(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto152(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *BoardHistoryEntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto94(l, v)
+func (v *BoardChanges) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto152(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto95(in *jlexer.Lexer, out *BoardDeleteRequest) {
+func easyjson56de76c1DecodeServerInternalPkgDto153(in *jlexer.Lexer, out *BoardArchive) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8735,8 +16144,52 @@ func easyjson56de76c1DecodeServerInternalPkgDto95(in *jlexer.Lexer, out *BoardDe
 		switch key {
 		case "board_id":
 			out.BoardID = uint64(in.Uint64())
-		case "workspace_id":
-			out.WorkspaceID = uint64(in.Uint64())
+		case "lists":
+			if in.IsNull() {
+				in.Skip()
+				out.Lists = nil
+			} else {
+				in.Delim('[')
+				if out.Lists == nil {
+					if !in.IsDelim(']') {
+						out.Lists = make([]SingleListInfo, 0, 0)
+					} else {
+						out.Lists = []SingleListInfo{}
+					}
+				} else {
+					out.Lists = (out.Lists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v196 SingleListInfo
+					(v196).UnmarshalEasyJSON(in)
+					out.Lists = append(out.Lists, v196)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "cards":
+			if in.IsNull() {
+				in.Skip()
+				out.Tasks = nil
+			} else {
+				in.Delim('[')
+				if out.Tasks == nil {
+					if !in.IsDelim(']') {
+						out.Tasks = make([]SingleTaskInfo, 0, 0)
+					} else {
+						out.Tasks = []SingleTaskInfo{}
+					}
+				} else {
+					out.Tasks = (out.Tasks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v197 SingleTaskInfo
+					(v197).UnmarshalEasyJSON(in)
+					out.Tasks = append(out.Tasks, v197)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -8747,7 +16200,7 @@ func easyjson56de76c1DecodeServerInternalPkgDto95(in *jlexer.Lexer, out *BoardDe
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto95(out *jwriter.Writer, in BoardDeleteRequest) {
+func easyjson56de76c1EncodeServerInternalPkgDto153(out *jwriter.Writer, in BoardArchive) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -8757,37 +16210,64 @@ func easyjson56de76c1EncodeServerInternalPkgDto95(out *jwriter.Writer, in BoardD
 		out.Uint64(uint64(in.BoardID))
 	}
 	{
-		const prefix string = ",\"workspace_id\":"
+		const prefix string = ",\"lists\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.WorkspaceID))
+		if in.Lists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v198, v199 := range in.Lists {
+				if v198 > 0 {
+					out.RawByte(',')
+				}
+				(v199).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"cards\":"
+		out.RawString(prefix)
+		if in.Tasks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v200, v201 := range in.Tasks {
+				if v200 > 0 {
+					out.RawByte(',')
+				}
+				(v201).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v BoardDeleteRequest) MarshalJSON() ([]byte, error) {
+func (v BoardArchive) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto95(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto153(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v BoardDeleteRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto95(w, v)
+func (v BoardArchive) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto153(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *BoardDeleteRequest) UnmarshalJSON(data []byte) error {
+func (v *BoardArchive) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto95(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto153(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *BoardDeleteRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto95(l, v)
+func (v *BoardArchive) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto153(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto96(in *jlexer.Lexer, out *AvatarRemovalInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto154(in *jlexer.Lexer, out *AvatarRemovalInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8820,7 +16300,7 @@ func easyjson56de76c1DecodeServerInternalPkgDto96(in *jlexer.Lexer, out *AvatarR
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto96(out *jwriter.Writer, in AvatarRemovalInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto154(out *jwriter.Writer, in AvatarRemovalInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -8840,27 +16320,27 @@ func easyjson56de76c1EncodeServerInternalPkgDto96(out *jwriter.Writer, in Avatar
 // MarshalJSON supports json.Marshaler interface
 func (v AvatarRemovalInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto96(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto154(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AvatarRemovalInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto96(w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto154(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AvatarRemovalInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto96(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto154(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AvatarRemovalInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto96(l, v)
+	easyjson56de76c1DecodeServerInternalPkgDto154(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto97(in *jlexer.Lexer, out *AuthInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto155(in *jlexer.Lexer, out *AuthInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8893,7 +16373,7 @@ func easyjson56de76c1DecodeServerInternalPkgDto97(in *jlexer.Lexer, out *AuthInf
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto97(out *jwriter.Writer, in AuthInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto155(out *jwriter.Writer, in AuthInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -8913,27 +16393,27 @@ func easyjson56de76c1EncodeServerInternalPkgDto97(out *jwriter.Writer, in AuthIn
 // MarshalJSON supports json.Marshaler interface
 func (v AuthInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto97(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto155(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AuthInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto97(w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto155(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AuthInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto97(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto155(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AuthInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto97(l, v)
+	easyjson56de76c1DecodeServerInternalPkgDto155(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto98(in *jlexer.Lexer, out *AuthDTO) {
+func easyjson56de76c1DecodeServerInternalPkgDto156(in *jlexer.Lexer, out *AuthDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8964,7 +16444,7 @@ func easyjson56de76c1DecodeServerInternalPkgDto98(in *jlexer.Lexer, out *AuthDTO
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto98(out *jwriter.Writer, in AuthDTO) {
+func easyjson56de76c1EncodeServerInternalPkgDto156(out *jwriter.Writer, in AuthDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -8979,25 +16459,25 @@ func easyjson56de76c1EncodeServerInternalPkgDto98(out *jwriter.Writer, in AuthDT
 // MarshalJSON supports json.Marshaler interface
 func (v AuthDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto98(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto156(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AuthDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto98(w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto156(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AuthDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto98(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto156(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AuthDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto98(l, v)
+	easyjson56de76c1DecodeServerInternalPkgDto156(l, v)
 }
 func easyjson56de76c1Decode(in *jlexer.Lexer, out *struct{ UserID uint64 }) {
 	isTopLevel := in.IsStart()
@@ -9041,7 +16521,7 @@ func easyjson56de76c1Encode(out *jwriter.Writer, in struct{ UserID uint64 }) {
 	}
 	out.RawByte('}')
 }
-func easyjson56de76c1DecodeServerInternalPkgDto99(in *jlexer.Lexer, out *AttachedFileInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto157(in *jlexer.Lexer, out *AttachedFileInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -9080,7 +16560,7 @@ func easyjson56de76c1DecodeServerInternalPkgDto99(in *jlexer.Lexer, out *Attache
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto99(out *jwriter.Writer, in AttachedFileInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto157(out *jwriter.Writer, in AttachedFileInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -9110,27 +16590,107 @@ func easyjson56de76c1EncodeServerInternalPkgDto99(out *jwriter.Writer, in Attach
 // MarshalJSON supports json.Marshaler interface
 func (v AttachedFileInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto99(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto157(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AttachedFileInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto99(w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto157(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AttachedFileInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto99(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto157(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AttachedFileInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto99(l, v)
+	easyjson56de76c1DecodeServerInternalPkgDto157(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto158(in *jlexer.Lexer, out *AssigneeTaskCount) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = uint64(in.Uint64())
+		case "email":
+			out.Email = string(in.String())
+		case "task_count":
+			out.TaskCount = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeServerInternalPkgDto158(out *jwriter.Writer, in AssigneeTaskCount) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.UserID))
+	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"task_count\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TaskCount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AssigneeTaskCount) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeServerInternalPkgDto158(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AssigneeTaskCount) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeServerInternalPkgDto158(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AssigneeTaskCount) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeServerInternalPkgDto158(&r, v)
+	return r.Error()
 }
-func easyjson56de76c1DecodeServerInternalPkgDto100(in *jlexer.Lexer, out *AllWorkspaces) {
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AssigneeTaskCount) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeServerInternalPkgDto158(l, v)
+}
+func easyjson56de76c1DecodeServerInternalPkgDto159(in *jlexer.Lexer, out *AllWorkspaces) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -9165,9 +16725,9 @@ func easyjson56de76c1DecodeServerInternalPkgDto100(in *jlexer.Lexer, out *AllWor
 					out.OwnedWorkspaces = (out.OwnedWorkspaces)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v79 UserOwnedWorkspaceInfo
-					(v79).UnmarshalEasyJSON(in)
-					out.OwnedWorkspaces = append(out.OwnedWorkspaces, v79)
+					var v202 UserOwnedWorkspaceInfo
+					(v202).UnmarshalEasyJSON(in)
+					out.OwnedWorkspaces = append(out.OwnedWorkspaces, v202)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -9188,9 +16748,9 @@ func easyjson56de76c1DecodeServerInternalPkgDto100(in *jlexer.Lexer, out *AllWor
 					out.GuestWorkspaces = (out.GuestWorkspaces)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v80 UserGuestWorkspaceInfo
-					(v80).UnmarshalEasyJSON(in)
-					out.GuestWorkspaces = append(out.GuestWorkspaces, v80)
+					var v203 UserGuestWorkspaceInfo
+					(v203).UnmarshalEasyJSON(in)
+					out.GuestWorkspaces = append(out.GuestWorkspaces, v203)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -9205,7 +16765,7 @@ func easyjson56de76c1DecodeServerInternalPkgDto100(in *jlexer.Lexer, out *AllWor
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto100(out *jwriter.Writer, in AllWorkspaces) {
+func easyjson56de76c1EncodeServerInternalPkgDto159(out *jwriter.Writer, in AllWorkspaces) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -9216,11 +16776,11 @@ func easyjson56de76c1EncodeServerInternalPkgDto100(out *jwriter.Writer, in AllWo
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v81, v82 := range in.OwnedWorkspaces {
-				if v81 > 0 {
+			for v204, v205 := range in.OwnedWorkspaces {
+				if v204 > 0 {
 					out.RawByte(',')
 				}
-				(v82).MarshalEasyJSON(out)
+				(v205).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -9232,11 +16792,11 @@ func easyjson56de76c1EncodeServerInternalPkgDto100(out *jwriter.Writer, in AllWo
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v83, v84 := range in.GuestWorkspaces {
-				if v83 > 0 {
+			for v206, v207 := range in.GuestWorkspaces {
+				if v206 > 0 {
 					out.RawByte(',')
 				}
-				(v84).MarshalEasyJSON(out)
+				(v207).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -9247,27 +16807,27 @@ func easyjson56de76c1EncodeServerInternalPkgDto100(out *jwriter.Writer, in AllWo
 // MarshalJSON supports json.Marshaler interface
 func (v AllWorkspaces) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto100(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto159(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AllWorkspaces) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto100(w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto159(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AllWorkspaces) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto100(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto159(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AllWorkspaces) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto100(l, v)
+	easyjson56de76c1DecodeServerInternalPkgDto159(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto101(in *jlexer.Lexer, out *AddTaskUserInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto160(in *jlexer.Lexer, out *AddTaskUserInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -9300,7 +16860,7 @@ func easyjson56de76c1DecodeServerInternalPkgDto101(in *jlexer.Lexer, out *AddTas
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto101(out *jwriter.Writer, in AddTaskUserInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto160(out *jwriter.Writer, in AddTaskUserInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -9320,27 +16880,27 @@ func easyjson56de76c1EncodeServerInternalPkgDto101(out *jwriter.Writer, in AddTa
 // MarshalJSON supports json.Marshaler interface
 func (v AddTaskUserInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto101(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto160(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AddTaskUserInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto101(w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto160(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AddTaskUserInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto101(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto160(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AddTaskUserInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto101(l, v)
+	easyjson56de76c1DecodeServerInternalPkgDto160(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto102(in *jlexer.Lexer, out *AddBoardUserRequest) {
+func easyjson56de76c1DecodeServerInternalPkgDto161(in *jlexer.Lexer, out *AddBoardUserRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -9375,7 +16935,7 @@ func easyjson56de76c1DecodeServerInternalPkgDto102(in *jlexer.Lexer, out *AddBoa
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto102(out *jwriter.Writer, in AddBoardUserRequest) {
+func easyjson56de76c1EncodeServerInternalPkgDto161(out *jwriter.Writer, in AddBoardUserRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -9400,27 +16960,27 @@ func easyjson56de76c1EncodeServerInternalPkgDto102(out *jwriter.Writer, in AddBo
 // MarshalJSON supports json.Marshaler interface
 func (v AddBoardUserRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto102(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto161(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AddBoardUserRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto102(w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto161(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AddBoardUserRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto102(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto161(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AddBoardUserRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto102(l, v)
+	easyjson56de76c1DecodeServerInternalPkgDto161(l, v)
 }
-func easyjson56de76c1DecodeServerInternalPkgDto103(in *jlexer.Lexer, out *AddBoardUserInfo) {
+func easyjson56de76c1DecodeServerInternalPkgDto162(in *jlexer.Lexer, out *AddBoardUserInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -9455,7 +17015,7 @@ func easyjson56de76c1DecodeServerInternalPkgDto103(in *jlexer.Lexer, out *AddBoa
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeServerInternalPkgDto103(out *jwriter.Writer, in AddBoardUserInfo) {
+func easyjson56de76c1EncodeServerInternalPkgDto162(out *jwriter.Writer, in AddBoardUserInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -9480,23 +17040,23 @@ func easyjson56de76c1EncodeServerInternalPkgDto103(out *jwriter.Writer, in AddBo
 // MarshalJSON supports json.Marshaler interface
 func (v AddBoardUserInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeServerInternalPkgDto103(&w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto162(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AddBoardUserInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeServerInternalPkgDto103(w, v)
+	easyjson56de76c1EncodeServerInternalPkgDto162(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AddBoardUserInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeServerInternalPkgDto103(&r, v)
+	easyjson56de76c1DecodeServerInternalPkgDto162(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AddBoardUserInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeServerInternalPkgDto103(l, v)
+	easyjson56de76c1DecodeServerInternalPkgDto162(l, v)
 }
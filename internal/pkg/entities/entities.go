@@ -64,6 +64,9 @@ type List struct {
 	Name         string  `json:"name"`
 	Description  *string `json:"description"`
 	ListPosition uint64  `json:"list_position"`
+	WipLimit     *uint64 `json:"wip_limit"`
+	WipMode      string  `json:"wip_mode"`
+	IsDone       bool    `json:"is_done"`
 	Tasks        []Task  `json:"tasks"`
 }
 
@@ -112,6 +115,9 @@ type Task struct {
 	ListPosition uint64     `json:"list_position"`
 	Start        *time.Time `json:"start"`
 	End          *time.Time `json:"end"`
+	Priority     string     `json:"priority"`
+	Estimate     *float64   `json:"estimate"`
+	ParentID     *uint64    `json:"parent_id"`
 	Users        []uint64   `json:"users"`
 	Checklists   []uint64   `json:"checklists"`
 	Comments     []uint64   `json:"comments"`
@@ -399,6 +399,28 @@ func easyjson3e8ab7adDecodeServerInternalPkgEntities2(in *jlexer.Lexer, out *Tas
 					in.AddError((*out.End).UnmarshalJSON(data))
 				}
 			}
+		case "priority":
+			out.Priority = string(in.String())
+		case "estimate":
+			if in.IsNull() {
+				in.Skip()
+				out.Estimate = nil
+			} else {
+				if out.Estimate == nil {
+					out.Estimate = new(float64)
+				}
+				*out.Estimate = float64(in.Float64())
+			}
+		case "parent_id":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentID = nil
+			} else {
+				if out.ParentID == nil {
+					out.ParentID = new(uint64)
+				}
+				*out.ParentID = uint64(in.Uint64())
+			}
 		case "users":
 			if in.IsNull() {
 				in.Skip()
@@ -530,6 +552,29 @@ func easyjson3e8ab7adEncodeServerInternalPkgEntities2(out *jwriter.Writer, in Ta
 			out.Raw((*in.End).MarshalJSON())
 		}
 	}
+	{
+		const prefix string = ",\"priority\":"
+		out.RawString(prefix)
+		out.String(string(in.Priority))
+	}
+	{
+		const prefix string = ",\"estimate\":"
+		out.RawString(prefix)
+		if in.Estimate == nil {
+			out.RawString("null")
+		} else {
+			out.Float64(float64(*in.Estimate))
+		}
+	}
+	{
+		const prefix string = ",\"parent_id\":"
+		out.RawString(prefix)
+		if in.ParentID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.ParentID))
+		}
+	}
 	{
 		const prefix string = ",\"users\":"
 		out.RawString(prefix)
@@ -989,6 +1034,20 @@ func easyjson3e8ab7adDecodeServerInternalPkgEntities7(in *jlexer.Lexer, out *Lis
 			}
 		case "list_position":
 			out.ListPosition = uint64(in.Uint64())
+		case "wip_limit":
+			if in.IsNull() {
+				in.Skip()
+				out.WipLimit = nil
+			} else {
+				if out.WipLimit == nil {
+					out.WipLimit = new(uint64)
+				}
+				*out.WipLimit = uint64(in.Uint64())
+			}
+		case "wip_mode":
+			out.WipMode = string(in.String())
+		case "is_done":
+			out.IsDone = bool(in.Bool())
 		case "tasks":
 			if in.IsNull() {
 				in.Skip()
@@ -1055,6 +1114,25 @@ func easyjson3e8ab7adEncodeServerInternalPkgEntities7(out *jwriter.Writer, in Li
 		out.RawString(prefix)
 		out.Uint64(uint64(in.ListPosition))
 	}
+	{
+		const prefix string = ",\"wip_limit\":"
+		out.RawString(prefix)
+		if in.WipLimit == nil {
+			out.RawString("null")
+		} else {
+			out.Uint64(uint64(*in.WipLimit))
+		}
+	}
+	{
+		const prefix string = ",\"wip_mode\":"
+		out.RawString(prefix)
+		out.String(string(in.WipMode))
+	}
+	{
+		const prefix string = ",\"is_done\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsDone))
+	}
 	{
 		const prefix string = ",\"tasks\":"
 		out.RawString(prefix)
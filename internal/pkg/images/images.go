@@ -0,0 +1,260 @@
+package images
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"regexp"
+	"server/internal/apperrors"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/webp"
+)
+
+const (
+	// MaxFileSize
+	// максимальный размер загружаемого файла в байтах
+	MaxFileSize = 10 << 20
+	// MaxDimension
+	// максимальная ширина и высота исходного изображения
+	MaxDimension = 8192
+	// MaxPixels
+	// максимальное количество пикселей исходного изображения
+	MaxPixels = 40_000_000
+)
+
+const (
+	// VariantThumb
+	// квадратная миниатюра, обрезанная по центру
+	VariantThumb = "thumb"
+	// VariantMedium
+	// изображение, вписанное в квадрат среднего размера без обрезки
+	VariantMedium = "medium"
+)
+
+// Variant
+// вариант изображения, который генерируется из каждой загрузки
+type Variant struct {
+	Name string
+	Size int
+	Crop bool
+}
+
+// Variants
+// набор генерируемых вариантов изображения
+var Variants = []Variant{
+	{Name: VariantThumb, Size: 128, Crop: true},
+	{Name: VariantMedium, Size: 512, Crop: false},
+}
+
+var decoders = map[string]func([]byte) (image.Image, error){
+	"png": func(data []byte) (image.Image, error) {
+		return png.Decode(bytes.NewReader(data))
+	},
+	"jpeg": func(data []byte) (image.Image, error) {
+		return jpeg.Decode(bytes.NewReader(data))
+	},
+	"gif": func(data []byte) (image.Image, error) {
+		return gif.Decode(bytes.NewReader(data))
+	},
+	"webp": func(data []byte) (image.Image, error) {
+		return webp.Decode(bytes.NewReader(data))
+	},
+}
+
+var variantFilePattern = regexp.MustCompile(`^([0-9a-f]{64})_([a-z]+)\.png$`)
+
+// Processed
+// обработанное изображение: хэш содержимого и закодированные в PNG варианты
+type Processed struct {
+	Hash     string
+	Format   string
+	Variants map[string][]byte
+}
+
+// DetectFormat
+// определяет формат изображения по содержимому файла, а не по переданному клиентом типу
+func DetectFormat(data []byte) (string, error) {
+	switch {
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return "png", nil
+	case bytes.HasPrefix(data, []byte("\xff\xd8\xff")):
+		return "jpeg", nil
+	case bytes.HasPrefix(data, []byte("GIF87a")), bytes.HasPrefix(data, []byte("GIF89a")):
+		return "gif", nil
+	case len(data) >= 12 && bytes.Equal(data[0:4], []byte("RIFF")) && bytes.Equal(data[8:12], []byte("WEBP")):
+		return "webp", nil
+	}
+	return "", apperrors.ErrInvalidImage
+}
+
+// Decode
+// проверяет размер файла и изображения и декодирует его
+// или возвращает ошибки apperrors.ErrInvalidImage, apperrors.ErrImageTooLarge
+func Decode(data []byte) (image.Image, string, error) {
+	if len(data) > MaxFileSize {
+		return nil, "", apperrors.ErrImageTooLarge
+	}
+
+	format, err := DetectFormat(data)
+	if err != nil {
+		return nil, "", err
+	}
+
+	config, configFormat, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || configFormat != format {
+		return nil, "", apperrors.ErrInvalidImage
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, "", apperrors.ErrInvalidImage
+	}
+	if config.Width > MaxDimension || config.Height > MaxDimension || config.Width*config.Height > MaxPixels {
+		return nil, "", apperrors.ErrImageTooLarge
+	}
+
+	img, err := decoders[format](data)
+	if err != nil {
+		return nil, "", apperrors.ErrInvalidImage
+	}
+	return img, format, nil
+}
+
+// Process
+// декодирует изображение и генерирует все варианты.
+// Варианты перекодируются в PNG, поэтому метаданные исходного файла (EXIF и т.п.) не сохраняются
+func Process(data []byte) (*Processed, error) {
+	img, format, err := Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(data)
+	processed := &Processed{
+		Hash:     hex.EncodeToString(sum[:]),
+		Format:   format,
+		Variants: make(map[string][]byte, len(Variants)),
+	}
+	for _, variant := range Variants {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, resize(img, variant)); err != nil {
+			return nil, apperrors.ErrInvalidImage
+		}
+		processed.Variants[variant.Name] = buf.Bytes()
+	}
+	return processed, nil
+}
+
+// FileName
+// возвращает имя файла варианта изображения с данным хэшем
+func FileName(hash string, variant string) string {
+	return hash + "_" + variant + ".png"
+}
+
+// Path
+// возвращает путь к варианту обработанного изображения в папке
+func (p *Processed) Path(dir string, variant string) string {
+	return filepath.ToSlash(filepath.Join(dir, FileName(p.Hash, variant)))
+}
+
+// Exists
+// проверяет, сохранены ли уже все варианты изображения в папке
+func (p *Processed) Exists(dir string) bool {
+	for name := range p.Variants {
+		if _, err := os.Stat(p.Path(dir, name)); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Save
+// сохраняет все варианты изображения в папку, создавая её при необходимости
+// или возвращает ошибки apperrors.ErrFailedToCreateFile, apperrors.ErrFailedToSaveFile
+func (p *Processed) Save(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return apperrors.ErrFailedToCreateFile
+	}
+	for name, content := range p.Variants {
+		if err := os.WriteFile(p.Path(dir, name), content, 0o644); err != nil {
+			return apperrors.ErrFailedToSaveFile
+		}
+	}
+	return nil
+}
+
+// Remove
+// удаляет все варианты изображения из папки
+// или возвращает ошибку apperrors.ErrFailedToDeleteFile
+func (p *Processed) Remove(dir string) error {
+	for name := range p.Variants {
+		if err := os.Remove(p.Path(dir, name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return apperrors.ErrFailedToDeleteFile
+		}
+	}
+	return nil
+}
+
+// Prune
+// удаляет из папки варианты всех изображений, кроме изображения с данным хэшем.
+// Файлы, названные не по схеме вариантов, не трогаются
+// или возвращает ошибку apperrors.ErrFailedToDeleteFile
+func Prune(dir string, keepHash string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return apperrors.ErrFailedToDeleteFile
+	}
+	for _, entry := range entries {
+		match := variantFilePattern.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil || match[1] == keepHash {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, entry.Name())); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return apperrors.ErrFailedToDeleteFile
+		}
+	}
+	return nil
+}
+
+func resize(src image.Image, variant Variant) image.Image {
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	if variant.Crop {
+		side := width
+		if height < side {
+			side = height
+		}
+		x0 := bounds.Min.X + (width-side)/2
+		y0 := bounds.Min.Y + (height-side)/2
+		bounds = image.Rect(x0, y0, x0+side, y0+side)
+
+		dst := image.NewNRGBA(image.Rect(0, 0, variant.Size, variant.Size))
+		draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Src, nil)
+		return dst
+	}
+
+	targetWidth, targetHeight := width, height
+	if width > variant.Size || height > variant.Size {
+		if width >= height {
+			targetWidth = variant.Size
+			targetHeight = max(1, height*variant.Size/width)
+		} else {
+			targetHeight = variant.Size
+			targetWidth = max(1, width*variant.Size/height)
+		}
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, targetWidth, targetHeight))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Src, nil)
+	return dst
+}
@@ -0,0 +1,139 @@
+package images
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"server/internal/apperrors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func encodedImage(t *testing.T, format string, width int, height int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	var err error
+	switch format {
+	case "png":
+		err = png.Encode(&buf, img)
+	case "jpeg":
+		err = jpeg.Encode(&buf, img, nil)
+	case "gif":
+		err = gif.Encode(&buf, img, nil)
+	}
+	require.NoError(t, err)
+	return buf.Bytes()
+}
+
+func TestDetectFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		data    []byte
+		format  string
+		wantErr error
+	}{
+		{name: "PNG", data: encodedImage(t, "png", 2, 2), format: "png"},
+		{name: "JPEG", data: encodedImage(t, "jpeg", 2, 2), format: "jpeg"},
+		{name: "GIF", data: encodedImage(t, "gif", 2, 2), format: "gif"},
+		{name: "WebP", data: []byte("RIFF\x00\x00\x00\x00WEBPVP8 "), format: "webp"},
+		{name: "Not an image", data: []byte("<svg></svg>"), wantErr: apperrors.ErrInvalidImage},
+		{name: "Empty", data: nil, wantErr: apperrors.ErrInvalidImage},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			format, err := DetectFormat(tt.data)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.format, format)
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	_, format, err := Decode(encodedImage(t, "jpeg", 16, 8))
+	require.NoError(t, err)
+	require.Equal(t, "jpeg", format)
+
+	truncated := encodedImage(t, "png", 16, 16)
+	_, _, err = Decode(truncated[:len(truncated)/2])
+	require.ErrorIs(t, err, apperrors.ErrInvalidImage)
+
+	_, _, err = Decode(make([]byte, MaxFileSize+1))
+	require.ErrorIs(t, err, apperrors.ErrImageTooLarge)
+
+	// заголовок PNG с размерами, превышающими допустимые, без данных изображения
+	huge := encodedImage(t, "png", 1, 1)
+	huge[16], huge[17], huge[18], huge[19] = 0, 1, 0, 0
+	binary.BigEndian.PutUint32(huge[29:33], crc32.ChecksumIEEE(huge[12:29]))
+	_, _, err = Decode(huge)
+	require.ErrorIs(t, err, apperrors.ErrImageTooLarge)
+}
+
+func TestProcess(t *testing.T) {
+	t.Parallel()
+
+	data := encodedImage(t, "gif", 1024, 256)
+	processed, err := Process(data)
+	require.NoError(t, err)
+	require.Equal(t, "gif", processed.Format)
+	require.Len(t, processed.Hash, 64)
+
+	thumb, err := png.DecodeConfig(bytes.NewReader(processed.Variants[VariantThumb]))
+	require.NoError(t, err)
+	require.Equal(t, 128, thumb.Width)
+	require.Equal(t, 128, thumb.Height)
+
+	medium, err := png.DecodeConfig(bytes.NewReader(processed.Variants[VariantMedium]))
+	require.NoError(t, err)
+	require.Equal(t, 512, medium.Width)
+	require.Equal(t, 128, medium.Height)
+
+	again, err := Process(data)
+	require.NoError(t, err)
+	require.Equal(t, processed.Hash, again.Hash)
+}
+
+func TestProcessed_SaveAndPrune(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "1")
+	old, err := Process(encodedImage(t, "png", 32, 32))
+	require.NoError(t, err)
+	current, err := Process(encodedImage(t, "jpeg", 32, 32))
+	require.NoError(t, err)
+
+	require.False(t, current.Exists(dir))
+	require.NoError(t, old.Save(dir))
+	require.NoError(t, current.Save(dir))
+	require.True(t, current.Exists(dir))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "avatar.jpg"), []byte("keep"), 0o644))
+
+	require.NoError(t, Prune(dir, current.Hash))
+	require.False(t, old.Exists(dir))
+	require.True(t, current.Exists(dir))
+	require.FileExists(t, filepath.Join(dir, "avatar.jpg"))
+
+	require.NoError(t, current.Remove(dir))
+	require.False(t, current.Exists(dir))
+	require.NoError(t, Prune(filepath.Join(dir, "missing"), ""))
+}
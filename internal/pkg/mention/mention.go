@@ -0,0 +1,33 @@
+package mention
+
+import (
+	"regexp"
+	"strings"
+)
+
+// MaxMentions
+// наибольшее количество упоминаний в одном тексте, которые учитываются
+const MaxMentions = 20
+
+// mentionPattern
+// упоминание -- email пользователя после @ в начале текста или после пробела/скобки
+var mentionPattern = regexp.MustCompile(`(?:^|[\s(\[{,;:])@([A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,})`)
+
+// Emails
+// возвращает email упомянутых в тексте пользователей в нижнем регистре и в порядке первого упоминания, без повторов
+func Emails(text string) []string {
+	emails := []string{}
+	seen := map[string]struct{}{}
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		email := strings.ToLower(match[1])
+		if _, ok := seen[email]; ok {
+			continue
+		}
+		seen[email] = struct{}{}
+		emails = append(emails, email)
+		if len(emails) == MaxMentions {
+			break
+		}
+	}
+	return emails
+}
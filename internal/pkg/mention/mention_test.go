@@ -0,0 +1,44 @@
+package mention
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmails(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{
+			name: "No mentions",
+			text: "write to me at user@example.com",
+			want: []string{},
+		},
+		{
+			name: "Mentions in order without repeats",
+			text: "@Bob@Example.com please review, cc (@alice@example.com). Thanks @bob@example.com!",
+			want: []string{"bob@example.com", "alice@example.com"},
+		},
+		{
+			name: "Sentence end",
+			text: "Done by @carol@mail.example.org.",
+			want: []string{"carol@mail.example.org"},
+		},
+		{
+			name: "Not an email",
+			text: "@channel @bob",
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, Emails(tt.text))
+		})
+	}
+}
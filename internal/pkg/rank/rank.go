@@ -0,0 +1,113 @@
+package rank
+
+import (
+	"server/internal/apperrors"
+	"strings"
+)
+
+const (
+	// Digits
+	// алфавит ключей порядка; символы идут в порядке ASCII, поэтому ключи сравниваются как строки (COLLATE "C")
+	Digits = "0123456789abcdefghijklmnopqrstuvwxyz"
+	// MaxLength
+	// длина ключа, после превышения которой порядок всех элементов контейнера строится заново
+	MaxLength = 24
+)
+
+// Between
+// возвращает ключ, лежащий строго между prev и next; пустая граница означает начало или конец контейнера.
+// Ключи не оканчиваются на первый символ алфавита, поэтому перед любым ключом всегда есть место
+// или возвращает ошибки apperrors.ErrInvalidRankBounds
+func Between(prev string, next string) (string, error) {
+	if !valid(prev) || !valid(next) || (next != "" && prev >= next) {
+		return "", apperrors.ErrInvalidRankBounds
+	}
+	return midpoint(prev, next), nil
+}
+
+// Spread
+// возвращает n равномерно распределённых возрастающих ключей одинаковой длины
+// с запасом места между соседними ключами
+func Spread(n int) []string {
+	base := len(Digits)
+	length, capacity := 1, base
+	for capacity < (n+1)*base {
+		length++
+		capacity *= base
+	}
+	step := capacity / (n + 1)
+
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = strings.TrimRight(encode((i+1)*step, length), Digits[:1])
+	}
+	return keys
+}
+
+// midpoint
+// ищет середину между prev и next цифра за цифрой; prev < next, пустой next означает отсутствие верхней границы
+func midpoint(prev string, next string) string {
+	if next != "" {
+		n := 0
+		for n < len(next) && digitAt(prev, n) == next[n] {
+			n++
+		}
+		if n > 0 {
+			return next[:n] + midpoint(suffix(prev, n), next[n:])
+		}
+	}
+
+	low := 0
+	if prev != "" {
+		low = strings.IndexByte(Digits, prev[0])
+	}
+	high := len(Digits)
+	if next != "" {
+		high = strings.IndexByte(Digits, next[0])
+	}
+	if high-low > 1 {
+		return string(Digits[(low+high+1)/2])
+	}
+	if len(next) > 1 {
+		return next[:1]
+	}
+	return string(Digits[low]) + midpoint(suffix(prev, 1), "")
+}
+
+func valid(key string) bool {
+	if key == "" {
+		return true
+	}
+	if key[len(key)-1] == Digits[0] {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if strings.IndexByte(Digits, key[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func digitAt(key string, i int) byte {
+	if i < len(key) {
+		return key[i]
+	}
+	return Digits[0]
+}
+
+func suffix(key string, n int) string {
+	if n >= len(key) {
+		return ""
+	}
+	return key[n:]
+}
+
+func encode(value int, length int) string {
+	key := make([]byte, length)
+	for i := length - 1; i >= 0; i-- {
+		key[i] = Digits[value%len(Digits)]
+		value /= len(Digits)
+	}
+	return string(key)
+}
@@ -0,0 +1,136 @@
+package rank
+
+import (
+	"errors"
+	"server/internal/apperrors"
+	"sort"
+	"testing"
+)
+
+func TestBetween(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		prev    string
+		next    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Empty container",
+			want: "i",
+		},
+		{
+			name: "First position",
+			next: "i",
+			want: "9",
+		},
+		{
+			name: "Last position",
+			prev: "i",
+			want: "r",
+		},
+		{
+			name: "Adjacent digits",
+			prev: "a",
+			next: "b",
+			want: "ai",
+		},
+		{
+			name: "Before the smallest digit",
+			next: "1",
+			want: "0i",
+		},
+		{
+			name: "Common prefix",
+			prev: "a1",
+			next: "a3",
+			want: "a2",
+		},
+		{
+			name:    "Bounds out of order",
+			prev:    "b",
+			next:    "a",
+			wantErr: true,
+		},
+		{
+			name:    "Equal bounds",
+			prev:    "a",
+			next:    "a",
+			wantErr: true,
+		},
+		{
+			name:    "Trailing zero",
+			prev:    "a0",
+			wantErr: true,
+		},
+		{
+			name:    "Unknown symbol",
+			prev:    "A",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Between(tt.prev, tt.next)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Between() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, apperrors.ErrInvalidRankBounds) {
+				t.Errorf("Between() error = %v, want %v", err, apperrors.ErrInvalidRankBounds)
+			}
+			if got != tt.want {
+				t.Errorf("Between() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBetween_RepeatedInserts(t *testing.T) {
+	t.Parallel()
+
+	keys := []string{}
+	prev, next := "", ""
+	for i := 0; i < 200; i++ {
+		key, err := Between(prev, next)
+		if err != nil {
+			t.Fatalf("Between(%q, %q) error = %v", prev, next, err)
+		}
+		if key <= prev || (next != "" && key >= next) {
+			t.Fatalf("Between(%q, %q) = %q is out of bounds", prev, next, key)
+		}
+		keys = append(keys, key)
+		// чередуем вставку в начало и в середину, чтобы ключи росли
+		if i%2 == 0 {
+			next = key
+		} else {
+			prev = key
+		}
+	}
+	if len(keys[len(keys)-1]) <= MaxLength {
+		t.Errorf("keys did not grow past MaxLength: %q", keys[len(keys)-1])
+	}
+}
+
+func TestSpread(t *testing.T) {
+	t.Parallel()
+	for _, n := range []int{0, 1, 35, 36, 1000} {
+		keys := Spread(n)
+		if len(keys) != n {
+			t.Fatalf("Spread(%d) returned %d keys", n, len(keys))
+		}
+		if !sort.StringsAreSorted(keys) {
+			t.Errorf("Spread(%d) keys are not sorted", n)
+		}
+		for i, key := range keys {
+			if !valid(key) || key == "" || len(key) > MaxLength {
+				t.Errorf("Spread(%d) key %q is invalid", n, key)
+			}
+			if i > 0 && keys[i-1] == key {
+				t.Errorf("Spread(%d) key %q is repeated", n, key)
+			}
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package recurrence
+
+import (
+	"server/internal/apperrors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// FreqDaily
+	// повторение через каждые Interval дней
+	FreqDaily = "DAILY"
+	// FreqWeekly
+	// повторение в дни Weekdays каждой Interval-й недели
+	FreqWeekly = "WEEKLY"
+	// FreqMonthly
+	// повторение в день MonthDay каждого Interval-го месяца
+	FreqMonthly = "MONTHLY"
+	// MaxInterval
+	// наибольший шаг повторения
+	MaxInterval = 366
+	// maxRuleLength
+	// наибольшая длина записи правила
+	maxRuleLength = 256
+)
+
+const (
+	untilLayout     = "20060102T150405Z"
+	untilDateLayout = "20060102"
+)
+
+// weekdayCodes
+// коды дней недели RRULE в порядке недели, начинающейся с понедельника
+var weekdayCodes = []string{"MO", "TU", "WE", "TH", "FR", "SA", "SU"}
+
+// Rule
+// правило повторения -- подмножество RRULE из RFC 5545: FREQ (DAILY, WEEKLY, MONTHLY), INTERVAL,
+// BYDAY для WEEKLY, BYMONTHDAY для MONTHLY и UNTIL.
+// Дни недели хранятся номерами от 0 (понедельник) до 6 (воскресенье) по возрастанию
+type Rule struct {
+	Freq     string
+	Interval int
+	Weekdays []int
+	MonthDay int
+	Until    *time.Time
+}
+
+// Parse
+// разбирает правило вида "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH" (префикс "RRULE:" допускается).
+// UNTIL задаётся в UTC как 20060102T150405Z или датой 20060102 -- тогда повторения идут до конца этого дня по UTC
+// или возвращает ошибку apperrors.ErrInvalidRecurrenceRule
+func Parse(value string) (Rule, error) {
+	value = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(value)), "RRULE:")
+	if value == "" || len(value) > maxRuleLength {
+		return Rule{}, apperrors.ErrInvalidRecurrenceRule
+	}
+
+	rule := Rule{Interval: 1}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok || val == "" || seen[key] {
+			return Rule{}, apperrors.ErrInvalidRecurrenceRule
+		}
+		seen[key] = true
+
+		var err error
+		switch key {
+		case "FREQ":
+			rule.Freq = val
+		case "INTERVAL":
+			rule.Interval, err = strconv.Atoi(val)
+		case "BYDAY":
+			rule.Weekdays, err = parseWeekdays(val)
+		case "BYMONTHDAY":
+			rule.MonthDay, err = strconv.Atoi(val)
+		case "UNTIL":
+			rule.Until, err = parseUntil(val)
+		default:
+			return Rule{}, apperrors.ErrInvalidRecurrenceRule
+		}
+		if err != nil {
+			return Rule{}, apperrors.ErrInvalidRecurrenceRule
+		}
+	}
+
+	if err := rule.validate(); err != nil {
+		return Rule{}, err
+	}
+	return rule, nil
+}
+
+// WithDefaults
+// возвращает правило, в котором не заданные день недели или день месяца взяты из первого срока first,
+// чтобы следующие сроки не зависели от того, на какой день пришёлся предыдущий
+func (r Rule) WithDefaults(first time.Time) Rule {
+	switch r.Freq {
+	case FreqWeekly:
+		if len(r.Weekdays) == 0 {
+			r.Weekdays = []int{weekdayIndex(first)}
+		}
+	case FreqMonthly:
+		if r.MonthDay == 0 {
+			r.MonthDay = first.Day()
+		}
+	}
+	return r
+}
+
+// Next
+// возвращает первый срок правила строго после after, сохраняя время суток after в часовом поясе loc.
+// Если в месяце нет дня MonthDay, берётся последний день месяца.
+// Второе значение ложно, если после after по правилу сроков больше нет
+func (r Rule) Next(after time.Time, loc *time.Location) (time.Time, bool) {
+	local := after.In(loc)
+	var next time.Time
+
+	switch r.Freq {
+	case FreqDaily:
+		next = local.AddDate(0, 0, r.Interval)
+	case FreqWeekly:
+		weekday := weekdayIndex(local)
+		next = local.AddDate(0, 0, 7*r.Interval-weekday+r.Weekdays[0])
+		for _, day := range r.Weekdays {
+			if day > weekday {
+				next = local.AddDate(0, 0, day-weekday)
+				break
+			}
+		}
+	case FreqMonthly:
+		year, month, _ := local.Date()
+		if day := clampDay(year, month, r.MonthDay); day > local.Day() {
+			next = withDate(local, year, month, day)
+		} else {
+			shifted := time.Date(year, month+time.Month(r.Interval), 1, 0, 0, 0, 0, loc)
+			next = withDate(local, shifted.Year(), shifted.Month(), clampDay(shifted.Year(), shifted.Month(), r.MonthDay))
+		}
+	default:
+		return time.Time{}, false
+	}
+
+	if r.Until != nil && next.After(*r.Until) {
+		return time.Time{}, false
+	}
+	return next, true
+}
+
+// String
+// возвращает правило в каноническом виде RRULE без префикса
+func (r Rule) String() string {
+	parts := []string{"FREQ=" + r.Freq, "INTERVAL=" + strconv.Itoa(r.Interval)}
+	if len(r.Weekdays) != 0 {
+		codes := make([]string, 0, len(r.Weekdays))
+		for _, day := range r.Weekdays {
+			codes = append(codes, weekdayCodes[day])
+		}
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+	if r.MonthDay != 0 {
+		parts = append(parts, "BYMONTHDAY="+strconv.Itoa(r.MonthDay))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(untilLayout))
+	}
+	return strings.Join(parts, ";")
+}
+
+func (r Rule) validate() error {
+	switch r.Freq {
+	case FreqDaily:
+		if len(r.Weekdays) != 0 || r.MonthDay != 0 {
+			return apperrors.ErrInvalidRecurrenceRule
+		}
+	case FreqWeekly:
+		if r.MonthDay != 0 {
+			return apperrors.ErrInvalidRecurrenceRule
+		}
+	case FreqMonthly:
+		if len(r.Weekdays) != 0 || r.MonthDay < 0 || r.MonthDay > 31 {
+			return apperrors.ErrInvalidRecurrenceRule
+		}
+	default:
+		return apperrors.ErrInvalidRecurrenceRule
+	}
+	if r.Interval < 1 || r.Interval > MaxInterval {
+		return apperrors.ErrInvalidRecurrenceRule
+	}
+	return nil
+}
+
+func parseWeekdays(value string) ([]int, error) {
+	seen := map[int]bool{}
+	days := []int{}
+	for _, code := range strings.Split(value, ",") {
+		day := -1
+		for i, known := range weekdayCodes {
+			if code == known {
+				day = i
+			}
+		}
+		if day < 0 {
+			return nil, apperrors.ErrInvalidRecurrenceRule
+		}
+		if !seen[day] {
+			seen[day] = true
+			days = append(days, day)
+		}
+	}
+	sort.Ints(days)
+	return days, nil
+}
+
+func parseUntil(value string) (*time.Time, error) {
+	if until, err := time.Parse(untilLayout, value); err == nil {
+		return &until, nil
+	}
+	day, err := time.Parse(untilDateLayout, value)
+	if err != nil {
+		return nil, err
+	}
+	until := day.Add(24*time.Hour - time.Second)
+	return &until, nil
+}
+
+func weekdayIndex(moment time.Time) int {
+	return (int(moment.Weekday()) + 6) % 7
+}
+
+func clampDay(year int, month time.Month, day int) int {
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if day > last {
+		return last
+	}
+	return day
+}
+
+func withDate(moment time.Time, year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, moment.Hour(), moment.Minute(), moment.Second(), moment.Nanosecond(), moment.Location())
+}
@@ -0,0 +1,173 @@
+package recurrence
+
+import (
+	"server/internal/apperrors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		rule    string
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Daily",
+			rule: "FREQ=DAILY",
+			want: "FREQ=DAILY;INTERVAL=1",
+		},
+		{
+			name: "Weekly with prefix, lowercase and repeated days",
+			rule: "rrule:freq=weekly;interval=2;byday=th,mo,th",
+			want: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH",
+		},
+		{
+			name: "Monthly until date",
+			rule: "FREQ=MONTHLY;BYMONTHDAY=31;UNTIL=20241231",
+			want: "FREQ=MONTHLY;INTERVAL=1;BYMONTHDAY=31;UNTIL=20241231T235959Z",
+		},
+		{
+			name:    "Yearly",
+			rule:    "FREQ=YEARLY",
+			wantErr: apperrors.ErrInvalidRecurrenceRule,
+		},
+		{
+			name:    "No frequency",
+			rule:    "INTERVAL=2",
+			wantErr: apperrors.ErrInvalidRecurrenceRule,
+		},
+		{
+			name:    "Zero interval",
+			rule:    "FREQ=DAILY;INTERVAL=0",
+			wantErr: apperrors.ErrInvalidRecurrenceRule,
+		},
+		{
+			name:    "Days of week for monthly rule",
+			rule:    "FREQ=MONTHLY;BYDAY=MO",
+			wantErr: apperrors.ErrInvalidRecurrenceRule,
+		},
+		{
+			name:    "Unknown day",
+			rule:    "FREQ=WEEKLY;BYDAY=XX",
+			wantErr: apperrors.ErrInvalidRecurrenceRule,
+		},
+		{
+			name:    "Unsupported part",
+			rule:    "FREQ=DAILY;COUNT=3",
+			wantErr: apperrors.ErrInvalidRecurrenceRule,
+		},
+		{
+			name:    "Repeated part",
+			rule:    "FREQ=DAILY;FREQ=WEEKLY",
+			wantErr: apperrors.ErrInvalidRecurrenceRule,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Parse(tt.rule)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				require.Equal(t, tt.want, got.String())
+			}
+		})
+	}
+}
+
+func TestRule_Next(t *testing.T) {
+	t.Parallel()
+
+	moscow, _ := time.LoadLocation("Europe/Moscow")
+	// среда
+	wednesday := time.Date(2024, 1, 10, 9, 30, 0, 0, moscow)
+	tests := []struct {
+		name   string
+		rule   string
+		after  time.Time
+		want   time.Time
+		wantOk bool
+	}{
+		{
+			name:   "Every two days",
+			rule:   "FREQ=DAILY;INTERVAL=2",
+			after:  wednesday,
+			want:   time.Date(2024, 1, 12, 9, 30, 0, 0, moscow),
+			wantOk: true,
+		},
+		{
+			name:   "Later day of the same week",
+			rule:   "FREQ=WEEKLY;BYDAY=MO,FR",
+			after:  wednesday,
+			want:   time.Date(2024, 1, 12, 9, 30, 0, 0, moscow),
+			wantOk: true,
+		},
+		{
+			name:   "First day of the week after the interval",
+			rule:   "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE",
+			after:  wednesday,
+			want:   time.Date(2024, 1, 22, 9, 30, 0, 0, moscow),
+			wantOk: true,
+		},
+		{
+			name:   "Last day of a short month",
+			rule:   "FREQ=MONTHLY;BYMONTHDAY=31",
+			after:  time.Date(2024, 1, 31, 9, 30, 0, 0, moscow),
+			want:   time.Date(2024, 2, 29, 9, 30, 0, 0, moscow),
+			wantOk: true,
+		},
+		{
+			name:   "Day stays after a short month",
+			rule:   "FREQ=MONTHLY;BYMONTHDAY=31",
+			after:  time.Date(2024, 2, 29, 9, 30, 0, 0, moscow),
+			want:   time.Date(2024, 3, 31, 9, 30, 0, 0, moscow),
+			wantOk: true,
+		},
+		{
+			name:   "Every quarter",
+			rule:   "FREQ=MONTHLY;INTERVAL=3;BYMONTHDAY=10",
+			after:  wednesday,
+			want:   time.Date(2024, 4, 10, 9, 30, 0, 0, moscow),
+			wantOk: true,
+		},
+		{
+			name:   "Past the end of the series",
+			rule:   "FREQ=DAILY;UNTIL=20240110",
+			after:  wednesday,
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rule, err := Parse(tt.rule)
+			require.NoError(t, err)
+
+			got, ok := rule.Next(tt.after.UTC(), moscow)
+			require.Equal(t, tt.wantOk, ok)
+			if tt.wantOk {
+				require.True(t, got.Equal(tt.want), "got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRule_WithDefaults(t *testing.T) {
+	t.Parallel()
+
+	first := time.Date(2024, 1, 10, 9, 30, 0, 0, time.UTC)
+
+	weekly, err := Parse("FREQ=WEEKLY")
+	require.NoError(t, err)
+	require.Equal(t, "FREQ=WEEKLY;INTERVAL=1;BYDAY=WE", weekly.WithDefaults(first).String())
+
+	monthly, err := Parse("FREQ=MONTHLY;INTERVAL=2")
+	require.NoError(t, err)
+	require.Equal(t, "FREQ=MONTHLY;INTERVAL=2;BYMONTHDAY=10", monthly.WithDefaults(first).String())
+}
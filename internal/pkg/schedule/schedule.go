@@ -0,0 +1,122 @@
+package schedule
+
+import (
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultTimezone
+	// часовой пояс пользователя, который не выбрал свой
+	DefaultTimezone = "UTC"
+	// MaxReminders
+	// наибольшее количество напоминаний о сроке у задания или пользователя
+	MaxReminders = 5
+	// MaxReminderOffset
+	// наибольшее смещение напоминания в минутах до срока -- 30 дней
+	MaxReminderOffset = 30 * 24 * 60
+)
+
+// ValidateTimezone
+// проверяет, что name -- название часового пояса из базы IANA, и возвращает его без пробелов по краям
+// или возвращает ошибку apperrors.ErrInvalidTimezone
+func ValidateTimezone(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || name == "Local" {
+		return "", apperrors.ErrInvalidTimezone
+	}
+	if _, err := time.LoadLocation(name); err != nil {
+		return "", apperrors.ErrInvalidTimezone
+	}
+	return name, nil
+}
+
+// Location
+// возвращает часовой пояс по названию; неизвестное или пустое название означает UTC
+func Location(name string) *time.Location {
+	if _, err := ValidateTimezone(name); err != nil {
+		return time.UTC
+	}
+	loc, _ := time.LoadLocation(strings.TrimSpace(name))
+	return loc
+}
+
+// Normalize
+// проверяет сроки обновляемого задания и приводит их к UTC.
+// У задания на весь день сроки округляются до начала дня в часовом поясе пользователя loc,
+// и окончание может совпадать с началом; у остальных начало должно быть строго раньше окончания
+// или возвращает ошибку apperrors.ErrInvalidTaskSchedule
+func Normalize(info *dto.UpdatedTaskInfo, loc *time.Location) error {
+	if info.AllDay {
+		info.Start = startOfDay(info.Start, loc)
+		info.End = startOfDay(info.End, loc)
+	}
+
+	if info.Start != nil && info.End != nil {
+		if info.End.Before(*info.Start) || (!info.AllDay && info.End.Equal(*info.Start)) {
+			return apperrors.ErrInvalidTaskSchedule
+		}
+	}
+
+	info.Start = inLocation(info.Start, time.UTC)
+	info.End = inLocation(info.End, time.UTC)
+	return nil
+}
+
+// Localize
+// переводит сроки задания в часовой пояс пользователя loc
+func Localize(task *dto.SingleTaskInfo, loc *time.Location) {
+	task.Start = inLocation(task.Start, loc)
+	task.End = inLocation(task.End, loc)
+}
+
+// LocalizeAll
+// переводит сроки заданий в часовой пояс пользователя loc
+func LocalizeAll(tasks []dto.SingleTaskInfo, loc *time.Location) {
+	for i := range tasks {
+		Localize(&tasks[i], loc)
+	}
+}
+
+// NormalizeReminders
+// проверяет смещения напоминаний в минутах до срока и возвращает их без повторов по возрастанию
+// или возвращает ошибку apperrors.ErrInvalidReminderOffsets
+func NormalizeReminders(offsets []uint64) ([]uint64, error) {
+	normalized := make([]uint64, 0, len(offsets))
+	seen := make(map[uint64]struct{}, len(offsets))
+	for _, offset := range offsets {
+		if offset == 0 || offset > MaxReminderOffset {
+			return nil, apperrors.ErrInvalidReminderOffsets
+		}
+		if _, ok := seen[offset]; ok {
+			continue
+		}
+		seen[offset] = struct{}{}
+		normalized = append(normalized, offset)
+	}
+	if len(normalized) > MaxReminders {
+		return nil, apperrors.ErrInvalidReminderOffsets
+	}
+	sort.Slice(normalized, func(i, j int) bool { return normalized[i] < normalized[j] })
+	return normalized, nil
+}
+
+func startOfDay(moment *time.Time, loc *time.Location) *time.Time {
+	if moment == nil {
+		return nil
+	}
+	local := moment.In(loc)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	return &day
+}
+
+func inLocation(moment *time.Time, loc *time.Location) *time.Time {
+	if moment == nil {
+		return nil
+	}
+	converted := moment.In(loc)
+	return &converted
+}
@@ -0,0 +1,204 @@
+package schedule
+
+import (
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTimezone(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		timezone string
+		want     string
+		wantErr  error
+	}{
+		{
+			name:     "IANA name",
+			timezone: " Europe/Moscow ",
+			want:     "Europe/Moscow",
+		},
+		{
+			name:     "UTC",
+			timezone: "UTC",
+			want:     "UTC",
+		},
+		{
+			name:     "Empty",
+			timezone: "  ",
+			wantErr:  apperrors.ErrInvalidTimezone,
+		},
+		{
+			name:     "Server local time",
+			timezone: "Local",
+			wantErr:  apperrors.ErrInvalidTimezone,
+		},
+		{
+			name:     "Unknown",
+			timezone: "Mars/Olympus",
+			wantErr:  apperrors.ErrInvalidTimezone,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ValidateTimezone(tt.timezone)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLocation(t *testing.T) {
+	t.Parallel()
+	require.Equal(t, "Asia/Tokyo", Location("Asia/Tokyo").String())
+	require.Equal(t, time.UTC, Location("Mars/Olympus"))
+	require.Equal(t, time.UTC, Location(""))
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+	at := func(value string) *time.Time {
+		moment, err := time.ParseInLocation("2006-01-02 15:04", value, moscow)
+		require.NoError(t, err)
+		return &moment
+	}
+	utc := func(value string) *time.Time {
+		moment, err := time.ParseInLocation("2006-01-02 15:04", value, time.UTC)
+		require.NoError(t, err)
+		return &moment
+	}
+
+	tests := []struct {
+		name      string
+		info      dto.UpdatedTaskInfo
+		wantStart *time.Time
+		wantEnd   *time.Time
+		wantErr   error
+	}{
+		{
+			name:      "Timed task is stored in UTC",
+			info:      dto.UpdatedTaskInfo{Start: at("2024-03-01 10:00"), End: at("2024-03-01 12:30")},
+			wantStart: utc("2024-03-01 07:00"),
+			wantEnd:   utc("2024-03-01 09:30"),
+		},
+		{
+			name: "Dates are cleared",
+			info: dto.UpdatedTaskInfo{},
+		},
+		{
+			name:    "Only deadline",
+			info:    dto.UpdatedTaskInfo{End: at("2024-03-01 12:30")},
+			wantEnd: utc("2024-03-01 09:30"),
+		},
+		{
+			name:    "Start after end",
+			info:    dto.UpdatedTaskInfo{Start: at("2024-03-02 10:00"), End: at("2024-03-01 12:30")},
+			wantErr: apperrors.ErrInvalidTaskSchedule,
+		},
+		{
+			name:    "Timed task without duration",
+			info:    dto.UpdatedTaskInfo{Start: at("2024-03-01 10:00"), End: at("2024-03-01 10:00")},
+			wantErr: apperrors.ErrInvalidTaskSchedule,
+		},
+		{
+			name:      "All-day task is rounded to user's midnight",
+			info:      dto.UpdatedTaskInfo{Start: at("2024-03-01 01:00"), End: at("2024-03-03 23:59"), AllDay: true},
+			wantStart: utc("2024-02-29 21:00"),
+			wantEnd:   utc("2024-03-02 21:00"),
+		},
+		{
+			name:      "Single day all-day task",
+			info:      dto.UpdatedTaskInfo{Start: at("2024-03-01 09:00"), End: at("2024-03-01 18:00"), AllDay: true},
+			wantStart: utc("2024-02-29 21:00"),
+			wantEnd:   utc("2024-02-29 21:00"),
+		},
+		{
+			name:    "All-day task ending the day before",
+			info:    dto.UpdatedTaskInfo{Start: at("2024-03-02 09:00"), End: at("2024-03-01 18:00"), AllDay: true},
+			wantErr: apperrors.ErrInvalidTaskSchedule,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			info := tt.info
+			err := Normalize(&info, moscow)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			require.Equal(t, tt.wantStart, info.Start)
+			require.Equal(t, tt.wantEnd, info.End)
+		})
+	}
+}
+
+func TestLocalizeAll(t *testing.T) {
+	t.Parallel()
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	end := time.Date(2024, 3, 1, 20, 0, 0, 0, time.UTC)
+
+	tasks := []dto.SingleTaskInfo{{ID: 1, End: &end}, {ID: 2}}
+	LocalizeAll(tasks, tokyo)
+
+	require.Equal(t, tokyo, tasks[0].End.Location())
+	require.True(t, tasks[0].End.Equal(end))
+	require.Equal(t, 2, tasks[0].End.Day())
+	require.Nil(t, tasks[1].Start)
+	require.Nil(t, tasks[1].End)
+	require.Equal(t, time.UTC, end.Location())
+}
+
+func TestNormalizeReminders(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		offsets []uint64
+		want    []uint64
+		wantErr error
+	}{
+		{
+			name:    "Sorted without repeats",
+			offsets: []uint64{1440, 60, 1440},
+			want:    []uint64{60, 1440},
+		},
+		{
+			name: "No reminders",
+			want: []uint64{},
+		},
+		{
+			name:    "Zero offset",
+			offsets: []uint64{0},
+			wantErr: apperrors.ErrInvalidReminderOffsets,
+		},
+		{
+			name:    "Too far before due",
+			offsets: []uint64{MaxReminderOffset + 1},
+			wantErr: apperrors.ErrInvalidReminderOffsets,
+		},
+		{
+			name:    "Too many",
+			offsets: []uint64{1, 2, 3, 4, 5, 6},
+			wantErr: apperrors.ErrInvalidReminderOffsets,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NormalizeReminders(tt.offsets)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
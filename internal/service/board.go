@@ -15,8 +15,8 @@ type IBoardService interface {
 	// создаёт доску и связь с пользователем-создателем
 	Create(context.Context, dto.NewBoardInfo) (*entities.Board, error)
 	// UpdateData
-	// возвращает доску со связанными пользователями, списками и заданиями
-	UpdateData(context.Context, dto.UpdatedBoardInfo) error
+	// обновляет данные доски и возвращает её текущее состояние, в том числе при конфликте версий
+	UpdateData(context.Context, dto.UpdatedBoardInfo) (*dto.SingleBoardInfo, error)
 	// UpdateThumbnail
 	// сохраняет картинку доски в папку images/board_thumbnails с названием id доски и сохраняет ссылку на изображение в БД
 	UpdateThumbnail(context.Context, dto.UpdatedBoardThumbnailInfo) (*dto.UrlObj, error)
@@ -30,9 +30,48 @@ type IBoardService interface {
 	// добавляет пользователя на доску
 	RemoveUser(context.Context, dto.RemoveBoardUserInfo) error
 	// GetHistory
-	// возвращает историю изменения доски
-	GetHistory(context.Context, dto.BoardID) (*[]dto.BoardHistoryEntry, error)
-	// SubmitEdit
-	// записывает изменение доски в историю
-	SubmitEdit(context.Context, dto.NewHistoryEntry) error
+	// возвращает историю изменения доски с учётом фильтров и пагинации
+	GetHistory(context.Context, dto.BoardHistoryRequest) (*[]dto.BoardHistoryEntry, error)
+	// RevertHistory
+	// отменяет изменение из истории доски и записывает отмену в историю
+	RevertHistory(context.Context, dto.HistoryEntryID) error
+	// ExportCSV
+	// выгружает задания доски в формате CSV
+	ExportCSV(context.Context, dto.IndividualBoardRequest) (*dto.ExportedBoard, error)
+	// ExportMarkdown
+	// выгружает задания доски в формате Markdown
+	ExportMarkdown(context.Context, dto.IndividualBoardRequest) (*dto.ExportedBoard, error)
+	// GetStats
+	// возвращает статистику доски: задания по спискам, исполнителям и тэгам, сроки, чеклисты и динамику по неделям
+	GetStats(context.Context, dto.BoardStatsRequest) (*dto.BoardStats, error)
+	// GetArchive
+	// возвращает архивные списки и задания доски
+	// или возвращает ошибки ...
+	GetArchive(context.Context, dto.BoardID) (*dto.BoardArchive, error)
+	// GetVersion
+	// возвращает текущую версию доски, которая увеличивается при каждом её изменении, и часовой пояс пользователя
+	GetVersion(context.Context, dto.BoardID) (*dto.BoardVersion, error)
+	// GetChanges
+	// возвращает сущности доски, созданные, изменённые или удалённые после указанной версии
+	GetChanges(context.Context, dto.BoardChangesRequest) (*dto.BoardChanges, error)
+	// Watch
+	// подписывает пользователя на изменения доски
+	// или возвращает ошибки ...
+	Watch(context.Context, dto.BoardID) error
+	// Unwatch
+	// отписывает пользователя от изменений доски
+	// или возвращает ошибки ...
+	Unwatch(context.Context, dto.BoardID) error
+	// GetWatchers
+	// возвращает наблюдателей доски
+	// или возвращает ошибки ...
+	GetWatchers(context.Context, dto.BoardID) (*[]dto.UserPublicInfo, error)
+	// GetTimeReport
+	// возвращает отчёт о времени по доске за период
+	// или возвращает ошибки ...
+	GetTimeReport(context.Context, dto.TimeReportRequest) (*dto.TimeReport, error)
+	// ExportTimeReportCSV
+	// выгружает отчёт о времени по доске за период в формате CSV
+	// или возвращает ошибки ...
+	ExportTimeReportCSV(context.Context, dto.TimeReportRequest) (*dto.ExportedBoard, error)
 }
@@ -15,7 +15,9 @@ func NewMicroBoardService(bs storage.IBoardStorage,
 	cs storage.ICommentStorage,
 	cls storage.IChecklistStorage,
 	clis storage.IChecklistItemStorage,
+	cfs storage.ICustomFieldStorage,
+	hs storage.IHistoryStorage,
 	connection *grpc.ClientConn,
 ) *micro.BoardService {
-	return micro.NewBoardService(bs, ts, us, cs, cls, clis, connection)
+	return micro.NewBoardService(bs, ts, us, cs, cls, clis, cfs, hs, connection)
 }
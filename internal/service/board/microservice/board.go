@@ -2,13 +2,19 @@ package microservice
 
 import (
 	"context"
-	"os"
+	"errors"
 	"server/internal/apperrors"
 	logger "server/internal/logging"
+	"server/internal/pkg/customfields"
 	"server/internal/pkg/dto"
 	"server/internal/pkg/entities"
+	"server/internal/pkg/images"
+	"server/internal/pkg/schedule"
+	"server/internal/service/history"
 	"server/internal/storage"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
@@ -21,6 +27,9 @@ type BoardService struct {
 	commentStorage       storage.ICommentStorage
 	checklistStorage     storage.IChecklistStorage
 	checklistItemStorage storage.IChecklistItemStorage
+	customFieldStorage   storage.ICustomFieldStorage
+	historyStorage       storage.IHistoryStorage
+	history              *history.Recorder
 }
 
 // NewBoardService
@@ -32,6 +41,8 @@ func NewBoardService(
 	cs storage.ICommentStorage,
 	cls storage.IChecklistStorage,
 	clis storage.IChecklistItemStorage,
+	cfs storage.ICustomFieldStorage,
+	hs storage.IHistoryStorage,
 	conn *grpc.ClientConn,
 ) *BoardService {
 	return &BoardService{
@@ -41,13 +52,29 @@ func NewBoardService(
 		commentStorage:       cs,
 		checklistStorage:     cls,
 		checklistItemStorage: clis,
+		customFieldStorage:   cfs,
+		historyStorage:       hs,
+		history:              history.NewRecorder(hs),
 	}
 }
 
 const nodeName = "service"
 
+const boardThumbnailsDir = "img/board_thumbnails/"
+
+const (
+	defaultHistoryPageSize = 50
+	maxHistoryPageSize     = 200
+)
+
+const (
+	defaultStatsWeeks = 12
+	maxStatsWeeks     = 52
+)
+
 // GetFullBoard
-// возвращает доску со связанными пользователями, списками и заданиями
+// возвращает доску со связанными пользователями, списками и заданиями;
+// при наличии фильтров по пользовательским полям возвращает только подходящие под все условия задания
 func (bs BoardService) GetFullBoard(ctx context.Context, info dto.IndividualBoardRequest) (*dto.FullBoardResult, error) {
 	funcName := "BoardService.GetFullBoard"
 	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
@@ -57,6 +84,10 @@ func (bs BoardService) GetFullBoard(ctx context.Context, info dto.IndividualBoar
 		Value: info.BoardID,
 	}
 
+	if info.DueFrom != nil && info.DueTo != nil && info.DueFrom.After(*info.DueTo) {
+		return nil, apperrors.ErrInvalidBoardFilter
+	}
+
 	users, err := bs.boardStorage.GetUsers(ctx, boardID)
 	if err != nil {
 		return nil, err
@@ -86,16 +117,46 @@ func (bs BoardService) GetFullBoard(ctx context.Context, info dto.IndividualBoar
 	}
 	logger.DebugFmt("Got lists", requestID.String(), funcName, nodeName)
 
-	taskIDs := dto.TaskIDs{}
+	customFields, err := bs.customFieldStorage.ReadMany(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	logger.DebugFmt("Got custom fields", requestID.String(), funcName, nodeName)
+
+	if len(info.CustomFields) != 0 {
+		err = bs.filterByCustomFields(ctx, *customFields, info.CustomFields, *lists)
+		if err != nil {
+			return nil, err
+		}
+		logger.DebugFmt("Tasks filtered by custom fields", requestID.String(), funcName, nodeName)
+	}
+
+	taskFilter := dto.TaskFilter{
+		AssigneeIDs: info.AssigneeIDs,
+		TagIDs:      info.TagIDs,
+		DueFrom:     info.DueFrom,
+		DueTo:       info.DueTo,
+		Text:        strings.TrimSpace(info.Text),
+	}
 	for _, list := range *lists {
-		taskIDs.Values = append(taskIDs.Values, list.TaskIDs...)
+		taskFilter.TaskIDs = append(taskFilter.TaskIDs, list.TaskIDs...)
+	}
+	tasks, err := bs.taskStorage.ReadMany(ctx, taskFilter)
+	if err != nil {
+		return nil, err
 	}
-	tasks, err := bs.taskStorage.ReadMany(ctx, taskIDs)
+	timezone, err := bs.userStorage.GetTimezone(ctx, dto.UserID{Value: info.UserID})
 	if err != nil {
 		return nil, err
 	}
+	schedule.LocalizeAll(*tasks, schedule.Location(timezone))
 	logger.DebugFmt("Got tasks", requestID.String(), funcName, nodeName)
 
+	if len(*tasks) != len(taskFilter.TaskIDs) {
+		keepListedTasks(*lists, *tasks)
+		logger.DebugFmt("Lists narrowed to filtered tasks", requestID.String(), funcName, nodeName)
+	}
+
 	commentIDs := dto.CommentIDs{}
 	checklistIDs := dto.ChecklistIDs{}
 	for _, task := range *tasks {
@@ -104,114 +165,260 @@ func (bs BoardService) GetFullBoard(ctx context.Context, info dto.IndividualBoar
 	}
 	logger.DebugFmt("Got comment ids", requestID.String(), funcName, nodeName)
 
-	comments, err := bs.commentStorage.ReadMany(ctx, commentIDs)
-	if err != nil {
-		return nil, err
+	comments := &[]dto.CommentInfo{}
+	if info.IncludeComments == nil || *info.IncludeComments {
+		comments, err = bs.commentStorage.ReadMany(ctx, commentIDs)
+		if err != nil {
+			return nil, err
+		}
+		logger.DebugFmt("Got comments", requestID.String(), funcName, nodeName)
 	}
-	logger.DebugFmt("Got comments", requestID.String(), funcName, nodeName)
 
-	checklists, err := bs.checklistStorage.ReadMany(ctx, checklistIDs)
-	if err != nil {
-		return nil, err
-	}
-	logger.DebugFmt("Got checklists", requestID.String(), funcName, nodeName)
+	checklists := &[]dto.ChecklistInfo{}
+	checklistItems := &[]dto.ChecklistItemInfo{}
+	if info.IncludeChecklists == nil || *info.IncludeChecklists {
+		checklists, err = bs.checklistStorage.ReadMany(ctx, checklistIDs)
+		if err != nil {
+			return nil, err
+		}
+		logger.DebugFmt("Got checklists", requestID.String(), funcName, nodeName)
 
-	checklistItemIDs := dto.ChecklistItemStringIDs{}
-	for _, checklist := range *checklists {
-		checklistItemIDs.Values = append(checklistItemIDs.Values, checklist.Items...)
+		checklistItemIDs := dto.ChecklistItemStringIDs{}
+		for _, checklist := range *checklists {
+			checklistItemIDs.Values = append(checklistItemIDs.Values, checklist.Items...)
+		}
+		checklistItems, err = bs.checklistItemStorage.ReadMany(ctx, checklistItemIDs)
+		if err != nil {
+			return nil, err
+		}
+		logger.DebugFmt("Got checklist items", requestID.String(), funcName, nodeName)
 	}
-	checklistItems, err := bs.checklistItemStorage.ReadMany(ctx, checklistItemIDs)
+
+	tags, err := bs.boardStorage.GetTags(ctx, boardID)
 	if err != nil {
 		return nil, err
 	}
-	logger.DebugFmt("Got checklist items", requestID.String(), funcName, nodeName)
+	logger.DebugFmt("Got tags", requestID.String(), funcName, nodeName)
 
-	tags, err := bs.boardStorage.GetTags(ctx, boardID)
+	values, err := bs.customFieldStorage.ReadValues(ctx, boardID)
 	if err != nil {
 		return nil, err
 	}
-	logger.DebugFmt("Got tags", requestID.String(), funcName, nodeName)
+	visibleValues := []dto.CustomFieldValueInfo{}
+	visibleTasks := make(map[uint64]struct{}, len(*tasks))
+	for _, task := range *tasks {
+		visibleTasks[task.ID] = struct{}{}
+	}
+	for _, value := range *values {
+		if _, ok := visibleTasks[value.TaskID]; ok {
+			visibleValues = append(visibleValues, value)
+		}
+	}
+	logger.DebugFmt("Got custom field values", requestID.String(), funcName, nodeName)
 
 	return &dto.FullBoardResult{
-		Users:          *users,
-		Board:          *board,
-		Lists:          *lists,
-		Tags:           *tags,
-		Tasks:          *tasks,
-		Comments:       *comments,
-		Checklists:     *checklists,
-		ChecklistItems: *checklistItems,
+		Users:             *users,
+		Board:             *board,
+		Lists:             *lists,
+		Tags:              *tags,
+		Tasks:             *tasks,
+		Comments:          *comments,
+		Checklists:        *checklists,
+		ChecklistItems:    *checklistItems,
+		CustomFields:      *customFields,
+		CustomFieldValues: visibleValues,
+		Timezone:          timezone,
 	}, nil
 }
 
+// keepListedTasks
+// оставляет в списках только задания, прошедшие фильтр, сохраняя их порядок
+func keepListedTasks(lists []dto.SingleListInfo, tasks []dto.SingleTaskInfo) {
+	visible := make(map[string]struct{}, len(tasks))
+	for _, task := range tasks {
+		visible[strconv.FormatUint(task.ID, 10)] = struct{}{}
+	}
+	for i := range lists {
+		taskIDs := []string{}
+		for _, id := range lists[i].TaskIDs {
+			if _, ok := visible[id]; ok {
+				taskIDs = append(taskIDs, id)
+			}
+		}
+		lists[i].TaskIDs = taskIDs
+	}
+}
+
+// filterByCustomFields
+// оставляет в списках только задания, значения пользовательских полей которых подходят под все условия
+// или возвращает ошибки apperrors.ErrCustomFieldNotFound (404), apperrors.ErrInvalidCustomFieldValue (400), ...
+func (bs BoardService) filterByCustomFields(ctx context.Context, fields []dto.CustomFieldInfo, filters []dto.CustomFieldFilter, lists []dto.SingleListInfo) error {
+	boardFields := make(map[uint64]dto.CustomFieldInfo, len(fields))
+	for _, field := range fields {
+		boardFields[field.ID] = field
+	}
+
+	request := dto.CustomFieldTaskFilter{TaskIDs: []string{}}
+	for _, filter := range filters {
+		field, ok := boardFields[filter.FieldID]
+		if !ok {
+			return apperrors.ErrCustomFieldNotFound
+		}
+		normalized, err := customfields.NormalizeFilter(field, filter)
+		if err != nil {
+			return err
+		}
+		request.Filters = append(request.Filters, normalized)
+	}
+	for _, list := range lists {
+		request.TaskIDs = append(request.TaskIDs, list.TaskIDs...)
+	}
+
+	matching, err := bs.customFieldStorage.FilterTasks(ctx, request)
+	if err != nil {
+		return err
+	}
+	matched := make(map[string]struct{}, len(matching))
+	for _, id := range matching {
+		matched[id] = struct{}{}
+	}
+
+	for i := range lists {
+		taskIDs := []string{}
+		for _, id := range lists[i].TaskIDs {
+			if _, ok := matched[id]; ok {
+				taskIDs = append(taskIDs, id)
+			}
+		}
+		lists[i].TaskIDs = taskIDs
+	}
+	return nil
+}
+
 // Create
-// создаёт доску и связь с пользователем-создателем
+// создаёт доску и связь с пользователем-создателем; если не удалось сохранить обложку, доска не создаётся
+// или возвращает ошибки apperrors.ErrInvalidImage (400), apperrors.ErrImageTooLarge (413), ...
 func (bs BoardService) Create(ctx context.Context, board dto.NewBoardInfo) (*entities.Board, error) {
 	funcName := "BoardService.Create"
 	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
 	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
 
-	defaultURL := "main_theme.jpg"
-	if board.Thumbnail == nil {
-		board.ThumbnailURL = &defaultURL
+	var thumbnail *images.Processed
+	if board.Thumbnail != nil {
+		processed, err := images.Process(*board.Thumbnail)
+		if err != nil {
+			return nil, err
+		}
+		thumbnail = processed
+		logger.DebugFmt("Thumbnail processed, source format "+processed.Format, requestID.String(), funcName, nodeName)
 	}
-	newBoard, err := bs.boardStorage.Create(ctx, board)
+
+	defaultURL := "main_theme.jpg"
+	board.ThumbnailURL = &defaultURL
+	var newBoard *entities.Board
+	var thumbnailDir string
+	err := bs.history.Create(ctx, func(ctx context.Context) (dto.HistoryEntityRef, error) {
+		var err error
+		newBoard, err = bs.boardStorage.Create(ctx, board)
+		if err != nil {
+			return dto.HistoryEntityRef{}, err
+		}
+		logger.DebugFmt("Board created", requestID.String(), funcName, nodeName)
+
+		if thumbnail != nil {
+			dir := boardThumbnailsDir + strconv.FormatUint(newBoard.ID, 10)
+			if err = thumbnail.Save(dir); err != nil {
+				return dto.HistoryEntityRef{}, err
+			}
+			thumbnailDir = dir
+
+			url := thumbnail.Path(dir, images.VariantMedium)
+			err = bs.boardStorage.UpdateThumbnailUrl(ctx, dto.BoardImageUrlInfo{ID: newBoard.ID, Url: url})
+			if err != nil {
+				return dto.HistoryEntityRef{}, err
+			}
+			newBoard.ThumbnailURL = &url
+			logger.DebugFmt("Thumbnail location: "+url, requestID.String(), funcName, nodeName)
+		}
+		return boardRef(newBoard.ID), nil
+	})
 	if err != nil {
+		if thumbnailDir != "" {
+			if errRemove := thumbnail.Remove(thumbnailDir); errRemove != nil {
+				logger.Error("Failed to remove thumbnail after unsuccessful create: " + errRemove.Error())
+			}
+		}
 		return nil, err
 	}
-	logger.DebugFmt("Board created", requestID.String(), funcName, nodeName)
-
-	// thumbnailUrlInfo := dto.ImageUrlInfo{
-	// 	ID:  info.ID,
-	// 	Url: info.BaseURL + "/" + fileLocation,
-	// }
-	// f, err := os.Create(thumbnailUrlInfo.Url)
-	// if err != nil {
-	// 	return nil, err
-	// }
-	// defer f.Close()
-	fileLocation := "img/board_thumbnails/" + strconv.FormatUint(newBoard.ID, 10) + ".png"
-	logger.DebugFmt("Thumbnail location: "+fileLocation, requestID.String(), funcName, nodeName)
 	return newBoard, nil
 }
 
 // UpdateData
-// возвращает доску со связанными пользователями, списками и заданиями
-func (bs BoardService) UpdateData(ctx context.Context, info dto.UpdatedBoardInfo) error {
-	return bs.boardStorage.UpdateData(ctx, info)
+// обновляет данные доски, если клиент видел её последнюю версию, и возвращает её текущее состояние.
+// При конфликте версий состояние возвращается вместе с ошибкой
+// или возвращает ошибки apperrors.ErrRowVersionMissing (400), apperrors.ErrRowVersionConflict (409), ...
+func (bs BoardService) UpdateData(ctx context.Context, info dto.UpdatedBoardInfo) (*dto.SingleBoardInfo, error) {
+	if info.RowVersion == 0 {
+		return nil, apperrors.ErrRowVersionMissing
+	}
+
+	err := bs.history.Track(ctx, dto.HistoryActionUpdate, func(ctx context.Context) error {
+		return bs.boardStorage.UpdateData(ctx, info)
+	}, boardRef(info.ID))
+	if err != nil && !errors.Is(err, apperrors.ErrRowVersionConflict) {
+		return nil, err
+	}
+
+	board, readErr := bs.boardStorage.GetById(ctx, dto.BoardID{Value: info.ID})
+	if readErr != nil {
+		return nil, readErr
+	}
+	return board, err
 }
 
 // UpdateThumbnail
-// сохраняет картинку доски в папку images/board_thumbnails с названием id доски и сохраняет ссылку на изображение в БД
+// обрабатывает загруженное изображение, сохраняет его варианты и обновляет ссылку на обложку доски,
+// удаляя варианты предыдущей обложки: поэтому смена обложки в истории не отменяется
+// или возвращает ошибки apperrors.ErrInvalidImage (400), apperrors.ErrImageTooLarge (413)
 func (bs BoardService) UpdateThumbnail(ctx context.Context, info dto.UpdatedBoardThumbnailInfo) (*dto.UrlObj, error) {
 	funcName := "BoardService.UpdateThumbnail"
 	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
 	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
 
-	fileLocation := "img/board_thumbnails/" + strconv.FormatUint(info.ID, 10) + ".png"
-	logger.DebugFmt("File location:"+fileLocation, requestID.String(), funcName, nodeName)
-
-	thumbnailUrlInfo := dto.BoardImageUrlInfo{
-		ID:  info.ID,
-		Url: fileLocation,
-	}
-	f, err := os.Create(thumbnailUrlInfo.Url)
+	processed, err := images.Process(info.Thumbnail)
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close()
-	logger.DebugFmt("File created", requestID.String(), funcName, nodeName)
+	logger.DebugFmt("Image processed, source format "+processed.Format, requestID.String(), funcName, nodeName)
 
-	err = bs.boardStorage.UpdateThumbnailUrl(ctx, thumbnailUrlInfo)
+	dir := boardThumbnailsDir + strconv.FormatUint(info.ID, 10)
+	existed := processed.Exists(dir)
+	if err = processed.Save(dir); err != nil {
+		return nil, err
+	}
+	logger.DebugFmt("Image variants saved to "+dir, requestID.String(), funcName, nodeName)
+
+	thumbnailUrlInfo := dto.BoardImageUrlInfo{
+		ID:  info.ID,
+		Url: processed.Path(dir, images.VariantMedium),
+	}
+	err = bs.history.Track(ctx, dto.HistoryActionUpdate, func(ctx context.Context) error {
+		return bs.boardStorage.UpdateThumbnailUrl(ctx, thumbnailUrlInfo)
+	}, boardRef(info.ID))
 	if err != nil {
-		errDelete := os.Remove(thumbnailUrlInfo.Url)
-		for errDelete != nil {
-			errDelete = os.Remove(thumbnailUrlInfo.Url)
+		if !existed {
+			if errRemove := processed.Remove(dir); errRemove != nil {
+				logger.Error("Failed to remove thumbnail after unsuccessful update: " + errRemove.Error())
+			}
 		}
 		return nil, err
 	}
 	logger.DebugFmt("thumbnail url updated", requestID.String(), funcName, nodeName)
 
+	if err = images.Prune(dir, processed.Hash); err != nil {
+		logger.Error("Failed to remove previous thumbnail variants: " + err.Error())
+	}
+
 	return &dto.UrlObj{Value: thumbnailUrlInfo.Url}, nil
 }
 
@@ -266,7 +473,17 @@ func (bs BoardService) AddUser(ctx context.Context, request dto.AddBoardUserRequ
 		BoardID:     request.BoardID,
 		WorkspaceID: request.WorkspaceID,
 	}
-	return bs.boardStorage.AddUser(ctx, info)
+	var user dto.UserPublicInfo
+	err = bs.history.Related(ctx, dto.HistoryActionAddUser, func(ctx context.Context) error {
+		var err error
+		user, err = bs.boardStorage.AddUser(ctx, info)
+		return err
+	}, &dto.HistoryFieldChange{Field: "id_user", After: targetUser.ID}, boardRef(request.BoardID))
+	if err != nil {
+		return dto.UserPublicInfo{}, err
+	}
+
+	return user, nil
 }
 
 // RemoveUser
@@ -303,17 +520,438 @@ func (bs BoardService) RemoveUser(ctx context.Context, info dto.RemoveBoardUserI
 	}
 	logger.DebugFmt("user in board", requestID.String(), funcName, nodeName)
 
-	return bs.boardStorage.RemoveUser(ctx, info)
+	return bs.history.Related(ctx, dto.HistoryActionRemoveUser, func(ctx context.Context) error {
+		return bs.boardStorage.RemoveUser(ctx, info)
+	}, &dto.HistoryFieldChange{Field: "id_user", Before: info.UserID}, boardRef(info.BoardID))
 }
 
 // GetHistory
-// возвращает историю изменения доски
-func (bs BoardService) GetHistory(ctx context.Context, id dto.BoardID) (*[]dto.BoardHistoryEntry, error) {
-	return bs.boardStorage.GetHistory(ctx, id)
+// возвращает историю изменения доски с учётом фильтров и пагинации
+func (bs BoardService) GetHistory(ctx context.Context, request dto.BoardHistoryRequest) (*[]dto.BoardHistoryEntry, error) {
+	funcName := "BoardService.GetHistory"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	accessInfo := dto.CheckBoardAccessInfo{
+		UserID:  ctx.Value(dto.UserObjKey).(*entities.User).ID,
+		BoardID: request.BoardID,
+	}
+	userAccess, err := bs.boardStorage.CheckAccess(ctx, accessInfo)
+	if err != nil {
+		return nil, apperrors.ErrCouldNotGetUser
+	}
+	if !userAccess {
+		return nil, apperrors.ErrNoBoardAccess
+	}
+	logger.DebugFmt("User has access to board", requestID.String(), funcName, nodeName)
+
+	if request.Limit == 0 {
+		request.Limit = defaultHistoryPageSize
+	}
+	if request.Limit > maxHistoryPageSize {
+		request.Limit = maxHistoryPageSize
+	}
+
+	return bs.historyStorage.ReadMany(ctx, request)
+}
+
+// RevertHistory
+// отменяет изменение из истории доски и записывает отмену в историю. Хранилище проверяет, что сущность всё ещё
+// на этой доске и после отмены останется на доске пользователя, а удалённую сущность восстанавливает, только если
+// вместе с ней ничего не было удалено
+// или возвращает ошибки apperrors.ErrHistoryNotRevertible (400), apperrors.ErrNoBoardAccess (403),
+// apperrors.ErrHistoryRevertConflict (409), ...
+func (bs BoardService) RevertHistory(ctx context.Context, id dto.HistoryEntryID) error {
+	funcName := "BoardService.RevertHistory"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+	user := ctx.Value(dto.UserObjKey).(*entities.User)
+
+	entry, err := bs.historyStorage.Read(ctx, id)
+	if err != nil {
+		return err
+	}
+	logger.DebugFmt("Got history entry", requestID.String(), funcName, nodeName)
+
+	userAccess, err := bs.boardStorage.CheckAccess(ctx, dto.CheckBoardAccessInfo{
+		UserID:  user.ID,
+		BoardID: entry.BoardID,
+	})
+	if err != nil {
+		return apperrors.ErrCouldNotGetUser
+	}
+	if !userAccess {
+		return apperrors.ErrNoBoardAccess
+	}
+	logger.DebugFmt("User has access to board", requestID.String(), funcName, nodeName)
+
+	revert, err := history.Inverse(*entry, user.ID)
+	if err != nil {
+		return err
+	}
+	logger.DebugFmt("Revert entry built", requestID.String(), funcName, nodeName)
+
+	return bs.historyStorage.Revert(ctx, dto.HistoryRevertInfo{
+		Original: *entry,
+		Revert:   revert,
+	})
 }
 
-// SubmitEdit
-// записывает изменение доски в историю
-func (bs BoardService) SubmitEdit(ctx context.Context, entry dto.NewHistoryEntry) error {
-	return bs.boardStorage.SubmitEdit(ctx, entry)
+// GetStats
+// возвращает статистику доски, посчитанную в хранилище; число недель динамики ограничено maxStatsWeeks
+func (bs BoardService) GetStats(ctx context.Context, request dto.BoardStatsRequest) (*dto.BoardStats, error) {
+	funcName := "BoardService.GetStats"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	userAccess, err := bs.boardStorage.CheckAccess(ctx, dto.CheckBoardAccessInfo{
+		UserID:  ctx.Value(dto.UserObjKey).(*entities.User).ID,
+		BoardID: request.BoardID,
+	})
+	if err != nil {
+		return nil, apperrors.ErrCouldNotGetUser
+	}
+	if !userAccess {
+		return nil, apperrors.ErrNoBoardAccess
+	}
+	logger.DebugFmt("User has access to board", requestID.String(), funcName, nodeName)
+
+	if request.Weeks == 0 {
+		request.Weeks = defaultStatsWeeks
+	}
+	if request.Weeks > maxStatsWeeks {
+		request.Weeks = maxStatsWeeks
+	}
+
+	return bs.boardStorage.GetStats(ctx, request)
+}
+
+// GetArchive
+// возвращает архивные списки доски и скрытые архивом задания: архивные или лежащие в архивных списках
+// или возвращает ошибки apperrors.ErrNoBoardAccess (403), ...
+func (bs BoardService) GetArchive(ctx context.Context, id dto.BoardID) (*dto.BoardArchive, error) {
+	funcName := "BoardService.GetArchive"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	userAccess, err := bs.boardStorage.CheckAccess(ctx, dto.CheckBoardAccessInfo{
+		UserID:  ctx.Value(dto.UserObjKey).(*entities.User).ID,
+		BoardID: id.Value,
+	})
+	if err != nil {
+		return nil, apperrors.ErrCouldNotGetUser
+	}
+	if !userAccess {
+		return nil, apperrors.ErrNoBoardAccess
+	}
+	logger.DebugFmt("User has access to board", requestID.String(), funcName, nodeName)
+
+	lists, err := bs.boardStorage.GetArchivedLists(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	logger.DebugFmt("Got archived lists", requestID.String(), funcName, nodeName)
+
+	archive := dto.BoardArchive{
+		BoardID: id.Value,
+		Lists:   *lists,
+		Tasks:   []dto.SingleTaskInfo{},
+	}
+
+	taskIDs, err := bs.boardStorage.GetArchivedTaskIDs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(taskIDs) != 0 {
+		tasks, err := bs.taskStorage.ReadMany(ctx, dto.TaskFilter{TaskIDs: taskIDs})
+		if err != nil {
+			return nil, err
+		}
+		if err = bs.localizeTasks(ctx, ctx.Value(dto.UserObjKey).(*entities.User).ID, *tasks); err != nil {
+			return nil, err
+		}
+		archive.Tasks = *tasks
+	}
+	logger.DebugFmt("Got archived tasks", requestID.String(), funcName, nodeName)
+
+	return &archive, nil
+}
+
+// localizeTasks
+// переводит сроки заданий в часовой пояс пользователя userID
+// или возвращает ошибки apperrors.ErrUserNotFound, ...
+func (bs BoardService) localizeTasks(ctx context.Context, userID uint64, tasks []dto.SingleTaskInfo) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+	timezone, err := bs.userStorage.GetTimezone(ctx, dto.UserID{Value: userID})
+	if err != nil {
+		return err
+	}
+	schedule.LocalizeAll(tasks, schedule.Location(timezone))
+	return nil
+}
+
+func boardRef(id uint64) dto.HistoryEntityRef {
+	return dto.HistoryEntityRef{EntityType: dto.HistoryEntityBoard, EntityID: id}
+}
+
+// GetVersion
+// возвращает текущую версию доски и часовой пояс пользователя, проверив его доступ к ней
+func (bs BoardService) GetVersion(ctx context.Context, id dto.BoardID) (*dto.BoardVersion, error) {
+	funcName := "BoardService.GetVersion"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	accessInfo := dto.CheckBoardAccessInfo{
+		UserID:  ctx.Value(dto.UserObjKey).(*entities.User).ID,
+		BoardID: id.Value,
+	}
+	userAccess, err := bs.boardStorage.CheckAccess(ctx, accessInfo)
+	if err != nil {
+		return nil, apperrors.ErrCouldNotGetUser
+	}
+	if !userAccess {
+		return nil, apperrors.ErrNoBoardAccess
+	}
+	logger.DebugFmt("User has access to board", requestID.String(), funcName, nodeName)
+
+	board, err := bs.boardStorage.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	timezone, err := bs.userStorage.GetTimezone(ctx, dto.UserID{Value: accessInfo.UserID})
+	if err != nil {
+		return nil, err
+	}
+	return &dto.BoardVersion{Version: board.Version, Timezone: timezone}, nil
+}
+
+// GetChanges
+// собирает изменения доски после указанной версии по её истории: для созданных и изменённых сущностей
+// возвращает их текущее состояние, для удалённых -- надгробия. Сущность, которой больше нет на доске
+// (например, удалённая вместе со списком или убранная в архив), тоже возвращается как удалённая.
+// Версия ответа читается до изменений, поэтому при следующем запросе с ней изменения могут повториться, но не потеряются
+func (bs BoardService) GetChanges(ctx context.Context, request dto.BoardChangesRequest) (*dto.BoardChanges, error) {
+	funcName := "BoardService.GetChanges"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	accessInfo := dto.CheckBoardAccessInfo{
+		UserID:  ctx.Value(dto.UserObjKey).(*entities.User).ID,
+		BoardID: request.BoardID,
+	}
+	userAccess, err := bs.boardStorage.CheckAccess(ctx, accessInfo)
+	if err != nil {
+		return nil, apperrors.ErrCouldNotGetUser
+	}
+	if !userAccess {
+		return nil, apperrors.ErrNoBoardAccess
+	}
+	logger.DebugFmt("User has access to board", requestID.String(), funcName, nodeName)
+
+	boardID := dto.BoardID{Value: request.BoardID}
+	board, err := bs.boardStorage.GetById(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.BoardChanges{
+		Version:           board.Version,
+		Users:             []dto.UserPublicInfo{},
+		Lists:             []dto.SingleListInfo{},
+		Tasks:             []dto.SingleTaskInfo{},
+		Comments:          []dto.CommentInfo{},
+		Checklists:        []dto.ChecklistInfo{},
+		ChecklistItems:    []dto.ChecklistItemInfo{},
+		Tags:              []dto.TagInfo{},
+		CustomFields:      []dto.CustomFieldInfo{},
+		CustomFieldValues: []dto.CustomFieldValueInfo{},
+		Deleted:           []dto.DeletedEntity{},
+	}
+	if request.Since >= board.Version {
+		return result, nil
+	}
+
+	changes, err := bs.historyStorage.ReadChanges(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	logger.DebugFmt("Got changed entities", requestID.String(), funcName, nodeName)
+
+	changed := map[string]map[uint64]uint64{}
+	for _, change := range *changes {
+		if change.Action == dto.HistoryActionDelete {
+			result.Deleted = append(result.Deleted, dto.DeletedEntity{
+				EntityType: change.EntityType,
+				EntityID:   change.EntityID,
+				Version:    change.Version,
+			})
+			continue
+		}
+		if changed[change.EntityType] == nil {
+			changed[change.EntityType] = map[uint64]uint64{}
+		}
+		changed[change.EntityType][change.EntityID] = change.Version
+	}
+
+	if len(changed[dto.HistoryEntityBoard]) != 0 {
+		result.Board = board
+		users, err := bs.boardStorage.GetUsers(ctx, boardID)
+		if err != nil {
+			return nil, err
+		}
+		result.Users = *users
+	}
+
+	if ids := changed[dto.HistoryEntityList]; len(ids) != 0 {
+		lists, err := bs.boardStorage.GetLists(ctx, boardID)
+		if err != nil {
+			return nil, err
+		}
+		for _, list := range *lists {
+			if takeChanged(ids, list.ID) {
+				result.Lists = append(result.Lists, list)
+			}
+		}
+	}
+
+	if ids := changed[dto.HistoryEntityTask]; len(ids) != 0 {
+		tasks, err := bs.taskStorage.ReadMany(ctx, dto.TaskFilter{TaskIDs: changedIDs(ids)})
+		if err != nil {
+			return nil, err
+		}
+		if err = bs.localizeTasks(ctx, accessInfo.UserID, *tasks); err != nil {
+			return nil, err
+		}
+		lists, err := bs.boardStorage.GetLists(ctx, boardID)
+		if err != nil {
+			return nil, err
+		}
+		onBoard := make(map[uint64]struct{}, len(*lists))
+		for _, list := range *lists {
+			onBoard[list.ID] = struct{}{}
+		}
+		for _, task := range *tasks {
+			if _, ok := onBoard[task.ListID]; ok && task.DateArchived == nil && takeChanged(ids, task.ID) {
+				result.Tasks = append(result.Tasks, task)
+			}
+		}
+
+		values, err := bs.customFieldStorage.ReadValues(ctx, boardID)
+		if err != nil {
+			return nil, err
+		}
+		visibleTasks := make(map[uint64]struct{}, len(result.Tasks))
+		for _, task := range result.Tasks {
+			visibleTasks[task.ID] = struct{}{}
+		}
+		for _, value := range *values {
+			if _, ok := visibleTasks[value.TaskID]; ok {
+				result.CustomFieldValues = append(result.CustomFieldValues, value)
+			}
+		}
+	}
+
+	if ids := changed[dto.HistoryEntityComment]; len(ids) != 0 {
+		comments, err := bs.commentStorage.ReadMany(ctx, dto.CommentIDs{Values: changedIDs(ids)})
+		if err != nil {
+			return nil, err
+		}
+		for _, comment := range *comments {
+			if takeChanged(ids, comment.ID) {
+				result.Comments = append(result.Comments, comment)
+			}
+		}
+	}
+
+	if ids := changed[dto.HistoryEntityChecklist]; len(ids) != 0 {
+		checklists, err := bs.checklistStorage.ReadMany(ctx, dto.ChecklistIDs{Values: changedIDs(ids)})
+		if err != nil {
+			return nil, err
+		}
+		for _, checklist := range *checklists {
+			if takeChanged(ids, checklist.ID) {
+				result.Checklists = append(result.Checklists, checklist)
+			}
+		}
+	}
+
+	if ids := changed[dto.HistoryEntityChecklistItem]; len(ids) != 0 {
+		items, err := bs.checklistItemStorage.ReadMany(ctx, dto.ChecklistItemStringIDs{Values: changedIDs(ids)})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range *items {
+			if takeChanged(ids, item.ID) {
+				result.ChecklistItems = append(result.ChecklistItems, item)
+			}
+		}
+	}
+
+	if ids := changed[dto.HistoryEntityTag]; len(ids) != 0 {
+		tags, err := bs.boardStorage.GetTags(ctx, boardID)
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range *tags {
+			if takeChanged(ids, tag.ID) {
+				result.Tags = append(result.Tags, tag)
+			}
+		}
+	}
+
+	if ids := changed[dto.HistoryEntityCustomField]; len(ids) != 0 {
+		fields, err := bs.customFieldStorage.ReadMany(ctx, boardID)
+		if err != nil {
+			return nil, err
+		}
+		for _, field := range *fields {
+			if takeChanged(ids, field.ID) {
+				result.CustomFields = append(result.CustomFields, field)
+			}
+		}
+	}
+	logger.DebugFmt("Got current state of changed entities", requestID.String(), funcName, nodeName)
+
+	for entityType, ids := range changed {
+		if entityType == dto.HistoryEntityBoard {
+			continue
+		}
+		for id, version := range ids {
+			result.Deleted = append(result.Deleted, dto.DeletedEntity{
+				EntityType: entityType,
+				EntityID:   id,
+				Version:    version,
+			})
+		}
+	}
+	sort.Slice(result.Deleted, func(i, j int) bool {
+		if result.Deleted[i].EntityType != result.Deleted[j].EntityType {
+			return result.Deleted[i].EntityType < result.Deleted[j].EntityType
+		}
+		return result.Deleted[i].EntityID < result.Deleted[j].EntityID
+	})
+
+	return result, nil
+}
+
+// takeChanged
+// отмечает изменённую сущность как найденную на доске; оставшиеся неотмеченными сущности считаются удалёнными
+func takeChanged(ids map[uint64]uint64, id uint64) bool {
+	if _, ok := ids[id]; !ok {
+		return false
+	}
+	delete(ids, id)
+	return true
+}
+
+// changedIDs
+// возвращает id изменённых сущностей строками, как их принимают хранилища
+func changedIDs(ids map[uint64]uint64) []string {
+	values := make([]string, 0, len(ids))
+	for id := range ids {
+		values = append(values, strconv.FormatUint(id, 10))
+	}
+	return values
 }
@@ -3,11 +3,19 @@ package microservice
 import (
 	"context"
 	"reflect"
+	"server/internal/config"
+	logging "server/internal/logging"
 	"server/internal/pkg/dto"
 	"server/internal/pkg/entities"
+	"server/internal/service/history"
 	"server/internal/storage"
+	"server/mocks/mock_storage"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 )
 
@@ -244,7 +252,7 @@ func TestBoardService_UpdateData(t *testing.T) {
 				checklistStorage:     tt.fields.checklistStorage,
 				checklistItemStorage: tt.fields.checklistItemStorage,
 			}
-			if err := bs.UpdateData(tt.args.ctx, tt.args.info); (err != nil) != tt.wantErr {
+			if _, err := bs.UpdateData(tt.args.ctx, tt.args.info); (err != nil) != tt.wantErr {
 				t.Errorf("UpdateData() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -303,6 +311,8 @@ func TestNewBoardService(t *testing.T) {
 		cs   storage.ICommentStorage
 		cls  storage.IChecklistStorage
 		clis storage.IChecklistItemStorage
+		cfs  storage.ICustomFieldStorage
+		hs   storage.IHistoryStorage
 		conn *grpc.ClientConn
 	}
 	tests := []struct {
@@ -314,9 +324,141 @@ func TestNewBoardService(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewBoardService(tt.args.bs, tt.args.ts, tt.args.us, tt.args.cs, tt.args.cls, tt.args.clis, tt.args.conn); !reflect.DeepEqual(got, tt.want) {
+			if got := NewBoardService(tt.args.bs, tt.args.ts, tt.args.us, tt.args.cs, tt.args.cls, tt.args.clis, tt.args.cfs, tt.args.hs, tt.args.conn); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("NewBoardService() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func TestBoardService_GetChanges(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	boardStorage := mock_storage.NewMockIBoardStorage(ctrl)
+	taskStorage := mock_storage.NewMockITaskStorage(ctrl)
+	commentStorage := mock_storage.NewMockICommentStorage(ctrl)
+	customFieldStorage := mock_storage.NewMockICustomFieldStorage(ctrl)
+	historyStorage := mock_storage.NewMockIHistoryStorage(ctrl)
+	userStorage := mock_storage.NewMockIUserStorage(ctrl)
+
+	logger, _ := logging.NewLogrusLogger(&config.LoggingConfig{Level: "debug"})
+	ctx := context.WithValue(
+		context.WithValue(
+			context.WithValue(context.Background(), dto.LoggerKey, &logger),
+			dto.UserObjKey, &entities.User{ID: 1},
+		),
+		dto.RequestIDKey, uuid.New(),
+	)
+	request := dto.BoardChangesRequest{BoardID: 1, Since: 2}
+	boardID := dto.BoardID{Value: 1}
+
+	boardStorage.EXPECT().CheckAccess(ctx, dto.CheckBoardAccessInfo{UserID: 1, BoardID: 1}).Return(true, nil)
+	boardStorage.EXPECT().GetById(ctx, boardID).Return(&dto.SingleBoardInfo{ID: 1, Version: 6}, nil)
+	historyStorage.EXPECT().ReadChanges(ctx, request).Return(&[]dto.BoardChange{
+		{EntityType: dto.HistoryEntityComment, EntityID: 7, Action: dto.HistoryActionCreate, Version: 6},
+		{EntityType: dto.HistoryEntityList, EntityID: 5, Action: dto.HistoryActionDelete, Version: 5},
+		{EntityType: dto.HistoryEntityTask, EntityID: 1, Action: dto.HistoryActionUpdate, Version: 3},
+		{EntityType: dto.HistoryEntityTask, EntityID: 2, Action: dto.HistoryActionMove, Version: 4},
+	}, nil)
+	taskStorage.EXPECT().ReadMany(ctx, gomock.Any()).Return(&[]dto.SingleTaskInfo{{ID: 1, ListID: 10}}, nil)
+	userStorage.EXPECT().GetTimezone(ctx, dto.UserID{Value: 1}).Return("UTC", nil)
+	boardStorage.EXPECT().GetLists(ctx, boardID).Return(&[]dto.SingleListInfo{{ID: 10}}, nil)
+	customFieldStorage.EXPECT().ReadValues(ctx, boardID).Return(&[]dto.CustomFieldValueInfo{{TaskID: 1}, {TaskID: 3}}, nil)
+	commentStorage.EXPECT().ReadMany(ctx, dto.CommentIDs{Values: []string{"7"}}).Return(&[]dto.CommentInfo{{ID: 7}}, nil)
+
+	bs := BoardService{
+		boardStorage:       boardStorage,
+		taskStorage:        taskStorage,
+		commentStorage:     commentStorage,
+		customFieldStorage: customFieldStorage,
+		historyStorage:     historyStorage,
+		userStorage:        userStorage,
+		history:            history.NewRecorder(historyStorage),
+	}
+	changes, err := bs.GetChanges(ctx, request)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(6), changes.Version)
+	require.Nil(t, changes.Board)
+	require.Equal(t, []dto.SingleTaskInfo{{ID: 1, ListID: 10}}, changes.Tasks)
+	require.Equal(t, []dto.CommentInfo{{ID: 7}}, changes.Comments)
+	require.Equal(t, []dto.CustomFieldValueInfo{{TaskID: 1}}, changes.CustomFieldValues)
+	require.Equal(t, []dto.DeletedEntity{
+		{EntityType: dto.HistoryEntityList, EntityID: 5, Version: 5},
+		{EntityType: dto.HistoryEntityTask, EntityID: 2, Version: 4},
+	}, changes.Deleted)
+}
+
+func TestBoardService_GetArchive(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	boardStorage := mock_storage.NewMockIBoardStorage(ctrl)
+	taskStorage := mock_storage.NewMockITaskStorage(ctrl)
+	userStorage := mock_storage.NewMockIUserStorage(ctrl)
+
+	logger, _ := logging.NewLogrusLogger(&config.LoggingConfig{Level: "debug"})
+	ctx := context.WithValue(
+		context.WithValue(
+			context.WithValue(context.Background(), dto.LoggerKey, &logger),
+			dto.UserObjKey, &entities.User{ID: 1},
+		),
+		dto.RequestIDKey, uuid.New(),
+	)
+	boardID := dto.BoardID{Value: 1}
+	due := time.Date(2024, 3, 1, 21, 30, 0, 0, time.UTC)
+	moscow, _ := time.LoadLocation("Europe/Moscow")
+	localDue := due.In(moscow)
+
+	boardStorage.EXPECT().CheckAccess(ctx, dto.CheckBoardAccessInfo{UserID: 1, BoardID: 1}).Return(true, nil)
+	boardStorage.EXPECT().GetArchivedLists(ctx, boardID).Return(&[]dto.SingleListInfo{{ID: 4, TaskIDs: []string{"9"}}}, nil)
+	boardStorage.EXPECT().GetArchivedTaskIDs(ctx, boardID).Return([]string{"7", "9"}, nil)
+	taskStorage.EXPECT().ReadMany(ctx, dto.TaskFilter{TaskIDs: []string{"7", "9"}}).
+		Return(&[]dto.SingleTaskInfo{{ID: 7, ListID: 3, End: &due}, {ID: 9, ListID: 4}}, nil)
+	userStorage.EXPECT().GetTimezone(ctx, dto.UserID{Value: 1}).Return("Europe/Moscow", nil)
+
+	bs := BoardService{
+		boardStorage: boardStorage,
+		taskStorage:  taskStorage,
+		userStorage:  userStorage,
+	}
+	archive, err := bs.GetArchive(ctx, boardID)
+	require.NoError(t, err)
+
+	require.Equal(t, &dto.BoardArchive{
+		BoardID: 1,
+		Lists:   []dto.SingleListInfo{{ID: 4, TaskIDs: []string{"9"}}},
+		Tasks:   []dto.SingleTaskInfo{{ID: 7, ListID: 3, End: &localDue}, {ID: 9, ListID: 4}},
+	}, archive)
+}
+
+func TestBoardService_GetVersion(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	boardStorage := mock_storage.NewMockIBoardStorage(ctrl)
+	userStorage := mock_storage.NewMockIUserStorage(ctrl)
+
+	logger, _ := logging.NewLogrusLogger(&config.LoggingConfig{Level: "debug"})
+	ctx := context.WithValue(
+		context.WithValue(
+			context.WithValue(context.Background(), dto.LoggerKey, &logger),
+			dto.UserObjKey, &entities.User{ID: 1},
+		),
+		dto.RequestIDKey, uuid.New(),
+	)
+	boardID := dto.BoardID{Value: 1}
+
+	boardStorage.EXPECT().CheckAccess(ctx, dto.CheckBoardAccessInfo{UserID: 1, BoardID: 1}).Return(true, nil)
+	boardStorage.EXPECT().GetById(ctx, boardID).Return(&dto.SingleBoardInfo{ID: 1, Version: 5}, nil)
+	userStorage.EXPECT().GetTimezone(ctx, dto.UserID{Value: 1}).Return("Europe/Moscow", nil)
+
+	bs := BoardService{
+		boardStorage: boardStorage,
+		userStorage:  userStorage,
+	}
+	version, err := bs.GetVersion(ctx, boardID)
+	require.NoError(t, err)
+	require.Equal(t, &dto.BoardVersion{Version: 5, Timezone: "Europe/Moscow"}, version)
+}
@@ -0,0 +1,304 @@
+package microservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	"server/internal/pkg/dto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const exportDateFormat = "2006-01-02 15:04"
+
+// csvFormulaPrefixes
+// символы, с которых табличные редакторы начинают формулу
+const csvFormulaPrefixes = "=+-@\t\r"
+
+// markdownEscaper
+// экранирует управляющие символы Markdown в названиях, переводы строк заменяются пробелами
+var markdownEscaper = strings.NewReplacer(
+	"\\", "\\\\", "`", "\\`", "*", "\\*", "_", "\\_", "#", "\\#", "|", "\\|",
+	"[", "\\[", "]", "\\]", "<", "\\<", ">", "\\>", "~", "\\~",
+	"\r\n", " ", "\n", " ", "\r", " ",
+)
+
+var csvExportHeader = []string{
+	"list", "name", "description", "assignees", "tags", "start", "end", "checklist progress", "date created",
+}
+
+// ExportCSV
+// выгружает задания доски в формате CSV
+func (bs BoardService) ExportCSV(ctx context.Context, info dto.IndividualBoardRequest) (*dto.ExportedBoard, error) {
+	funcName := "BoardService.ExportCSV"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	board, err := bs.GetFullBoard(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+	logger.DebugFmt("Got full board", requestID.String(), funcName, nodeName)
+
+	content, err := renderBoardCSV(board)
+	if err != nil {
+		logger.DebugFmt("Failed to render CSV with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotExportBoard
+	}
+	logger.DebugFmt("CSV rendered", requestID.String(), funcName, nodeName)
+
+	return &dto.ExportedBoard{
+		Filename:    "board_" + strconv.FormatUint(board.Board.ID, 10) + ".csv",
+		ContentType: "text/csv; charset=utf-8",
+		Content:     content,
+	}, nil
+}
+
+// ExportMarkdown
+// выгружает задания доски в формате Markdown, по разделу на каждый список
+func (bs BoardService) ExportMarkdown(ctx context.Context, info dto.IndividualBoardRequest) (*dto.ExportedBoard, error) {
+	funcName := "BoardService.ExportMarkdown"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	board, err := bs.GetFullBoard(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+	logger.DebugFmt("Got full board", requestID.String(), funcName, nodeName)
+
+	content := renderBoardMarkdown(board)
+	logger.DebugFmt("Markdown rendered", requestID.String(), funcName, nodeName)
+
+	return &dto.ExportedBoard{
+		Filename:    "board_" + strconv.FormatUint(board.Board.ID, 10) + ".md",
+		ContentType: "text/markdown; charset=utf-8",
+		Content:     content,
+	}, nil
+}
+
+// boardExportIndex
+// справочники доски, нужные для выгрузки заданий
+type boardExportIndex struct {
+	users          map[string]dto.UserPublicInfo
+	tags           map[string]dto.TagInfo
+	tasks          map[string]dto.SingleTaskInfo
+	checklistItems map[uint64][]dto.ChecklistItemInfo
+	taskChecklists map[uint64][]uint64
+}
+
+func newBoardExportIndex(board *dto.FullBoardResult) boardExportIndex {
+	index := boardExportIndex{
+		users:          map[string]dto.UserPublicInfo{},
+		tags:           map[string]dto.TagInfo{},
+		tasks:          map[string]dto.SingleTaskInfo{},
+		checklistItems: map[uint64][]dto.ChecklistItemInfo{},
+		taskChecklists: map[uint64][]uint64{},
+	}
+	for _, user := range board.Users {
+		index.users[strconv.FormatUint(user.ID, 10)] = user
+	}
+	for _, tag := range board.Tags {
+		index.tags[strconv.FormatUint(tag.ID, 10)] = tag
+	}
+	for _, task := range board.Tasks {
+		index.tasks[strconv.FormatUint(task.ID, 10)] = task
+	}
+	for _, item := range board.ChecklistItems {
+		index.checklistItems[item.ChecklistID] = append(index.checklistItems[item.ChecklistID], item)
+	}
+	for _, checklist := range board.Checklists {
+		index.taskChecklists[checklist.TaskID] = append(index.taskChecklists[checklist.TaskID], checklist.ID)
+	}
+	return index
+}
+
+func (index boardExportIndex) assignees(task dto.SingleTaskInfo) []string {
+	assignees := []string{}
+	for _, id := range task.UserIDs {
+		user, ok := index.users[id]
+		if !ok {
+			continue
+		}
+		assignees = append(assignees, userDisplayName(user))
+	}
+	return assignees
+}
+
+func (index boardExportIndex) tagNames(task dto.SingleTaskInfo) []string {
+	tags := []string{}
+	for _, id := range task.TagIDs {
+		tag, ok := index.tags[id]
+		if !ok {
+			continue
+		}
+		tags = append(tags, tag.Name)
+	}
+	return tags
+}
+
+func (index boardExportIndex) checklistProgress(task dto.SingleTaskInfo) (done int, total int) {
+	for _, checklistID := range index.taskChecklists[task.ID] {
+		for _, item := range index.checklistItems[checklistID] {
+			total++
+			if item.Done {
+				done++
+			}
+		}
+	}
+	return done, total
+}
+
+func userDisplayName(user dto.UserPublicInfo) string {
+	nameParts := []string{}
+	if user.Name != nil && *user.Name != "" {
+		nameParts = append(nameParts, *user.Name)
+	}
+	if user.Surname != nil && *user.Surname != "" {
+		nameParts = append(nameParts, *user.Surname)
+	}
+	if len(nameParts) == 0 {
+		return user.Email
+	}
+	return strings.Join(nameParts, " ")
+}
+
+func formatExportDate(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+	return date.Format(exportDateFormat)
+}
+
+// csvCell
+// защищает ячейку от выполнения как формулы при открытии файла в табличном редакторе
+func csvCell(value string) string {
+	if value != "" && strings.ContainsRune(csvFormulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// markdownText
+// экранирует текст для вставки в заголовок или строку списка Markdown
+func markdownText(value string) string {
+	return markdownEscaper.Replace(value)
+}
+
+// markdownQuote
+// экранирует многострочный текст и оформляет его цитатой, сохраняя деление на строки: описание задания
+// не может добавить в выгрузку свои заголовки или строки свойств
+func markdownQuote(value string) string {
+	lines := strings.Split(strings.ReplaceAll(strings.ReplaceAll(value, "\r\n", "\n"), "\r", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("> "+markdownText(line), " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func markdownTexts(values []string) []string {
+	escaped := make([]string, 0, len(values))
+	for _, value := range values {
+		escaped = append(escaped, markdownText(value))
+	}
+	return escaped
+}
+
+func renderBoardCSV(board *dto.FullBoardResult) ([]byte, error) {
+	index := newBoardExportIndex(board)
+
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	if err := writer.Write(csvExportHeader); err != nil {
+		return nil, err
+	}
+
+	for _, list := range board.Lists {
+		for _, taskID := range list.TaskIDs {
+			task, ok := index.tasks[taskID]
+			if !ok {
+				continue
+			}
+
+			description := ""
+			if task.Description != nil {
+				description = *task.Description
+			}
+			done, total := index.checklistProgress(task)
+
+			record := []string{
+				csvCell(list.Name),
+				csvCell(task.Name),
+				csvCell(description),
+				csvCell(strings.Join(index.assignees(task), ", ")),
+				csvCell(strings.Join(index.tagNames(task), ", ")),
+				formatExportDate(task.Start),
+				formatExportDate(task.End),
+				fmt.Sprintf("%d/%d", done, total),
+				task.DateCreated.Format(exportDateFormat),
+			}
+			if err := writer.Write(record); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func renderBoardMarkdown(board *dto.FullBoardResult) []byte {
+	index := newBoardExportIndex(board)
+
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "# %s\n", markdownText(board.Board.Name))
+
+	for _, list := range board.Lists {
+		fmt.Fprintf(&buffer, "\n## %s\n", markdownText(list.Name))
+
+		if len(list.TaskIDs) == 0 {
+			buffer.WriteString("\n_Нет заданий_\n")
+			continue
+		}
+
+		for _, taskID := range list.TaskIDs {
+			task, ok := index.tasks[taskID]
+			if !ok {
+				continue
+			}
+
+			fmt.Fprintf(&buffer, "\n### %s\n\n", markdownText(task.Name))
+			if task.Description != nil && *task.Description != "" {
+				fmt.Fprintf(&buffer, "%s\n\n", markdownQuote(*task.Description))
+			}
+			if assignees := index.assignees(task); len(assignees) > 0 {
+				fmt.Fprintf(&buffer, "- **Исполнители:** %s\n", strings.Join(markdownTexts(assignees), ", "))
+			}
+			if tags := index.tagNames(task); len(tags) > 0 {
+				fmt.Fprintf(&buffer, "- **Тэги:** %s\n", strings.Join(markdownTexts(tags), ", "))
+			}
+			if task.Start != nil {
+				fmt.Fprintf(&buffer, "- **Начало:** %s\n", formatExportDate(task.Start))
+			}
+			if task.End != nil {
+				fmt.Fprintf(&buffer, "- **Конец:** %s\n", formatExportDate(task.End))
+			}
+			if done, total := index.checklistProgress(task); total > 0 {
+				fmt.Fprintf(&buffer, "- **Чек-листы:** %d/%d\n", done, total)
+			}
+			fmt.Fprintf(&buffer, "- **Создано:** %s\n", task.DateCreated.Format(exportDateFormat))
+		}
+	}
+
+	return buffer.Bytes()
+}
@@ -0,0 +1,116 @@
+package microservice
+
+import (
+	"encoding/csv"
+	"server/internal/pkg/dto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func exportTestBoard() *dto.FullBoardResult {
+	name := "Ivan"
+	description := "Описание, с запятой"
+	created := time.Date(2023, 12, 1, 10, 0, 0, 0, time.UTC)
+	end := time.Date(2023, 12, 10, 18, 30, 0, 0, time.UTC)
+
+	return &dto.FullBoardResult{
+		Board: dto.SingleBoardInfo{ID: 1, Name: "Board"},
+		Lists: []dto.SingleListInfo{
+			{ID: 1, Name: "Todo", TaskIDs: []string{"1"}},
+			{ID: 2, Name: "Done", TaskIDs: []string{}},
+		},
+		Tasks: []dto.SingleTaskInfo{
+			{
+				ID:           1,
+				ListID:       1,
+				Name:         "Task",
+				Description:  &description,
+				DateCreated:  created,
+				End:          &end,
+				UserIDs:      []string{"1", "2"},
+				TagIDs:       []string{"3"},
+				ChecklistIDs: []string{"1"},
+			},
+		},
+		Users: []dto.UserPublicInfo{
+			{ID: 1, Email: "ivan@mail.ru", Name: &name},
+			{ID: 2, Email: "anon@mail.ru"},
+		},
+		Tags:       []dto.TagInfo{{ID: 3, Name: "bug"}},
+		Checklists: []dto.ChecklistInfo{{ID: 1, TaskID: 1, Items: []string{"1", "2"}}},
+		ChecklistItems: []dto.ChecklistItemInfo{
+			{ID: 1, ChecklistID: 1, Done: true},
+			{ID: 2, ChecklistID: 1, Done: false},
+		},
+	}
+}
+
+func TestRenderBoardCSV(t *testing.T) {
+	t.Parallel()
+
+	content, err := renderBoardCSV(exportTestBoard())
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(strings.NewReader(string(content))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, csvExportHeader, records[0])
+	require.Equal(t, []string{
+		"Todo", "Task", "Описание, с запятой", "Ivan, anon@mail.ru", "bug",
+		"", "2023-12-10 18:30", "1/2", "2023-12-01 10:00",
+	}, records[1])
+}
+
+func TestRenderBoardMarkdown(t *testing.T) {
+	t.Parallel()
+
+	content := string(renderBoardMarkdown(exportTestBoard()))
+
+	require.True(t, strings.HasPrefix(content, "# Board\n"))
+	require.Contains(t, content, "\n## Todo\n")
+	require.Contains(t, content, "\n### Task\n")
+	require.Contains(t, content, "- **Исполнители:** Ivan, anon@mail.ru\n")
+	require.Contains(t, content, "- **Чек-листы:** 1/2\n")
+	require.Contains(t, content, "\n## Done\n\n_Нет заданий_\n")
+}
+
+func TestRenderBoardCSV_Formulas(t *testing.T) {
+	t.Parallel()
+
+	board := exportTestBoard()
+	board.Lists[0].Name = "@list"
+	board.Tasks[0].Name = "=HYPERLINK(\"http://evil\")"
+	description := "-2+3"
+	board.Tasks[0].Description = &description
+	board.Tags[0].Name = "+tag"
+
+	content, err := renderBoardCSV(board)
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(strings.NewReader(string(content))).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, "'@list", records[1][0])
+	require.Equal(t, "'=HYPERLINK(\"http://evil\")", records[1][1])
+	require.Equal(t, "'-2+3", records[1][2])
+	require.Equal(t, "Ivan, anon@mail.ru", records[1][3])
+	require.Equal(t, "'+tag", records[1][4])
+}
+
+func TestRenderBoardMarkdown_Escaping(t *testing.T) {
+	t.Parallel()
+
+	board := exportTestBoard()
+	board.Lists[0].Name = "To*do*"
+	board.Tasks[0].Name = "# fix | snake_case\nнастройки"
+	description := "first *line*\r\n\n## fake heading\n- **Исполнители:** nobody"
+	board.Tasks[0].Description = &description
+
+	content := string(renderBoardMarkdown(board))
+
+	require.Contains(t, content, "\n## To\\*do\\*\n")
+	require.Contains(t, content, "\n### \\# fix \\| snake\\_case настройки\n")
+	require.Contains(t, content, "\n> first \\*line\\*\n>\n> \\#\\# fake heading\n> - \\*\\*Исполнители:\\*\\* nobody\n\n")
+}
@@ -0,0 +1,143 @@
+package microservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	"server/internal/pkg/dto"
+	"server/internal/pkg/entities"
+	"server/internal/pkg/schedule"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxTimeReportRange
+// наибольший период отчёта о времени
+const maxTimeReportRange = 366 * 24 * time.Hour
+
+var csvTimeReportHeader = []string{
+	"date", "user", "email", "task id", "task", "hours",
+}
+
+// GetTimeReport
+// возвращает время по заданиям доски за период по дням, пользователям и заданиям;
+// дни считаются в часовом поясе пользователя, запущенные таймеры в отчёт не входят
+// или возвращает ошибки apperrors.ErrInvalidTimeReportRange (400), apperrors.ErrNoBoardAccess (403), ...
+func (bs BoardService) GetTimeReport(ctx context.Context, info dto.TimeReportRequest) (*dto.TimeReport, error) {
+	funcName := "BoardService.GetTimeReport"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	if !info.From.Before(info.To) || info.To.Sub(info.From) > maxTimeReportRange {
+		return nil, apperrors.ErrInvalidTimeReportRange
+	}
+
+	userID := ctx.Value(dto.UserObjKey).(*entities.User).ID
+	if err := bs.checkBoardAccess(ctx, userID, info.BoardID); err != nil {
+		return nil, err
+	}
+
+	timezone, err := bs.userStorage.GetTimezone(ctx, dto.UserID{Value: userID})
+	if err != nil {
+		return nil, err
+	}
+	info.Timezone = schedule.Location(timezone).String()
+
+	rows, err := bs.boardStorage.ReadTimeReport(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+	logger.DebugFmt("Got time report rows", requestID.String(), funcName, nodeName)
+
+	report := dto.TimeReport{
+		BoardID: info.BoardID,
+		From:    info.From,
+		To:      info.To,
+		Rows:    *rows,
+	}
+	byUser := map[uint64]uint64{}
+	for _, row := range *rows {
+		report.TotalSeconds += row.Seconds
+		byUser[row.UserID] += row.Seconds
+	}
+	report.Users = make([]dto.UserTimeTotal, 0, len(byUser))
+	for id, seconds := range byUser {
+		report.Users = append(report.Users, dto.UserTimeTotal{UserID: id, Seconds: seconds})
+	}
+	sort.Slice(report.Users, func(i, j int) bool { return report.Users[i].UserID < report.Users[j].UserID })
+
+	return &report, nil
+}
+
+// ExportTimeReportCSV
+// выгружает отчёт о времени по доске за период в формате CSV
+// или возвращает ошибки apperrors.ErrCouldNotExportTimeReport (500), ...
+func (bs BoardService) ExportTimeReportCSV(ctx context.Context, info dto.TimeReportRequest) (*dto.ExportedBoard, error) {
+	funcName := "BoardService.ExportTimeReportCSV"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	report, err := bs.GetTimeReport(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := renderTimeReportCSV(report)
+	if err != nil {
+		logger.DebugFmt("Failed to render CSV with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotExportTimeReport
+	}
+	logger.DebugFmt("CSV rendered", requestID.String(), funcName, nodeName)
+
+	return &dto.ExportedBoard{
+		Filename:    "time_report_" + strconv.FormatUint(report.BoardID, 10) + ".csv",
+		ContentType: "text/csv; charset=utf-8",
+		Content:     content,
+	}, nil
+}
+
+// renderTimeReportCSV
+// выгружает строки отчёта о времени и итог в CSV; названия заданий и имена пользователей задают пользователи,
+// поэтому они защищены от выполнения как формулы
+func renderTimeReportCSV(report *dto.TimeReport) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	if err := writer.Write(csvTimeReportHeader); err != nil {
+		return nil, err
+	}
+
+	for _, row := range report.Rows {
+		record := []string{
+			row.Date,
+			csvCell(userDisplayName(dto.UserPublicInfo{ID: row.UserID, Email: row.Email, Name: row.Name, Surname: row.Surname})),
+			csvCell(row.Email),
+			strconv.FormatUint(row.TaskID, 10),
+			csvCell(row.TaskName),
+			formatHours(row.Seconds),
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Write([]string{"", "", "", "", "total", formatHours(report.TotalSeconds)}); err != nil {
+		return nil, err
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// formatHours
+// переводит секунды в часы с двумя знаками после запятой, как их выставляют в счетах
+func formatHours(seconds uint64) string {
+	return strconv.FormatFloat(float64(seconds)/3600, 'f', 2, 64)
+}
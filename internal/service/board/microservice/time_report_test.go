@@ -0,0 +1,124 @@
+package microservice
+
+import (
+	"context"
+	"encoding/csv"
+	"server/internal/apperrors"
+	"server/internal/config"
+	logging "server/internal/logging"
+	"server/internal/pkg/dto"
+	"server/internal/pkg/entities"
+	"server/mocks/mock_storage"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBoardService_GetTimeReport(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		to      time.Time
+		access  bool
+		wantErr error
+	}{
+		{
+			name:   "Report built",
+			to:     from.AddDate(0, 1, 0),
+			access: true,
+		},
+		{
+			name:    "Empty range",
+			to:      from,
+			wantErr: apperrors.ErrInvalidTimeReportRange,
+		},
+		{
+			name:    "Range too long",
+			to:      from.AddDate(2, 0, 0),
+			wantErr: apperrors.ErrInvalidTimeReportRange,
+		},
+		{
+			name:    "No board access",
+			to:      from.AddDate(0, 1, 0),
+			access:  false,
+			wantErr: apperrors.ErrNoBoardAccess,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			boardStorage := mock_storage.NewMockIBoardStorage(ctrl)
+			userStorage := mock_storage.NewMockIUserStorage(ctrl)
+
+			logger, _ := logging.NewLogrusLogger(&config.LoggingConfig{Level: "debug"})
+			ctx := context.WithValue(
+				context.WithValue(
+					context.WithValue(context.Background(), dto.LoggerKey, &logger),
+					dto.UserObjKey, &entities.User{ID: 1},
+				),
+				dto.RequestIDKey, uuid.New(),
+			)
+			request := dto.TimeReportRequest{BoardID: 3, From: from, To: tt.to}
+
+			if tt.wantErr != apperrors.ErrInvalidTimeReportRange {
+				boardStorage.EXPECT().CheckAccess(ctx, dto.CheckBoardAccessInfo{UserID: 1, BoardID: 3}).Return(tt.access, nil)
+			}
+			if tt.wantErr == nil {
+				userStorage.EXPECT().GetTimezone(ctx, dto.UserID{Value: 1}).Return("Europe/Moscow", nil)
+				filter := request
+				filter.Timezone = "Europe/Moscow"
+				boardStorage.EXPECT().ReadTimeReport(ctx, filter).Return(&[]dto.TimeReportRow{
+					{Date: "2024-03-01", UserID: 2, TaskID: 5, Seconds: 1800},
+					{Date: "2024-03-01", UserID: 1, TaskID: 5, Seconds: 3600},
+					{Date: "2024-03-02", UserID: 2, TaskID: 6, Seconds: 600},
+				}, nil)
+			}
+
+			bs := BoardService{boardStorage: boardStorage, userStorage: userStorage}
+			report, err := bs.GetTimeReport(ctx, request)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			require.Equal(t, uint64(6000), report.TotalSeconds)
+			require.Equal(t, []dto.UserTimeTotal{{UserID: 1, Seconds: 3600}, {UserID: 2, Seconds: 2400}}, report.Users)
+			require.Len(t, report.Rows, 3)
+		})
+	}
+}
+
+func TestRenderTimeReportCSV(t *testing.T) {
+	t.Parallel()
+
+	name := "Ivan"
+	formula := "@SUM(A1)"
+	content, err := renderTimeReportCSV(&dto.TimeReport{
+		BoardID: 3,
+		Rows: []dto.TimeReportRow{
+			{Date: "2024-03-01", UserID: 1, Email: "ivan@mail.ru", Name: &name, TaskID: 5, TaskName: "Вёрстка, главная", Seconds: 5400},
+			{Date: "2024-03-02", UserID: 2, Email: "anon@mail.ru", TaskID: 6, TaskName: "Ревью", Seconds: 600},
+			{Date: "2024-03-02", UserID: 3, Email: "eve@mail.ru", Name: &formula, TaskID: 7, TaskName: "=HYPERLINK(\"x\")", Seconds: 0},
+		},
+		TotalSeconds: 6000,
+	})
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(strings.NewReader(string(content))).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		csvTimeReportHeader,
+		{"2024-03-01", "Ivan", "ivan@mail.ru", "5", "Вёрстка, главная", "1.50"},
+		{"2024-03-02", "anon@mail.ru", "anon@mail.ru", "6", "Ревью", "0.17"},
+		{"2024-03-02", "'@SUM(A1)", "eve@mail.ru", "7", "'=HYPERLINK(\"x\")", "0.00"},
+		{"", "", "", "", "total", "1.67"},
+	}, records)
+}
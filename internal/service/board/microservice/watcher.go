@@ -0,0 +1,53 @@
+package microservice
+
+import (
+	"context"
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"server/internal/pkg/entities"
+)
+
+// Watch
+// подписывает пользователя на изменения всех сущностей доски
+// или возвращает ошибки apperrors.ErrNoBoardAccess (403), ...
+func (bs BoardService) Watch(ctx context.Context, id dto.BoardID) error {
+	userID := ctx.Value(dto.UserObjKey).(*entities.User).ID
+	if err := bs.checkBoardAccess(ctx, userID, id.Value); err != nil {
+		return err
+	}
+	return bs.boardStorage.WatchBoard(ctx, dto.BoardWatcherInfo{BoardID: id.Value, UserID: userID})
+}
+
+// Unwatch
+// отписывает пользователя от изменений доски; подписки на отдельные задания остаются
+// или возвращает ошибки apperrors.ErrNotWatching (404), apperrors.ErrNoBoardAccess (403), ...
+func (bs BoardService) Unwatch(ctx context.Context, id dto.BoardID) error {
+	userID := ctx.Value(dto.UserObjKey).(*entities.User).ID
+	if err := bs.checkBoardAccess(ctx, userID, id.Value); err != nil {
+		return err
+	}
+	return bs.boardStorage.UnwatchBoard(ctx, dto.BoardWatcherInfo{BoardID: id.Value, UserID: userID})
+}
+
+// GetWatchers
+// возвращает наблюдателей доски
+// или возвращает ошибки apperrors.ErrNoBoardAccess (403), ...
+func (bs BoardService) GetWatchers(ctx context.Context, id dto.BoardID) (*[]dto.UserPublicInfo, error) {
+	if err := bs.checkBoardAccess(ctx, ctx.Value(dto.UserObjKey).(*entities.User).ID, id.Value); err != nil {
+		return nil, err
+	}
+	return bs.boardStorage.ReadBoardWatchers(ctx, id)
+}
+
+// checkBoardAccess
+// проверяет, что у пользователя есть доступ к доске
+func (bs BoardService) checkBoardAccess(ctx context.Context, userID uint64, boardID uint64) error {
+	userAccess, err := bs.boardStorage.CheckAccess(ctx, dto.CheckBoardAccessInfo{UserID: userID, BoardID: boardID})
+	if err != nil {
+		return apperrors.ErrCouldNotGetUser
+	}
+	if !userAccess {
+		return apperrors.ErrNoBoardAccess
+	}
+	return nil
+}
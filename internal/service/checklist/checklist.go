@@ -9,6 +9,6 @@ import (
 )
 
 // TODO: Checklist microservice
-func NewMicroChecklistService(checklistStorage storage.IChecklistStorage, connection *grpc.ClientConn) *micro.ChecklistService {
-	return micro.NewChecklistService(checklistStorage)
+func NewMicroChecklistService(checklistStorage storage.IChecklistStorage, historyStorage storage.IHistoryStorage, connection *grpc.ClientConn) *micro.ChecklistService {
+	return micro.NewChecklistService(checklistStorage, historyStorage)
 }
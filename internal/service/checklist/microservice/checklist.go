@@ -3,18 +3,21 @@ package microservice
 import (
 	"context"
 	"server/internal/pkg/dto"
+	"server/internal/service/history"
 	"server/internal/storage"
 )
 
 type ChecklistService struct {
 	storage storage.IChecklistStorage
+	history *history.Recorder
 }
 
 // NewBoardService
 // возвращает BoardService с инициализированным хранилищем
-func NewChecklistService(storage storage.IChecklistStorage) *ChecklistService {
+func NewChecklistService(storage storage.IChecklistStorage, historyStorage storage.IHistoryStorage) *ChecklistService {
 	return &ChecklistService{
 		storage: storage,
+		history: history.NewRecorder(historyStorage),
 	}
 }
 
@@ -22,19 +25,40 @@ func NewChecklistService(storage storage.IChecklistStorage) *ChecklistService {
 // создает новый чеклист
 // или возвращает ошибки ...
 func (cls ChecklistService) Create(ctx context.Context, info dto.NewChecklistInfo) (*dto.ChecklistInfo, error) {
-	return cls.storage.Create(ctx, info)
+	var checklist *dto.ChecklistInfo
+	err := cls.history.Create(ctx, func(ctx context.Context) (dto.HistoryEntityRef, error) {
+		var err error
+		checklist, err = cls.storage.Create(ctx, info)
+		if err != nil {
+			return dto.HistoryEntityRef{}, err
+		}
+		return checklistRef(checklist.ID), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return checklist, nil
 }
 
 // Update
 // обновляет чеклист
 // или возвращает ошибки ...
 func (cls ChecklistService) Update(ctx context.Context, info dto.UpdatedChecklistInfo) error {
-	return cls.storage.Update(ctx, info)
+	return cls.history.Track(ctx, dto.HistoryActionUpdate, func(ctx context.Context) error {
+		return cls.storage.Update(ctx, info)
+	}, checklistRef(info.ID))
 }
 
 // Delete
 // удаляет чеклист по id
 // или возвращает ошибки ...
 func (cls ChecklistService) Delete(ctx context.Context, id dto.ChecklistID) error {
-	return cls.storage.Delete(ctx, id)
+	return cls.history.Track(ctx, dto.HistoryActionDelete, func(ctx context.Context) error {
+		return cls.storage.Delete(ctx, id)
+	}, checklistRef(id.Value))
+}
+
+func checklistRef(id uint64) dto.HistoryEntityRef {
+	return dto.HistoryEntityRef{EntityType: dto.HistoryEntityChecklist, EntityID: id}
 }
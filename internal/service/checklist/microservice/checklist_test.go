@@ -100,7 +100,8 @@ func TestChecklistService_Update(t *testing.T) {
 
 func TestNewChecklistService(t *testing.T) {
 	type args struct {
-		storage storage.IChecklistStorage
+		storage        storage.IChecklistStorage
+		historyStorage storage.IHistoryStorage
 	}
 	tests := []struct {
 		name string
@@ -111,7 +112,7 @@ func TestNewChecklistService(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewChecklistService(tt.args.storage); !reflect.DeepEqual(got, tt.want) {
+			if got := NewChecklistService(tt.args.storage, tt.args.historyStorage); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("NewChecklistService() = %v, want %v", got, tt.want)
 			}
 		})
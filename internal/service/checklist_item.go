@@ -22,7 +22,7 @@ type IChecklistItemService interface {
 	// или возвращает ошибки ...
 	Delete(context.Context, dto.ChecklistItemID) error
 	// UpdateOrder
-	// изменяет порядок вещей в чеклистах по id
+	// ставит элемент сразу после другого элемента того же чеклиста
 	// или возвращает ошибки ...
-	UpdateOrder(context.Context, dto.ChecklistItemIDs) error
+	UpdateOrder(context.Context, dto.ChecklistItemMoveInfo) error
 }
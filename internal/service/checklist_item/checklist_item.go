@@ -9,6 +9,6 @@ import (
 )
 
 // TODO: Checklist item microservice
-func NewMicroChecklistItemService(checklistItemStorage storage.IChecklistItemStorage, connection *grpc.ClientConn) *micro.ChecklistItemService {
-	return micro.NewChecklistItemService(checklistItemStorage)
+func NewMicroChecklistItemService(checklistItemStorage storage.IChecklistItemStorage, historyStorage storage.IHistoryStorage, connection *grpc.ClientConn) *micro.ChecklistItemService {
+	return micro.NewChecklistItemService(checklistItemStorage, historyStorage)
 }
@@ -3,18 +3,21 @@ package microservice
 import (
 	"context"
 	"server/internal/pkg/dto"
+	"server/internal/service/history"
 	"server/internal/storage"
 )
 
 type ChecklistItemService struct {
 	storage storage.IChecklistItemStorage
+	history *history.Recorder
 }
 
 // NewChecklistItemService
 // возвращает ChecklistItemService с инициализированным хранилищем
-func NewChecklistItemService(storage storage.IChecklistItemStorage) *ChecklistItemService {
+func NewChecklistItemService(storage storage.IChecklistItemStorage, historyStorage storage.IHistoryStorage) *ChecklistItemService {
 	return &ChecklistItemService{
 		storage: storage,
+		history: history.NewRecorder(historyStorage),
 	}
 }
 
@@ -22,26 +25,49 @@ func NewChecklistItemService(storage storage.IChecklistItemStorage) *ChecklistIt
 // создает новый элемент чеклиста
 // или возвращает ошибки ...
 func (cls ChecklistItemService) Create(ctx context.Context, info dto.NewChecklistItemInfo) (*dto.ChecklistItemInfo, error) {
-	return cls.storage.Create(ctx, info)
+	var item *dto.ChecklistItemInfo
+	err := cls.history.Create(ctx, func(ctx context.Context) (dto.HistoryEntityRef, error) {
+		var err error
+		item, err = cls.storage.Create(ctx, info)
+		if err != nil {
+			return dto.HistoryEntityRef{}, err
+		}
+		return checklistItemRef(item.ID), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
 }
 
 // Update
 // обновляет элемент чеклиста
 // или возвращает ошибки ...
 func (cls ChecklistItemService) Update(ctx context.Context, info dto.UpdatedChecklistItemInfo) error {
-	return cls.storage.Update(ctx, info)
+	return cls.history.Track(ctx, dto.HistoryActionUpdate, func(ctx context.Context) error {
+		return cls.storage.Update(ctx, info)
+	}, checklistItemRef(info.ID))
 }
 
 // Delete
 // удаляет элемент чеклиста по id
 // или возвращает ошибки ...
 func (cls ChecklistItemService) Delete(ctx context.Context, id dto.ChecklistItemID) error {
-	return cls.storage.Delete(ctx, id)
+	return cls.history.Track(ctx, dto.HistoryActionDelete, func(ctx context.Context) error {
+		return cls.storage.Delete(ctx, id)
+	}, checklistItemRef(id.Value))
 }
 
 // UpdateOrder
-// изменяет порядок вещей в чеклисте по id
+// ставит элемент чеклиста после другого элемента того же чеклиста
 // или возвращает ошибки ...
-func (cls ChecklistItemService) UpdateOrder(ctx context.Context, ids dto.ChecklistItemIDs) error {
-	return cls.storage.UpdateOrder(ctx, ids)
+func (cls ChecklistItemService) UpdateOrder(ctx context.Context, info dto.ChecklistItemMoveInfo) error {
+	return cls.history.Track(ctx, dto.HistoryActionReorder, func(ctx context.Context) error {
+		return cls.storage.UpdateOrder(ctx, info)
+	}, checklistItemRef(info.ItemID))
+}
+
+func checklistItemRef(id uint64) dto.HistoryEntityRef {
+	return dto.HistoryEntityRef{EntityType: dto.HistoryEntityChecklistItem, EntityID: id}
 }
@@ -100,7 +100,8 @@ func TestChecklistItemService_Update(t *testing.T) {
 
 func TestNewChecklistItemService(t *testing.T) {
 	type args struct {
-		storage storage.IChecklistItemStorage
+		storage        storage.IChecklistItemStorage
+		historyStorage storage.IHistoryStorage
 	}
 	tests := []struct {
 		name string
@@ -111,7 +112,7 @@ func TestNewChecklistItemService(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewChecklistItemService(tt.args.storage); !reflect.DeepEqual(got, tt.want) {
+			if got := NewChecklistItemService(tt.args.storage, tt.args.historyStorage); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("NewChecklistItemService() = %v, want %v", got, tt.want)
 			}
 		})
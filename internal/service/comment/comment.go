@@ -9,6 +9,6 @@ import (
 )
 
 // TODO: Board microservice
-func NewMicroCommentService(commentStorage storage.ICommentStorage, connection *grpc.ClientConn) *micro.CommentService {
-	return micro.NewCommentService(commentStorage)
+func NewMicroCommentService(commentStorage storage.ICommentStorage, historyStorage storage.IHistoryStorage, connection *grpc.ClientConn) *micro.CommentService {
+	return micro.NewCommentService(commentStorage, historyStorage)
 }
@@ -4,18 +4,22 @@ import (
 	"context"
 	"server/internal/pkg/dto"
 	"server/internal/pkg/entities"
+	"server/internal/pkg/mention"
+	"server/internal/service/history"
 	"server/internal/storage"
 )
 
 type CommentService struct {
 	storage storage.ICommentStorage
+	history *history.Recorder
 }
 
 // NewBoardService
 // возвращает BoardService с инициализированным хранилищем
-func NewCommentService(storage storage.ICommentStorage) *CommentService {
+func NewCommentService(storage storage.ICommentStorage, historyStorage storage.IHistoryStorage) *CommentService {
 	return &CommentService{
 		storage: storage,
+		history: history.NewRecorder(historyStorage),
 	}
 }
 
@@ -27,8 +31,22 @@ func (cs CommentService) GetFromTask(ctx context.Context, id dto.TaskID) (*[]dto
 }
 
 // Create
-// создает новый комментарий
+// создает новый комментарий; автор и упомянутые в тексте через @email участники доски подписываются на задание
 // или возвращает ошибки ...
 func (cs CommentService) Create(ctx context.Context, info dto.NewCommentInfo) (*entities.Comment, error) {
-	return cs.storage.Create(ctx, info)
+	info.Mentions = mention.Emails(info.Text)
+	var comment *entities.Comment
+	err := cs.history.Create(ctx, func(ctx context.Context) (dto.HistoryEntityRef, error) {
+		var err error
+		comment, err = cs.storage.Create(ctx, info)
+		if err != nil {
+			return dto.HistoryEntityRef{}, err
+		}
+		return dto.HistoryEntityRef{EntityType: dto.HistoryEntityComment, EntityID: comment.ID}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, nil
 }
@@ -79,7 +79,8 @@ func TestCommentService_GetFromTask(t *testing.T) {
 
 func TestNewCommentService(t *testing.T) {
 	type args struct {
-		storage storage.ICommentStorage
+		storage        storage.ICommentStorage
+		historyStorage storage.IHistoryStorage
 	}
 	tests := []struct {
 		name string
@@ -90,7 +91,7 @@ func TestNewCommentService(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewCommentService(tt.args.storage); !reflect.DeepEqual(got, tt.want) {
+			if got := NewCommentService(tt.args.storage, tt.args.historyStorage); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("NewCommentService() = %v, want %v", got, tt.want)
 			}
 		})
@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+	"server/internal/pkg/dto"
+)
+
+// Интерфейс для сервиса пользовательских полей
+//
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/mock_service/$GOFILE -package=mock_service
+type ICustomFieldService interface {
+	// Create
+	// создает новое пользовательское поле доски
+	// или возвращает ошибки ...
+	Create(context.Context, dto.NewCustomFieldInfo) (*dto.CustomFieldInfo, error)
+	// Update
+	// обновляет пользовательское поле
+	// или возвращает ошибки ...
+	Update(context.Context, dto.UpdatedCustomFieldInfo) error
+	// Delete
+	// удаляет пользовательское поле по id
+	// или возвращает ошибки ...
+	Delete(context.Context, dto.CustomFieldID) error
+}
@@ -0,0 +1,14 @@
+package custom_field
+
+import (
+	"server/internal/storage"
+
+	micro "server/internal/service/custom_field/microservice"
+
+	"google.golang.org/grpc"
+)
+
+// TODO: CustomField microservice
+func NewMicroCustomFieldService(customFieldStorage storage.ICustomFieldStorage, boardStorage storage.IBoardStorage, historyStorage storage.IHistoryStorage, connection *grpc.ClientConn) *micro.CustomFieldService {
+	return micro.NewCustomFieldService(customFieldStorage, boardStorage, historyStorage)
+}
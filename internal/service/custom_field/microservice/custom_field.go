@@ -0,0 +1,146 @@
+package microservice
+
+import (
+	"context"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	"server/internal/pkg/customfields"
+	"server/internal/pkg/dto"
+	"server/internal/pkg/entities"
+	"server/internal/service/history"
+	"server/internal/storage"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const nodeName = "service"
+
+type CustomFieldService struct {
+	storage      storage.ICustomFieldStorage
+	boardStorage storage.IBoardStorage
+	history      *history.Recorder
+}
+
+// NewCustomFieldService
+// возвращает CustomFieldService с инициализированными хранилищами
+func NewCustomFieldService(cfs storage.ICustomFieldStorage, bs storage.IBoardStorage, hs storage.IHistoryStorage) *CustomFieldService {
+	return &CustomFieldService{
+		storage:      cfs,
+		boardStorage: bs,
+		history:      history.NewRecorder(hs),
+	}
+}
+
+// Create
+// проверяет описание поля и создает новое пользовательское поле доски
+// или возвращает ошибки ...
+func (cfs CustomFieldService) Create(ctx context.Context, info dto.NewCustomFieldInfo) (*dto.CustomFieldInfo, error) {
+	funcName := "CustomFieldService.Create"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	options, err := customfields.ValidateDefinition(info.Name, info.Type, info.Options)
+	if err != nil {
+		return nil, err
+	}
+	info.Name = strings.TrimSpace(info.Name)
+	info.Options = options
+	logger.DebugFmt("Custom field definition is valid", requestID.String(), funcName, nodeName)
+
+	if err := cfs.checkAccess(ctx, info.BoardID); err != nil {
+		return nil, err
+	}
+	logger.DebugFmt("User has access to board", requestID.String(), funcName, nodeName)
+
+	var field *dto.CustomFieldInfo
+	err = cfs.history.Create(ctx, func(ctx context.Context) (dto.HistoryEntityRef, error) {
+		var err error
+		field, err = cfs.storage.Create(ctx, info)
+		if err != nil {
+			return dto.HistoryEntityRef{}, err
+		}
+		return customFieldRef(field.ID), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return field, nil
+}
+
+// Update
+// обновляет название, варианты выбора и позицию пользовательского поля; тип поля не меняется
+// или возвращает ошибки ...
+func (cfs CustomFieldService) Update(ctx context.Context, info dto.UpdatedCustomFieldInfo) error {
+	funcName := "CustomFieldService.Update"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	field, err := cfs.storage.Read(ctx, dto.CustomFieldID{Value: info.ID})
+	if err != nil {
+		return err
+	}
+	logger.DebugFmt("Got custom field", requestID.String(), funcName, nodeName)
+
+	if err := cfs.checkAccess(ctx, field.BoardID); err != nil {
+		return err
+	}
+	logger.DebugFmt("User has access to board", requestID.String(), funcName, nodeName)
+
+	options, err := customfields.ValidateDefinition(info.Name, field.Type, info.Options)
+	if err != nil {
+		return err
+	}
+	info.Name = strings.TrimSpace(info.Name)
+	info.Options = options
+	logger.DebugFmt("Custom field definition is valid", requestID.String(), funcName, nodeName)
+
+	return cfs.history.Track(ctx, dto.HistoryActionUpdate, func(ctx context.Context) error {
+		return cfs.storage.Update(ctx, info)
+	}, customFieldRef(info.ID))
+}
+
+// Delete
+// удаляет пользовательское поле вместе с его значениями у заданий
+// или возвращает ошибки ...
+func (cfs CustomFieldService) Delete(ctx context.Context, id dto.CustomFieldID) error {
+	funcName := "CustomFieldService.Delete"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	field, err := cfs.storage.Read(ctx, id)
+	if err != nil {
+		return err
+	}
+	logger.DebugFmt("Got custom field", requestID.String(), funcName, nodeName)
+
+	if err := cfs.checkAccess(ctx, field.BoardID); err != nil {
+		return err
+	}
+	logger.DebugFmt("User has access to board", requestID.String(), funcName, nodeName)
+
+	return cfs.history.Track(ctx, dto.HistoryActionDelete, func(ctx context.Context) error {
+		return cfs.storage.Delete(ctx, id)
+	}, customFieldRef(id.Value))
+}
+
+// checkAccess
+// проверяет, есть ли у пользователя из контекста доступ к доске
+func (cfs CustomFieldService) checkAccess(ctx context.Context, boardID uint64) error {
+	userAccess, err := cfs.boardStorage.CheckAccess(ctx, dto.CheckBoardAccessInfo{
+		UserID:  ctx.Value(dto.UserObjKey).(*entities.User).ID,
+		BoardID: boardID,
+	})
+	if err != nil {
+		return apperrors.ErrCouldNotGetUser
+	}
+	if !userAccess {
+		return apperrors.ErrNoBoardAccess
+	}
+	return nil
+}
+
+func customFieldRef(id uint64) dto.HistoryEntityRef {
+	return dto.HistoryEntityRef{EntityType: dto.HistoryEntityCustomField, EntityID: id}
+}
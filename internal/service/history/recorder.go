@@ -0,0 +1,384 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	"server/internal/pkg/dto"
+	"server/internal/pkg/entities"
+	"server/internal/storage"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+const nodeName = "service"
+
+// Recorder
+// записывает изменения сущностей в историю доски, сравнивая их состояние до и после изменения
+type Recorder struct {
+	storage storage.IHistoryStorage
+}
+
+// NewRecorder
+// возвращает Recorder с инициализированным хранилищем истории
+func NewRecorder(hs storage.IHistoryStorage) *Recorder {
+	return &Recorder{
+		storage: hs,
+	}
+}
+
+// Track
+// выполняет изменение и записывает в историю разницу состояний каждой из затронутых сущностей.
+// Состояния, изменение и запись истории выполняются в одной транзакции: если что-то из этого
+// не удалось, изменение откатывается вместе с записью и возвращается ошибка
+func (r *Recorder) Track(ctx context.Context, action string, mutate func(context.Context) error, refs ...dto.HistoryEntityRef) error {
+	return r.storage.Transaction(ctx, func(ctx context.Context) error {
+		before, err := r.snapshots(ctx, refs)
+		if err != nil {
+			return err
+		}
+
+		if err := mutate(ctx); err != nil {
+			return err
+		}
+
+		for i, ref := range refs {
+			after, err := r.snapshot(ctx, ref)
+			if err != nil {
+				return err
+			}
+			if err = r.record(ctx, ref, action, before[i], after); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// TrackMove
+// выполняет перенос сущности и в той же транзакции записывает его в историю. Если сущность перешла
+// на другую доску, перенос записывается в историю и старой, и новой доски
+func (r *Recorder) TrackMove(ctx context.Context, mutate func(context.Context) error, ref dto.HistoryEntityRef) error {
+	return r.storage.Transaction(ctx, func(ctx context.Context) error {
+		before, err := r.snapshot(ctx, ref)
+		if err != nil {
+			return err
+		}
+
+		if err := mutate(ctx); err != nil {
+			return err
+		}
+
+		after, err := r.snapshot(ctx, ref)
+		if err != nil {
+			return err
+		}
+		if before != nil && after != nil && before.BoardID != after.BoardID {
+			err = r.write(ctx, ref, dto.HistoryActionMove, before.BoardID, before.TaskID, nil, DiffFields(before.Fields, after.Fields))
+			if err != nil {
+				return err
+			}
+		}
+		return r.record(ctx, ref, dto.HistoryActionMove, before, after)
+	})
+}
+
+// TrackBulk
+// выполняет массовое изменение и в той же транзакции записывает его в историю одной записью без id сущности:
+// каждое изменение помечается id затронутой сущности. Для изменения связей (пользователей, тэгов)
+// вместо разницы состояний каждой сущности записывается link
+func (r *Recorder) TrackBulk(ctx context.Context, action string, mutate func(context.Context) error, link *dto.HistoryFieldChange, refs ...dto.HistoryEntityRef) error {
+	return r.storage.Transaction(ctx, func(ctx context.Context) error {
+		before, err := r.snapshots(ctx, refs)
+		if err != nil {
+			return err
+		}
+
+		if err := mutate(ctx); err != nil {
+			return err
+		}
+
+		var boardID uint64
+		var watchers []uint64
+		changes := []dto.HistoryFieldChange{}
+		for i, ref := range refs {
+			var beforeFields, afterFields map[string]interface{}
+			if before[i] != nil {
+				boardID = before[i].BoardID
+				beforeFields = before[i].Fields
+			}
+			if link != nil {
+				if before[i] != nil {
+					change := *link
+					change.EntityID = ref.EntityID
+					changes = append(changes, change)
+				}
+				continue
+			}
+			after, err := r.snapshot(ctx, ref)
+			if err != nil {
+				return err
+			}
+			if after != nil {
+				boardID = after.BoardID
+				afterFields = after.Fields
+			} else if before[i] != nil {
+				watchers = append(watchers, before[i].Watchers...)
+			}
+			for _, change := range DiffFields(beforeFields, afterFields) {
+				change.EntityID = ref.EntityID
+				changes = append(changes, change)
+			}
+		}
+		if boardID == 0 {
+			return nil
+		}
+		if len(changes) == 0 && action != dto.HistoryActionDelete {
+			return nil
+		}
+		return r.write(ctx, dto.HistoryEntityRef{EntityType: refs[0].EntityType}, action, boardID, nil, watchers, changes)
+	})
+}
+
+// Create
+// создаёт сущность и в той же транзакции записывает её создание в историю;
+// create возвращает ссылку на созданную сущность
+func (r *Recorder) Create(ctx context.Context, create func(context.Context) (dto.HistoryEntityRef, error)) error {
+	return r.storage.Transaction(ctx, func(ctx context.Context) error {
+		ref, err := create(ctx)
+		if err != nil {
+			return err
+		}
+
+		after, err := r.snapshot(ctx, ref)
+		if err != nil {
+			return err
+		}
+		return r.record(ctx, ref, dto.HistoryActionCreate, nil, after)
+	})
+}
+
+// Related
+// изменяет связь сущности с другим объектом (пользователем, тэгом, файлом) и в той же транзакции
+// записывает в историю link. link читается после изменения, поэтому mutate может дописать в него прежнее значение
+func (r *Recorder) Related(ctx context.Context, action string, mutate func(context.Context) error, link *dto.HistoryFieldChange, ref dto.HistoryEntityRef) error {
+	return r.storage.Transaction(ctx, func(ctx context.Context) error {
+		if err := mutate(ctx); err != nil {
+			return err
+		}
+
+		snapshot, err := r.snapshot(ctx, ref)
+		if err != nil || snapshot == nil {
+			return err
+		}
+		return r.write(ctx, ref, action, snapshot.BoardID, snapshot.TaskID, nil, []dto.HistoryFieldChange{*link})
+	})
+}
+
+// snapshots
+// возвращает состояния сущностей до изменения
+func (r *Recorder) snapshots(ctx context.Context, refs []dto.HistoryEntityRef) ([]*dto.EntitySnapshot, error) {
+	before := make([]*dto.EntitySnapshot, len(refs))
+	for i, ref := range refs {
+		snapshot, err := r.snapshot(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		before[i] = snapshot
+	}
+	return before, nil
+}
+
+// snapshot
+// возвращает состояние сущности или nil, если её нет
+func (r *Recorder) snapshot(ctx context.Context, ref dto.HistoryEntityRef) (*dto.EntitySnapshot, error) {
+	funcName := "Recorder.snapshot"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	snapshot, err := r.storage.Snapshot(ctx, ref)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrHistoryEntityNotFound) {
+			return nil, nil
+		}
+		logger.Error("Failed to take " + ref.EntityType + " snapshot: " + err.Error())
+		logger.DebugFmt("Snapshot failed", requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotRecordHistory
+	}
+	return snapshot, nil
+}
+
+func (r *Recorder) record(ctx context.Context, ref dto.HistoryEntityRef, action string, before *dto.EntitySnapshot, after *dto.EntitySnapshot) error {
+	var boardID uint64
+	var taskID *uint64
+	var watchers []uint64
+	var beforeFields, afterFields map[string]interface{}
+	if before != nil {
+		boardID = before.BoardID
+		taskID = before.TaskID
+		beforeFields = before.Fields
+		if after == nil {
+			watchers = before.Watchers
+		}
+		if action == dto.HistoryActionDelete && before.Children != nil {
+			beforeFields = make(map[string]interface{}, len(before.Fields)+1)
+			for field, value := range before.Fields {
+				beforeFields[field] = value
+			}
+			beforeFields[dto.HistoryChildrenField] = *before.Children
+		}
+	}
+	if after != nil {
+		boardID = after.BoardID
+		taskID = after.TaskID
+		afterFields = after.Fields
+	}
+	if boardID == 0 {
+		return nil
+	}
+
+	changes := DiffFields(beforeFields, afterFields)
+	if len(changes) == 0 && action != dto.HistoryActionCreate && action != dto.HistoryActionDelete {
+		return nil
+	}
+	return r.write(ctx, ref, action, boardID, taskID, watchers, changes)
+}
+
+// write
+// записывает изменение в историю от имени пользователя из контекста. watchers -- наблюдатели удалённых сущностей,
+// которых уже не найти по строкам в БД
+func (r *Recorder) write(ctx context.Context, ref dto.HistoryEntityRef, action string, boardID uint64, taskID *uint64, watchers []uint64, changes []dto.HistoryFieldChange) error {
+	funcName := "Recorder.write"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	user, ok := ctx.Value(dto.UserObjKey).(*entities.User)
+	if !ok {
+		logger.Error("No user in context, " + action + " " + ref.EntityType + " not recorded")
+		return apperrors.ErrCouldNotRecordHistory
+	}
+
+	err := r.storage.Record(ctx, dto.NewHistoryEntry{
+		UserID:     user.ID,
+		BoardID:    boardID,
+		EntityType: ref.EntityType,
+		EntityID:   ref.EntityID,
+		Action:     action,
+		Changes:    changes,
+		TaskID:     taskID,
+		Watchers:   watchers,
+	})
+	if err != nil {
+		logger.Error("Failed to record " + action + " " + ref.EntityType + " in history: " + err.Error())
+		return apperrors.ErrCouldNotRecordHistory
+	}
+	logger.DebugFmt("Recorded "+action+" "+ref.EntityType, requestID.String(), funcName, nodeName)
+	return nil
+}
+
+// untrackedFields
+// поля, изменения которых не записываются в историю: они меняются при каждом изменении сущности
+var untrackedFields = []string{"id", "version", "row_version"}
+
+// DiffFields
+// возвращает изменённые поля сущности в порядке их названий, не учитывая ID и служебные версии строки
+func DiffFields(before map[string]interface{}, after map[string]interface{}) []dto.HistoryFieldChange {
+	fields := map[string]struct{}{}
+	for field := range before {
+		fields[field] = struct{}{}
+	}
+	for field := range after {
+		fields[field] = struct{}{}
+	}
+	for _, field := range untrackedFields {
+		delete(fields, field)
+	}
+
+	names := make([]string, 0, len(fields))
+	for field := range fields {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+
+	changes := []dto.HistoryFieldChange{}
+	for _, field := range names {
+		oldValue, newValue := before[field], after[field]
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		changes = append(changes, dto.HistoryFieldChange{
+			Field:  field,
+			Before: oldValue,
+			After:  newValue,
+		})
+	}
+	return changes
+}
+
+// inverseActions
+// действия, которыми отменяются записанные в историю действия
+var inverseActions = map[string]string{
+	dto.HistoryActionCreate:     dto.HistoryActionDelete,
+	dto.HistoryActionDelete:     dto.HistoryActionCreate,
+	dto.HistoryActionUpdate:     dto.HistoryActionUpdate,
+	dto.HistoryActionMove:       dto.HistoryActionMove,
+	dto.HistoryActionReorder:    dto.HistoryActionReorder,
+	dto.HistoryActionAddUser:    dto.HistoryActionRemoveUser,
+	dto.HistoryActionRemoveUser: dto.HistoryActionAddUser,
+	dto.HistoryActionAddTag:     dto.HistoryActionRemoveTag,
+	dto.HistoryActionRemoveTag:  dto.HistoryActionAddTag,
+	dto.HistoryActionArchive:    dto.HistoryActionUnarchive,
+	dto.HistoryActionUnarchive:  dto.HistoryActionArchive,
+}
+
+// irreversibleFields
+// поля, изменения которых нельзя отменить: варианты прежней обложки доски удаляются при её замене
+var irreversibleFields = []string{"thumbnail_url"}
+
+// Inverse
+// возвращает запись истории об отмене изменения: обратное действие с переставленными значениями полей.
+// Создание доски, изменение участников доски, файлы, обложку и массовые изменения отменить нельзя
+func Inverse(entry dto.BoardHistoryEntry, userID uint64) (dto.NewHistoryEntry, error) {
+	action, ok := inverseActions[entry.Action]
+	if !ok || entry.EntityID == 0 {
+		return dto.NewHistoryEntry{}, apperrors.ErrHistoryNotRevertible
+	}
+	if entry.EntityType == dto.HistoryEntityBoard && action != dto.HistoryActionUpdate {
+		return dto.NewHistoryEntry{}, apperrors.ErrHistoryNotRevertible
+	}
+	if len(entry.Changes) == 0 {
+		return dto.NewHistoryEntry{}, apperrors.ErrHistoryNotRevertible
+	}
+
+	changes := make([]dto.HistoryFieldChange, 0, len(entry.Changes))
+	for _, change := range entry.Changes {
+		if change.Field == dto.HistoryChildrenField {
+			continue
+		}
+		for _, field := range irreversibleFields {
+			if change.Field == field {
+				return dto.NewHistoryEntry{}, apperrors.ErrHistoryNotRevertible
+			}
+		}
+		changes = append(changes, dto.HistoryFieldChange{
+			Field:  change.Field,
+			Before: change.After,
+			After:  change.Before,
+		})
+	}
+
+	revertedID := entry.ID
+	return dto.NewHistoryEntry{
+		UserID:     userID,
+		BoardID:    entry.BoardID,
+		EntityType: entry.EntityType,
+		EntityID:   entry.EntityID,
+		Action:     action,
+		Changes:    changes,
+		RevertedID: &revertedID,
+		TaskID:     entry.TaskID,
+	}, nil
+}
@@ -0,0 +1,342 @@
+package history
+
+import (
+	"context"
+	"server/internal/apperrors"
+	"server/internal/config"
+	logging "server/internal/logging"
+	"server/internal/pkg/dto"
+	"server/internal/pkg/entities"
+	"server/mocks/mock_storage"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func getLogger() logging.ILogger {
+	logger, _ := logging.NewLogrusLogger(&config.LoggingConfig{
+		Level:                  "info",
+		DisableTimestamp:       false,
+		FullTimestamp:          true,
+		LevelBasedReport:       true,
+		DisableLevelTruncation: true,
+		ReportCaller:           true,
+	})
+	return &logger
+}
+
+func recorderContext() context.Context {
+	ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+	ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+	return context.WithValue(ctx, dto.UserObjKey, &entities.User{ID: 7})
+}
+
+// expectTransaction
+// выполняет функции, переданные в Transaction хранилища, без открытия транзакции
+func expectTransaction(hs *mock_storage.MockIHistoryStorage) {
+	hs.EXPECT().Transaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) }).
+		AnyTimes()
+}
+
+func TestDiffFields(t *testing.T) {
+	t.Parallel()
+
+	before := map[string]interface{}{"id": float64(1), "name": "old", "description": nil, "list_position": float64(0)}
+	after := map[string]interface{}{"id": float64(1), "name": "new", "description": "text", "list_position": float64(0)}
+
+	require.Equal(t, []dto.HistoryFieldChange{
+		{Field: "description", Before: nil, After: "text"},
+		{Field: "name", Before: "old", After: "new"},
+	}, DiffFields(before, after))
+	require.Equal(t, []dto.HistoryFieldChange{
+		{Field: "name", Before: nil, After: "new"},
+	}, DiffFields(nil, map[string]interface{}{"id": float64(1), "name": "new"}))
+	require.Empty(t, DiffFields(before, before))
+	require.Empty(t, DiffFields(
+		map[string]interface{}{"id": float64(1), "version": float64(3), "row_version": float64(1)},
+		map[string]interface{}{"id": float64(1), "version": float64(4), "row_version": float64(2)},
+	))
+}
+
+func TestRecorder_Track(t *testing.T) {
+	t.Parallel()
+
+	ref := dto.HistoryEntityRef{EntityType: dto.HistoryEntityTask, EntityID: 1}
+
+	t.Run("Update recorded", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		hs := mock_storage.NewMockIHistoryStorage(ctrl)
+		expectTransaction(hs)
+		gomock.InOrder(
+			hs.EXPECT().Snapshot(gomock.Any(), ref).
+				Return(&dto.EntitySnapshot{BoardID: 3, Fields: map[string]interface{}{"name": "old"}}, nil),
+			hs.EXPECT().Snapshot(gomock.Any(), ref).
+				Return(&dto.EntitySnapshot{BoardID: 3, Fields: map[string]interface{}{"name": "new"}}, nil),
+			hs.EXPECT().Record(gomock.Any(), dto.NewHistoryEntry{
+				UserID:     7,
+				BoardID:    3,
+				EntityType: dto.HistoryEntityTask,
+				EntityID:   1,
+				Action:     dto.HistoryActionUpdate,
+				Changes:    []dto.HistoryFieldChange{{Field: "name", Before: "old", After: "new"}},
+			}).Return(nil),
+		)
+
+		err := NewRecorder(hs).Track(recorderContext(), dto.HistoryActionUpdate, func(context.Context) error { return nil }, ref)
+		require.NoError(t, err)
+	})
+
+	t.Run("Unchanged update not recorded", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		hs := mock_storage.NewMockIHistoryStorage(ctrl)
+		expectTransaction(hs)
+		hs.EXPECT().Snapshot(gomock.Any(), ref).
+			Return(&dto.EntitySnapshot{BoardID: 3, Fields: map[string]interface{}{"name": "same"}}, nil).Times(2)
+
+		err := NewRecorder(hs).Track(recorderContext(), dto.HistoryActionUpdate, func(context.Context) error { return nil }, ref)
+		require.NoError(t, err)
+	})
+
+	t.Run("Delete recorded with board, task and watchers from snapshot before", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		hs := mock_storage.NewMockIHistoryStorage(ctrl)
+		expectTransaction(hs)
+		taskID := uint64(1)
+		children := uint64(2)
+		gomock.InOrder(
+			hs.EXPECT().Snapshot(gomock.Any(), ref).
+				Return(&dto.EntitySnapshot{BoardID: 3, TaskID: &taskID, Children: &children, Watchers: []uint64{4, 5},
+					Fields: map[string]interface{}{"name": "old"}}, nil),
+			hs.EXPECT().Snapshot(gomock.Any(), ref).
+				Return(nil, apperrors.ErrHistoryEntityNotFound),
+			hs.EXPECT().Record(gomock.Any(), dto.NewHistoryEntry{
+				UserID:     7,
+				BoardID:    3,
+				EntityType: dto.HistoryEntityTask,
+				EntityID:   1,
+				Action:     dto.HistoryActionDelete,
+				Changes: []dto.HistoryFieldChange{
+					{Field: dto.HistoryChildrenField, Before: children, After: nil},
+					{Field: "name", Before: "old", After: nil},
+				},
+				TaskID:   &taskID,
+				Watchers: []uint64{4, 5},
+			}).Return(nil),
+		)
+
+		err := NewRecorder(hs).Track(recorderContext(), dto.HistoryActionDelete, func(context.Context) error { return nil }, ref)
+		require.NoError(t, err)
+	})
+
+	t.Run("Failed mutation not recorded", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		hs := mock_storage.NewMockIHistoryStorage(ctrl)
+		expectTransaction(hs)
+		hs.EXPECT().Snapshot(gomock.Any(), ref).
+			Return(&dto.EntitySnapshot{BoardID: 3, Fields: map[string]interface{}{"name": "old"}}, nil)
+
+		err := NewRecorder(hs).Track(recorderContext(), dto.HistoryActionUpdate, func(context.Context) error {
+			return apperrors.ErrTaskNotUpdated
+		}, ref)
+		require.ErrorIs(t, err, apperrors.ErrTaskNotUpdated)
+	})
+
+	t.Run("Failed snapshot before blocks mutation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		hs := mock_storage.NewMockIHistoryStorage(ctrl)
+		expectTransaction(hs)
+		hs.EXPECT().Snapshot(gomock.Any(), ref).Return(nil, apperrors.ErrCouldNotExecuteQuery)
+
+		mutated := false
+		err := NewRecorder(hs).Track(recorderContext(), dto.HistoryActionUpdate, func(context.Context) error {
+			mutated = true
+			return nil
+		}, ref)
+		require.ErrorIs(t, err, apperrors.ErrCouldNotRecordHistory)
+		require.False(t, mutated)
+	})
+
+	t.Run("Failed record returned", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		hs := mock_storage.NewMockIHistoryStorage(ctrl)
+		expectTransaction(hs)
+		gomock.InOrder(
+			hs.EXPECT().Snapshot(gomock.Any(), ref).
+				Return(&dto.EntitySnapshot{BoardID: 3, Fields: map[string]interface{}{"name": "old"}}, nil),
+			hs.EXPECT().Snapshot(gomock.Any(), ref).
+				Return(&dto.EntitySnapshot{BoardID: 3, Fields: map[string]interface{}{"name": "new"}}, nil),
+			hs.EXPECT().Record(gomock.Any(), gomock.Any()).Return(apperrors.ErrCouldNotExecuteQuery),
+		)
+
+		err := NewRecorder(hs).Track(recorderContext(), dto.HistoryActionUpdate, func(context.Context) error { return nil }, ref)
+		require.ErrorIs(t, err, apperrors.ErrCouldNotRecordHistory)
+	})
+}
+
+func TestRecorder_Related(t *testing.T) {
+	t.Parallel()
+
+	ref := dto.HistoryEntityRef{EntityType: dto.HistoryEntityTask, EntityID: 1}
+	ctrl := gomock.NewController(t)
+	hs := mock_storage.NewMockIHistoryStorage(ctrl)
+	expectTransaction(hs)
+	hs.EXPECT().Snapshot(gomock.Any(), ref).Return(&dto.EntitySnapshot{BoardID: 3}, nil)
+	hs.EXPECT().Record(gomock.Any(), gomock.Any()).Return(apperrors.ErrCouldNotExecuteQuery)
+
+	err := NewRecorder(hs).Related(recorderContext(), dto.HistoryActionAddUser, func(context.Context) error { return nil },
+		&dto.HistoryFieldChange{Field: "id_user", After: 2}, ref)
+	require.ErrorIs(t, err, apperrors.ErrCouldNotRecordHistory)
+}
+
+func TestRecorder_Create(t *testing.T) {
+	t.Parallel()
+
+	type txMarker struct{}
+	ref := dto.HistoryEntityRef{EntityType: dto.HistoryEntityTask, EntityID: 5}
+
+	t.Run("Creation and record share the transaction", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		hs := mock_storage.NewMockIHistoryStorage(ctrl)
+		hs.EXPECT().Transaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(context.WithValue(ctx, txMarker{}, true))
+			})
+		hs.EXPECT().Snapshot(gomock.Any(), ref).
+			Return(&dto.EntitySnapshot{BoardID: 3, Fields: map[string]interface{}{"name": "task"}}, nil)
+		hs.EXPECT().Record(gomock.Any(), gomock.Any()).Return(nil)
+
+		err := NewRecorder(hs).Create(recorderContext(), func(ctx context.Context) (dto.HistoryEntityRef, error) {
+			require.Equal(t, true, ctx.Value(txMarker{}))
+			return ref, nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Failed record fails the transaction", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		hs := mock_storage.NewMockIHistoryStorage(ctrl)
+		hs.EXPECT().Transaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				err := fn(ctx)
+				require.ErrorIs(t, err, apperrors.ErrCouldNotRecordHistory)
+				return err
+			})
+		hs.EXPECT().Snapshot(gomock.Any(), ref).
+			Return(&dto.EntitySnapshot{BoardID: 3, Fields: map[string]interface{}{"name": "task"}}, nil)
+		hs.EXPECT().Record(gomock.Any(), gomock.Any()).Return(apperrors.ErrCouldNotExecuteQuery)
+
+		err := NewRecorder(hs).Create(recorderContext(), func(context.Context) (dto.HistoryEntityRef, error) {
+			return ref, nil
+		})
+		require.ErrorIs(t, err, apperrors.ErrCouldNotRecordHistory)
+	})
+}
+
+func TestRecorder_TrackMove(t *testing.T) {
+	t.Parallel()
+
+	ref := dto.HistoryEntityRef{EntityType: dto.HistoryEntityList, EntityID: 1}
+	changes := []dto.HistoryFieldChange{{Field: "id_board", Before: float64(3), After: float64(4)}}
+
+	t.Run("Move to another board recorded on both boards", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		hs := mock_storage.NewMockIHistoryStorage(ctrl)
+		expectTransaction(hs)
+		gomock.InOrder(
+			hs.EXPECT().Snapshot(gomock.Any(), ref).
+				Return(&dto.EntitySnapshot{BoardID: 3, Fields: map[string]interface{}{"id_board": float64(3)}}, nil),
+			hs.EXPECT().Snapshot(gomock.Any(), ref).
+				Return(&dto.EntitySnapshot{BoardID: 4, Fields: map[string]interface{}{"id_board": float64(4)}}, nil),
+			hs.EXPECT().Record(gomock.Any(), dto.NewHistoryEntry{
+				UserID:     7,
+				BoardID:    3,
+				EntityType: dto.HistoryEntityList,
+				EntityID:   1,
+				Action:     dto.HistoryActionMove,
+				Changes:    changes,
+			}).Return(nil),
+			hs.EXPECT().Record(gomock.Any(), dto.NewHistoryEntry{
+				UserID:     7,
+				BoardID:    4,
+				EntityType: dto.HistoryEntityList,
+				EntityID:   1,
+				Action:     dto.HistoryActionMove,
+				Changes:    changes,
+			}).Return(nil),
+		)
+
+		err := NewRecorder(hs).TrackMove(recorderContext(), func(context.Context) error { return nil }, ref)
+		require.NoError(t, err)
+	})
+
+	t.Run("Move within a board recorded once", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		hs := mock_storage.NewMockIHistoryStorage(ctrl)
+		expectTransaction(hs)
+		gomock.InOrder(
+			hs.EXPECT().Snapshot(gomock.Any(), ref).
+				Return(&dto.EntitySnapshot{BoardID: 3, Fields: map[string]interface{}{"rank": "a"}}, nil),
+			hs.EXPECT().Snapshot(gomock.Any(), ref).
+				Return(&dto.EntitySnapshot{BoardID: 3, Fields: map[string]interface{}{"rank": "b"}}, nil),
+			hs.EXPECT().Record(gomock.Any(), gomock.Any()).Return(nil).Times(1),
+		)
+
+		err := NewRecorder(hs).TrackMove(recorderContext(), func(context.Context) error { return nil }, ref)
+		require.NoError(t, err)
+	})
+}
+
+func TestInverse(t *testing.T) {
+	t.Parallel()
+
+	taskID := uint64(1)
+	entry := dto.BoardHistoryEntry{
+		ID:         5,
+		BoardID:    3,
+		EntityType: dto.HistoryEntityTask,
+		EntityID:   1,
+		Action:     dto.HistoryActionDelete,
+		Changes: []dto.HistoryFieldChange{
+			{Field: dto.HistoryChildrenField, Before: float64(0), After: nil},
+			{Field: "name", Before: "task", After: nil},
+		},
+		TaskID: &taskID,
+	}
+
+	revert, err := Inverse(entry, 7)
+	require.NoError(t, err)
+	revertedID := uint64(5)
+	require.Equal(t, dto.NewHistoryEntry{
+		UserID:     7,
+		BoardID:    3,
+		EntityType: dto.HistoryEntityTask,
+		EntityID:   1,
+		Action:     dto.HistoryActionCreate,
+		Changes:    []dto.HistoryFieldChange{{Field: "name", Before: nil, After: "task"}},
+		RevertedID: &revertedID,
+		TaskID:     &taskID,
+	}, revert)
+
+	entry.Action = dto.HistoryActionAttachFile
+	_, err = Inverse(entry, 7)
+	require.ErrorIs(t, err, apperrors.ErrHistoryNotRevertible)
+
+	entry.EntityType = dto.HistoryEntityBoard
+	entry.Action = dto.HistoryActionCreate
+	_, err = Inverse(entry, 7)
+	require.ErrorIs(t, err, apperrors.ErrHistoryNotRevertible)
+
+	entry.Action = dto.HistoryActionUpdate
+	entry.Changes = []dto.HistoryFieldChange{{Field: "thumbnail_url", Before: "old.png", After: "new.png"}}
+	_, err = Inverse(entry, 7)
+	require.ErrorIs(t, err, apperrors.ErrHistoryNotRevertible)
+
+	entry.EntityType = dto.HistoryEntityTask
+	entry.EntityID = 0
+	entry.Action = dto.HistoryActionArchive
+	_, err = Inverse(entry, 7)
+	require.ErrorIs(t, err, apperrors.ErrHistoryNotRevertible)
+}
@@ -15,15 +15,39 @@ type IListService interface {
 	// или возвращает ошибки ...
 	Create(context.Context, dto.NewListInfo) (*entities.List, error)
 	// Update
-	// обновляет список
+	// обновляет список и возвращает его текущее состояние, в том числе при конфликте версий
 	// или возвращает ошибки ...
-	Update(context.Context, dto.UpdatedListInfo) error
+	Update(context.Context, dto.UpdatedListInfo) (*dto.SingleListInfo, error)
 	// Delete
 	// удаляет список по id
 	// или возвращает ошибки ...
 	Delete(context.Context, dto.ListID) error
+	// Archive
+	// переносит список в архив
+	// или возвращает ошибки ...
+	Archive(context.Context, dto.ListID) error
+	// Unarchive
+	// возвращает список из архива; если задания списка превышают его мягкий лимит, возвращает предупреждение
+	// или возвращает ошибки ...
+	Unarchive(context.Context, dto.ListID) (*dto.WipLimitWarning, error)
+	// ArchiveTasks
+	// переносит в архив все задания списка
+	// или возвращает ошибки ...
+	ArchiveTasks(context.Context, dto.ListID) error
 	// UpdateOrder
-	// изменяет порядок списков по id
+	// ставит список сразу после другого списка той же доски, меняя ключ порядка только у него
+	// или возвращает ошибки ...
+	UpdateOrder(context.Context, dto.ListMoveInfo) error
+	// SortTasks
+	// сортирует задания списка по приоритету, сроку, названию или дате создания и сохраняет новый порядок
+	// или возвращает ошибки ...
+	SortTasks(context.Context, dto.ListSortInfo) error
+	// Move
+	// переносит список с заданиями на другую доску и сообщает, что изменилось в заданиях
+	// или возвращает ошибки ...
+	Move(context.Context, dto.ListTransferInfo) (*dto.ListTransferReport, error)
+	// Copy
+	// копирует список с заданиями на доску и сообщает, что изменилось в копиях заданий
 	// или возвращает ошибки ...
-	UpdateOrder(context.Context, dto.ListIDs) error
+	Copy(context.Context, dto.ListTransferInfo) (*dto.ListTransferReport, error)
 }
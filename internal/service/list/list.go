@@ -9,6 +9,6 @@ import (
 )
 
 // TODO: List microservice
-func NewMicroListService(listStorage storage.IListStorage, connection *grpc.ClientConn) *micro.ListService {
-	return micro.NewListService(listStorage, connection)
+func NewMicroListService(listStorage storage.IListStorage, boardStorage storage.IBoardStorage, historyStorage storage.IHistoryStorage, connection *grpc.ClientConn) *micro.ListService {
+	return micro.NewListService(listStorage, boardStorage, historyStorage, connection)
 }
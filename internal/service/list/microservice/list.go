@@ -2,22 +2,30 @@ package microservice
 
 import (
 	"context"
+	"errors"
+	"server/internal/apperrors"
 	"server/internal/pkg/dto"
 	"server/internal/pkg/entities"
+	"server/internal/service/history"
 	"server/internal/storage"
+	"strconv"
 
 	"google.golang.org/grpc"
 )
 
 type ListService struct {
-	storage storage.IListStorage
+	storage      storage.IListStorage
+	boardStorage storage.IBoardStorage
+	history      *history.Recorder
 }
 
-// NewBoardService
-// возвращает BoardService с инициализированным хранилищем
-func NewListService(storage storage.IListStorage, connection *grpc.ClientConn) *ListService {
+// NewListService
+// возвращает ListService с инициализированным хранилищем
+func NewListService(storage storage.IListStorage, boardStorage storage.IBoardStorage, historyStorage storage.IHistoryStorage, connection *grpc.ClientConn) *ListService {
 	return &ListService{
-		storage: storage,
+		storage:      storage,
+		boardStorage: boardStorage,
+		history:      history.NewRecorder(historyStorage),
 	}
 }
 
@@ -25,26 +33,233 @@ func NewListService(storage storage.IListStorage, connection *grpc.ClientConn) *
 // создает новый список
 // или возвращает ошибки ...
 func (ls ListService) Create(ctx context.Context, info dto.NewListInfo) (*entities.List, error) {
-	return ls.storage.Create(ctx, info)
+	var list *entities.List
+	err := ls.history.Create(ctx, func(ctx context.Context) (dto.HistoryEntityRef, error) {
+		var err error
+		list, err = ls.storage.Create(ctx, info)
+		if err != nil {
+			return dto.HistoryEntityRef{}, err
+		}
+		return listRef(list.ID), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
 }
 
 // Update
-// обновляет список
-// или возвращает ошибки ...
-func (ls ListService) Update(ctx context.Context, info dto.UpdatedListInfo) error {
-	return ls.storage.Update(ctx, info)
+// обновляет список вместе с лимитом задач в нём, если клиент видел его последнюю версию,
+// и возвращает текущее состояние списка. При конфликте версий состояние возвращается вместе с ошибкой
+// или возвращает ошибки apperrors.ErrInvalidWipLimit (400), apperrors.ErrRowVersionMissing (400),
+// apperrors.ErrRowVersionConflict (409), ...
+func (ls ListService) Update(ctx context.Context, info dto.UpdatedListInfo) (*dto.SingleListInfo, error) {
+	if info.WipMode == "" {
+		info.WipMode = dto.WipModeSoft
+	}
+	if info.WipMode != dto.WipModeSoft && info.WipMode != dto.WipModeHard {
+		return nil, apperrors.ErrInvalidWipLimit
+	}
+	if info.WipLimit != nil && *info.WipLimit == 0 {
+		return nil, apperrors.ErrInvalidWipLimit
+	}
+	if info.RowVersion == 0 {
+		return nil, apperrors.ErrRowVersionMissing
+	}
+
+	err := ls.history.Track(ctx, dto.HistoryActionUpdate, func(ctx context.Context) error {
+		return ls.storage.Update(ctx, info)
+	}, listRef(info.ID))
+	if err != nil && !errors.Is(err, apperrors.ErrRowVersionConflict) {
+		return nil, err
+	}
+
+	list, readErr := ls.storage.Read(ctx, dto.ListID{Value: info.ID})
+	if readErr != nil {
+		return nil, readErr
+	}
+	return list, err
 }
 
 // Delete
 // удаляет список по id
 // или возвращает ошибки ...
 func (ls ListService) Delete(ctx context.Context, id dto.ListID) error {
-	return ls.storage.Delete(ctx, id)
+	return ls.history.Track(ctx, dto.HistoryActionDelete, func(ctx context.Context) error {
+		return ls.storage.Delete(ctx, id)
+	}, listRef(id.Value))
+}
+
+// Archive
+// переносит список в архив; задания списка скрываются с доски вместе с ним
+// или возвращает ошибки apperrors.ErrListNotFound (404), ...
+func (ls ListService) Archive(ctx context.Context, id dto.ListID) error {
+	return ls.history.Track(ctx, dto.HistoryActionArchive, func(ctx context.Context) error {
+		return ls.storage.Archive(ctx, id)
+	}, listRef(id.Value))
+}
+
+// Unarchive
+// возвращает список из архива вместе с его заданиями. Жёсткий лимит задач и заблокированные задания
+// проверяет хранилище; если задания превышают мягкий лимит, возвращает предупреждение
+// или возвращает ошибки apperrors.ErrListNotFound (404), apperrors.ErrWipLimitExceeded (409), apperrors.ErrTaskBlocked (409), ...
+func (ls ListService) Unarchive(ctx context.Context, id dto.ListID) (*dto.WipLimitWarning, error) {
+	err := ls.history.Track(ctx, dto.HistoryActionUnarchive, func(ctx context.Context) error {
+		return ls.storage.Unarchive(ctx, id)
+	}, listRef(id.Value))
+	if err != nil {
+		return nil, err
+	}
+
+	wip, err := ls.storage.GetWipInfo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if wip.WipLimit == nil || wip.TaskCount <= *wip.WipLimit {
+		return nil, nil
+	}
+	return &dto.WipLimitWarning{
+		ListID:    id.Value,
+		WipLimit:  *wip.WipLimit,
+		TaskCount: wip.TaskCount,
+	}, nil
+}
+
+// ArchiveTasks
+// переносит в архив все задания списка; архивирование каждого задания записывается в историю
+// или возвращает ошибки apperrors.ErrListNotFound (404), ...
+func (ls ListService) ArchiveTasks(ctx context.Context, id dto.ListID) error {
+	refs, err := ls.taskRefs(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return ls.history.Track(ctx, dto.HistoryActionArchive, func(ctx context.Context) error {
+		return ls.storage.ArchiveTasks(ctx, id)
+	}, refs...)
+}
+
+// SortTasks
+// сортирует задания списка по приоритету, сроку, названию или дате создания и сохраняет новый порядок.
+// Пересортировка записывается в историю одной записью
+// или возвращает ошибки apperrors.ErrInvalidListSort (400), apperrors.ErrListNotFound (404), ...
+func (ls ListService) SortTasks(ctx context.Context, info dto.ListSortInfo) error {
+	switch info.By {
+	case dto.ListSortPriority, dto.ListSortDue, dto.ListSortName, dto.ListSortCreated:
+	default:
+		return apperrors.ErrInvalidListSort
+	}
+
+	refs, err := ls.taskRefs(ctx, dto.ListID{Value: info.ListID})
+	if err != nil {
+		return err
+	}
+
+	return ls.history.TrackBulk(ctx, dto.HistoryActionReorder, func(ctx context.Context) error {
+		return ls.storage.SortTasks(ctx, info)
+	}, nil, refs...)
+}
+
+// taskRefs
+// возвращает ссылки истории на задания списка
+// или возвращает ошибки apperrors.ErrListNotFound (404), ...
+func (ls ListService) taskRefs(ctx context.Context, id dto.ListID) ([]dto.HistoryEntityRef, error) {
+	list, err := ls.storage.Read(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make([]dto.HistoryEntityRef, 0, len(list.TaskIDs))
+	for _, taskID := range list.TaskIDs {
+		value, err := strconv.ParseUint(taskID, 10, 64)
+		if err != nil {
+			return nil, apperrors.ErrCouldNotGetList
+		}
+		refs = append(refs, dto.HistoryEntityRef{EntityType: dto.HistoryEntityTask, EntityID: value})
+	}
+	return refs, nil
 }
 
 // UpdateOrder
-// изменяет порядок списков по id
+// ставит список после другого списка той же доски
 // или возвращает ошибки ...
-func (ls ListService) UpdateOrder(ctx context.Context, ids dto.ListIDs) error {
-	return ls.storage.UpdateOrder(ctx, ids)
+func (ls ListService) UpdateOrder(ctx context.Context, info dto.ListMoveInfo) error {
+	return ls.history.Track(ctx, dto.HistoryActionReorder, func(ctx context.Context) error {
+		return ls.storage.UpdateOrder(ctx, info)
+	}, listRef(info.ListID))
+}
+
+// Move
+// переносит список с заданиями на другую доску; пользователь должен иметь доступ к обеим доскам.
+// Перенос записывается в историю обеих досок
+// или возвращает ошибки apperrors.ErrNoBoardAccess (403), apperrors.ErrListAlreadyOnBoard (400), apperrors.ErrWipLimitExceeded (409), ...
+func (ls ListService) Move(ctx context.Context, info dto.ListTransferInfo) (*dto.ListTransferReport, error) {
+	if err := ls.checkTransferAccess(ctx, info); err != nil {
+		return nil, err
+	}
+
+	var report *dto.ListTransferReport
+	err := ls.history.TrackMove(ctx, func(ctx context.Context) error {
+		var err error
+		report, err = ls.storage.Move(ctx, info)
+		return err
+	}, listRef(info.ListID))
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// Copy
+// копирует список с заданиями на доску; пользователь должен иметь доступ к доске списка и к целевой доске
+// или возвращает ошибки apperrors.ErrNoBoardAccess (403), apperrors.ErrWipLimitExceeded (409), ...
+func (ls ListService) Copy(ctx context.Context, info dto.ListTransferInfo) (*dto.ListTransferReport, error) {
+	if err := ls.checkTransferAccess(ctx, info); err != nil {
+		return nil, err
+	}
+
+	var report *dto.ListTransferReport
+	err := ls.history.Create(ctx, func(ctx context.Context) (dto.HistoryEntityRef, error) {
+		var err error
+		report, err = ls.storage.Copy(ctx, info)
+		if err != nil {
+			return dto.HistoryEntityRef{}, err
+		}
+		return listRef(report.ListID), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// checkTransferAccess
+// проверяет, что список существует, а у пользователя есть доступ к его доске и к целевой доске
+func (ls ListService) checkTransferAccess(ctx context.Context, info dto.ListTransferInfo) error {
+	list, err := ls.storage.Read(ctx, dto.ListID{Value: info.ListID})
+	if err != nil {
+		return err
+	}
+
+	userID := ctx.Value(dto.UserObjKey).(*entities.User).ID
+	for _, boardID := range []uint64{list.BoardID, info.BoardID} {
+		userAccess, err := ls.boardStorage.CheckAccess(ctx, dto.CheckBoardAccessInfo{
+			UserID:  userID,
+			BoardID: boardID,
+		})
+		if err != nil {
+			return apperrors.ErrCouldNotGetUser
+		}
+		if !userAccess {
+			return apperrors.ErrNoBoardAccess
+		}
+	}
+	return nil
+}
+
+func listRef(id uint64) dto.HistoryEntityRef {
+	return dto.HistoryEntityRef{EntityType: dto.HistoryEntityList, EntityID: id}
 }
@@ -3,14 +3,30 @@ package microservice
 import (
 	"context"
 	"reflect"
+	"server/internal/apperrors"
+	"server/internal/config"
+	logging "server/internal/logging"
 	"server/internal/pkg/dto"
 	"server/internal/pkg/entities"
+	"server/internal/service/history"
 	"server/internal/storage"
+	"server/mocks/mock_storage"
 	"testing"
 
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 )
 
+// expectTransaction
+// выполняет функции, переданные в Transaction хранилища истории, без открытия транзакции
+func expectTransaction(hs *mock_storage.MockIHistoryStorage) {
+	hs.EXPECT().Transaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) }).
+		AnyTimes()
+}
+
 func TestListService_Create(t *testing.T) {
 	type fields struct {
 		storage storage.IListStorage
@@ -94,7 +110,7 @@ func TestListService_Update(t *testing.T) {
 			ls := ListService{
 				storage: tt.fields.storage,
 			}
-			if err := ls.Update(tt.args.ctx, tt.args.info); (err != nil) != tt.wantErr {
+			if _, err := ls.Update(tt.args.ctx, tt.args.info); (err != nil) != tt.wantErr {
 				t.Errorf("Update() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -103,8 +119,10 @@ func TestListService_Update(t *testing.T) {
 
 func TestNewListService(t *testing.T) {
 	type args struct {
-		storage    storage.IListStorage
-		connection *grpc.ClientConn
+		storage        storage.IListStorage
+		boardStorage   storage.IBoardStorage
+		historyStorage storage.IHistoryStorage
+		connection     *grpc.ClientConn
 	}
 	tests := []struct {
 		name string
@@ -115,9 +133,243 @@ func TestNewListService(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewListService(tt.args.storage, tt.args.connection); !reflect.DeepEqual(got, tt.want) {
+			if got := NewListService(tt.args.storage, tt.args.boardStorage, tt.args.historyStorage, tt.args.connection); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("NewListService() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func TestListService_Move(t *testing.T) {
+	t.Parallel()
+
+	info := dto.ListTransferInfo{ListID: 1, BoardID: 4}
+	report := &dto.ListTransferReport{
+		ListID:  1,
+		BoardID: 4,
+		Changes: dto.TransferChanges{
+			DroppedAssignees: []dto.DroppedAssignee{{TaskID: 2, UserID: 9}},
+		},
+	}
+	tests := []struct {
+		name         string
+		targetAccess bool
+		want         *dto.ListTransferReport
+		wantErr      error
+	}{
+		{
+			name:         "Moved",
+			targetAccess: true,
+			want:         report,
+		},
+		{
+			name:         "No access to target board",
+			targetAccess: false,
+			wantErr:      apperrors.ErrNoBoardAccess,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			listStorage := mock_storage.NewMockIListStorage(ctrl)
+			boardStorage := mock_storage.NewMockIBoardStorage(ctrl)
+			historyStorage := mock_storage.NewMockIHistoryStorage(ctrl)
+			expectTransaction(historyStorage)
+
+			listStorage.EXPECT().Read(gomock.Any(), dto.ListID{Value: 1}).
+				Return(&dto.SingleListInfo{ID: 1, BoardID: 3}, nil)
+			boardStorage.EXPECT().CheckAccess(gomock.Any(), dto.CheckBoardAccessInfo{UserID: 7, BoardID: 3}).
+				Return(true, nil)
+			boardStorage.EXPECT().CheckAccess(gomock.Any(), dto.CheckBoardAccessInfo{UserID: 7, BoardID: 4}).
+				Return(tt.targetAccess, nil)
+			if tt.targetAccess {
+				historyStorage.EXPECT().Snapshot(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrHistoryEntityNotFound).AnyTimes()
+				listStorage.EXPECT().Move(gomock.Any(), info).Return(report, nil)
+			}
+
+			ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+			ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+			ctx = context.WithValue(ctx, dto.UserObjKey, &entities.User{ID: 7})
+
+			ls := ListService{storage: listStorage, boardStorage: boardStorage, history: history.NewRecorder(historyStorage)}
+			got, err := ls.Move(ctx, info)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestListService_ArchiveTasks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		readErr error
+		wantErr error
+	}{
+		{
+			name: "Archived",
+		},
+		{
+			name:    "List not found",
+			readErr: apperrors.ErrListNotFound,
+			wantErr: apperrors.ErrListNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			listStorage := mock_storage.NewMockIListStorage(ctrl)
+			historyStorage := mock_storage.NewMockIHistoryStorage(ctrl)
+			expectTransaction(historyStorage)
+
+			if tt.readErr != nil {
+				listStorage.EXPECT().Read(gomock.Any(), dto.ListID{Value: 1}).Return(nil, tt.readErr)
+			} else {
+				listStorage.EXPECT().Read(gomock.Any(), dto.ListID{Value: 1}).
+					Return(&dto.SingleListInfo{ID: 1, BoardID: 3, TaskIDs: []string{"5", "6"}}, nil)
+				for _, taskID := range []uint64{5, 6} {
+					historyStorage.EXPECT().Snapshot(gomock.Any(), dto.HistoryEntityRef{EntityType: dto.HistoryEntityTask, EntityID: taskID}).
+						Return(nil, apperrors.ErrHistoryEntityNotFound).Times(2)
+				}
+				listStorage.EXPECT().ArchiveTasks(gomock.Any(), dto.ListID{Value: 1}).Return(nil)
+			}
+
+			ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+			ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+
+			ls := ListService{storage: listStorage, history: history.NewRecorder(historyStorage)}
+			err := ls.ArchiveTasks(ctx, dto.ListID{Value: 1})
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestListService_Unarchive(t *testing.T) {
+	t.Parallel()
+
+	limit := uint64(2)
+	tests := []struct {
+		name         string
+		wip          *dto.ListWipInfo
+		unarchiveErr error
+		want         *dto.WipLimitWarning
+		wantErr      error
+	}{
+		{
+			name: "Under limit",
+			wip:  &dto.ListWipInfo{ListID: 1, WipLimit: &limit, WipMode: dto.WipModeSoft, TaskCount: 2},
+		},
+		{
+			name: "Soft limit exceeded",
+			wip:  &dto.ListWipInfo{ListID: 1, WipLimit: &limit, WipMode: dto.WipModeSoft, TaskCount: 3},
+			want: &dto.WipLimitWarning{ListID: 1, WipLimit: 2, TaskCount: 3},
+		},
+		{
+			name:         "Hard limit exceeded",
+			unarchiveErr: apperrors.ErrWipLimitExceeded,
+			wantErr:      apperrors.ErrWipLimitExceeded,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			listStorage := mock_storage.NewMockIListStorage(ctrl)
+			historyStorage := mock_storage.NewMockIHistoryStorage(ctrl)
+			expectTransaction(historyStorage)
+			historyStorage.EXPECT().Snapshot(gomock.Any(), gomock.Any()).
+				Return(nil, apperrors.ErrHistoryEntityNotFound).AnyTimes()
+			listStorage.EXPECT().Unarchive(gomock.Any(), dto.ListID{Value: 1}).Return(tt.unarchiveErr)
+			if tt.wip != nil {
+				listStorage.EXPECT().GetWipInfo(gomock.Any(), dto.ListID{Value: 1}).Return(tt.wip, nil)
+			}
+
+			ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+			ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+
+			ls := ListService{storage: listStorage, history: history.NewRecorder(historyStorage)}
+			got, err := ls.Unarchive(ctx, dto.ListID{Value: 1})
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestListService_SortTasks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		info    dto.ListSortInfo
+		readErr error
+		wantErr error
+	}{
+		{
+			name: "Sorted",
+			info: dto.ListSortInfo{ListID: 1, By: dto.ListSortPriority},
+		},
+		{
+			name:    "Unknown sort key",
+			info:    dto.ListSortInfo{ListID: 1, By: "color"},
+			wantErr: apperrors.ErrInvalidListSort,
+		},
+		{
+			name:    "List not found",
+			info:    dto.ListSortInfo{ListID: 1, By: dto.ListSortDue},
+			readErr: apperrors.ErrListNotFound,
+			wantErr: apperrors.ErrListNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			listStorage := mock_storage.NewMockIListStorage(ctrl)
+			historyStorage := mock_storage.NewMockIHistoryStorage(ctrl)
+			expectTransaction(historyStorage)
+
+			if tt.readErr != nil {
+				listStorage.EXPECT().Read(gomock.Any(), dto.ListID{Value: 1}).Return(nil, tt.readErr)
+			} else if tt.wantErr == nil {
+				listStorage.EXPECT().Read(gomock.Any(), dto.ListID{Value: 1}).
+					Return(&dto.SingleListInfo{ID: 1, BoardID: 3, TaskIDs: []string{"5", "6"}}, nil)
+				for _, taskID := range []uint64{5, 6} {
+					historyStorage.EXPECT().Snapshot(gomock.Any(), dto.HistoryEntityRef{EntityType: dto.HistoryEntityTask, EntityID: taskID}).
+						Return(nil, apperrors.ErrHistoryEntityNotFound).Times(2)
+				}
+				listStorage.EXPECT().SortTasks(gomock.Any(), tt.info).Return(nil)
+			}
+
+			ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+			ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+
+			ls := ListService{storage: listStorage, history: history.NewRecorder(historyStorage)}
+			err := ls.SortTasks(ctx, tt.info)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func getLogger() logging.ILogger {
+	logger, _ := logging.NewLogrusLogger(&config.LoggingConfig{
+		Level:                  "info",
+		DisableTimestamp:       false,
+		FullTimestamp:          true,
+		LevelBasedReport:       true,
+		DisableLevelTruncation: true,
+		ReportCaller:           true,
+	})
+	return &logger
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+	"server/internal/pkg/dto"
+)
+
+// Интерфейс для сервиса уведомлений
+//
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/mock_service/$GOFILE -package=mock_service
+type INotificationService interface {
+	// GetNotifications
+	// возвращает уведомления пользователя, начиная с новых
+	// или возвращает ошибки ...
+	GetNotifications(context.Context, dto.NotificationsRequest) (*[]dto.NotificationInfo, error)
+	// MarkRead
+	// отмечает уведомления пользователя прочитанными
+	// или возвращает ошибки ...
+	MarkRead(context.Context, dto.NotificationsReadInfo) error
+}
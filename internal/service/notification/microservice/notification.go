@@ -0,0 +1,133 @@
+package microservice
+
+import (
+	"context"
+	"fmt"
+	logger "server/internal/logging"
+	"server/internal/pkg/dto"
+	"server/internal/pkg/schedule"
+	"server/internal/storage"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const nodeName = "service"
+
+const (
+	// defaultNotificationsLimit
+	// количество уведомлений в ответе, если клиент его не указал
+	defaultNotificationsLimit = 50
+	// maxNotificationsLimit
+	// наибольшее количество уведомлений в ответе
+	maxNotificationsLimit = 200
+)
+
+type NotificationService struct {
+	storage     storage.INotificationStorage
+	userStorage storage.IUserStorage
+}
+
+// NewNotificationService
+// возвращает NotificationService с инициализированными хранилищами
+func NewNotificationService(ns storage.INotificationStorage, us storage.IUserStorage) *NotificationService {
+	return &NotificationService{
+		storage:     ns,
+		userStorage: us,
+	}
+}
+
+// GetNotifications
+// возвращает уведомления пользователя, начиная с новых; сроки заданий переводятся в часовой пояс пользователя
+// или возвращает ошибки apperrors.ErrCouldNotGetNotifications (500), ...
+func (ns NotificationService) GetNotifications(ctx context.Context, request dto.NotificationsRequest) (*[]dto.NotificationInfo, error) {
+	funcName := "NotificationService.GetNotifications"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	if request.Limit == 0 {
+		request.Limit = defaultNotificationsLimit
+	}
+	if request.Limit > maxNotificationsLimit {
+		request.Limit = maxNotificationsLimit
+	}
+
+	notifications, err := ns.storage.ReadMany(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	logger.DebugFmt("Got notifications", requestID.String(), funcName, nodeName)
+
+	if len(*notifications) == 0 {
+		return notifications, nil
+	}
+	timezone, err := ns.userStorage.GetTimezone(ctx, dto.UserID{Value: request.UserID})
+	if err != nil {
+		return nil, err
+	}
+	loc := schedule.Location(timezone)
+	for i := range *notifications {
+		if due := (*notifications)[i].DueAt; due != nil {
+			local := due.In(loc)
+			(*notifications)[i].DueAt = &local
+		}
+	}
+
+	return notifications, nil
+}
+
+// MarkRead
+// отмечает уведомления пользователя прочитанными
+// или возвращает ошибки apperrors.ErrNotificationsNotUpdated (500), ...
+func (ns NotificationService) MarkRead(ctx context.Context, info dto.NotificationsReadInfo) error {
+	return ns.storage.MarkRead(ctx, info)
+}
+
+// ReminderScheduler
+// периодически создает напоминания о подходящих сроках заданий
+type ReminderScheduler struct {
+	storage  storage.INotificationStorage
+	interval time.Duration
+}
+
+// NewReminderScheduler
+// возвращает ReminderScheduler, который делает проход раз в interval
+func NewReminderScheduler(ns storage.INotificationStorage, interval time.Duration) *ReminderScheduler {
+	return &ReminderScheduler{
+		storage:  ns,
+		interval: interval,
+	}
+}
+
+// Run
+// делает проходы планировщика до отмены ctx; ошибки прохода логируются, и следующий проход делается по расписанию
+func (rs ReminderScheduler) Run(ctx context.Context, logger logger.ILogger) {
+	ticker := time.NewTicker(rs.interval)
+	defer ticker.Stop()
+
+	for {
+		rs.Tick(ctx, logger)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick
+// делает один проход планировщика и возвращает количество созданных напоминаний
+func (rs ReminderScheduler) Tick(ctx context.Context, logger logger.ILogger) int64 {
+	funcName := "ReminderScheduler.Tick"
+	requestID := uuid.New()
+	ctx = context.WithValue(context.WithValue(ctx, dto.LoggerKey, logger), dto.RequestIDKey, requestID)
+
+	created, err := rs.storage.CreateDueReminders(ctx)
+	if err != nil {
+		logger.Error("Creating due reminders failed with error: " + err.Error())
+		return 0
+	}
+	logger.DebugFmt(fmt.Sprintf("Created %d due reminders", created), requestID.String(), funcName, nodeName)
+
+	return created
+}
@@ -0,0 +1,141 @@
+package microservice
+
+import (
+	"context"
+	"server/internal/apperrors"
+	"server/internal/config"
+	logging "server/internal/logging"
+	"server/internal/pkg/dto"
+	"server/mocks/mock_storage"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNotificationService_GetNotifications(t *testing.T) {
+	t.Parallel()
+
+	due := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		request   dto.NotificationsRequest
+		limit     uint64
+		stored    []dto.NotificationInfo
+		timezone  bool
+		wantErr   error
+		wantLocal bool
+	}{
+		{
+			name:      "Default limit and localized due date",
+			request:   dto.NotificationsRequest{UserID: 1},
+			limit:     defaultNotificationsLimit,
+			stored:    []dto.NotificationInfo{{ID: 1, Kind: dto.NotificationDueReminder, DueAt: &due}},
+			timezone:  true,
+			wantLocal: true,
+		},
+		{
+			name:    "Limit capped, nothing found",
+			request: dto.NotificationsRequest{UserID: 1, Limit: 1000},
+			limit:   maxNotificationsLimit,
+			stored:  []dto.NotificationInfo{},
+		},
+		{
+			name:    "Storage fail",
+			request: dto.NotificationsRequest{UserID: 1, Limit: 10},
+			limit:   10,
+			wantErr: apperrors.ErrCouldNotGetNotifications,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			notificationStorage := mock_storage.NewMockINotificationStorage(ctrl)
+			userStorage := mock_storage.NewMockIUserStorage(ctrl)
+
+			request := tt.request
+			request.Limit = tt.limit
+			if tt.wantErr != nil {
+				notificationStorage.EXPECT().ReadMany(gomock.Any(), request).Return(nil, tt.wantErr)
+			} else {
+				stored := append([]dto.NotificationInfo{}, tt.stored...)
+				notificationStorage.EXPECT().ReadMany(gomock.Any(), request).Return(&stored, nil)
+			}
+			if tt.timezone {
+				userStorage.EXPECT().GetTimezone(gomock.Any(), dto.UserID{Value: 1}).Return("Europe/Moscow", nil)
+			}
+
+			ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+			ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+
+			ns := NewNotificationService(notificationStorage, userStorage)
+			got, err := ns.GetNotifications(ctx, tt.request)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			require.Len(t, *got, len(tt.stored))
+			if tt.wantLocal {
+				require.Equal(t, "Europe/Moscow", (*got)[0].DueAt.Location().String())
+				require.True(t, (*got)[0].DueAt.Equal(due))
+			}
+		})
+	}
+}
+
+func TestReminderScheduler_Tick(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		created int64
+		err     error
+		want    int64
+	}{
+		{
+			name:    "Reminders created",
+			created: 2,
+			want:    2,
+		},
+		{
+			name: "Storage fail",
+			err:  apperrors.ErrRemindersNotCreated,
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			notificationStorage := mock_storage.NewMockINotificationStorage(ctrl)
+			notificationStorage.EXPECT().CreateDueReminders(gomock.Any()).
+				DoAndReturn(func(ctx context.Context) (int64, error) {
+					require.NotNil(t, ctx.Value(dto.LoggerKey))
+					require.NotNil(t, ctx.Value(dto.RequestIDKey))
+					return tt.created, tt.err
+				})
+
+			rs := NewReminderScheduler(notificationStorage, time.Minute)
+			require.Equal(t, tt.want, rs.Tick(context.Background(), getLogger()))
+		})
+	}
+}
+
+func getLogger() logging.ILogger {
+	logger, _ := logging.NewLogrusLogger(&config.LoggingConfig{
+		Level:                  "info",
+		DisableTimestamp:       false,
+		FullTimestamp:          true,
+		LevelBasedReport:       true,
+		DisableLevelTruncation: true,
+		ReportCaller:           true,
+	})
+	return &logger
+}
@@ -0,0 +1,21 @@
+package notification
+
+import (
+	"server/internal/storage"
+	"time"
+
+	micro "server/internal/service/notification/microservice"
+
+	"google.golang.org/grpc"
+)
+
+// TODO: Notification microservice
+func NewMicroNotificationService(notificationStorage storage.INotificationStorage, userStorage storage.IUserStorage, connection *grpc.ClientConn) *micro.NotificationService {
+	return micro.NewNotificationService(notificationStorage, userStorage)
+}
+
+// NewReminderScheduler
+// возвращает планировщик напоминаний о сроках заданий, который делает проход раз в interval
+func NewReminderScheduler(notificationStorage storage.INotificationStorage, interval time.Duration) *micro.ReminderScheduler {
+	return micro.NewReminderScheduler(notificationStorage, interval)
+}
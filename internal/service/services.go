@@ -9,7 +9,9 @@ import (
 	comment "server/internal/service/comment"
 	csat "server/internal/service/csat"
 	csrf "server/internal/service/csrf"
+	custom_field "server/internal/service/custom_field"
 	list "server/internal/service/list"
+	notification "server/internal/service/notification"
 	tag "server/internal/service/tag"
 	task "server/internal/service/task"
 	user "server/internal/service/user"
@@ -33,22 +35,26 @@ type Services struct {
 	CSATQuestion  ICSATQuestionService
 	CSATAnswer    ICSATSAnswerService
 	Tag           ITagService
+	CustomField   ICustomFieldService
+	Notification  INotificationService
 }
 
 func NewMicroServices(storages *storage.Storages, config config.SessionConfig, conn *grpc.ClientConn) *Services {
 	return &Services{
 		Auth:          auth.NewMicroAuthService(storages.Auth, config, conn),
-		Board:         board.NewMicroBoardService(storages.Board, storages.Task, storages.User, storages.Comment, storages.Checklist, storages.ChecklistItem, conn),
-		Comment:       comment.NewMicroCommentService(storages.Comment, conn),
-		Checklist:     checklist.NewMicroChecklistService(storages.Checklist, conn),
-		ChecklistItem: checklist_item.NewMicroChecklistItemService(storages.ChecklistItem, conn),
+		Board:         board.NewMicroBoardService(storages.Board, storages.Task, storages.User, storages.Comment, storages.Checklist, storages.ChecklistItem, storages.CustomField, storages.History, conn),
+		Comment:       comment.NewMicroCommentService(storages.Comment, storages.History, conn),
+		Checklist:     checklist.NewMicroChecklistService(storages.Checklist, storages.History, conn),
+		ChecklistItem: checklist_item.NewMicroChecklistItemService(storages.ChecklistItem, storages.History, conn),
 		CSATAnswer:    csat.NewMicroCSATAnswerService(storages.CSATAnswer, conn),
 		CSATQuestion:  csat.NewMicroCSATQuestionService(storages.CSATQuestion, conn),
 		CSRF:          csrf.NewMicroCSRFService(storages.CSRF, config, conn),
-		List:          list.NewMicroListService(storages.List, conn),
-		Task:          task.NewMicroTaskService(storages.Task, storages.User, conn),
+		List:          list.NewMicroListService(storages.List, storages.Board, storages.History, conn),
+		Task:          task.NewMicroTaskService(storages.Task, storages.User, storages.List, storages.Board, storages.CustomField, storages.History, conn),
 		User:          user.NewMicroUserService(storages.User, conn),
 		Workspace:     workspace.NewMicroWorkspaceService(storages.Workspace, conn),
-		Tag:           tag.NewMicroTagService(storages.Tag, conn),
+		Tag:           tag.NewMicroTagService(storages.Tag, storages.History, conn),
+		CustomField:   custom_field.NewMicroCustomFieldService(storages.CustomField, storages.Board, storages.History, conn),
+		Notification:  notification.NewMicroNotificationService(storages.Notification, storages.User, conn),
 	}
 }
@@ -4,18 +4,21 @@ import (
 	"context"
 	"server/internal/pkg/dto"
 	"server/internal/pkg/entities"
+	"server/internal/service/history"
 	"server/internal/storage"
 )
 
 type TagService struct {
 	storage storage.ITagStorage
+	history *history.Recorder
 }
 
 // NewBoardService
 // возвращает BoardService с инициализированным хранилищем
-func NewTagService(ts storage.ITagStorage) *TagService {
+func NewTagService(ts storage.ITagStorage, hs storage.IHistoryStorage) *TagService {
 	return &TagService{
 		storage: ts,
+		history: history.NewRecorder(hs),
 	}
 }
 
@@ -23,33 +26,62 @@ func NewTagService(ts storage.ITagStorage) *TagService {
 // создает новое задание
 // или возвращает ошибки ...
 func (ts TagService) Create(ctx context.Context, info dto.NewTagInfo) (*entities.Tag, error) {
-	return ts.storage.Create(ctx, info)
+	var tag *entities.Tag
+	err := ts.history.Create(ctx, func(ctx context.Context) (dto.HistoryEntityRef, error) {
+		var err error
+		tag, err = ts.storage.Create(ctx, info)
+		if err != nil {
+			return dto.HistoryEntityRef{}, err
+		}
+		return tagRef(tag.ID), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tag, nil
 }
 
 // Update
 // обновляет задание
 // или возвращает ошибки ...
 func (ts TagService) Update(ctx context.Context, info dto.UpdatedTagInfo) error {
-	return ts.storage.Update(ctx, info)
+	return ts.history.Track(ctx, dto.HistoryActionUpdate, func(ctx context.Context) error {
+		return ts.storage.Update(ctx, info)
+	}, tagRef(info.ID))
 }
 
 // Delete
 // удаляет задание
 // или возвращает ошибки ...
 func (ts TagService) Delete(ctx context.Context, id dto.TagID) error {
-	return ts.storage.Delete(ctx, id)
+	return ts.history.Track(ctx, dto.HistoryActionDelete, func(ctx context.Context) error {
+		return ts.storage.Delete(ctx, id)
+	}, tagRef(id.Value))
 }
 
 // AddToTask
 // добавляет тэг к заданию
 // или возвращает ошибки ...
 func (ts TagService) AddToTask(ctx context.Context, ids dto.TagAndTaskIDs) error {
-	return ts.storage.AddToTask(ctx, ids)
+	return ts.history.Related(ctx, dto.HistoryActionAddTag, func(ctx context.Context) error {
+		return ts.storage.AddToTask(ctx, ids)
+	}, &dto.HistoryFieldChange{Field: "id_tag", After: ids.TagID}, taskRef(ids.TaskID))
 }
 
 // RemoveFromTask
 // удаляет тэг из заданию
 // или возвращает ошибки ...
 func (ts TagService) RemoveFromTask(ctx context.Context, ids dto.TagAndTaskIDs) error {
-	return ts.storage.RemoveFromTask(ctx, ids)
+	return ts.history.Related(ctx, dto.HistoryActionRemoveTag, func(ctx context.Context) error {
+		return ts.storage.RemoveFromTask(ctx, ids)
+	}, &dto.HistoryFieldChange{Field: "id_tag", Before: ids.TagID}, taskRef(ids.TaskID))
+}
+
+func tagRef(id uint64) dto.HistoryEntityRef {
+	return dto.HistoryEntityRef{EntityType: dto.HistoryEntityTag, EntityID: id}
+}
+
+func taskRef(id uint64) dto.HistoryEntityRef {
+	return dto.HistoryEntityRef{EntityType: dto.HistoryEntityTask, EntityID: id}
 }
@@ -9,6 +9,6 @@ import (
 )
 
 // TODO: Tag microservice
-func NewMicroTagService(tagStorage storage.ITagStorage, connection *grpc.ClientConn) *micro.TagService {
-	return micro.NewTagService(tagStorage)
+func NewMicroTagService(tagStorage storage.ITagStorage, historyStorage storage.IHistoryStorage, connection *grpc.ClientConn) *micro.TagService {
+	return micro.NewTagService(tagStorage, historyStorage)
 }
@@ -11,25 +11,45 @@ import (
 //go:generate mockgen -source=$GOFILE -destination=../../mocks/mock_service/$GOFILE -package=mock_service
 type ITaskService interface {
 	// Create
-	// создает новое задание
+	// создает новое задание, возвращая предупреждение при превышении мягкого лимита задач в списке
 	// или возвращает ошибки ...
-	Create(context.Context, dto.NewTaskInfo) (*entities.Task, error)
+	Create(context.Context, dto.NewTaskInfo) (*entities.Task, *dto.WipLimitWarning, error)
 	// Read
 	// возвращает заание с привязанными пользователями
 	// или возвращает ошибки ...
 	Read(context.Context, dto.TaskID) (*dto.SingleTaskInfo, error)
 	// Update
-	// обновляет задание
+	// обновляет задание и возвращает его текущее состояние, в том числе при конфликте версий
 	// или возвращает ошибки ...
-	Update(context.Context, dto.UpdatedTaskInfo) error
+	Update(context.Context, dto.UpdatedTaskInfo) (*dto.SingleTaskInfo, error)
 	// Move
-	// переносит задание в другой список
+	// ставит задание в список доски после другого задания, возвращая предупреждение при превышении мягкого лимита задач в нём
 	// или возвращает ошибки ...
-	Move(context.Context, dto.TaskMoveInfo) error
+	Move(context.Context, dto.TaskMoveInfo) (*dto.WipLimitWarning, error)
+	// MoveToBoard
+	// переносит задание в список другой доски, возвращая отчёт о переносе и предупреждение при превышении мягкого лимита задач в списке
+	// или возвращает ошибки ...
+	MoveToBoard(context.Context, dto.TaskTransferInfo) (*dto.TaskTransferReport, *dto.WipLimitWarning, error)
+	// Copy
+	// копирует задание с выбранными частями в список, возвращая отчёт с id копии и предупреждение при превышении мягкого лимита задач в списке
+	// или возвращает ошибки ...
+	Copy(context.Context, dto.TaskCopyInfo) (*dto.TaskTransferReport, *dto.WipLimitWarning, error)
+	// Bulk
+	// применяет операцию к нескольким заданиям одной доски, возвращая предупреждение при превышении мягкого лимита задач в списке
+	// или возвращает ошибки ...
+	Bulk(context.Context, dto.TaskBulkRequest) (*dto.WipLimitWarning, error)
 	// Delete
-	// удаляет задание
+	// удаляет задание, а если выбрано каскадное удаление, то и все его подзадачи
 	// или возвращает ошибки ...
-	Delete(context.Context, dto.TaskID) error
+	Delete(context.Context, dto.TaskCascadeInfo) error
+	// Archive
+	// переносит задание в архив, а если выбрана каскадная архивация, то и все его подзадачи
+	// или возвращает ошибки ...
+	Archive(context.Context, dto.TaskCascadeInfo) error
+	// Unarchive
+	// возвращает задание из архива; при превышении мягкого лимита задач списка возвращает предупреждение
+	// или возвращает ошибки ...
+	Unarchive(context.Context, dto.TaskID) (*dto.WipLimitWarning, error)
 	// AddUser
 	// добавляет пользователя в карточку
 	// или возвращает ошибки ...
@@ -38,6 +58,10 @@ type ITaskService interface {
 	// удаляет пользователя из карточки
 	// или возвращает ошибки ...
 	RemoveUser(context.Context, dto.RemoveTaskUserInfo) error
+	// SetCustomFieldValue
+	// сохраняет значение пользовательского поля у задания
+	// или возвращает ошибки ...
+	SetCustomFieldValue(context.Context, dto.CustomFieldValueInfo) error
 	// GetFileList
 	// добавляет файл в задание
 	// или возвращает ошибки ...
@@ -50,4 +74,72 @@ type ITaskService interface {
 	// добавляет файл в задание
 	// или возвращает ошибки ...
 	Remove(context.Context, dto.RemoveFileInfo) error
+	// SetReminders
+	// заменяет смещения напоминаний о сроке задания
+	// или возвращает ошибки ...
+	SetReminders(context.Context, dto.TaskRemindersInfo) ([]uint64, error)
+	// SetRecurrence
+	// задаёт заданию правило повторения или меняет правило его серии и возвращает правило в каноническом виде
+	// или возвращает ошибки ...
+	SetRecurrence(context.Context, dto.TaskRecurrenceRequest) (string, error)
+	// StopRecurrence
+	// останавливает серию повторений, к которой относится задание
+	// или возвращает ошибки ...
+	StopRecurrence(context.Context, dto.TaskID) error
+	// Link
+	// связывает задания зависимостью: первое блокирует второе
+	// или возвращает ошибки ...
+	Link(context.Context, dto.TaskDependencyInfo) error
+	// Unlink
+	// удаляет зависимость между заданиями
+	// или возвращает ошибки ...
+	Unlink(context.Context, dto.TaskDependencyInfo) error
+	// AttachSubtask
+	// делает задание подзадачей другого задания той же доски
+	// или возвращает ошибки ...
+	AttachSubtask(context.Context, dto.SubtaskInfo) error
+	// DetachSubtask
+	// отвязывает подзадачу от родительского задания
+	// или возвращает ошибки ...
+	DetachSubtask(context.Context, dto.TaskID) error
+	// Watch
+	// подписывает пользователя на изменения задания
+	// или возвращает ошибки ...
+	Watch(context.Context, dto.TaskID) error
+	// Unwatch
+	// отписывает пользователя от изменений задания
+	// или возвращает ошибки ...
+	Unwatch(context.Context, dto.TaskID) error
+	// GetWatchers
+	// возвращает наблюдателей задания
+	// или возвращает ошибки ...
+	GetWatchers(context.Context, dto.TaskID) (*[]dto.UserPublicInfo, error)
+	// StartTimer
+	// запускает таймер пользователя на задании; у пользователя запущен не больше чем один таймер
+	// или возвращает ошибки ...
+	StartTimer(context.Context, dto.TaskID) (*dto.WorklogInfo, error)
+	// StopTimer
+	// останавливает запущенный таймер пользователя
+	// или возвращает ошибки ...
+	StopTimer(context.Context, dto.TimerStopInfo) (*dto.WorklogInfo, error)
+	// GetTimer
+	// возвращает запущенный таймер пользователя или nil
+	// или возвращает ошибки ...
+	GetTimer(context.Context) (*dto.WorklogInfo, error)
+	// AddWorklog
+	// вносит вручную запись учёта времени по заданию
+	// или возвращает ошибки ...
+	AddWorklog(context.Context, dto.NewWorklogInfo) (*dto.WorklogInfo, error)
+	// DeleteWorklog
+	// удаляет запись учёта времени пользователя
+	// или возвращает ошибки ...
+	DeleteWorklog(context.Context, dto.WorklogDeleteInfo) error
+	// GetWorklogs
+	// возвращает записи учёта времени по заданию с суммами
+	// или возвращает ошибки ...
+	GetWorklogs(context.Context, dto.TaskID) (*dto.TaskTimeInfo, error)
+	// GetActivity
+	// возвращает страницу ленты активности задания в хронологическом порядке
+	// или возвращает ошибки ...
+	GetActivity(context.Context, dto.TaskActivityRequest) (*dto.TaskActivity, error)
 }
@@ -0,0 +1,32 @@
+package task
+
+import (
+	"context"
+	"server/internal/pkg/dto"
+)
+
+const (
+	defaultActivityPageSize = 50
+	maxActivityPageSize     = 200
+)
+
+// GetActivity
+// возвращает страницу ленты активности задания в хронологическом порядке: события из истории доски
+// вперемешку с комментариями задания
+// или возвращает ошибки apperrors.ErrNoBoardAccess (403), apperrors.ErrCouldNotGetHistory (500), ...
+func (ts TaskService) GetActivity(ctx context.Context, request dto.TaskActivityRequest) (*dto.TaskActivity, error) {
+	_, boardID, err := ts.checkTaskBoardAccess(ctx, request.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	request.BoardID = boardID
+
+	if request.Limit == 0 {
+		request.Limit = defaultActivityPageSize
+	}
+	if request.Limit > maxActivityPageSize {
+		request.Limit = maxActivityPageSize
+	}
+
+	return ts.historyStorage.ReadTaskActivity(ctx, request)
+}
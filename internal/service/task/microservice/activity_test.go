@@ -0,0 +1,80 @@
+package task
+
+import (
+	"context"
+	"server/internal/pkg/dto"
+	"server/internal/pkg/entities"
+	"server/mocks/mock_storage"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTaskService_GetActivity(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		request   dto.TaskActivityRequest
+		wantLimit uint64
+	}{
+		{
+			name:      "Default page size",
+			request:   dto.TaskActivityRequest{TaskID: 1},
+			wantLimit: defaultActivityPageSize,
+		},
+		{
+			name:      "Requested page size",
+			request:   dto.TaskActivityRequest{TaskID: 1, Limit: 2, Offset: 2},
+			wantLimit: 2,
+		},
+		{
+			name:      "Page size capped",
+			request:   dto.TaskActivityRequest{TaskID: 1, Limit: 1000},
+			wantLimit: maxActivityPageSize,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			taskStorage := mock_storage.NewMockITaskStorage(ctrl)
+			listStorage := mock_storage.NewMockIListStorage(ctrl)
+			boardStorage := mock_storage.NewMockIBoardStorage(ctrl)
+			historyStorage := mock_storage.NewMockIHistoryStorage(ctrl)
+			expectTransaction(historyStorage)
+			taskStorage.EXPECT().ReadMany(gomock.Any(), dto.TaskFilter{TaskIDs: []string{"1"}}).
+				Return(&[]dto.SingleTaskInfo{{ID: 1, ListID: 2}}, nil)
+			listStorage.EXPECT().Read(gomock.Any(), dto.ListID{Value: 2}).
+				Return(&dto.SingleListInfo{ID: 2, BoardID: 3}, nil)
+			boardStorage.EXPECT().CheckAccess(gomock.Any(), dto.CheckBoardAccessInfo{UserID: 5, BoardID: 3}).
+				Return(true, nil)
+			historyStorage.EXPECT().ReadTaskActivity(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, request dto.TaskActivityRequest) (*dto.TaskActivity, error) {
+					require.Equal(t, dto.TaskActivityRequest{TaskID: 1, BoardID: 3, Limit: tt.wantLimit, Offset: tt.request.Offset}, request)
+					return &dto.TaskActivity{TaskID: 1, Items: []dto.TaskActivityItem{{Type: dto.TaskActivityCreated, Timestamp: start}}, Total: 3}, nil
+				})
+
+			ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+			ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+			ctx = context.WithValue(ctx, dto.UserObjKey, &entities.User{ID: 5})
+
+			ts := TaskService{
+				storage:        taskStorage,
+				listStorage:    listStorage,
+				boardStorage:   boardStorage,
+				historyStorage: historyStorage,
+			}
+			activity, err := ts.GetActivity(ctx, tt.request)
+			require.NoError(t, err)
+			require.Equal(t, uint64(3), activity.Total)
+			require.Len(t, activity.Items, 1)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	"server/internal/pkg/dto"
+
+	"github.com/google/uuid"
+)
+
+// Link
+// связывает задания зависимостью: BlockerID блокирует BlockedID и записывает связь в историю обоих заданий.
+// Задания могут лежать на разных досках одного рабочего пространства; пользователь должен иметь доступ к обеим доскам
+// или возвращает ошибки apperrors.ErrSelfDependency (400), apperrors.ErrDependencyAcrossWorkspaces (400),
+// apperrors.ErrNoBoardAccess (403), apperrors.ErrDependencyCycle (409), ...
+func (ts TaskService) Link(ctx context.Context, info dto.TaskDependencyInfo) error {
+	funcName := "TaskService.Link"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	if err := ts.checkDependencyAccess(ctx, info); err != nil {
+		return err
+	}
+	logger.DebugFmt(fmt.Sprintf("User has access to tasks %v and %v", info.BlockerID, info.BlockedID), requestID.String(), funcName, nodeName)
+
+	return ts.history.Related(ctx, dto.HistoryActionLink, func(ctx context.Context) error {
+		return ts.history.Related(ctx, dto.HistoryActionLink, func(ctx context.Context) error {
+			return ts.storage.AddDependency(ctx, info)
+		}, &dto.HistoryFieldChange{Field: "id_blocked", After: info.BlockedID}, taskRef(info.BlockerID))
+	}, &dto.HistoryFieldChange{Field: "id_blocker", After: info.BlockerID}, taskRef(info.BlockedID))
+}
+
+// Unlink
+// удаляет зависимость между заданиями и записывает это в историю обоих заданий
+// или возвращает ошибки apperrors.ErrDependencyNotFound (404), apperrors.ErrNoBoardAccess (403), ...
+func (ts TaskService) Unlink(ctx context.Context, info dto.TaskDependencyInfo) error {
+	if err := ts.checkDependencyAccess(ctx, info); err != nil {
+		return err
+	}
+	return ts.history.Related(ctx, dto.HistoryActionUnlink, func(ctx context.Context) error {
+		return ts.history.Related(ctx, dto.HistoryActionUnlink, func(ctx context.Context) error {
+			return ts.storage.RemoveDependency(ctx, info)
+		}, &dto.HistoryFieldChange{Field: "id_blocked", Before: info.BlockedID}, taskRef(info.BlockerID))
+	}, &dto.HistoryFieldChange{Field: "id_blocker", Before: info.BlockerID}, taskRef(info.BlockedID))
+}
+
+// checkDependencyAccess
+// проверяет, что зависимость связывает разные задания, а пользователь имеет доступ к доскам обоих
+func (ts TaskService) checkDependencyAccess(ctx context.Context, info dto.TaskDependencyInfo) error {
+	if info.BlockerID == info.BlockedID {
+		return apperrors.ErrSelfDependency
+	}
+	for _, id := range []uint64{info.BlockerID, info.BlockedID} {
+		if _, err := ts.checkTaskAccess(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package task
+
+import (
+	"context"
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"server/internal/pkg/entities"
+	"server/internal/service/history"
+	"server/mocks/mock_storage"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTaskService_Link(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		info    dto.TaskDependencyInfo
+		access  map[uint64]bool
+		wantErr error
+	}{
+		{
+			name:   "Tasks on different boards",
+			info:   dto.TaskDependencyInfo{BlockerID: 1, BlockedID: 2},
+			access: map[uint64]bool{1: true, 2: true},
+		},
+		{
+			name:    "No access to blocked task board",
+			info:    dto.TaskDependencyInfo{BlockerID: 1, BlockedID: 2},
+			access:  map[uint64]bool{1: true, 2: false},
+			wantErr: apperrors.ErrNoBoardAccess,
+		},
+		{
+			name:    "Task blocks itself",
+			info:    dto.TaskDependencyInfo{BlockerID: 1, BlockedID: 1},
+			wantErr: apperrors.ErrSelfDependency,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			taskStorage := mock_storage.NewMockITaskStorage(ctrl)
+			listStorage := mock_storage.NewMockIListStorage(ctrl)
+			boardStorage := mock_storage.NewMockIBoardStorage(ctrl)
+			for _, id := range []uint64{tt.info.BlockerID, tt.info.BlockedID} {
+				access, ok := tt.access[id]
+				if !ok {
+					break
+				}
+				// задание id лежит в списке 10+id на доске 20+id
+				taskStorage.EXPECT().ReadMany(gomock.Any(), dto.TaskFilter{TaskIDs: []string{strconv.FormatUint(id, 10)}}).
+					Return(&[]dto.SingleTaskInfo{{ID: id, ListID: 10 + id}}, nil)
+				listStorage.EXPECT().Read(gomock.Any(), dto.ListID{Value: 10 + id}).
+					Return(&dto.SingleListInfo{ID: 10 + id, BoardID: 20 + id}, nil)
+				boardStorage.EXPECT().CheckAccess(gomock.Any(), dto.CheckBoardAccessInfo{UserID: 5, BoardID: 20 + id}).
+					Return(access, nil)
+			}
+			historyStorage := mock_storage.NewMockIHistoryStorage(ctrl)
+			expectTransaction(historyStorage)
+			if tt.wantErr == nil {
+				taskStorage.EXPECT().AddDependency(gomock.Any(), tt.info).Return(nil)
+				for _, link := range []struct {
+					taskID  uint64
+					field   string
+					otherID uint64
+				}{
+					{taskID: tt.info.BlockerID, field: "id_blocked", otherID: tt.info.BlockedID},
+					{taskID: tt.info.BlockedID, field: "id_blocker", otherID: tt.info.BlockerID},
+				} {
+					historyStorage.EXPECT().Snapshot(gomock.Any(), dto.HistoryEntityRef{EntityType: dto.HistoryEntityTask, EntityID: link.taskID}).
+						Return(&dto.EntitySnapshot{BoardID: 20 + link.taskID}, nil)
+					historyStorage.EXPECT().Record(gomock.Any(), dto.NewHistoryEntry{
+						UserID:     5,
+						BoardID:    20 + link.taskID,
+						EntityType: dto.HistoryEntityTask,
+						EntityID:   link.taskID,
+						Action:     dto.HistoryActionLink,
+						Changes:    []dto.HistoryFieldChange{{Field: link.field, After: link.otherID}},
+					}).Return(nil)
+				}
+			}
+
+			ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+			ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+			ctx = context.WithValue(ctx, dto.UserObjKey, &entities.User{ID: 5})
+
+			ts := TaskService{
+				storage:      taskStorage,
+				listStorage:  listStorage,
+				boardStorage: boardStorage,
+				history:      history.NewRecorder(historyStorage),
+			}
+			require.ErrorIs(t, ts.Link(ctx, tt.info), tt.wantErr)
+		})
+	}
+}
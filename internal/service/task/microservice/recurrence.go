@@ -0,0 +1,223 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	"server/internal/pkg/dto"
+	"server/internal/pkg/entities"
+	"server/internal/pkg/recurrence"
+	"server/internal/pkg/schedule"
+	"server/internal/service/history"
+	"server/internal/storage"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// recurrenceField
+// поле задания, под которым смена правила повторения записывается в историю
+const recurrenceField = "recurrence"
+
+// SetRecurrence
+// задаёт заданию правило повторения или меняет правило серии, к которой оно относится. Сроки серии считаются
+// в часовом поясе пользователя; не заданные в правиле день недели или день месяца берутся из срока задания.
+// Смена правила записывается в историю задания
+// или возвращает ошибки apperrors.ErrInvalidRecurrenceRule (400), apperrors.ErrRecurrenceWithoutDueDate (400),
+// apperrors.ErrNoBoardAccess (403), ...
+func (ts TaskService) SetRecurrence(ctx context.Context, request dto.TaskRecurrenceRequest) (string, error) {
+	funcName := "TaskService.SetRecurrence"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	rule, err := recurrence.Parse(request.Rule)
+	if err != nil {
+		return "", err
+	}
+
+	task, err := ts.checkTaskAccess(ctx, request.TaskID)
+	if err != nil {
+		return "", err
+	}
+	logger.DebugFmt("User has access to task board", requestID.String(), funcName, nodeName)
+
+	if task.End == nil {
+		return "", apperrors.ErrRecurrenceWithoutDueDate
+	}
+	loc, err := ts.userLocation(ctx)
+	if err != nil {
+		return "", err
+	}
+	rule = rule.WithDefaults(task.End.In(loc))
+
+	change := dto.HistoryFieldChange{Field: recurrenceField, After: rule.String()}
+	err = ts.history.Related(ctx, dto.HistoryActionSetField, func(ctx context.Context) error {
+		previous, err := ts.storage.SetRecurrence(ctx, dto.TaskRecurrenceInfo{
+			TaskID:   task.ID,
+			ListID:   task.ListID,
+			UserID:   ctx.Value(dto.UserObjKey).(*entities.User).ID,
+			Rule:     rule.String(),
+			Timezone: loc.String(),
+			DueAt:    task.End.UTC(),
+		})
+		if previous != nil {
+			change.Before = *previous
+		}
+		return err
+	}, &change, taskRef(task.ID))
+	if err != nil {
+		return "", err
+	}
+	logger.DebugFmt("Recurrence saved", requestID.String(), funcName, nodeName)
+
+	return rule.String(), nil
+}
+
+// StopRecurrence
+// останавливает серию повторений, к которой относится задание, и записывает остановку в историю; созданные задания серии остаются
+// или возвращает ошибки apperrors.ErrRecurrenceNotFound (404), apperrors.ErrNoBoardAccess (403), ...
+func (ts TaskService) StopRecurrence(ctx context.Context, id dto.TaskID) error {
+	if _, err := ts.checkTaskAccess(ctx, id.Value); err != nil {
+		return err
+	}
+	return stopRecurrence(ctx, ts.storage, ts.history, id.Value)
+}
+
+// stopRecurrence
+// останавливает серию задания taskID и в той же транзакции записывает в историю задания её правило
+func stopRecurrence(ctx context.Context, ts storage.ITaskStorage, recorder *history.Recorder, taskID uint64) error {
+	change := dto.HistoryFieldChange{Field: recurrenceField}
+	return recorder.Related(ctx, dto.HistoryActionSetField, func(ctx context.Context) error {
+		rule, err := ts.StopRecurrence(ctx, dto.TaskID{Value: taskID})
+		change.Before = rule
+		return err
+	}, &change, taskRef(taskID))
+}
+
+// RecurrenceGenerator
+// периодически создает следующие задания серий повторений, текущее задание которых выполнено или срок которого наступил.
+// Созданные задания записываются в историю так же, как созданные пользователем
+type RecurrenceGenerator struct {
+	storage  storage.ITaskStorage
+	history  *history.Recorder
+	interval time.Duration
+	now      func() time.Time
+}
+
+// NewRecurrenceGenerator
+// возвращает RecurrenceGenerator, который делает проход раз в interval
+func NewRecurrenceGenerator(ts storage.ITaskStorage, hs storage.IHistoryStorage, interval time.Duration) *RecurrenceGenerator {
+	return &RecurrenceGenerator{
+		storage:  ts,
+		history:  history.NewRecorder(hs),
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Run
+// делает проходы генератора до отмены ctx; ошибки прохода логируются, и следующий проход делается по расписанию
+func (rg RecurrenceGenerator) Run(ctx context.Context, logger logger.ILogger) {
+	ticker := time.NewTicker(rg.interval)
+	defer ticker.Stop()
+
+	for {
+		rg.Tick(ctx, logger)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick
+// делает один проход генератора и возвращает количество созданных заданий.
+// Следующий срок серии -- первый срок по правилу позже текущего момента, так что после простоя
+// пропущенные сроки не создаются, а серия за один проход догоняет расписание. Серия, у которой сроки по правилу
+// закончились, останавливается с записью в историю. Задание создаётся от имени пользователя, задавшего правило, и в той же транзакции записывается в историю,
+// откуда о нём узнают наблюдатели. Если список серии заполнен до жёсткого лимита, задание не создаётся
+// и серия ждёт следующего прохода
+func (rg RecurrenceGenerator) Tick(ctx context.Context, logger logger.ILogger) int {
+	funcName := "RecurrenceGenerator.Tick"
+	requestID := uuid.New()
+	ctx = context.WithValue(context.WithValue(ctx, dto.LoggerKey, logger), dto.RequestIDKey, requestID)
+
+	recurrences, err := rg.storage.ReadDueRecurrences(ctx)
+	if err != nil {
+		logger.Error("Reading due recurrences failed with error: " + err.Error())
+		return 0
+	}
+
+	now := rg.now()
+	generated := 0
+	for _, due := range *recurrences {
+		rule, err := recurrence.Parse(due.Rule)
+		if err != nil {
+			logger.Error(fmt.Sprintf("Recurrence %d has invalid rule %q", due.ID, due.Rule))
+			continue
+		}
+		loc := schedule.Location(due.Timezone)
+
+		next, ok := rule.Next(due.DueAt, loc)
+		for ok && !next.After(now) {
+			next, ok = rule.Next(next, loc)
+		}
+		userCtx := context.WithValue(ctx, dto.UserObjKey, &entities.User{ID: due.UserID})
+		if !ok {
+			if err = stopRecurrence(userCtx, rg.storage, rg.history, due.TaskID); err != nil {
+				logger.Error(fmt.Sprintf("Stopping finished recurrence %d failed with error: %s", due.ID, err.Error()))
+			}
+			continue
+		}
+
+		var taskID uint64
+		err = rg.history.Create(userCtx, func(ctx context.Context) (dto.HistoryEntityRef, error) {
+			var err error
+			taskID, err = rg.storage.GenerateRecurrence(ctx, dto.RecurrenceInstanceInfo{
+				RecurrenceID: due.ID,
+				TaskID:       due.TaskID,
+				DueAt:        due.DueAt,
+				NextStart:    nextStart(due, next, loc),
+				NextDueAt:    next.UTC(),
+			})
+			return taskRef(taskID), err
+		})
+		if errors.Is(err, apperrors.ErrWipLimitExceeded) {
+			logger.Info(fmt.Sprintf("List of recurrence %d is at its hard wip limit, task postponed", due.ID))
+			continue
+		}
+		if err != nil {
+			logger.Error(fmt.Sprintf("Generating task of recurrence %d failed with error: %s", due.ID, err.Error()))
+			continue
+		}
+		if taskID != 0 {
+			generated++
+		}
+	}
+	logger.DebugFmt(fmt.Sprintf("Generated %d recurring tasks", generated), requestID.String(), funcName, nodeName)
+
+	return generated
+}
+
+// nextStart
+// возвращает начало следующего задания серии со сроком next: длительность текущего задания сохраняется,
+// у задания на весь день -- в днях. Если у текущего задания нет начала, у следующего его тоже нет
+func nextStart(due dto.DueRecurrence, next time.Time, loc *time.Location) *time.Time {
+	if due.TaskStart == nil || due.TaskEnd == nil {
+		return nil
+	}
+	duration := due.TaskEnd.Sub(*due.TaskStart)
+
+	var start time.Time
+	if due.AllDay {
+		days := int(math.Round(duration.Hours() / 24))
+		start = next.In(loc).AddDate(0, 0, -days).UTC()
+	} else {
+		start = next.Add(-duration).UTC()
+	}
+	return &start
+}
@@ -0,0 +1,208 @@
+package task
+
+import (
+	"context"
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"server/internal/pkg/entities"
+	"server/internal/service/history"
+	"server/mocks/mock_storage"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTaskService_SetRecurrence(t *testing.T) {
+	t.Parallel()
+
+	moscow, _ := time.LoadLocation("Europe/Moscow")
+	// среда, 10:00 по Москве
+	end := time.Date(2024, 1, 10, 7, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		request dto.TaskRecurrenceRequest
+		task    *dto.SingleTaskInfo
+		stored  *dto.TaskRecurrenceInfo
+		before  *string
+		want    string
+		wantErr error
+	}{
+		{
+			name:    "Weekday taken from due date",
+			request: dto.TaskRecurrenceRequest{TaskID: 1, Rule: "FREQ=WEEKLY"},
+			task:    &dto.SingleTaskInfo{ID: 1, ListID: 2, End: &end},
+			stored: &dto.TaskRecurrenceInfo{
+				TaskID: 1, ListID: 2, UserID: 5, Rule: "FREQ=WEEKLY;INTERVAL=1;BYDAY=WE", Timezone: moscow.String(), DueAt: end,
+			},
+			want: "FREQ=WEEKLY;INTERVAL=1;BYDAY=WE",
+		},
+		{
+			name:    "Series rule changed",
+			request: dto.TaskRecurrenceRequest{TaskID: 1, Rule: "FREQ=DAILY"},
+			task:    &dto.SingleTaskInfo{ID: 1, ListID: 2, End: &end},
+			stored: &dto.TaskRecurrenceInfo{
+				TaskID: 1, ListID: 2, UserID: 5, Rule: "FREQ=DAILY;INTERVAL=1", Timezone: moscow.String(), DueAt: end,
+			},
+			before: func() *string { rule := "FREQ=WEEKLY;INTERVAL=1;BYDAY=WE"; return &rule }(),
+			want:   "FREQ=DAILY;INTERVAL=1",
+		},
+		{
+			name:    "Task without due date",
+			request: dto.TaskRecurrenceRequest{TaskID: 1, Rule: "FREQ=DAILY"},
+			task:    &dto.SingleTaskInfo{ID: 1, ListID: 2},
+			wantErr: apperrors.ErrRecurrenceWithoutDueDate,
+		},
+		{
+			name:    "Invalid rule",
+			request: dto.TaskRecurrenceRequest{TaskID: 1, Rule: "FREQ=HOURLY"},
+			wantErr: apperrors.ErrInvalidRecurrenceRule,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			taskStorage := mock_storage.NewMockITaskStorage(ctrl)
+			userStorage := mock_storage.NewMockIUserStorage(ctrl)
+			listStorage := mock_storage.NewMockIListStorage(ctrl)
+			boardStorage := mock_storage.NewMockIBoardStorage(ctrl)
+			historyStorage := mock_storage.NewMockIHistoryStorage(ctrl)
+			expectTransaction(historyStorage)
+			if tt.task != nil {
+				taskStorage.EXPECT().ReadMany(gomock.Any(), dto.TaskFilter{TaskIDs: []string{"1"}}).
+					Return(&[]dto.SingleTaskInfo{*tt.task}, nil)
+				listStorage.EXPECT().Read(gomock.Any(), dto.ListID{Value: 2}).
+					Return(&dto.SingleListInfo{ID: 2, BoardID: 3}, nil)
+				boardStorage.EXPECT().CheckAccess(gomock.Any(), dto.CheckBoardAccessInfo{UserID: 5, BoardID: 3}).
+					Return(true, nil)
+			}
+			if tt.stored != nil {
+				userStorage.EXPECT().GetTimezone(gomock.Any(), dto.UserID{Value: 5}).Return("Europe/Moscow", nil)
+				taskStorage.EXPECT().SetRecurrence(gomock.Any(), *tt.stored).Return(tt.before, nil)
+				var before interface{}
+				if tt.before != nil {
+					before = *tt.before
+				}
+				historyStorage.EXPECT().Snapshot(gomock.Any(), taskRef(1)).
+					Return(&dto.EntitySnapshot{BoardID: 3}, nil)
+				historyStorage.EXPECT().Record(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, entry dto.NewHistoryEntry) error {
+						require.Equal(t, dto.HistoryActionSetField, entry.Action)
+						require.Equal(t, []dto.HistoryFieldChange{{Field: "recurrence", Before: before, After: tt.want}}, entry.Changes)
+						return nil
+					})
+			}
+
+			ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+			ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+			ctx = context.WithValue(ctx, dto.UserObjKey, &entities.User{ID: 5})
+
+			ts := TaskService{
+				storage:      taskStorage,
+				userStorage:  userStorage,
+				listStorage:  listStorage,
+				boardStorage: boardStorage,
+				history:      history.NewRecorder(historyStorage),
+			}
+			got, err := ts.SetRecurrence(ctx, tt.request)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRecurrenceGenerator_Tick(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 20, 12, 0, 0, 0, time.UTC)
+	due := time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+	start := due.Add(-2 * time.Hour)
+	generated := &dto.RecurrenceInstanceInfo{
+		RecurrenceID: 7,
+		TaskID:       1,
+		DueAt:        due,
+		NextStart:    func() *time.Time { next := due.AddDate(0, 0, 14).Add(-2 * time.Hour); return &next }(),
+		NextDueAt:    due.AddDate(0, 0, 14),
+	}
+	tests := []struct {
+		name        string
+		due         dto.DueRecurrence
+		generated   *dto.RecurrenceInstanceInfo
+		generateErr error
+		stopped     bool
+		want        int
+	}{
+		{
+			name:      "Missed due dates skipped",
+			due:       dto.DueRecurrence{ID: 7, TaskID: 1, UserID: 5, Rule: "FREQ=WEEKLY;INTERVAL=1;BYDAY=WE", Timezone: "UTC", DueAt: due, TaskStart: &start, TaskEnd: &due},
+			generated: generated,
+			want:      1,
+		},
+		{
+			name:        "List at hard wip limit",
+			due:         dto.DueRecurrence{ID: 7, TaskID: 1, UserID: 5, Rule: "FREQ=WEEKLY;INTERVAL=1;BYDAY=WE", Timezone: "UTC", DueAt: due, TaskStart: &start, TaskEnd: &due},
+			generated:   generated,
+			generateErr: apperrors.ErrWipLimitExceeded,
+			want:        0,
+		},
+		{
+			name:    "Finished series stopped",
+			due:     dto.DueRecurrence{ID: 7, TaskID: 1, UserID: 5, Rule: "FREQ=DAILY;INTERVAL=1;UNTIL=20240115", Timezone: "UTC", DueAt: due},
+			stopped: true,
+			want:    0,
+		},
+		{
+			name: "Invalid rule skipped",
+			due:  dto.DueRecurrence{ID: 7, TaskID: 1, Rule: "FREQ=HOURLY", Timezone: "UTC", DueAt: due},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			taskStorage := mock_storage.NewMockITaskStorage(ctrl)
+			historyStorage := mock_storage.NewMockIHistoryStorage(ctrl)
+			expectTransaction(historyStorage)
+			taskStorage.EXPECT().ReadDueRecurrences(gomock.Any()).Return(&[]dto.DueRecurrence{tt.due}, nil)
+			if tt.generated != nil && tt.generateErr != nil {
+				taskStorage.EXPECT().GenerateRecurrence(gomock.Any(), *tt.generated).Return(uint64(0), tt.generateErr)
+			}
+			if tt.generated != nil && tt.generateErr == nil {
+				taskStorage.EXPECT().GenerateRecurrence(gomock.Any(), *tt.generated).Return(uint64(2), nil)
+				taskID := uint64(2)
+				historyStorage.EXPECT().Snapshot(gomock.Any(), taskRef(2)).
+					Return(&dto.EntitySnapshot{BoardID: 3, TaskID: &taskID, Fields: map[string]interface{}{"name": "chore"}}, nil)
+				historyStorage.EXPECT().Record(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, entry dto.NewHistoryEntry) error {
+						require.Equal(t, tt.due.UserID, entry.UserID)
+						require.Equal(t, dto.HistoryActionCreate, entry.Action)
+						require.Equal(t, uint64(3), entry.BoardID)
+						return nil
+					})
+			}
+			if tt.stopped {
+				taskStorage.EXPECT().StopRecurrence(gomock.Any(), dto.TaskID{Value: tt.due.TaskID}).Return(tt.due.Rule, nil)
+				historyStorage.EXPECT().Snapshot(gomock.Any(), taskRef(tt.due.TaskID)).
+					Return(&dto.EntitySnapshot{BoardID: 3}, nil)
+				historyStorage.EXPECT().Record(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, entry dto.NewHistoryEntry) error {
+						require.Equal(t, tt.due.UserID, entry.UserID)
+						require.Equal(t, []dto.HistoryFieldChange{{Field: "recurrence", Before: tt.due.Rule}}, entry.Changes)
+						return nil
+					})
+			}
+
+			rg := NewRecurrenceGenerator(taskStorage, historyStorage, time.Minute)
+			rg.now = func() time.Time { return now }
+			require.Equal(t, tt.want, rg.Tick(context.Background(), getLogger()))
+		})
+	}
+}
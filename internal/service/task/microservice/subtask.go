@@ -0,0 +1,97 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	"server/internal/pkg/dto"
+	"server/internal/pkg/entities"
+
+	"github.com/google/uuid"
+)
+
+// AttachSubtask
+// делает задание info.TaskID подзадачей задания info.ParentID. Подзадача может лежать в любом списке
+// доски родительского задания; пользователь должен иметь доступ к этой доске
+// или возвращает ошибки apperrors.ErrSubtaskOnOtherBoard (400), apperrors.ErrNoBoardAccess (403),
+// apperrors.ErrSubtaskCycle (409), ...
+func (ts TaskService) AttachSubtask(ctx context.Context, info dto.SubtaskInfo) error {
+	funcName := "TaskService.AttachSubtask"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	if info.ParentID == info.TaskID {
+		return apperrors.ErrSubtaskCycle
+	}
+	task, err := ts.checkTaskAccess(ctx, info.TaskID)
+	if err != nil {
+		return err
+	}
+	if err = ts.checkSubtaskBoard(ctx, info.ParentID, task.ListID); err != nil {
+		return err
+	}
+	logger.DebugFmt(fmt.Sprintf("Task %v can be a subtask of %v", info.TaskID, info.ParentID), requestID.String(), funcName, nodeName)
+
+	return ts.history.Track(ctx, dto.HistoryActionUpdate, func(ctx context.Context) error {
+		return ts.storage.AttachSubtask(ctx, info)
+	}, taskRef(info.TaskID))
+}
+
+// DetachSubtask
+// отвязывает подзадачу от родительского задания
+// или возвращает ошибки apperrors.ErrNotSubtask (404), apperrors.ErrNoBoardAccess (403), ...
+func (ts TaskService) DetachSubtask(ctx context.Context, id dto.TaskID) error {
+	if _, err := ts.checkTaskAccess(ctx, id.Value); err != nil {
+		return err
+	}
+	return ts.history.Track(ctx, dto.HistoryActionUpdate, func(ctx context.Context) error {
+		return ts.storage.DetachSubtask(ctx, id)
+	}, taskRef(id.Value))
+}
+
+// checkSubtaskBoard
+// проверяет, что список listID лежит на доске родительского задания parentID
+func (ts TaskService) checkSubtaskBoard(ctx context.Context, parentID uint64, listID uint64) error {
+	parent, err := ts.readCurrent(ctx, parentID)
+	if err != nil {
+		return err
+	}
+	parentList, err := ts.listStorage.Read(ctx, dto.ListID{Value: parent.ListID})
+	if err != nil {
+		return err
+	}
+	list := parentList
+	if listID != parent.ListID {
+		list, err = ts.listStorage.Read(ctx, dto.ListID{Value: listID})
+		if err != nil {
+			return err
+		}
+	}
+	if list.BoardID != parentList.BoardID {
+		return apperrors.ErrSubtaskOnOtherBoard
+	}
+	return nil
+}
+
+// cascade
+// применяет удаление или архивацию к заданию и всем его подзадачам одной массовой операцией,
+// записывая в историю изменение каждого из них
+func (ts TaskService) cascade(ctx context.Context, id uint64, operation string) error {
+	subtasks, err := ts.storage.ReadSubtasks(ctx, dto.TaskID{Value: id})
+	if err != nil {
+		return err
+	}
+
+	taskIDs := append([]uint64{id}, subtasks...)
+	refs := make([]dto.HistoryEntityRef, 0, len(taskIDs))
+	for _, taskID := range taskIDs {
+		refs = append(refs, taskRef(taskID))
+	}
+	return ts.history.Track(ctx, bulkActions[operation], func(ctx context.Context) error {
+		return ts.storage.Bulk(ctx, dto.TaskBulkInfo{
+			TaskBulkRequest: dto.TaskBulkRequest{TaskIDs: taskIDs, Operation: operation},
+			RequesterID:     ctx.Value(dto.UserObjKey).(*entities.User).ID,
+		})
+	}, refs...)
+}
@@ -0,0 +1,106 @@
+package task
+
+import (
+	"context"
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"server/internal/pkg/entities"
+	"server/internal/service/history"
+	"server/mocks/mock_storage"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTaskService_AttachSubtask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		info        dto.SubtaskInfo
+		parentBoard uint64
+		wantErr     error
+	}{
+		{
+			name:        "Subtask in another list of the board",
+			info:        dto.SubtaskInfo{ParentID: 1, TaskID: 2},
+			parentBoard: 7,
+		},
+		{
+			name:        "Parent on another board",
+			info:        dto.SubtaskInfo{ParentID: 1, TaskID: 2},
+			parentBoard: 8,
+			wantErr:     apperrors.ErrSubtaskOnOtherBoard,
+		},
+		{
+			name:    "Task is its own parent",
+			info:    dto.SubtaskInfo{ParentID: 2, TaskID: 2},
+			wantErr: apperrors.ErrSubtaskCycle,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			taskStorage := mock_storage.NewMockITaskStorage(ctrl)
+			listStorage := mock_storage.NewMockIListStorage(ctrl)
+			boardStorage := mock_storage.NewMockIBoardStorage(ctrl)
+			historyStorage := mock_storage.NewMockIHistoryStorage(ctrl)
+			expectTransaction(historyStorage)
+			if tt.parentBoard != 0 {
+				taskStorage.EXPECT().ReadMany(gomock.Any(), dto.TaskFilter{TaskIDs: []string{"2"}}).
+					Return(&[]dto.SingleTaskInfo{{ID: 2, ListID: 4}}, nil)
+				listStorage.EXPECT().Read(gomock.Any(), dto.ListID{Value: 4}).
+					Return(&dto.SingleListInfo{ID: 4, BoardID: 7}, nil).Times(2)
+				boardStorage.EXPECT().CheckAccess(gomock.Any(), dto.CheckBoardAccessInfo{UserID: 5, BoardID: 7}).
+					Return(true, nil)
+				taskStorage.EXPECT().ReadMany(gomock.Any(), dto.TaskFilter{TaskIDs: []string{"1"}}).
+					Return(&[]dto.SingleTaskInfo{{ID: 1, ListID: 3}}, nil)
+				listStorage.EXPECT().Read(gomock.Any(), dto.ListID{Value: 3}).
+					Return(&dto.SingleListInfo{ID: 3, BoardID: tt.parentBoard}, nil)
+			}
+			if tt.wantErr == nil {
+				historyStorage.EXPECT().Snapshot(gomock.Any(), gomock.Any()).Return(nil, apperrors.ErrHistoryEntityNotFound).Times(2)
+				taskStorage.EXPECT().AttachSubtask(gomock.Any(), tt.info).Return(nil)
+			}
+
+			ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+			ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+			ctx = context.WithValue(ctx, dto.UserObjKey, &entities.User{ID: 5})
+
+			ts := TaskService{
+				storage:      taskStorage,
+				listStorage:  listStorage,
+				boardStorage: boardStorage,
+				history:      history.NewRecorder(historyStorage),
+			}
+			require.ErrorIs(t, ts.AttachSubtask(ctx, tt.info), tt.wantErr)
+		})
+	}
+}
+
+func TestTaskService_DeleteCascade(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	taskStorage := mock_storage.NewMockITaskStorage(ctrl)
+	historyStorage := mock_storage.NewMockIHistoryStorage(ctrl)
+	expectTransaction(historyStorage)
+	taskStorage.EXPECT().ReadSubtasks(gomock.Any(), dto.TaskID{Value: 1}).Return([]uint64{2, 3}, nil)
+	historyStorage.EXPECT().Snapshot(gomock.Any(), gomock.Any()).Return(nil, apperrors.ErrHistoryEntityNotFound).Times(6)
+	taskStorage.EXPECT().Bulk(gomock.Any(), dto.TaskBulkInfo{
+		TaskBulkRequest: dto.TaskBulkRequest{TaskIDs: []uint64{1, 2, 3}, Operation: dto.TaskBulkDelete},
+		RequesterID:     5,
+	}).Return(nil)
+
+	ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+	ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+	ctx = context.WithValue(ctx, dto.UserObjKey, &entities.User{ID: 5})
+
+	ts := TaskService{storage: taskStorage, history: history.NewRecorder(historyStorage)}
+	require.NoError(t, ts.Delete(ctx, dto.TaskCascadeInfo{ID: 1, Cascade: true}))
+}
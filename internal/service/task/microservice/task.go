@@ -3,12 +3,16 @@ package task
 import (
 	"context"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"os"
 	"server/internal/apperrors"
 	logger "server/internal/logging"
+	"server/internal/pkg/customfields"
 	"server/internal/pkg/dto"
 	"server/internal/pkg/entities"
+	"server/internal/pkg/schedule"
+	"server/internal/service/history"
 	"server/internal/storage"
 	"strconv"
 	"strings"
@@ -19,17 +23,44 @@ import (
 
 const nodeName = "service"
 
+// maxBulkTasks
+// наибольшее количество заданий в одной массовой операции
+const maxBulkTasks = 500
+
+// bulkActions
+// действия истории, которыми записываются массовые операции
+var bulkActions = map[string]string{
+	dto.TaskBulkMove:      dto.HistoryActionMove,
+	dto.TaskBulkAssign:    dto.HistoryActionAddUser,
+	dto.TaskBulkUnassign:  dto.HistoryActionRemoveUser,
+	dto.TaskBulkAddTag:    dto.HistoryActionAddTag,
+	dto.TaskBulkRemoveTag: dto.HistoryActionRemoveTag,
+	dto.TaskBulkSetDue:    dto.HistoryActionUpdate,
+	dto.TaskBulkArchive:   dto.HistoryActionArchive,
+	dto.TaskBulkDelete:    dto.HistoryActionDelete,
+}
+
 type TaskService struct {
-	storage     storage.ITaskStorage
-	userStorage storage.IUserStorage
+	storage            storage.ITaskStorage
+	userStorage        storage.IUserStorage
+	listStorage        storage.IListStorage
+	boardStorage       storage.IBoardStorage
+	customFieldStorage storage.ICustomFieldStorage
+	historyStorage     storage.IHistoryStorage
+	history            *history.Recorder
 }
 
-// NewBoardService
-// возвращает BoardService с инициализированным хранилищем
-func NewTaskService(ts storage.ITaskStorage, us storage.IUserStorage) *TaskService {
+// NewTaskService
+// возвращает TaskService с инициализированным хранилищем
+func NewTaskService(ts storage.ITaskStorage, us storage.IUserStorage, ls storage.IListStorage, bs storage.IBoardStorage, cfs storage.ICustomFieldStorage, hs storage.IHistoryStorage) *TaskService {
 	return &TaskService{
-		storage:     ts,
-		userStorage: us,
+		storage:            ts,
+		userStorage:        us,
+		listStorage:        ls,
+		boardStorage:       bs,
+		customFieldStorage: cfs,
+		historyStorage:     hs,
+		history:            history.NewRecorder(hs),
 	}
 }
 
@@ -37,28 +68,175 @@ func NewTaskService(ts storage.ITaskStorage, us storage.IUserStorage) *TaskServi
 // возвращает задание
 // или возвращает ошибки ...
 func (ts TaskService) Read(ctx context.Context, id dto.TaskID) (*dto.SingleTaskInfo, error) {
-	return ts.storage.Read(ctx, id)
+	task, err := ts.storage.Read(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := ts.userLocation(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schedule.Localize(task, loc)
+	return task, nil
 }
 
 // Create
-// создает новое задание
-// или возвращает ошибки ...
-func (ts TaskService) Create(ctx context.Context, info dto.NewTaskInfo) (*entities.Task, error) {
-	return ts.storage.Create(ctx, info)
+// создает новое задание, проверяя лимит задач в списке; подзадача создается в списке доски родительского задания.
+// При превышении мягкого лимита возвращает предупреждение
+// или возвращает ошибки apperrors.ErrSubtaskOnOtherBoard (400), apperrors.ErrWipLimitExceeded (409), ...
+func (ts TaskService) Create(ctx context.Context, info dto.NewTaskInfo) (*entities.Task, *dto.WipLimitWarning, error) {
+	if info.ParentID != nil {
+		if err := ts.checkSubtaskBoard(ctx, *info.ParentID, info.ListID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	warning, err := ts.checkWipLimit(ctx, info.ListID, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var task *entities.Task
+	err = ts.history.Create(ctx, func(ctx context.Context) (dto.HistoryEntityRef, error) {
+		var err error
+		task, err = ts.storage.Create(ctx, info)
+		if err != nil {
+			return dto.HistoryEntityRef{}, err
+		}
+		return taskRef(task.ID), nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return task, warning, nil
 }
 
 // Update
-// обновляет задание
-// или возвращает ошибки ...
-func (ts TaskService) Update(ctx context.Context, info dto.UpdatedTaskInfo) error {
-	return ts.storage.Update(ctx, info)
+// обновляет задание, если клиент видел его последнюю версию, и возвращает его текущее состояние.
+// При конфликте версий текущее состояние возвращается вместе с ошибкой
+// Сроки проверяются и переводятся в UTC, задание возвращается со сроками в часовом поясе пользователя
+// или возвращает ошибки apperrors.ErrRowVersionMissing (400), apperrors.ErrInvalidTaskSchedule (400),
+// apperrors.ErrInvalidTaskPriority (400), apperrors.ErrInvalidTaskEstimate (400), apperrors.ErrRowVersionConflict (409), ...
+func (ts TaskService) Update(ctx context.Context, info dto.UpdatedTaskInfo) (*dto.SingleTaskInfo, error) {
+	if info.Priority == "" {
+		info.Priority = dto.TaskPriorityNone
+	}
+	switch info.Priority {
+	case dto.TaskPriorityNone, dto.TaskPriorityLow, dto.TaskPriorityMedium, dto.TaskPriorityHigh, dto.TaskPriorityUrgent:
+	default:
+		return nil, apperrors.ErrInvalidTaskPriority
+	}
+	if info.Estimate != nil && *info.Estimate < 0 {
+		return nil, apperrors.ErrInvalidTaskEstimate
+	}
+	if info.RowVersion == 0 {
+		return nil, apperrors.ErrRowVersionMissing
+	}
+
+	loc, err := ts.userLocation(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = schedule.Normalize(&info, loc); err != nil {
+		return nil, err
+	}
+
+	err = ts.history.Track(ctx, dto.HistoryActionUpdate, func(ctx context.Context) error {
+		return ts.storage.Update(ctx, info)
+	}, taskRef(info.ID))
+	if err != nil && !errors.Is(err, apperrors.ErrRowVersionConflict) {
+		return nil, err
+	}
+
+	task, readErr := ts.readCurrent(ctx, info.ID)
+	if readErr != nil {
+		return nil, readErr
+	}
+	schedule.Localize(task, loc)
+	return task, err
+}
+
+// userLocation
+// возвращает часовой пояс пользователя из контекста; без пользователя сроки показываются в UTC
+// или возвращает ошибки apperrors.ErrUserNotFound, ...
+func (ts TaskService) userLocation(ctx context.Context) (*time.Location, error) {
+	user, ok := ctx.Value(dto.UserObjKey).(*entities.User)
+	if !ok {
+		return time.UTC, nil
+	}
+	timezone, err := ts.userStorage.GetTimezone(ctx, dto.UserID{Value: user.ID})
+	if err != nil {
+		return nil, err
+	}
+	return schedule.Location(timezone), nil
+}
+
+// readCurrent
+// возвращает текущее состояние задания
+// или возвращает ошибки apperrors.ErrTaskNotFound (404), ...
+func (ts TaskService) readCurrent(ctx context.Context, id uint64) (*dto.SingleTaskInfo, error) {
+	tasks, err := ts.storage.ReadMany(ctx, dto.TaskFilter{TaskIDs: []string{strconv.FormatUint(id, 10)}})
+	if err != nil {
+		return nil, err
+	}
+	if len(*tasks) == 0 {
+		return nil, apperrors.ErrTaskNotFound
+	}
+	return &(*tasks)[0], nil
 }
 
 // Delete
-// удаляет задание
+// удаляет задание; при каскадном удалении вместе с ним в одной транзакции удаляются все его подзадачи,
+// иначе подзадачи остаются без родителя
 // или возвращает ошибки ...
-func (ts TaskService) Delete(ctx context.Context, id dto.TaskID) error {
-	return ts.storage.Delete(ctx, id)
+func (ts TaskService) Delete(ctx context.Context, info dto.TaskCascadeInfo) error {
+	if info.Cascade {
+		return ts.cascade(ctx, info.ID, dto.TaskBulkDelete)
+	}
+	return ts.history.Track(ctx, dto.HistoryActionDelete, func(ctx context.Context) error {
+		return ts.storage.Delete(ctx, dto.TaskID{Value: info.ID})
+	}, taskRef(info.ID))
+}
+
+// Archive
+// переносит задание в архив; при каскадной архивации вместе с ним в архив переносятся все его подзадачи
+// или возвращает ошибки apperrors.ErrTaskNotFound (404), ...
+func (ts TaskService) Archive(ctx context.Context, info dto.TaskCascadeInfo) error {
+	if info.Cascade {
+		return ts.cascade(ctx, info.ID, dto.TaskBulkArchive)
+	}
+	return ts.history.Track(ctx, dto.HistoryActionArchive, func(ctx context.Context) error {
+		return ts.storage.Archive(ctx, dto.TaskID{Value: info.ID})
+	}, taskRef(info.ID))
+}
+
+// Unarchive
+// возвращает задание из архива в его список, проверяя лимит задач в нём и блокирующие задания.
+// При превышении мягкого лимита возвращает предупреждение
+// или возвращает ошибки apperrors.ErrTaskNotFound (404), apperrors.ErrWipLimitExceeded (409), apperrors.ErrTaskBlocked (409), ...
+func (ts TaskService) Unarchive(ctx context.Context, id dto.TaskID) (*dto.WipLimitWarning, error) {
+	current, err := ts.readCurrent(ctx, id.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	var warning *dto.WipLimitWarning
+	if current.DateArchived != nil {
+		warning, err = ts.checkWipLimit(ctx, current.ListID, 1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = ts.history.Track(ctx, dto.HistoryActionUnarchive, func(ctx context.Context) error {
+		return ts.storage.Unarchive(ctx, id)
+	}, taskRef(id.Value))
+	if err != nil {
+		return nil, err
+	}
+
+	return warning, nil
 }
 
 // AddUser
@@ -80,7 +258,9 @@ func (ts TaskService) AddUser(ctx context.Context, info dto.AddTaskUserInfo) err
 	}
 	logger.DebugFmt("user not in task", requestID.String(), funcName, nodeName)
 
-	return ts.storage.AddUser(ctx, info)
+	return ts.history.Related(ctx, dto.HistoryActionAddUser, func(ctx context.Context) error {
+		return ts.storage.AddUser(ctx, info)
+	}, &dto.HistoryFieldChange{Field: "id_user", After: info.UserID}, taskRef(info.TaskID))
 }
 
 // RemoveUser
@@ -102,14 +282,378 @@ func (ts TaskService) RemoveUser(ctx context.Context, info dto.RemoveTaskUserInf
 	}
 	logger.DebugFmt("user not in task", requestID.String(), funcName, nodeName)
 
-	return ts.storage.RemoveUser(ctx, info)
+	return ts.history.Related(ctx, dto.HistoryActionRemoveUser, func(ctx context.Context) error {
+		return ts.storage.RemoveUser(ctx, info)
+	}, &dto.HistoryFieldChange{Field: "id_user", Before: info.UserID}, taskRef(info.TaskID))
 }
 
 // Move
-// переносит задание в другой список
-// или возвращает ошибки ...
-func (ts TaskService) Move(ctx context.Context, taskMoveInfo dto.TaskMoveInfo) error {
-	return ts.storage.Move(ctx, taskMoveInfo)
+// переносит задание в другой список, проверяя лимит задач в нём и блокирующие задания.
+// При превышении мягкого лимита возвращает предупреждение
+// или возвращает ошибки apperrors.ErrWipLimitExceeded (409), apperrors.ErrTaskBlocked (409), ...
+func (ts TaskService) Move(ctx context.Context, info dto.TaskMoveInfo) (*dto.WipLimitWarning, error) {
+	current, err := ts.readCurrent(ctx, info.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	var warning *dto.WipLimitWarning
+	if current.ListID != info.ListID {
+		warning, err = ts.checkWipLimit(ctx, info.ListID, 1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = ts.history.Track(ctx, dto.HistoryActionMove, func(ctx context.Context) error {
+		return ts.storage.Move(ctx, info)
+	}, taskRef(info.TaskID))
+	if err != nil {
+		return nil, err
+	}
+
+	return warning, nil
+}
+
+// MoveToBoard
+// переносит задание в список другой доски; пользователь должен иметь доступ к обеим доскам.
+// Проверяет лимит задач и блокирующие задания для целевого списка и при превышении мягкого лимита возвращает предупреждение.
+// Перенос записывается в историю обеих досок
+// или возвращает ошибки apperrors.ErrNoBoardAccess (403), apperrors.ErrTaskAlreadyOnBoard (400), apperrors.ErrWipLimitExceeded (409),
+// apperrors.ErrTaskBlocked (409), ...
+func (ts TaskService) MoveToBoard(ctx context.Context, info dto.TaskTransferInfo) (*dto.TaskTransferReport, *dto.WipLimitWarning, error) {
+	sourceBoardID, targetBoardID, err := ts.checkTransferAccess(ctx, info.TaskID, info.ListID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if sourceBoardID == targetBoardID {
+		return nil, nil, apperrors.ErrTaskAlreadyOnBoard
+	}
+
+	warning, err := ts.checkWipLimit(ctx, info.ListID, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var report *dto.TaskTransferReport
+	err = ts.history.TrackMove(ctx, func(ctx context.Context) error {
+		var err error
+		report, err = ts.storage.MoveToBoard(ctx, info)
+		return err
+	}, taskRef(info.TaskID))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return report, warning, nil
+}
+
+// Copy
+// копирует задание с выбранными частями в список; пользователь должен иметь доступ к доске задания и к доске списка.
+// Проверяет лимит задач в списке и при превышении мягкого лимита возвращает предупреждение
+// или возвращает ошибки apperrors.ErrNoBoardAccess (403), apperrors.ErrWipLimitExceeded (409), ...
+func (ts TaskService) Copy(ctx context.Context, info dto.TaskCopyInfo) (*dto.TaskTransferReport, *dto.WipLimitWarning, error) {
+	if _, _, err := ts.checkTransferAccess(ctx, info.TaskID, info.ListID); err != nil {
+		return nil, nil, err
+	}
+
+	warning, err := ts.checkWipLimit(ctx, info.ListID, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var report *dto.TaskTransferReport
+	err = ts.history.Create(ctx, func(ctx context.Context) (dto.HistoryEntityRef, error) {
+		var err error
+		report, err = ts.storage.Copy(ctx, info)
+		if err != nil {
+			return dto.HistoryEntityRef{}, err
+		}
+		return taskRef(report.TaskID), nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return report, warning, nil
+}
+
+// checkTransferAccess
+// проверяет, что задание и список существуют, а у пользователя есть доступ к доске задания и к доске списка;
+// возвращает id обеих досок
+func (ts TaskService) checkTransferAccess(ctx context.Context, taskID uint64, listID uint64) (uint64, uint64, error) {
+	current, err := ts.readCurrent(ctx, taskID)
+	if err != nil {
+		return 0, 0, err
+	}
+	source, err := ts.listStorage.Read(ctx, dto.ListID{Value: current.ListID})
+	if err != nil {
+		return 0, 0, err
+	}
+	target, err := ts.listStorage.Read(ctx, dto.ListID{Value: listID})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	userID := ctx.Value(dto.UserObjKey).(*entities.User).ID
+	for _, boardID := range []uint64{source.BoardID, target.BoardID} {
+		userAccess, err := ts.boardStorage.CheckAccess(ctx, dto.CheckBoardAccessInfo{
+			UserID:  userID,
+			BoardID: boardID,
+		})
+		if err != nil {
+			return 0, 0, apperrors.ErrCouldNotGetUser
+		}
+		if !userAccess {
+			return 0, 0, apperrors.ErrNoBoardAccess
+		}
+	}
+	return source.BoardID, target.BoardID, nil
+}
+
+// Bulk
+// проверяет операцию и применяет её к заданиям одной доски, записывая в историю одно изменение.
+// При переносе проверяет лимит задач и блокирующие задания для списка и при превышении мягкого лимита возвращает предупреждение
+// или возвращает ошибки apperrors.ErrInvalidBulkOperation (400), apperrors.ErrInvalidTaskSchedule (400),
+// apperrors.ErrWipLimitExceeded (409), apperrors.ErrTaskBlocked (409), ...
+func (ts TaskService) Bulk(ctx context.Context, request dto.TaskBulkRequest) (*dto.WipLimitWarning, error) {
+	funcName := "TaskService.Bulk"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	link, err := validateBulk(&request)
+	if err != nil {
+		return nil, err
+	}
+	logger.DebugFmt(fmt.Sprintf("Bulk %s of %v tasks is valid", request.Operation, len(request.TaskIDs)), requestID.String(), funcName, nodeName)
+
+	var warning *dto.WipLimitWarning
+	if request.Operation == dto.TaskBulkMove {
+		warning, err = ts.checkBulkMove(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	refs := make([]dto.HistoryEntityRef, 0, len(request.TaskIDs))
+	for _, id := range request.TaskIDs {
+		refs = append(refs, taskRef(id))
+	}
+	err = ts.history.TrackBulk(ctx, bulkActions[request.Operation], func(ctx context.Context) error {
+		return ts.storage.Bulk(ctx, dto.TaskBulkInfo{
+			TaskBulkRequest: request,
+			RequesterID:     ctx.Value(dto.UserObjKey).(*entities.User).ID,
+		})
+	}, link, refs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return warning, nil
+}
+
+// validateBulk
+// проверяет, что операция известна и для неё переданы нужные параметры, и убирает повторы id заданий;
+// для операций над связями возвращает изменение, которое записывается в историю для каждого задания
+func validateBulk(request *dto.TaskBulkRequest) (*dto.HistoryFieldChange, error) {
+	if _, ok := bulkActions[request.Operation]; !ok {
+		return nil, apperrors.ErrInvalidBulkOperation
+	}
+
+	seen := map[uint64]struct{}{}
+	taskIDs := make([]uint64, 0, len(request.TaskIDs))
+	for _, id := range request.TaskIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		taskIDs = append(taskIDs, id)
+	}
+	if len(taskIDs) == 0 || len(taskIDs) > maxBulkTasks {
+		return nil, apperrors.ErrInvalidBulkOperation
+	}
+	request.TaskIDs = taskIDs
+
+	switch request.Operation {
+	case dto.TaskBulkMove:
+		if request.ListID == nil {
+			return nil, apperrors.ErrInvalidBulkOperation
+		}
+	case dto.TaskBulkAssign, dto.TaskBulkUnassign:
+		if request.UserID == nil {
+			return nil, apperrors.ErrInvalidBulkOperation
+		}
+		if request.Operation == dto.TaskBulkAssign {
+			return &dto.HistoryFieldChange{Field: "id_user", After: *request.UserID}, nil
+		}
+		return &dto.HistoryFieldChange{Field: "id_user", Before: *request.UserID}, nil
+	case dto.TaskBulkAddTag, dto.TaskBulkRemoveTag:
+		if request.TagID == nil {
+			return nil, apperrors.ErrInvalidBulkOperation
+		}
+		if request.Operation == dto.TaskBulkAddTag {
+			return &dto.HistoryFieldChange{Field: "id_tag", After: *request.TagID}, nil
+		}
+		return &dto.HistoryFieldChange{Field: "id_tag", Before: *request.TagID}, nil
+	}
+	return nil, nil
+}
+
+// checkBulkMove
+// проверяет лимит задач в списке, в который переносятся задания; задания, уже лежащие в нём, не учитываются
+func (ts TaskService) checkBulkMove(ctx context.Context, request dto.TaskBulkRequest) (*dto.WipLimitWarning, error) {
+	ids := make([]string, 0, len(request.TaskIDs))
+	for _, id := range request.TaskIDs {
+		ids = append(ids, strconv.FormatUint(id, 10))
+	}
+	tasks, err := ts.storage.ReadMany(ctx, dto.TaskFilter{TaskIDs: ids})
+	if err != nil {
+		return nil, err
+	}
+	var adding uint64
+	for _, task := range *tasks {
+		if task.ListID != *request.ListID {
+			adding++
+		}
+	}
+	return ts.checkWipLimit(ctx, *request.ListID, adding)
+}
+
+// checkWipLimit
+// проверяет, можно ли добавить в список ещё adding заданий.
+// Для мягкого лимита возвращает предупреждение, для жёсткого -- ошибку apperrors.ErrWipLimitExceeded.
+// Жёсткий лимит окончательно проверяет хранилище в транзакции, которая добавляет задания в список
+func (ts TaskService) checkWipLimit(ctx context.Context, listID uint64, adding uint64) (*dto.WipLimitWarning, error) {
+	funcName := "TaskService.checkWipLimit"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	if adding == 0 {
+		return nil, nil
+	}
+	wip, err := ts.listStorage.GetWipInfo(ctx, dto.ListID{Value: listID})
+	if err != nil {
+		return nil, err
+	}
+	if wip.WipLimit == nil || wip.TaskCount+adding <= *wip.WipLimit {
+		return nil, nil
+	}
+	logger.DebugFmt(fmt.Sprintf("List %v has %v tasks with limit %v (%s)", listID, wip.TaskCount, *wip.WipLimit, wip.WipMode), requestID.String(), funcName, nodeName)
+
+	if wip.WipMode == dto.WipModeHard {
+		return nil, apperrors.ErrWipLimitExceeded
+	}
+	return &dto.WipLimitWarning{
+		ListID:    listID,
+		WipLimit:  *wip.WipLimit,
+		TaskCount: wip.TaskCount + adding,
+	}, nil
+}
+
+// SetCustomFieldValue
+// проверяет доступ пользователя к доске задания, принадлежность поля этой доске и значение по типу поля
+// и сохраняет его у задания; пустое значение удаляет его
+// или возвращает ошибки apperrors.ErrInvalidCustomFieldValue (400), apperrors.ErrCustomFieldNotInBoard (400),
+// apperrors.ErrNoBoardAccess (403), ...
+func (ts TaskService) SetCustomFieldValue(ctx context.Context, info dto.CustomFieldValueInfo) error {
+	funcName := "TaskService.SetCustomFieldValue"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	_, boardID, err := ts.checkTaskBoardAccess(ctx, info.TaskID)
+	if err != nil {
+		return err
+	}
+	logger.DebugFmt("User has access to task board", requestID.String(), funcName, nodeName)
+
+	field, err := ts.customFieldStorage.Read(ctx, dto.CustomFieldID{Value: info.FieldID})
+	if err != nil {
+		return err
+	}
+	if field.BoardID != boardID {
+		return apperrors.ErrCustomFieldNotInBoard
+	}
+	logger.DebugFmt("Got custom field", requestID.String(), funcName, nodeName)
+
+	info.Value, err = customfields.NormalizeValue(*field, info.Value)
+	if err != nil {
+		return err
+	}
+	logger.DebugFmt("Custom field value is valid", requestID.String(), funcName, nodeName)
+
+	change := dto.HistoryFieldChange{Field: "custom_field:" + strconv.FormatUint(field.ID, 10), After: info.Value}
+	return ts.history.Related(ctx, dto.HistoryActionSetField, func(ctx context.Context) error {
+		previous, err := ts.customFieldStorage.SetValue(ctx, info)
+		change.Before = previous
+		return err
+	}, &change, taskRef(info.TaskID))
+}
+
+// SetReminders
+// проверяет смещения напоминаний и доступ пользователя к доске задания, заменяет напоминания задания
+// и записывает замену в историю; возвращает сохранённые смещения по возрастанию
+// или возвращает ошибки apperrors.ErrInvalidReminderOffsets (400), apperrors.ErrNoBoardAccess (403), ...
+func (ts TaskService) SetReminders(ctx context.Context, info dto.TaskRemindersInfo) ([]uint64, error) {
+	funcName := "TaskService.SetReminders"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	offsets, err := schedule.NormalizeReminders(info.Offsets)
+	if err != nil {
+		return nil, err
+	}
+	info.Offsets = offsets
+
+	if _, err = ts.checkTaskAccess(ctx, info.TaskID); err != nil {
+		return nil, err
+	}
+	logger.DebugFmt("User has access to task board", requestID.String(), funcName, nodeName)
+
+	change := dto.HistoryFieldChange{Field: "reminders", After: offsets}
+	err = ts.history.Related(ctx, dto.HistoryActionSetField, func(ctx context.Context) error {
+		previous, err := ts.storage.SetReminders(ctx, info)
+		change.Before = previous
+		return err
+	}, &change, taskRef(info.TaskID))
+	if err != nil {
+		return nil, err
+	}
+	logger.DebugFmt("Reminders saved", requestID.String(), funcName, nodeName)
+
+	return offsets, nil
+}
+
+// checkTaskAccess
+// проверяет, что пользователь из контекста состоит в доске задания, и возвращает задание
+// или возвращает ошибки apperrors.ErrTaskNotFound (404), apperrors.ErrNoBoardAccess (403), ...
+func (ts TaskService) checkTaskAccess(ctx context.Context, taskID uint64) (*dto.SingleTaskInfo, error) {
+	task, _, err := ts.checkTaskBoardAccess(ctx, taskID)
+	return task, err
+}
+
+// checkTaskBoardAccess
+// проверяет, что пользователь из контекста состоит в доске задания, и возвращает задание и id его доски
+// или возвращает ошибки apperrors.ErrTaskNotFound (404), apperrors.ErrNoBoardAccess (403), ...
+func (ts TaskService) checkTaskBoardAccess(ctx context.Context, taskID uint64) (*dto.SingleTaskInfo, uint64, error) {
+	task, err := ts.readCurrent(ctx, taskID)
+	if err != nil {
+		return nil, 0, err
+	}
+	list, err := ts.listStorage.Read(ctx, dto.ListID{Value: task.ListID})
+	if err != nil {
+		return nil, 0, err
+	}
+	userAccess, err := ts.boardStorage.CheckAccess(ctx, dto.CheckBoardAccessInfo{
+		UserID:  ctx.Value(dto.UserObjKey).(*entities.User).ID,
+		BoardID: list.BoardID,
+	})
+	if err != nil {
+		return nil, 0, apperrors.ErrCouldNotGetUser
+	}
+	if !userAccess {
+		return nil, 0, apperrors.ErrNoBoardAccess
+	}
+	return task, list.BoardID, nil
 }
 
 // GetFileList
@@ -163,7 +707,9 @@ func (ts TaskService) Attach(ctx context.Context, info dto.NewFileInfo) (*dto.At
 		DateCreated:  createdAt,
 	}
 
-	err = ts.storage.AttachFile(ctx, *fileInfo)
+	err = ts.history.Related(ctx, dto.HistoryActionAttachFile, func(ctx context.Context) error {
+		return ts.storage.AttachFile(ctx, *fileInfo)
+	}, &dto.HistoryFieldChange{Field: "file", After: fileInfo.OriginalName}, taskRef(info.TaskID))
 	if err != nil {
 		errDelete := os.Remove(fileLocation)
 		if errDelete != nil {
@@ -177,38 +723,43 @@ func (ts TaskService) Attach(ctx context.Context, info dto.NewFileInfo) (*dto.At
 }
 
 // Remove
-// удаляет файл из задания
+// удаляет файл из задания; с диска файл удаляется после записи изменения и только если на него
+// не ссылаются копии задания. Не удалённый с диска файл уже не привязан к заданию, поэтому это не ошибка
 // или возвращает ошибки ...
 func (ts TaskService) Remove(ctx context.Context, info dto.RemoveFileInfo) error {
 	funcName := "TaskService.RemoveUser"
 	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
 	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
 
-	err := ts.storage.RemoveFile(ctx, info)
-	if err != nil {
-		logger.DebugFmt("Failed to remove file from database with error: "+err.Error(), requestID.String(), funcName, nodeName)
-		return apperrors.ErrTaskNotUpdated
-	}
-
-	err = os.Remove(info.FilePath)
-	if err != nil {
-		logger.DebugFmt("Failed to delete file with error: "+err.Error(), requestID.String(), funcName, nodeName)
-		logger.DebugFmt("Attaching file back to the task", requestID.String(), funcName, nodeName)
-		err = ts.storage.AttachFile(ctx, dto.AttachedFileInfo{
-			TaskID:       info.TaskID,
-			OriginalName: info.OriginalName,
-			FilePath:     info.FilePath,
-			DateCreated:  time.Now(),
-		})
+	var inUse bool
+	err := ts.history.Related(ctx, dto.HistoryActionRemoveFile, func(ctx context.Context) error {
+		err := ts.storage.RemoveFile(ctx, info)
 		if err != nil {
-			return apperrors.ErrFailedToDeleteFile
+			logger.DebugFmt("Failed to remove file from database with error: "+err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrTaskNotUpdated
 		}
-		return apperrors.ErrFailedToDeleteFile
+
+		inUse, err = ts.storage.FileInUse(ctx, info.FilePath)
+		return err
+	}, &dto.HistoryFieldChange{Field: "file", Before: info.OriginalName}, taskRef(info.TaskID))
+	if err != nil {
+		return err
+	}
+	if inUse {
+		logger.DebugFmt("File is still attached to a copy of the task, keeping it on disk", requestID.String(), funcName, nodeName)
+		return nil
 	}
 
+	if err = os.Remove(info.FilePath); err != nil {
+		logger.Error("Failed to delete detached file " + info.FilePath + ": " + err.Error())
+	}
 	return nil
 }
 
+func taskRef(id uint64) dto.HistoryEntityRef {
+	return dto.HistoryEntityRef{EntityType: dto.HistoryEntityTask, EntityID: id}
+}
+
 func hashFromFileInfo(strs ...string) string {
 	hasher := sha256.New()
 	hasher.Write([]byte(strings.Join(strs, "")))
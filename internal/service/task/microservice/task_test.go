@@ -3,16 +3,38 @@ package task
 import (
 	"context"
 	"reflect"
+	"server/internal/apperrors"
+	"server/internal/config"
+	logging "server/internal/logging"
 	"server/internal/pkg/dto"
 	"server/internal/pkg/entities"
+	"server/internal/service/history"
 	"server/internal/storage"
+	"server/mocks/mock_storage"
 	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 )
 
+// expectTransaction
+// выполняет функции, переданные в Transaction хранилища истории, без открытия транзакции
+func expectTransaction(hs *mock_storage.MockIHistoryStorage) {
+	hs.EXPECT().Transaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) }).
+		AnyTimes()
+}
+
 func TestNewTaskService(t *testing.T) {
 	type args struct {
-		ts storage.ITaskStorage
-		us storage.IUserStorage
+		ts  storage.ITaskStorage
+		us  storage.IUserStorage
+		ls  storage.IListStorage
+		bs  storage.IBoardStorage
+		cfs storage.ICustomFieldStorage
+		hs  storage.IHistoryStorage
 	}
 	tests := []struct {
 		name string
@@ -23,7 +45,7 @@ func TestNewTaskService(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewTaskService(tt.args.ts, tt.args.us); !reflect.DeepEqual(got, tt.want) {
+			if got := NewTaskService(tt.args.ts, tt.args.us, tt.args.ls, tt.args.bs, tt.args.cfs, tt.args.hs); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("NewTaskService() = %v, want %v", got, tt.want)
 			}
 		})
@@ -84,7 +106,7 @@ func TestTaskService_Create(t *testing.T) {
 				storage:     tt.fields.taskStorage,
 				userStorage: tt.fields.userStorage,
 			}
-			got, err := ts.Create(tt.args.ctx, tt.args.info)
+			got, _, err := ts.Create(tt.args.ctx, tt.args.info)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Create() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -102,8 +124,8 @@ func TestTaskService_Delete(t *testing.T) {
 		userStorage storage.IUserStorage
 	}
 	type args struct {
-		ctx context.Context
-		id  dto.TaskID
+		ctx  context.Context
+		info dto.TaskCascadeInfo
 	}
 	tests := []struct {
 		name    string
@@ -119,7 +141,7 @@ func TestTaskService_Delete(t *testing.T) {
 				storage:     tt.fields.taskStorage,
 				userStorage: tt.fields.userStorage,
 			}
-			if err := ts.Delete(tt.args.ctx, tt.args.id); (err != nil) != tt.wantErr {
+			if err := ts.Delete(tt.args.ctx, tt.args.info); (err != nil) != tt.wantErr {
 				t.Errorf("Delete() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -215,9 +237,617 @@ func TestTaskService_Update(t *testing.T) {
 				storage:     tt.fields.taskStorage,
 				userStorage: tt.fields.userStorage,
 			}
-			if err := ts.Update(tt.args.ctx, tt.args.info); (err != nil) != tt.wantErr {
+			if _, err := ts.Update(tt.args.ctx, tt.args.info); (err != nil) != tt.wantErr {
 				t.Errorf("Update() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
+
+func TestTaskService_UpdateRowVersion(t *testing.T) {
+	t.Parallel()
+
+	current := dto.SingleTaskInfo{ID: 1, ListID: 2, Name: "new", RowVersion: 4}
+	tests := []struct {
+		name      string
+		info      dto.UpdatedTaskInfo
+		updateErr error
+		want      *dto.SingleTaskInfo
+		wantErr   error
+	}{
+		{
+			name:    "Updated",
+			info:    dto.UpdatedTaskInfo{ID: 1, Name: "new", Priority: dto.TaskPriorityHigh, RowVersion: 3},
+			want:    &current,
+			wantErr: nil,
+		},
+		{
+			name:      "Stale version",
+			info:      dto.UpdatedTaskInfo{ID: 1, Name: "old", Priority: dto.TaskPriorityNone, RowVersion: 2},
+			updateErr: apperrors.ErrRowVersionConflict,
+			want:      &current,
+			wantErr:   apperrors.ErrRowVersionConflict,
+		},
+		{
+			name:    "No version",
+			info:    dto.UpdatedTaskInfo{ID: 1, Name: "new"},
+			wantErr: apperrors.ErrRowVersionMissing,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			taskStorage := mock_storage.NewMockITaskStorage(ctrl)
+			historyStorage := mock_storage.NewMockIHistoryStorage(ctrl)
+			expectTransaction(historyStorage)
+			if tt.info.RowVersion != 0 {
+				historyStorage.EXPECT().Snapshot(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrHistoryEntityNotFound).AnyTimes()
+				taskStorage.EXPECT().Update(gomock.Any(), tt.info).Return(tt.updateErr)
+				taskStorage.EXPECT().ReadMany(gomock.Any(), dto.TaskFilter{TaskIDs: []string{"1"}}).
+					Return(&[]dto.SingleTaskInfo{current}, nil)
+			}
+
+			ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+			ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+
+			ts := TaskService{storage: taskStorage, history: history.NewRecorder(historyStorage)}
+			got, err := ts.Update(ctx, tt.info)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTaskService_UpdateSchedule(t *testing.T) {
+	t.Parallel()
+
+	moscow, _ := time.LoadLocation("Europe/Moscow")
+	start := time.Date(2024, 3, 1, 10, 0, 0, 0, moscow)
+	end := time.Date(2024, 3, 1, 12, 0, 0, 0, moscow)
+	storedStart := start.UTC()
+	storedEnd := end.UTC()
+	tests := []struct {
+		name    string
+		info    dto.UpdatedTaskInfo
+		stored  *dto.UpdatedTaskInfo
+		wantErr error
+	}{
+		{
+			name:   "Dates stored in UTC",
+			info:   dto.UpdatedTaskInfo{ID: 1, Start: &start, End: &end, RowVersion: 3},
+			stored: &dto.UpdatedTaskInfo{ID: 1, Start: &storedStart, End: &storedEnd, Priority: dto.TaskPriorityNone, RowVersion: 3},
+		},
+		{
+			name:    "Start after end",
+			info:    dto.UpdatedTaskInfo{ID: 1, Start: &end, End: &start, RowVersion: 3},
+			wantErr: apperrors.ErrInvalidTaskSchedule,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			taskStorage := mock_storage.NewMockITaskStorage(ctrl)
+			userStorage := mock_storage.NewMockIUserStorage(ctrl)
+			historyStorage := mock_storage.NewMockIHistoryStorage(ctrl)
+			expectTransaction(historyStorage)
+			userStorage.EXPECT().GetTimezone(gomock.Any(), dto.UserID{Value: 5}).Return("Europe/Moscow", nil)
+			if tt.stored != nil {
+				historyStorage.EXPECT().Snapshot(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrHistoryEntityNotFound).AnyTimes()
+				taskStorage.EXPECT().Update(gomock.Any(), *tt.stored).Return(nil)
+				taskStorage.EXPECT().ReadMany(gomock.Any(), dto.TaskFilter{TaskIDs: []string{"1"}}).
+					Return(&[]dto.SingleTaskInfo{{ID: 1, Start: &storedStart, End: &storedEnd}}, nil)
+			}
+
+			ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+			ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+			ctx = context.WithValue(ctx, dto.UserObjKey, &entities.User{ID: 5})
+
+			ts := TaskService{storage: taskStorage, userStorage: userStorage, history: history.NewRecorder(historyStorage)}
+			got, err := ts.Update(ctx, tt.info)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			require.Equal(t, moscow, got.Start.Location())
+			require.True(t, got.Start.Equal(start))
+			require.True(t, got.End.Equal(end))
+		})
+	}
+}
+
+func TestTaskService_UpdatePriority(t *testing.T) {
+	t.Parallel()
+
+	estimate := 2.5
+	negative := -1.0
+	tests := []struct {
+		name    string
+		info    dto.UpdatedTaskInfo
+		stored  *dto.UpdatedTaskInfo
+		wantErr error
+	}{
+		{
+			name:   "Priority and estimate stored",
+			info:   dto.UpdatedTaskInfo{ID: 1, Priority: dto.TaskPriorityUrgent, Estimate: &estimate, RowVersion: 3},
+			stored: &dto.UpdatedTaskInfo{ID: 1, Priority: dto.TaskPriorityUrgent, Estimate: &estimate, RowVersion: 3},
+		},
+		{
+			name:   "No priority",
+			info:   dto.UpdatedTaskInfo{ID: 1, RowVersion: 3},
+			stored: &dto.UpdatedTaskInfo{ID: 1, Priority: dto.TaskPriorityNone, RowVersion: 3},
+		},
+		{
+			name:    "Unknown priority",
+			info:    dto.UpdatedTaskInfo{ID: 1, Priority: "critical", RowVersion: 3},
+			wantErr: apperrors.ErrInvalidTaskPriority,
+		},
+		{
+			name:    "Negative estimate",
+			info:    dto.UpdatedTaskInfo{ID: 1, Estimate: &negative, RowVersion: 3},
+			wantErr: apperrors.ErrInvalidTaskEstimate,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			taskStorage := mock_storage.NewMockITaskStorage(ctrl)
+			historyStorage := mock_storage.NewMockIHistoryStorage(ctrl)
+			expectTransaction(historyStorage)
+			if tt.stored != nil {
+				historyStorage.EXPECT().Snapshot(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrHistoryEntityNotFound).AnyTimes()
+				taskStorage.EXPECT().Update(gomock.Any(), *tt.stored).Return(nil)
+				taskStorage.EXPECT().ReadMany(gomock.Any(), dto.TaskFilter{TaskIDs: []string{"1"}}).
+					Return(&[]dto.SingleTaskInfo{{ID: 1, Priority: tt.stored.Priority, Estimate: tt.stored.Estimate}}, nil)
+			}
+
+			ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+			ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+
+			ts := TaskService{storage: taskStorage, history: history.NewRecorder(historyStorage)}
+			_, err := ts.Update(ctx, tt.info)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestTaskService_SetReminders(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		info    dto.TaskRemindersInfo
+		access  bool
+		stored  *dto.TaskRemindersInfo
+		before  []uint64
+		want    []uint64
+		wantErr error
+	}{
+		{
+			name:   "Offsets deduplicated and sorted",
+			info:   dto.TaskRemindersInfo{TaskID: 1, Offsets: []uint64{60, 15, 60}},
+			access: true,
+			stored: &dto.TaskRemindersInfo{TaskID: 1, Offsets: []uint64{15, 60}},
+			before: []uint64{30},
+			want:   []uint64{15, 60},
+		},
+		{
+			name:    "Zero offset",
+			info:    dto.TaskRemindersInfo{TaskID: 1, Offsets: []uint64{0}},
+			wantErr: apperrors.ErrInvalidReminderOffsets,
+		},
+		{
+			name:    "No board access",
+			info:    dto.TaskRemindersInfo{TaskID: 1, Offsets: []uint64{30}},
+			access:  false,
+			wantErr: apperrors.ErrNoBoardAccess,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			taskStorage := mock_storage.NewMockITaskStorage(ctrl)
+			listStorage := mock_storage.NewMockIListStorage(ctrl)
+			boardStorage := mock_storage.NewMockIBoardStorage(ctrl)
+			historyStorage := mock_storage.NewMockIHistoryStorage(ctrl)
+			expectTransaction(historyStorage)
+			if tt.wantErr != apperrors.ErrInvalidReminderOffsets {
+				taskStorage.EXPECT().ReadMany(gomock.Any(), dto.TaskFilter{TaskIDs: []string{"1"}}).
+					Return(&[]dto.SingleTaskInfo{{ID: 1, ListID: 2}}, nil)
+				listStorage.EXPECT().Read(gomock.Any(), dto.ListID{Value: 2}).
+					Return(&dto.SingleListInfo{ID: 2, BoardID: 3}, nil)
+				boardStorage.EXPECT().CheckAccess(gomock.Any(), dto.CheckBoardAccessInfo{UserID: 5, BoardID: 3}).
+					Return(tt.access, nil)
+			}
+			if tt.stored != nil {
+				taskStorage.EXPECT().SetReminders(gomock.Any(), *tt.stored).Return(tt.before, nil)
+				historyStorage.EXPECT().Snapshot(gomock.Any(), dto.HistoryEntityRef{EntityType: dto.HistoryEntityTask, EntityID: 1}).
+					Return(&dto.EntitySnapshot{BoardID: 3}, nil)
+				historyStorage.EXPECT().Record(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, entry dto.NewHistoryEntry) error {
+						require.Equal(t, dto.HistoryActionSetField, entry.Action)
+						require.Equal(t, []dto.HistoryFieldChange{{Field: "reminders", Before: tt.before, After: tt.want}}, entry.Changes)
+						return nil
+					})
+			}
+
+			ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+			ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+			ctx = context.WithValue(ctx, dto.UserObjKey, &entities.User{ID: 5})
+
+			ts := TaskService{
+				storage:      taskStorage,
+				listStorage:  listStorage,
+				boardStorage: boardStorage,
+				history:      history.NewRecorder(historyStorage),
+			}
+			got, err := ts.SetReminders(ctx, tt.info)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTaskService_SetCustomFieldValue(t *testing.T) {
+	t.Parallel()
+
+	info := dto.CustomFieldValueInfo{TaskID: 1, FieldID: 7, Value: "ok"}
+	tests := []struct {
+		name       string
+		access     bool
+		fieldBoard uint64
+		wantErr    error
+	}{
+		{
+			name:       "Happy path",
+			access:     true,
+			fieldBoard: 3,
+		},
+		{
+			name:    "No board access",
+			access:  false,
+			wantErr: apperrors.ErrNoBoardAccess,
+		},
+		{
+			name:       "Field from another board",
+			access:     true,
+			fieldBoard: 4,
+			wantErr:    apperrors.ErrCustomFieldNotInBoard,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			taskStorage := mock_storage.NewMockITaskStorage(ctrl)
+			listStorage := mock_storage.NewMockIListStorage(ctrl)
+			boardStorage := mock_storage.NewMockIBoardStorage(ctrl)
+			customFieldStorage := mock_storage.NewMockICustomFieldStorage(ctrl)
+			historyStorage := mock_storage.NewMockIHistoryStorage(ctrl)
+			expectTransaction(historyStorage)
+
+			taskStorage.EXPECT().ReadMany(gomock.Any(), dto.TaskFilter{TaskIDs: []string{"1"}}).
+				Return(&[]dto.SingleTaskInfo{{ID: 1, ListID: 2}}, nil)
+			listStorage.EXPECT().Read(gomock.Any(), dto.ListID{Value: 2}).
+				Return(&dto.SingleListInfo{ID: 2, BoardID: 3}, nil)
+			boardStorage.EXPECT().CheckAccess(gomock.Any(), dto.CheckBoardAccessInfo{UserID: 5, BoardID: 3}).
+				Return(tt.access, nil)
+			if tt.access {
+				customFieldStorage.EXPECT().Read(gomock.Any(), dto.CustomFieldID{Value: 7}).
+					Return(&dto.CustomFieldInfo{ID: 7, BoardID: tt.fieldBoard, Type: dto.CustomFieldText}, nil)
+			}
+			if tt.wantErr == nil {
+				customFieldStorage.EXPECT().SetValue(gomock.Any(), info).Return(nil, nil)
+				historyStorage.EXPECT().Snapshot(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrHistoryEntityNotFound)
+			}
+
+			ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+			ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+			ctx = context.WithValue(ctx, dto.UserObjKey, &entities.User{ID: 5})
+
+			ts := TaskService{
+				storage:            taskStorage,
+				listStorage:        listStorage,
+				boardStorage:       boardStorage,
+				customFieldStorage: customFieldStorage,
+				history:            history.NewRecorder(historyStorage),
+			}
+			err := ts.SetCustomFieldValue(ctx, info)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestTaskService_checkWipLimit(t *testing.T) {
+	t.Parallel()
+
+	limit := uint64(2)
+	tests := []struct {
+		name    string
+		wip     *dto.ListWipInfo
+		want    *dto.WipLimitWarning
+		wantErr error
+	}{
+		{
+			name: "No limit",
+			wip:  &dto.ListWipInfo{ListID: 1, WipMode: dto.WipModeHard, TaskCount: 10},
+		},
+		{
+			name: "Under limit",
+			wip:  &dto.ListWipInfo{ListID: 1, WipLimit: &limit, WipMode: dto.WipModeHard, TaskCount: 1},
+		},
+		{
+			name: "Soft limit reached",
+			wip:  &dto.ListWipInfo{ListID: 1, WipLimit: &limit, WipMode: dto.WipModeSoft, TaskCount: 2},
+			want: &dto.WipLimitWarning{ListID: 1, WipLimit: 2, TaskCount: 3},
+		},
+		{
+			name:    "Hard limit reached",
+			wip:     &dto.ListWipInfo{ListID: 1, WipLimit: &limit, WipMode: dto.WipModeHard, TaskCount: 2},
+			wantErr: apperrors.ErrWipLimitExceeded,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ls := mock_storage.NewMockIListStorage(ctrl)
+			ls.EXPECT().GetWipInfo(gomock.Any(), dto.ListID{Value: 1}).Return(tt.wip, nil)
+
+			ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+			ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+
+			ts := TaskService{listStorage: ls}
+			got, err := ts.checkWipLimit(ctx, 1, 1)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTaskService_Unarchive(t *testing.T) {
+	t.Parallel()
+
+	limit := uint64(2)
+	archived := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		wip     *dto.ListWipInfo
+		want    *dto.WipLimitWarning
+		wantErr error
+	}{
+		{
+			name: "Under limit",
+			wip:  &dto.ListWipInfo{ListID: 3, WipLimit: &limit, WipMode: dto.WipModeSoft, TaskCount: 1},
+		},
+		{
+			name: "Soft limit reached",
+			wip:  &dto.ListWipInfo{ListID: 3, WipLimit: &limit, WipMode: dto.WipModeSoft, TaskCount: 2},
+			want: &dto.WipLimitWarning{ListID: 3, WipLimit: 2, TaskCount: 3},
+		},
+		{
+			name:    "Hard limit reached",
+			wip:     &dto.ListWipInfo{ListID: 3, WipLimit: &limit, WipMode: dto.WipModeHard, TaskCount: 2},
+			wantErr: apperrors.ErrWipLimitExceeded,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			taskStorage := mock_storage.NewMockITaskStorage(ctrl)
+			listStorage := mock_storage.NewMockIListStorage(ctrl)
+			historyStorage := mock_storage.NewMockIHistoryStorage(ctrl)
+			expectTransaction(historyStorage)
+			taskStorage.EXPECT().ReadMany(gomock.Any(), dto.TaskFilter{TaskIDs: []string{"1"}}).
+				Return(&[]dto.SingleTaskInfo{{ID: 1, ListID: 3, DateArchived: &archived}}, nil)
+			listStorage.EXPECT().GetWipInfo(gomock.Any(), dto.ListID{Value: 3}).Return(tt.wip, nil)
+			if tt.wantErr == nil {
+				historyStorage.EXPECT().Snapshot(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrHistoryEntityNotFound).AnyTimes()
+				taskStorage.EXPECT().Unarchive(gomock.Any(), dto.TaskID{Value: 1}).Return(nil)
+			}
+
+			ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+			ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+
+			ts := TaskService{storage: taskStorage, listStorage: listStorage, history: history.NewRecorder(historyStorage)}
+			got, err := ts.Unarchive(ctx, dto.TaskID{Value: 1})
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTaskService_MoveToBoard(t *testing.T) {
+	t.Parallel()
+
+	info := dto.TaskTransferInfo{TaskID: 1, ListID: 6}
+	report := &dto.TaskTransferReport{TaskID: 1, ListID: 6, BoardID: 4}
+	tests := []struct {
+		name        string
+		targetBoard uint64
+		access      map[uint64]bool
+		want        *dto.TaskTransferReport
+		wantErr     error
+	}{
+		{
+			name:        "Happy path",
+			targetBoard: 4,
+			access:      map[uint64]bool{3: true, 4: true},
+			want:        report,
+		},
+		{
+			name:        "Same board",
+			targetBoard: 3,
+			access:      map[uint64]bool{3: true},
+			wantErr:     apperrors.ErrTaskAlreadyOnBoard,
+		},
+		{
+			name:        "No access to target board",
+			targetBoard: 4,
+			access:      map[uint64]bool{3: true, 4: false},
+			wantErr:     apperrors.ErrNoBoardAccess,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			taskStorage := mock_storage.NewMockITaskStorage(ctrl)
+			listStorage := mock_storage.NewMockIListStorage(ctrl)
+			boardStorage := mock_storage.NewMockIBoardStorage(ctrl)
+			historyStorage := mock_storage.NewMockIHistoryStorage(ctrl)
+			expectTransaction(historyStorage)
+
+			taskStorage.EXPECT().ReadMany(gomock.Any(), dto.TaskFilter{TaskIDs: []string{"1"}}).
+				Return(&[]dto.SingleTaskInfo{{ID: 1, ListID: 2}}, nil)
+			listStorage.EXPECT().Read(gomock.Any(), dto.ListID{Value: 2}).Return(&dto.SingleListInfo{ID: 2, BoardID: 3}, nil)
+			listStorage.EXPECT().Read(gomock.Any(), dto.ListID{Value: 6}).Return(&dto.SingleListInfo{ID: 6, BoardID: tt.targetBoard}, nil)
+			for _, boardID := range []uint64{3, tt.targetBoard} {
+				access, ok := tt.access[boardID]
+				if !ok {
+					break
+				}
+				boardStorage.EXPECT().CheckAccess(gomock.Any(), dto.CheckBoardAccessInfo{UserID: 1, BoardID: boardID}).Return(access, nil)
+				if !access {
+					break
+				}
+			}
+			if tt.want != nil {
+				listStorage.EXPECT().GetWipInfo(gomock.Any(), dto.ListID{Value: 6}).Return(&dto.ListWipInfo{ListID: 6}, nil)
+				historyStorage.EXPECT().Snapshot(gomock.Any(), gomock.Any()).
+					Return(nil, apperrors.ErrHistoryEntityNotFound).AnyTimes()
+				taskStorage.EXPECT().MoveToBoard(gomock.Any(), info).Return(tt.want, nil)
+			}
+
+			ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+			ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+			ctx = context.WithValue(ctx, dto.UserObjKey, &entities.User{ID: 1})
+
+			ts := TaskService{
+				storage:      taskStorage,
+				listStorage:  listStorage,
+				boardStorage: boardStorage,
+				history:      history.NewRecorder(historyStorage),
+			}
+			got, warning, err := ts.MoveToBoard(ctx, info)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+			require.Nil(t, warning)
+		})
+	}
+}
+
+func TestTaskService_Bulk(t *testing.T) {
+	t.Parallel()
+
+	userID := uint64(5)
+	listID := uint64(3)
+	tests := []struct {
+		name    string
+		request dto.TaskBulkRequest
+		applied []uint64
+		changes []dto.HistoryFieldChange
+		wantErr error
+	}{
+		{
+			name:    "Assign deduplicated tasks",
+			request: dto.TaskBulkRequest{TaskIDs: []uint64{1, 2, 1}, Operation: dto.TaskBulkAssign, UserID: &userID},
+			applied: []uint64{1, 2},
+			changes: []dto.HistoryFieldChange{
+				{EntityID: 1, Field: "id_user", After: userID},
+				{EntityID: 2, Field: "id_user", After: userID},
+			},
+		},
+		{
+			name:    "Unknown operation",
+			request: dto.TaskBulkRequest{TaskIDs: []uint64{1}, Operation: "rename"},
+			wantErr: apperrors.ErrInvalidBulkOperation,
+		},
+		{
+			name:    "No tasks",
+			request: dto.TaskBulkRequest{Operation: dto.TaskBulkArchive},
+			wantErr: apperrors.ErrInvalidBulkOperation,
+		},
+		{
+			name:    "Move without list",
+			request: dto.TaskBulkRequest{TaskIDs: []uint64{1}, Operation: dto.TaskBulkMove},
+			wantErr: apperrors.ErrInvalidBulkOperation,
+		},
+		{
+			name:    "Tag operation without tag",
+			request: dto.TaskBulkRequest{TaskIDs: []uint64{1}, Operation: dto.TaskBulkAddTag, ListID: &listID},
+			wantErr: apperrors.ErrInvalidBulkOperation,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			taskStorage := mock_storage.NewMockITaskStorage(ctrl)
+			historyStorage := mock_storage.NewMockIHistoryStorage(ctrl)
+			expectTransaction(historyStorage)
+			if tt.wantErr == nil {
+				historyStorage.EXPECT().Snapshot(gomock.Any(), gomock.Any()).
+					Return(&dto.EntitySnapshot{BoardID: 7}, nil).Times(len(tt.applied))
+				applied := tt.request
+				applied.TaskIDs = tt.applied
+				taskStorage.EXPECT().Bulk(gomock.Any(), dto.TaskBulkInfo{TaskBulkRequest: applied, RequesterID: 1}).Return(nil)
+				historyStorage.EXPECT().Record(gomock.Any(), dto.NewHistoryEntry{
+					UserID:     1,
+					BoardID:    7,
+					EntityType: dto.HistoryEntityTask,
+					Action:     dto.HistoryActionAddUser,
+					Changes:    tt.changes,
+				}).Return(nil)
+			}
+
+			ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+			ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+			ctx = context.WithValue(ctx, dto.UserObjKey, &entities.User{ID: 1})
+
+			ts := TaskService{storage: taskStorage, history: history.NewRecorder(historyStorage)}
+			warning, err := ts.Bulk(ctx, tt.request)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Nil(t, warning)
+		})
+	}
+}
+
+func getLogger() logging.ILogger {
+	logger, _ := logging.NewLogrusLogger(&config.LoggingConfig{
+		Level:                  "info",
+		DisableTimestamp:       false,
+		FullTimestamp:          true,
+		LevelBasedReport:       true,
+		DisableLevelTruncation: true,
+		ReportCaller:           true,
+	})
+	return &logger
+}
@@ -0,0 +1,43 @@
+package task
+
+import (
+	"context"
+	"server/internal/pkg/dto"
+	"server/internal/pkg/entities"
+)
+
+// Watch
+// подписывает пользователя на изменения задания
+// или возвращает ошибки apperrors.ErrNoBoardAccess (403), ...
+func (ts TaskService) Watch(ctx context.Context, id dto.TaskID) error {
+	if _, err := ts.checkTaskAccess(ctx, id.Value); err != nil {
+		return err
+	}
+	return ts.storage.WatchTask(ctx, dto.TaskWatcherInfo{
+		TaskID: id.Value,
+		UserID: ctx.Value(dto.UserObjKey).(*entities.User).ID,
+	})
+}
+
+// Unwatch
+// отписывает пользователя от изменений задания; исполнитель остаётся исполнителем
+// или возвращает ошибки apperrors.ErrNotWatching (404), apperrors.ErrNoBoardAccess (403), ...
+func (ts TaskService) Unwatch(ctx context.Context, id dto.TaskID) error {
+	if _, err := ts.checkTaskAccess(ctx, id.Value); err != nil {
+		return err
+	}
+	return ts.storage.UnwatchTask(ctx, dto.TaskWatcherInfo{
+		TaskID: id.Value,
+		UserID: ctx.Value(dto.UserObjKey).(*entities.User).ID,
+	})
+}
+
+// GetWatchers
+// возвращает наблюдателей задания
+// или возвращает ошибки apperrors.ErrNoBoardAccess (403), ...
+func (ts TaskService) GetWatchers(ctx context.Context, id dto.TaskID) (*[]dto.UserPublicInfo, error) {
+	if _, err := ts.checkTaskAccess(ctx, id.Value); err != nil {
+		return nil, err
+	}
+	return ts.storage.ReadTaskWatchers(ctx, id)
+}
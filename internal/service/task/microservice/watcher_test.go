@@ -0,0 +1,61 @@
+package task
+
+import (
+	"context"
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"server/internal/pkg/entities"
+	"server/mocks/mock_storage"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTaskService_Watch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		access  bool
+		wantErr error
+	}{
+		{
+			name:   "Watcher subscribed",
+			access: true,
+		},
+		{
+			name:    "No board access",
+			access:  false,
+			wantErr: apperrors.ErrNoBoardAccess,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			taskStorage := mock_storage.NewMockITaskStorage(ctrl)
+			listStorage := mock_storage.NewMockIListStorage(ctrl)
+			boardStorage := mock_storage.NewMockIBoardStorage(ctrl)
+			taskStorage.EXPECT().ReadMany(gomock.Any(), dto.TaskFilter{TaskIDs: []string{"1"}}).
+				Return(&[]dto.SingleTaskInfo{{ID: 1, ListID: 2}}, nil)
+			listStorage.EXPECT().Read(gomock.Any(), dto.ListID{Value: 2}).
+				Return(&dto.SingleListInfo{ID: 2, BoardID: 3}, nil)
+			boardStorage.EXPECT().CheckAccess(gomock.Any(), dto.CheckBoardAccessInfo{UserID: 5, BoardID: 3}).
+				Return(tt.access, nil)
+			if tt.wantErr == nil {
+				taskStorage.EXPECT().WatchTask(gomock.Any(), dto.TaskWatcherInfo{TaskID: 1, UserID: 5}).Return(nil)
+			}
+
+			ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+			ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+			ctx = context.WithValue(ctx, dto.UserObjKey, &entities.User{ID: 5})
+
+			ts := TaskService{storage: taskStorage, listStorage: listStorage, boardStorage: boardStorage}
+			require.ErrorIs(t, ts.Watch(ctx, dto.TaskID{Value: 1}), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package task
+
+import (
+	"context"
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"server/internal/pkg/entities"
+	"sort"
+	"time"
+)
+
+// maxWorklogDuration
+// наибольшая длительность записи учёта времени, вносимой вручную
+const maxWorklogDuration = 24 * time.Hour
+
+// StartTimer
+// запускает таймер пользователя на задании; запущенный ранее таймер останавливается
+// или возвращает ошибки apperrors.ErrNoBoardAccess (403), apperrors.ErrWorklogNotUpdated (500), ...
+func (ts TaskService) StartTimer(ctx context.Context, id dto.TaskID) (*dto.WorklogInfo, error) {
+	if _, err := ts.checkTaskAccess(ctx, id.Value); err != nil {
+		return nil, err
+	}
+	return ts.storage.StartTimer(ctx, dto.TimerInfo{
+		TaskID: id.Value,
+		UserID: ctx.Value(dto.UserObjKey).(*entities.User).ID,
+	})
+}
+
+// StopTimer
+// останавливает запущенный таймер пользователя
+// или возвращает ошибки apperrors.ErrTimerNotRunning (404), ...
+func (ts TaskService) StopTimer(ctx context.Context, info dto.TimerStopInfo) (*dto.WorklogInfo, error) {
+	info.UserID = ctx.Value(dto.UserObjKey).(*entities.User).ID
+	return ts.storage.StopTimer(ctx, info)
+}
+
+// GetTimer
+// возвращает запущенный таймер пользователя или nil
+// или возвращает ошибки apperrors.ErrCouldNotGetWorklogs (500), ...
+func (ts TaskService) GetTimer(ctx context.Context) (*dto.WorklogInfo, error) {
+	return ts.storage.ReadTimer(ctx, dto.UserID{Value: ctx.Value(dto.UserObjKey).(*entities.User).ID})
+}
+
+// AddWorklog
+// вносит вручную запись учёта времени по заданию; без начала запись заканчивается в момент внесения
+// или возвращает ошибки apperrors.ErrInvalidWorklog (400), apperrors.ErrNoBoardAccess (403), ...
+func (ts TaskService) AddWorklog(ctx context.Context, info dto.NewWorklogInfo) (*dto.WorklogInfo, error) {
+	duration := time.Duration(info.DurationSeconds) * time.Second
+	if duration < time.Second || duration > maxWorklogDuration {
+		return nil, apperrors.ErrInvalidWorklog
+	}
+
+	now := time.Now()
+	if info.Start == nil {
+		start := now.Add(-duration)
+		info.Start = &start
+	} else if info.Start.Add(duration).After(now) {
+		return nil, apperrors.ErrInvalidWorklog
+	}
+
+	if _, err := ts.checkTaskAccess(ctx, info.TaskID); err != nil {
+		return nil, err
+	}
+	info.UserID = ctx.Value(dto.UserObjKey).(*entities.User).ID
+
+	return ts.storage.AddWorklog(ctx, info)
+}
+
+// DeleteWorklog
+// удаляет запись учёта времени; удалить можно только свою запись
+// или возвращает ошибки apperrors.ErrWorklogNotFound (404), ...
+func (ts TaskService) DeleteWorklog(ctx context.Context, info dto.WorklogDeleteInfo) error {
+	info.UserID = ctx.Value(dto.UserObjKey).(*entities.User).ID
+	return ts.storage.DeleteWorklog(ctx, info)
+}
+
+// GetWorklogs
+// возвращает записи учёта времени по заданию и суммы по завершённым записям -- всего и по пользователям
+// или возвращает ошибки apperrors.ErrNoBoardAccess (403), apperrors.ErrCouldNotGetWorklogs (500), ...
+func (ts TaskService) GetWorklogs(ctx context.Context, id dto.TaskID) (*dto.TaskTimeInfo, error) {
+	if _, err := ts.checkTaskAccess(ctx, id.Value); err != nil {
+		return nil, err
+	}
+
+	entries, err := ts.storage.ReadWorklogs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	info := dto.TaskTimeInfo{TaskID: id.Value, Entries: *entries, Users: []dto.UserTimeTotal{}}
+	byUser := map[uint64]uint64{}
+	for _, entry := range *entries {
+		if entry.End == nil {
+			continue
+		}
+		info.TotalSeconds += entry.DurationSeconds
+		byUser[entry.UserID] += entry.DurationSeconds
+	}
+	info.Users = userTimeTotals(byUser)
+
+	return &info, nil
+}
+
+// userTimeTotals
+// раскладывает суммы времени по пользователям в порядке id пользователей
+func userTimeTotals(byUser map[uint64]uint64) []dto.UserTimeTotal {
+	totals := make([]dto.UserTimeTotal, 0, len(byUser))
+	for userID, seconds := range byUser {
+		totals = append(totals, dto.UserTimeTotal{UserID: userID, Seconds: seconds})
+	}
+	sort.Slice(totals, func(i, j int) bool { return totals[i].UserID < totals[j].UserID })
+	return totals
+}
@@ -0,0 +1,131 @@
+package task
+
+import (
+	"context"
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"server/internal/pkg/entities"
+	"server/mocks/mock_storage"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTaskService_AddWorklog(t *testing.T) {
+	t.Parallel()
+
+	past := time.Now().Add(-48 * time.Hour)
+	soon := time.Now().Add(-10 * time.Minute)
+	tests := []struct {
+		name    string
+		info    dto.NewWorklogInfo
+		access  bool
+		wantErr error
+	}{
+		{
+			name:   "Entry with start",
+			info:   dto.NewWorklogInfo{TaskID: 1, Start: &past, DurationSeconds: 3600},
+			access: true,
+		},
+		{
+			name:   "Entry ending now",
+			info:   dto.NewWorklogInfo{TaskID: 1, DurationSeconds: 3600},
+			access: true,
+		},
+		{
+			name:    "Zero duration",
+			info:    dto.NewWorklogInfo{TaskID: 1, DurationSeconds: 0},
+			wantErr: apperrors.ErrInvalidWorklog,
+		},
+		{
+			name:    "Longer than a day",
+			info:    dto.NewWorklogInfo{TaskID: 1, Start: &past, DurationSeconds: 25 * 3600},
+			wantErr: apperrors.ErrInvalidWorklog,
+		},
+		{
+			name:    "Ends in the future",
+			info:    dto.NewWorklogInfo{TaskID: 1, Start: &soon, DurationSeconds: 3600},
+			wantErr: apperrors.ErrInvalidWorklog,
+		},
+		{
+			name:    "No board access",
+			info:    dto.NewWorklogInfo{TaskID: 1, Start: &past, DurationSeconds: 3600},
+			access:  false,
+			wantErr: apperrors.ErrNoBoardAccess,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			taskStorage := mock_storage.NewMockITaskStorage(ctrl)
+			listStorage := mock_storage.NewMockIListStorage(ctrl)
+			boardStorage := mock_storage.NewMockIBoardStorage(ctrl)
+			if tt.wantErr != apperrors.ErrInvalidWorklog {
+				taskStorage.EXPECT().ReadMany(gomock.Any(), dto.TaskFilter{TaskIDs: []string{"1"}}).
+					Return(&[]dto.SingleTaskInfo{{ID: 1, ListID: 2}}, nil)
+				listStorage.EXPECT().Read(gomock.Any(), dto.ListID{Value: 2}).
+					Return(&dto.SingleListInfo{ID: 2, BoardID: 3}, nil)
+				boardStorage.EXPECT().CheckAccess(gomock.Any(), dto.CheckBoardAccessInfo{UserID: 5, BoardID: 3}).
+					Return(tt.access, nil)
+			}
+			if tt.wantErr == nil {
+				taskStorage.EXPECT().AddWorklog(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, info dto.NewWorklogInfo) (*dto.WorklogInfo, error) {
+						require.Equal(t, uint64(5), info.UserID)
+						require.NotNil(t, info.Start)
+						require.False(t, info.Start.Add(time.Hour).After(time.Now()))
+						return &dto.WorklogInfo{ID: 7, TaskID: 1, UserID: 5, Start: *info.Start}, nil
+					})
+			}
+
+			ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+			ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+			ctx = context.WithValue(ctx, dto.UserObjKey, &entities.User{ID: 5})
+
+			ts := TaskService{storage: taskStorage, listStorage: listStorage, boardStorage: boardStorage}
+			_, err := ts.AddWorklog(ctx, tt.info)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestTaskService_GetWorklogs(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	taskStorage := mock_storage.NewMockITaskStorage(ctrl)
+	listStorage := mock_storage.NewMockIListStorage(ctrl)
+	boardStorage := mock_storage.NewMockIBoardStorage(ctrl)
+	taskStorage.EXPECT().ReadMany(gomock.Any(), dto.TaskFilter{TaskIDs: []string{"1"}}).
+		Return(&[]dto.SingleTaskInfo{{ID: 1, ListID: 2}}, nil)
+	listStorage.EXPECT().Read(gomock.Any(), dto.ListID{Value: 2}).
+		Return(&dto.SingleListInfo{ID: 2, BoardID: 3}, nil)
+	boardStorage.EXPECT().CheckAccess(gomock.Any(), dto.CheckBoardAccessInfo{UserID: 5, BoardID: 3}).
+		Return(true, nil)
+
+	end := time.Now()
+	entries := []dto.WorklogInfo{
+		{ID: 1, TaskID: 1, UserID: 6, End: &end, DurationSeconds: 600},
+		{ID: 2, TaskID: 1, UserID: 5, End: &end, DurationSeconds: 3600},
+		{ID: 3, TaskID: 1, UserID: 6, End: &end, DurationSeconds: 1200},
+		{ID: 4, TaskID: 1, UserID: 5, DurationSeconds: 300},
+	}
+	taskStorage.EXPECT().ReadWorklogs(gomock.Any(), dto.TaskID{Value: 1}).Return(&entries, nil)
+
+	ctx := context.WithValue(context.Background(), dto.LoggerKey, getLogger())
+	ctx = context.WithValue(ctx, dto.RequestIDKey, uuid.New())
+	ctx = context.WithValue(ctx, dto.UserObjKey, &entities.User{ID: 5})
+
+	ts := TaskService{storage: taskStorage, listStorage: listStorage, boardStorage: boardStorage}
+	info, err := ts.GetWorklogs(ctx, dto.TaskID{Value: 1})
+	require.NoError(t, err)
+	require.Equal(t, entries, info.Entries)
+	require.Equal(t, uint64(5400), info.TotalSeconds)
+	require.Equal(t, []dto.UserTimeTotal{{UserID: 5, Seconds: 3600}, {UserID: 6, Seconds: 1800}}, info.Users)
+}
@@ -2,6 +2,7 @@ package task
 
 import (
 	"server/internal/storage"
+	"time"
 
 	micro "server/internal/service/task/microservice"
 
@@ -9,6 +10,12 @@ import (
 )
 
 // TODO: Task microservice
-func NewMicroTaskService(taskStorage storage.ITaskStorage, userStorage storage.IUserStorage, connection *grpc.ClientConn) *micro.TaskService {
-	return micro.NewTaskService(taskStorage, userStorage)
+func NewMicroTaskService(taskStorage storage.ITaskStorage, userStorage storage.IUserStorage, listStorage storage.IListStorage, boardStorage storage.IBoardStorage, customFieldStorage storage.ICustomFieldStorage, historyStorage storage.IHistoryStorage, connection *grpc.ClientConn) *micro.TaskService {
+	return micro.NewTaskService(taskStorage, userStorage, listStorage, boardStorage, customFieldStorage, historyStorage)
+}
+
+// NewRecurrenceGenerator
+// возвращает генератор заданий серий повторений, который делает проход раз в interval
+func NewRecurrenceGenerator(taskStorage storage.ITaskStorage, historyStorage storage.IHistoryStorage, interval time.Duration) *micro.RecurrenceGenerator {
+	return micro.NewRecurrenceGenerator(taskStorage, historyStorage, interval)
 }
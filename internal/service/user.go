@@ -30,6 +30,14 @@ type IUserService interface {
 	// обновляет профиль пользователя
 	// или возвращает ошибки ...
 	UpdateProfile(context.Context, dto.UserProfileInfo) error
+	// UpdateTimezone
+	// обновляет часовой пояс, в котором пользователю показываются сроки заданий
+	// или возвращает ошибки apperrors.ErrInvalidTimezone (400), ...
+	UpdateTimezone(context.Context, dto.UserTimezoneInfo) error
+	// UpdateReminders
+	// обновляет смещения напоминаний о сроках заданий пользователя по умолчанию
+	// или возвращает ошибки apperrors.ErrInvalidReminderOffsets (400), ...
+	UpdateReminders(context.Context, dto.UserRemindersInfo) ([]uint64, error)
 	// UpdateAvatar
 	// обновляет аватарку пользователя
 	// или возвращает ошибки ...
@@ -6,6 +6,8 @@ import (
 	"server/internal/apperrors"
 	"server/internal/pkg/dto"
 	"server/internal/pkg/entities"
+	"server/internal/pkg/images"
+	"server/internal/pkg/schedule"
 	"server/internal/storage"
 	microservice "server/microservices/user/user"
 
@@ -33,6 +35,8 @@ var UserServiceErrors = map[microservice.ErrorCode]error{
 	microservice.ErrorCode_FAILED_TO_CREATE_FILE: apperrors.ErrFailedToCreateFile,
 	microservice.ErrorCode_FAILED_TO_SAVE_FILE:   apperrors.ErrFailedToSaveFile,
 	microservice.ErrorCode_FAILED_TO_DELETE_FILE: apperrors.ErrFailedToDeleteFile,
+	microservice.ErrorCode_INVALID_IMAGE:         apperrors.ErrInvalidImage,
+	microservice.ErrorCode_IMAGE_TOO_LARGE:       apperrors.ErrImageTooLarge,
 }
 
 const nodeName = "service"
@@ -207,14 +211,48 @@ func (us UserService) UpdateProfile(ctx context.Context, info dto.UserProfileInf
 	return UserServiceErrors[serverResponse.Code]
 }
 
-// UpdateProfile
-// обновляет аватарку пользователя
-// или возвращает ошибку apperrors.ErrUserNotFound (409)
+// UpdateTimezone
+// проверяет название часового пояса и сохраняет его как часовой пояс пользователя
+// или возвращает ошибки apperrors.ErrInvalidTimezone (400), apperrors.ErrUserNotUpdated (500)
+func (us UserService) UpdateTimezone(ctx context.Context, info dto.UserTimezoneInfo) error {
+	timezone, err := schedule.ValidateTimezone(info.Timezone)
+	if err != nil {
+		return err
+	}
+	info.Timezone = timezone
+	return us.storage.UpdateTimezone(ctx, info)
+}
+
+// UpdateReminders
+// проверяет смещения напоминаний и сохраняет их как смещения пользователя по умолчанию;
+// возвращает сохранённые смещения по возрастанию
+// или возвращает ошибки apperrors.ErrInvalidReminderOffsets (400), apperrors.ErrUserNotUpdated (500)
+func (us UserService) UpdateReminders(ctx context.Context, info dto.UserRemindersInfo) ([]uint64, error) {
+	offsets, err := schedule.NormalizeReminders(info.Offsets)
+	if err != nil {
+		return nil, err
+	}
+	info.Offsets = offsets
+	if err = us.storage.UpdateReminders(ctx, info); err != nil {
+		return nil, err
+	}
+	return offsets, nil
+}
+
+// UpdateAvatar
+// проверяет загруженное изображение и обновляет аватарку пользователя
+// или возвращает ошибки apperrors.ErrInvalidImage (400), apperrors.ErrImageTooLarge (413), apperrors.ErrUserNotFound (409)
 func (us UserService) UpdateAvatar(ctx context.Context, info dto.AvatarChangeInfo) (*dto.UrlObj, error) {
 	funcName := "UserService.UpdateAvatar"
 	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
 	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
 
+	_, format, err := images.Decode(info.Avatar)
+	if err != nil {
+		return &dto.UrlObj{}, err
+	}
+	logger.DebugFmt("Avatar validated, format "+format, requestID.String(), funcName, nodeName)
+
 	grpcRequest := &microservice.UpdateAvatarRequest{
 		RequestID: requestID.String(),
 		Value: &microservice.AvatarChangeInfo{
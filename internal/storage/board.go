@@ -26,12 +26,20 @@ type IBoardStorage interface {
 	// находит списки в доске
 	// или возвращает ошибки ...
 	GetLists(context.Context, dto.BoardID) (*[]dto.SingleListInfo, error)
+	// GetArchivedLists
+	// находит архивные списки в доске
+	// или возвращает ошибки ...
+	GetArchivedLists(context.Context, dto.BoardID) (*[]dto.SingleListInfo, error)
+	// GetArchivedTaskIDs
+	// находит id заданий доски, скрытых архивом
+	// или возвращает ошибки ...
+	GetArchivedTaskIDs(context.Context, dto.BoardID) ([]string, error)
 	// GetTags
 	// находит тэги в доске
 	// или возвращает ошибки ...
 	GetTags(context.Context, dto.BoardID) (*[]dto.TagInfo, error)
 	// UpdateData
-	// обновляет доску
+	// обновляет доску, если её версия не изменилась
 	UpdateData(context.Context, dto.UpdatedBoardInfo) error
 	// Update
 	// обновляет картинку доски
@@ -48,10 +56,24 @@ type IBoardStorage interface {
 	// RemoveUser
 	// удаляет пользователя с доски
 	RemoveUser(context.Context, dto.RemoveBoardUserInfo) error
-	// GetHistory
-	// возвращает историю изменения доски
-	GetHistory(context.Context, dto.BoardID) (*[]dto.BoardHistoryEntry, error)
-	// SubmitEdit
-	// записывает изменение доски в историю
-	SubmitEdit(context.Context, dto.NewHistoryEntry) error
+	// GetStats
+	// считает статистику доски
+	// или возвращает ошибки ...
+	GetStats(context.Context, dto.BoardStatsRequest) (*dto.BoardStats, error)
+	// WatchBoard
+	// подписывает пользователя на изменения доски
+	// или возвращает ошибки ...
+	WatchBoard(context.Context, dto.BoardWatcherInfo) error
+	// UnwatchBoard
+	// отписывает пользователя от изменений доски
+	// или возвращает ошибки ...
+	UnwatchBoard(context.Context, dto.BoardWatcherInfo) error
+	// ReadBoardWatchers
+	// возвращает наблюдателей доски
+	// или возвращает ошибки ...
+	ReadBoardWatchers(context.Context, dto.BoardID) (*[]dto.UserPublicInfo, error)
+	// ReadTimeReport
+	// возвращает время по заданиям доски за период по дням, пользователям и заданиям
+	// или возвращает ошибки ...
+	ReadTimeReport(context.Context, dto.TimeReportRequest) (*[]dto.TimeReportRow, error)
 }
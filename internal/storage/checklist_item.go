@@ -26,7 +26,7 @@ type IChecklistItemStorage interface {
 	// или возвращает ошибки ...
 	Delete(context.Context, dto.ChecklistItemID) error
 	// UpdateOrder
-	// изменяет порядок вещей в чеклистах по id
+	// ставит элемент сразу после другого элемента того же чеклиста, меняя ключ порядка только у него
 	// или возвращает ошибки ...
-	UpdateOrder(context.Context, dto.ChecklistItemIDs) error
+	UpdateOrder(context.Context, dto.ChecklistItemMoveInfo) error
 }
@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"context"
+	"server/internal/pkg/dto"
+)
+
+// Интерфейс для хранилища пользовательских полей
+//
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/mock_storage/$GOFILE -package=mock_storage
+type ICustomFieldStorage interface {
+	// Create
+	// создает новое пользовательское поле доски
+	// или возвращает ошибки ...
+	Create(context.Context, dto.NewCustomFieldInfo) (*dto.CustomFieldInfo, error)
+	// Read
+	// возвращает пользовательское поле по id
+	// или возвращает ошибки ...
+	Read(context.Context, dto.CustomFieldID) (*dto.CustomFieldInfo, error)
+	// ReadMany
+	// возвращает пользовательские поля доски
+	// или возвращает ошибки ...
+	ReadMany(context.Context, dto.BoardID) (*[]dto.CustomFieldInfo, error)
+	// Update
+	// обновляет пользовательское поле и удаляет значения, ссылающиеся на удалённые варианты выбора
+	// или возвращает ошибки ...
+	Update(context.Context, dto.UpdatedCustomFieldInfo) error
+	// Delete
+	// удаляет пользовательское поле вместе с его значениями
+	// или возвращает ошибки ...
+	Delete(context.Context, dto.CustomFieldID) error
+	// SetValue
+	// сохраняет значение поля у задания (пустое значение удаляет его) и возвращает предыдущее значение
+	// или возвращает ошибки ...
+	SetValue(context.Context, dto.CustomFieldValueInfo) (interface{}, error)
+	// ReadValues
+	// возвращает значения пользовательских полей у всех заданий доски
+	// или возвращает ошибки ...
+	ReadValues(context.Context, dto.BoardID) (*[]dto.CustomFieldValueInfo, error)
+	// FilterTasks
+	// возвращает id заданий, значения полей которых подходят под все условия
+	// или возвращает ошибки ...
+	FilterTasks(context.Context, dto.CustomFieldTaskFilter) ([]string, error)
+}
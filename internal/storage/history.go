@@ -0,0 +1,45 @@
+package storage
+
+import (
+	"context"
+	"server/internal/pkg/dto"
+)
+
+// Интерфейс для хранилища истории изменений
+//
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/mock_storage/$GOFILE -package=mock_storage
+type IHistoryStorage interface {
+	// Transaction
+	// выполняет fn в одной транзакции: запросы хранилищ, получивших контекст fn, выполняются в ней,
+	// а ошибка fn откатывает их все
+	// или возвращает ошибки ...
+	Transaction(context.Context, func(context.Context) error) error
+	// Snapshot
+	// возвращает текущее состояние сущности и ID её доски; в транзакции строка сущности блокируется до её конца
+	// или возвращает ошибки ...
+	Snapshot(context.Context, dto.HistoryEntityRef) (*dto.EntitySnapshot, error)
+	// Record
+	// записывает изменение в историю доски
+	// или возвращает ошибки ...
+	Record(context.Context, dto.NewHistoryEntry) error
+	// Read
+	// возвращает запись из истории изменений по её ID
+	// или возвращает ошибки ...
+	Read(context.Context, dto.HistoryEntryID) (*dto.BoardHistoryEntry, error)
+	// ReadMany
+	// возвращает историю изменений доски с учётом фильтров и пагинации
+	// или возвращает ошибки ...
+	ReadMany(context.Context, dto.BoardHistoryRequest) (*[]dto.BoardHistoryEntry, error)
+	// ReadTaskActivity
+	// возвращает страницу ленты активности задания: события из истории и комментарии в хронологическом порядке
+	// или возвращает ошибки ...
+	ReadTaskActivity(context.Context, dto.TaskActivityRequest) (*dto.TaskActivity, error)
+	// ReadChanges
+	// возвращает последнее изменение каждой сущности доски, записанное после указанной версии
+	// или возвращает ошибки ...
+	ReadChanges(context.Context, dto.BoardChangesRequest) (*[]dto.BoardChange, error)
+	// Revert
+	// в одной транзакции применяет изменение, обратное записи в истории, и записывает его в историю
+	// или возвращает ошибки ...
+	Revert(context.Context, dto.HistoryRevertInfo) error
+}
@@ -18,16 +18,48 @@ type IListStorage interface {
 	// GetWithID новый список задач в БД по данным
 	// или возвращает ошибки ...
 	GetTasksWithID(context.Context, dto.ListIDs) (*[]dto.SingleTaskInfo, error)
+	// Read
+	// возвращает список задач по id вместе с количеством заданий в нём
+	// или возвращает ошибки ...
+	Read(context.Context, dto.ListID) (*dto.SingleListInfo, error)
 	// Update
-	// обновляет списсок задач в БД
+	// обновляет списсок задач в БД, если его версия не изменилась
 	// или возвращает ошибки ...
 	Update(context.Context, dto.UpdatedListInfo) error
 	// Delete
 	// удаляет списсок задач в БД
 	// или возвращает ошибки ...
 	Delete(context.Context, dto.ListID) error
+	// Archive
+	// переносит список в архив
+	// или возвращает ошибки ...
+	Archive(context.Context, dto.ListID) error
+	// Unarchive
+	// возвращает список из архива
+	// или возвращает ошибки ...
+	Unarchive(context.Context, dto.ListID) error
+	// ArchiveTasks
+	// переносит в архив все задания списка
+	// или возвращает ошибки ...
+	ArchiveTasks(context.Context, dto.ListID) error
 	// UpdateOrder
-	// изменяет порядок списков по id
+	// ставит список сразу после другого списка той же доски, меняя ключ порядка только у него
+	// или возвращает ошибки ...
+	UpdateOrder(context.Context, dto.ListMoveInfo) error
+	// SortTasks
+	// сортирует задания списка по ключу и сохраняет новый порядок
+	// или возвращает ошибки ...
+	SortTasks(context.Context, dto.ListSortInfo) error
+	// GetWipInfo
+	// возвращает лимит задач в списке и текущее количество задач в нём
 	// или возвращает ошибки ...
-	UpdateOrder(context.Context, dto.ListIDs) error
+	GetWipInfo(context.Context, dto.ListID) (*dto.ListWipInfo, error)
+	// Move
+	// переносит список с заданиями на другую доску, приводя тэги, исполнителей и пользовательские поля заданий к ней
+	// или возвращает ошибки apperrors.ErrWipLimitExceeded, ...
+	Move(context.Context, dto.ListTransferInfo) (*dto.ListTransferReport, error)
+	// Copy
+	// копирует список с заданиями на доску, приводя тэги, исполнителей и пользовательские поля копий к ней
+	// или возвращает ошибки apperrors.ErrWipLimitExceeded, ...
+	Copy(context.Context, dto.ListTransferInfo) (*dto.ListTransferReport, error)
 }
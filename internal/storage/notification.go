@@ -0,0 +1,25 @@
+package storage
+
+import (
+	"context"
+	"server/internal/pkg/dto"
+)
+
+// Интерфейс для хранилища уведомлений
+//
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/mock_storage/$GOFILE -package=mock_storage
+type INotificationStorage interface {
+	// CreateDueReminders
+	// создает напоминания исполнителям заданий, срок которых подошёл к смещению напоминания,
+	// и возвращает количество созданных напоминаний
+	// или возвращает ошибки ...
+	CreateDueReminders(context.Context) (int64, error)
+	// ReadMany
+	// возвращает уведомления пользователя, начиная с новых
+	// или возвращает ошибки ...
+	ReadMany(context.Context, dto.NotificationsRequest) (*[]dto.NotificationInfo, error)
+	// MarkRead
+	// отмечает уведомления пользователя прочитанными
+	// или возвращает ошибки ...
+	MarkRead(context.Context, dto.NotificationsReadInfo) error
+}
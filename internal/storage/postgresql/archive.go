@@ -0,0 +1,57 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	"server/internal/pkg/dto"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// archivedAt
+// возвращает новое значение date_archived: при повторном переносе в архив сохраняется время первого переноса,
+// при возврате из архива значение сбрасывается
+func archivedAt(archived bool) interface{} {
+	if archived {
+		return sq.Expr("COALESCE(date_archived, CURRENT_TIMESTAMP)")
+	}
+	return nil
+}
+
+// setArchived
+// переносит в архив или возвращает из него строки table, подходящие под условие where, увеличивая их версию,
+// и возвращает количество затронутых строк
+// или возвращает ошибки apperrors.ErrCouldNotBuildQuery, failed
+func setArchived(ctx context.Context, tx executor, table string, where sq.Sqlizer, archived bool, failed error) (int64, error) {
+	funcName := "setArchived"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Update(table).
+		Set("date_archived", archivedAt(archived)).
+		Set("row_version", sq.Expr("row_version + 1")).
+		Where(where).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	result, err := tx.Exec(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return 0, failed
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return 0, failed
+	}
+	logger.DebugFmt(fmt.Sprintf("Archive state changed for %d rows", affected), requestID.String(), funcName, nodeName)
+
+	return affected, nil
+}
@@ -66,7 +66,7 @@ func (s *PostgreSQLBoardStorage) GetById(ctx context.Context, id dto.BoardID) (*
 	}
 	logger.DebugFmt("Built query\n\t"+boardSql+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	row := s.db.QueryRow(boardSql, args...)
+	row := conn(ctx, s.db).QueryRow(boardSql, args...)
 
 	var board dto.SingleBoardInfo
 	err = row.Scan(
@@ -76,6 +76,8 @@ func (s *PostgreSQLBoardStorage) GetById(ctx context.Context, id dto.BoardID) (*
 		&board.Name,
 		&board.DateCreated,
 		&board.ThumbnailURL,
+		&board.Version,
+		&board.RowVersion,
 	)
 	if err != nil {
 		return nil, apperrors.ErrCouldNotGetBoard
@@ -105,7 +107,7 @@ func (s *PostgreSQLBoardStorage) GetUsers(ctx context.Context, id dto.BoardID) (
 	}
 	logger.DebugFmt("Built query\n\t"+sql+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	rows, err := s.db.Query(sql, args...)
+	rows, err := conn(ctx, s.db).Query(sql, args...)
 	if err != nil {
 		return nil, apperrors.ErrCouldNotGetBoardUsers
 	}
@@ -135,19 +137,37 @@ func (s *PostgreSQLBoardStorage) GetUsers(ctx context.Context, id dto.BoardID) (
 }
 
 // GetLists
-// находит списки в доске
+// находит неархивные списки в доске
 // или возвращает ошибки ...
 func (s *PostgreSQLBoardStorage) GetLists(ctx context.Context, id dto.BoardID) (*[]dto.SingleListInfo, error) {
-	funcName := "PostgreSQLBoardStorage.GetLists"
+	return s.getLists(ctx, "PostgreSQLBoardStorage.GetLists", sq.Eq{
+		"public.list.id_board":      id.Value,
+		"public.list.date_archived": nil,
+	})
+}
+
+// GetArchivedLists
+// находит архивные списки в доске
+// или возвращает ошибки ...
+func (s *PostgreSQLBoardStorage) GetArchivedLists(ctx context.Context, id dto.BoardID) (*[]dto.SingleListInfo, error) {
+	return s.getLists(ctx, "PostgreSQLBoardStorage.GetArchivedLists", sq.And{
+		sq.Eq{"public.list.id_board": id.Value},
+		sq.NotEq{"public.list.date_archived": nil},
+	})
+}
+
+// getLists
+// находит списки, подходящие под условие, вместе с их неархивными заданиями
+func (s *PostgreSQLBoardStorage) getLists(ctx context.Context, funcName string, where sq.Sqlizer) (*[]dto.SingleListInfo, error) {
 	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
 	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
 
 	listSql, args, err := sq.Select(allListTaskAggFields...).
 		From("public.list").
-		LeftJoin("public.task ON public.task.id_list = public.list.id").
-		Where(sq.Eq{"public.list.id_board": id.Value}).
+		LeftJoin(activeListTasks).
+		Where(where).
 		GroupBy("public.list.id").
-		OrderBy("public.list.list_position").
+		OrderBy("public.list.rank", "public.list.id").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
@@ -155,7 +175,7 @@ func (s *PostgreSQLBoardStorage) GetLists(ctx context.Context, id dto.BoardID) (
 	}
 	logger.DebugFmt("Built query\n\t"+listSql+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	rows, err := s.db.Query(listSql, args...)
+	rows, err := conn(ctx, s.db).Query(listSql, args...)
 	if err != nil {
 		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
 		return nil, apperrors.ErrCouldNotGetList
@@ -172,6 +192,13 @@ func (s *PostgreSQLBoardStorage) GetLists(ctx context.Context, id dto.BoardID) (
 			&list.BoardID,
 			&list.Name,
 			&list.ListPosition,
+			&list.WipLimit,
+			&list.WipMode,
+			&list.IsDone,
+			&list.RequireUnblocked,
+			&list.RowVersion,
+			&list.DateArchived,
+			&list.TaskCount,
 			(*pq.StringArray)(&list.TaskIDs),
 		)
 		if err != nil {
@@ -185,6 +212,40 @@ func (s *PostgreSQLBoardStorage) GetLists(ctx context.Context, id dto.BoardID) (
 	return &lists, nil
 }
 
+// GetArchivedTaskIDs
+// находит id заданий доски, скрытых архивом: архивных или лежащих в архивных списках
+// или возвращает ошибки ...
+func (s *PostgreSQLBoardStorage) GetArchivedTaskIDs(ctx context.Context, id dto.BoardID) ([]string, error) {
+	funcName := "PostgreSQLBoardStorage.GetArchivedTaskIDs"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select("array_remove(array_agg(public.task.id ORDER BY public.task.id), NULL)").
+		From("public.task").
+		Join("public.list ON public.list.id = public.task.id_list").
+		Where(sq.Eq{"public.list.id_board": id.Value}).
+		Where(sq.Or{
+			sq.NotEq{"public.task.date_archived": nil},
+			sq.NotEq{"public.list.date_archived": nil},
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	ids := []string{}
+	err = conn(ctx, s.db).QueryRow(query, args...).Scan((*pq.StringArray)(&ids))
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotGetTask
+	}
+	logger.DebugFmt("Got archived task ids", requestID.String(), funcName, nodeName)
+
+	return ids, nil
+}
+
 // GetTags
 // находит тэги в доске
 // или возвращает ошибки ...
@@ -205,7 +266,7 @@ func (s *PostgreSQLBoardStorage) GetTags(ctx context.Context, id dto.BoardID) (*
 	}
 	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	rows, err := s.db.Query(query, args...)
+	rows, err := conn(ctx, s.db).Query(query, args...)
 	if err != nil {
 		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
 		return nil, apperrors.ErrCouldNotExecuteQuery
@@ -267,7 +328,7 @@ func (s *PostgreSQLBoardStorage) CheckAccess(ctx context.Context, info dto.Check
 	}
 	logger.DebugFmt("Built query\n\t"+userSql+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	row := s.db.QueryRow(userSql, args...)
+	row := conn(ctx, s.db).QueryRow(userSql, args...)
 	logger.DebugFmt("Got user row", requestID.String(), funcName, nodeName)
 
 	var count uint64
@@ -323,7 +384,7 @@ func (s *PostgreSQLBoardStorage) Create(ctx context.Context, info dto.NewBoardIn
 	}
 	logger.DebugFmt("Built query\n\t"+query1+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	tx, err := beginTx(ctx, s.db)
 	if err != nil {
 		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
 		return nil, apperrors.ErrCouldNotBeginTransaction
@@ -441,7 +502,8 @@ func (s *PostgreSQLBoardStorage) UpdateData(ctx context.Context, info dto.Update
 	sql, args, err := sq.
 		Update("public.board").
 		Set("name", info.Name).
-		Where(sq.Eq{"id": info.ID}).
+		Set("row_version", sq.Expr("row_version + 1")).
+		Where(sq.Eq{"id": info.ID, "row_version": info.RowVersion}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 
@@ -451,12 +513,21 @@ func (s *PostgreSQLBoardStorage) UpdateData(ctx context.Context, info dto.Update
 	}
 	logger.DebugFmt("Built query\n\t"+sql+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	_, err = s.db.Exec(sql, args...)
+	result, err := conn(ctx, s.db).Exec(sql, args...)
 
 	if err != nil {
 		return apperrors.ErrBoardNotUpdated
 	}
 
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return apperrors.ErrBoardNotUpdated
+	}
+	if updated == 0 {
+		logger.DebugFmt("Board row version is stale", requestID.String(), funcName, nodeName)
+		return apperrors.ErrRowVersionConflict
+	}
+
 	return nil
 }
 
@@ -475,7 +546,7 @@ func (s *PostgreSQLBoardStorage) UpdateThumbnailUrl(ctx context.Context, info dt
 		return apperrors.ErrCouldNotBuildQuery
 	}
 
-	_, err = s.db.Exec(sql, args...)
+	_, err = conn(ctx, s.db).Exec(sql, args...)
 
 	if err != nil {
 		return apperrors.ErrBoardNotUpdated
@@ -498,7 +569,7 @@ func (s *PostgreSQLBoardStorage) Delete(ctx context.Context, info dto.BoardDelet
 		return apperrors.ErrCouldNotBuildQuery
 	}
 
-	_, err = s.db.Exec(sql, args...)
+	_, err = conn(ctx, s.db).Exec(sql, args...)
 
 	if err != nil {
 		return apperrors.ErrBoardNotDeleted
@@ -514,7 +585,7 @@ func (s *PostgreSQLBoardStorage) AddUser(ctx context.Context, info dto.AddBoardU
 	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
 	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
 
-	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	tx, err := beginTx(ctx, s.db)
 	if err != nil {
 		return dto.UserPublicInfo{}, apperrors.ErrCouldNotBeginTransaction
 	}
@@ -530,7 +601,7 @@ func (s *PostgreSQLBoardStorage) AddUser(ctx context.Context, info dto.AddBoardU
 	}
 	logger.DebugFmt("Built query\n\t"+query1+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	_, err = s.db.Exec(query1, args...)
+	_, err = conn(ctx, s.db).Exec(query1, args...)
 	if err != nil {
 		logger.DebugFmt("Insert into board_user failed with error "+err.Error(), requestID.String(), funcName, nodeName)
 		err = tx.Rollback()
@@ -590,7 +661,7 @@ func (s *PostgreSQLBoardStorage) AddUser(ctx context.Context, info dto.AddBoardU
 	}
 	logger.DebugFmt("Built query\n\t"+userQuery+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	row := s.db.QueryRow(userQuery, args...)
+	row := conn(ctx, s.db).QueryRow(userQuery, args...)
 	user := dto.UserPublicInfo{}
 	if row.Scan(&user.ID, &user.Email, &user.Name, &user.Surname, &user.AvatarURL, &user.Description) != nil {
 		logger.DebugFmt("Query failed with error "+err.Error(), requestID.String(), funcName, nodeName)
@@ -622,7 +693,7 @@ func (s *PostgreSQLBoardStorage) RemoveUser(ctx context.Context, info dto.Remove
 	}
 	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	tx, err := beginTx(ctx, s.db)
 	if err != nil {
 		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
 		return apperrors.ErrCouldNotBeginTransaction
@@ -736,83 +807,161 @@ func (s *PostgreSQLBoardStorage) RemoveUser(ctx context.Context, info dto.Remove
 	return nil
 }
 
-// GetHistory
-// возвращает историю изменения доски
-func (s *PostgreSQLBoardStorage) GetHistory(ctx context.Context, id dto.BoardID) (*[]dto.BoardHistoryEntry, error) {
-	funcName := "PostgreSQLBoardStorage.GetHistory"
+// GetStats
+// считает статистику доски агрегирующими запросами в одной читающей транзакции
+// или возвращает ошибки ...
+func (s *PostgreSQLBoardStorage) GetStats(ctx context.Context, request dto.BoardStatsRequest) (*dto.BoardStats, error) {
+	funcName := "PostgreSQLBoardStorage.GetStats"
 	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
 	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
 
-	entrySql, args, err := sq.Select(append(allHistoryEntryFields, allPublicUserFields...)...).
-		From("public.edit_history").
-		LeftJoin("public.user ON public.user.id = public.edit_history.id_user").
-		Where(sq.Eq{"public.edit_history.id_board": id.Value}).
-		OrderBy("public.edit_history.edit_date").
-		PlaceholderFormat(sq.Dollar).
-		ToSql()
-	if err != nil {
-		return nil, apperrors.ErrCouldNotBuildQuery
+	boardTasks := "public.list ON public.list.id = public.task.id_list"
+	onBoard := sq.Eq{"public.list.id_board": request.BoardID}
+	queries := []sq.SelectBuilder{
+		sq.Select(boardStatsListFields...).
+			From("public.list").
+			LeftJoin("public.task ON public.task.id_list = public.list.id").
+			Where(onBoard).
+			GroupBy("public.list.id").
+			OrderBy("public.list.rank", "public.list.id"),
+		sq.Select(boardStatsTaskFields...).
+			From("public.task").
+			Join(boardTasks).
+			Where(onBoard),
+		sq.Select(boardStatsAssigneeFields...).
+			From("public.task_user").
+			Join("public.task ON public.task.id = public.task_user.id_task").
+			Join(boardTasks).
+			Join("public.user ON public.user.id = public.task_user.id_user").
+			Where(onBoard).
+			GroupBy("public.user.id").
+			OrderBy("count(public.task.id) DESC", "public.user.id"),
+		sq.Select(boardStatsChecklistFields...).
+			From("public.checklist_item").
+			Join("public.checklist ON public.checklist.id = public.checklist_item.id_checklist").
+			Join("public.task ON public.task.id = public.checklist.id_task").
+			Join(boardTasks).
+			Where(onBoard),
+		sq.Select(boardStatsTagFields...).
+			From("public.tag").
+			Join("public.tag_board ON public.tag_board.id_tag = public.tag.id").
+			LeftJoin("public.tag_task ON public.tag_task.id_tag = public.tag.id").
+			LeftJoin("public.task ON public.task.id = public.tag_task.id_task").
+			LeftJoin("public.list ON public.list.id = public.task.id_list AND public.list.id_board = public.tag_board.id_board").
+			Where(sq.Eq{"public.tag_board.id_board": request.BoardID}).
+			GroupBy("public.tag.id").
+			OrderBy("count(public.list.id) DESC", "public.tag.id"),
+		sq.Select("week.start").
+			Column(sq.Expr("(SELECT count(*) FROM public.task JOIN "+boardTasks+" WHERE public.list.id_board = ? "+
+				"AND public.task.date_created >= week.start AND public.task.date_created < week.start + interval '1 week')", request.BoardID)).
+			Column(sq.Expr("(SELECT count(*) FROM public.task JOIN "+boardTasks+" WHERE public.list.id_board = ? "+
+				"AND public.task.date_completed >= week.start AND public.task.date_completed < week.start + interval '1 week')", request.BoardID)).
+			From(fmt.Sprintf("generate_series(date_trunc('week', LOCALTIMESTAMP) - interval '%d week', "+
+				"date_trunc('week', LOCALTIMESTAMP), interval '1 week') AS week(start)", request.Weeks-1)).
+			OrderBy("week.start"),
+	}
+
+	stats := dto.BoardStats{
+		BoardID:          request.BoardID,
+		TasksPerList:     []dto.ListTaskCount{},
+		TasksPerAssignee: []dto.AssigneeTaskCount{},
+		Tags:             []dto.TagTaskCount{},
+		Weekly:           []dto.WeeklyTaskCount{},
+	}
+	scanners := []func(rows *sql.Rows) error{
+		func(rows *sql.Rows) error {
+			var list dto.ListTaskCount
+			err := rows.Scan(&list.ListID, &list.Name, &list.IsDone, &list.TaskCount)
+			stats.TasksPerList = append(stats.TasksPerList, list)
+			return err
+		},
+		func(rows *sql.Rows) error {
+			return rows.Scan(&stats.TaskCount, &stats.Overdue, &stats.DueThisWeek, &stats.Unassigned)
+		},
+		func(rows *sql.Rows) error {
+			var assignee dto.AssigneeTaskCount
+			err := rows.Scan(&assignee.UserID, &assignee.Email, &assignee.TaskCount)
+			stats.TasksPerAssignee = append(stats.TasksPerAssignee, assignee)
+			return err
+		},
+		func(rows *sql.Rows) error {
+			return rows.Scan(&stats.ChecklistItems, &stats.ChecklistItemsDone)
+		},
+		func(rows *sql.Rows) error {
+			var tag dto.TagTaskCount
+			err := rows.Scan(&tag.TagID, &tag.Name, &tag.Color, &tag.TaskCount)
+			stats.Tags = append(stats.Tags, tag)
+			return err
+		},
+		func(rows *sql.Rows) error {
+			var week dto.WeeklyTaskCount
+			err := rows.Scan(&week.WeekStart, &week.Created, &week.Completed)
+			stats.Weekly = append(stats.Weekly, week)
+			return err
+		},
 	}
-	logger.DebugFmt("Built query\n\t"+entrySql+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
-
-	rows, err := s.db.Query(entrySql, args...)
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
 	if err != nil {
-		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
-		return nil, apperrors.ErrCouldNotGetList
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBeginTransaction
 	}
-	defer rows.Close()
-	logger.DebugFmt("Got history entries", requestID.String(), funcName, nodeName)
-
-	historyEntries := []dto.BoardHistoryEntry{}
-	for rows.Next() {
-		var edit dto.BoardHistoryEntry
+	logger.DebugFmt("Transaction started", requestID.String(), funcName, nodeName)
 
-		err = rows.Scan(
-			&edit.DateEdited,
-			&edit.Actions,
-			&edit.User.ID,
-			&edit.User.Email,
-			&edit.User.Name,
-			&edit.User.Surname,
-			&edit.User.Description,
-			&edit.User.AvatarURL,
-		)
+	for i, builder := range queries {
+		err = runStatsQuery(ctx, tx, builder, scanners[i])
 		if err != nil {
-			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
-			return nil, apperrors.ErrCouldNotGetBoard
+			if errRollback := tx.Rollback(); errRollback != nil {
+				logger.DebugFmt("Transaction rollback failed with error "+errRollback.Error(), requestID.String(), funcName, nodeName)
+				return nil, apperrors.ErrCouldNotRollback
+			}
+			return nil, err
 		}
-		historyEntries = append(historyEntries, edit)
 	}
-	logger.DebugFmt("Collected edit info rows", requestID.String(), funcName, nodeName)
+	logger.DebugFmt("Collected board stats", requestID.String(), funcName, nodeName)
 
-	return &historyEntries, nil
+	err = tx.Commit()
+	if err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotCommit
+	}
+
+	if stats.ChecklistItems != 0 {
+		stats.ChecklistCompletion = float64(stats.ChecklistItemsDone) / float64(stats.ChecklistItems)
+	}
+
+	return &stats, nil
 }
 
-// SubmitEdit
-// записывает изменение доски в историю
-func (s *PostgreSQLBoardStorage) SubmitEdit(ctx context.Context, entry dto.NewHistoryEntry) error {
-	funcName := "PostgreSQLBoardStorage.SubmitEdit"
+// runStatsQuery
+// выполняет запрос статистики и передаёт каждую строку результата в scan
+func runStatsQuery(ctx context.Context, tx executor, builder sq.SelectBuilder, scan func(rows *sql.Rows) error) error {
+	funcName := "runStatsQuery"
 	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
 	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
 
-	sql, args, err := sq.
-		Insert("public.edit_history").
-		Columns("id_user", "id_board", "edit_date", "edit_summary").
-		Values(entry.UserID, entry.BoardID, time.Now(), entry.Actions).
-		PlaceholderFormat(sq.Dollar).
-		ToSql()
+	query, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
 	if err != nil {
 		logger.DebugFmt("Failed to build query with error "+err.Error(), requestID.String(), funcName, nodeName)
 		return apperrors.ErrCouldNotBuildQuery
 	}
-	logger.DebugFmt("Built query\n\t"+sql+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	_, err = s.db.Exec(sql, args...)
+	rows, err := tx.Query(query, args...)
 	if err != nil {
-		logger.DebugFmt("Insert failed with error "+err.Error(), requestID.String(), funcName, nodeName)
-		return apperrors.ErrCouldNotAddTaskUser
+		logger.DebugFmt("Query failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotGetBoardStats
 	}
-	logger.DebugFmt("query executed", requestID.String(), funcName, nodeName)
+	defer rows.Close()
 
+	for rows.Next() {
+		if err = scan(rows); err != nil {
+			logger.DebugFmt("Failed to scan row with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotGetBoardStats
+		}
+	}
+	if err = rows.Err(); err != nil {
+		logger.DebugFmt("Reading rows failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotGetBoardStats
+	}
 	return nil
 }
@@ -3,6 +3,7 @@ package postgresql
 import (
 	"context"
 	"errors"
+	"reflect"
 	"regexp"
 	"server/internal/apperrors"
 	"server/internal/pkg/dto"
@@ -168,7 +169,7 @@ func TestBoardStorage_GetById(t *testing.T) {
 					mock.ExpectQuery(regexp.QuoteMeta(sql)).
 						WithArgs(args.id.Value).
 						WillReturnRows(sqlmock.NewRows(allBoardFields).
-							AddRow(1, 1, 1, "Mock board", time.Now(), "thumbnail.png"),
+							AddRow(1, 1, 1, "Mock board", time.Now(), "thumbnail.png", 3, 1),
 						)
 				},
 			},
@@ -201,7 +202,7 @@ func TestBoardStorage_GetById(t *testing.T) {
 					mock.ExpectQuery(regexp.QuoteMeta(sql)).
 						WithArgs(args.id.Value).
 						WillReturnRows(sqlmock.NewRows(allBoardFields).
-							AddRow(0, 0, 0, nil, time.Now(), nil),
+							AddRow(0, 0, 0, nil, time.Now(), nil, 0, 0),
 						)
 				},
 			},
@@ -404,16 +405,16 @@ func TestBoardStorage_GetLists(t *testing.T) {
 				query: func(mock sqlmock.Sqlmock, args args) {
 					sql, _, _ := sq.Select(allListTaskAggFields...).
 						From("public.list").
-						LeftJoin("public.task ON public.task.id_list = public.list.id").
-						Where(sq.Eq{"public.list.id_board": args.id.Value}).
+						LeftJoin(activeListTasks).
+						Where(sq.Eq{"public.list.id_board": args.id.Value, "public.list.date_archived": nil}).
 						GroupBy("public.list.id").
-						OrderBy("public.list.list_position").
+						OrderBy("public.list.rank", "public.list.id").
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
 					mock.ExpectQuery(regexp.QuoteMeta(sql)).
 						WithArgs(args.id.Value).
 						WillReturnRows(sqlmock.NewRows(allListTaskAggFields).
-							AddRow(1, 1, "Mock board", 0, pq.StringArray{}),
+							AddRow(1, 1, "Mock board", 0, nil, "soft", false, false, 1, nil, 0, pq.StringArray{}),
 						)
 				},
 			},
@@ -439,10 +440,10 @@ func TestBoardStorage_GetLists(t *testing.T) {
 				query: func(mock sqlmock.Sqlmock, args args) {
 					sql, _, _ := sq.Select(allListTaskAggFields...).
 						From("public.list").
-						LeftJoin("public.task ON public.task.id_list = public.list.id").
-						Where(sq.Eq{"public.list.id_board": args.id.Value}).
+						LeftJoin(activeListTasks).
+						Where(sq.Eq{"public.list.id_board": args.id.Value, "public.list.date_archived": nil}).
 						GroupBy("public.list.id").
-						OrderBy("public.list.list_position").
+						OrderBy("public.list.rank", "public.list.id").
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
 					mock.ExpectQuery(regexp.QuoteMeta(sql)).
@@ -462,16 +463,16 @@ func TestBoardStorage_GetLists(t *testing.T) {
 				query: func(mock sqlmock.Sqlmock, args args) {
 					sql, _, _ := sq.Select(allListTaskAggFields...).
 						From("public.list").
-						LeftJoin("public.task ON public.task.id_list = public.list.id").
-						Where(sq.Eq{"public.list.id_board": args.id.Value}).
+						LeftJoin(activeListTasks).
+						Where(sq.Eq{"public.list.id_board": args.id.Value, "public.list.date_archived": nil}).
 						GroupBy("public.list.id").
-						OrderBy("public.list.list_position").
+						OrderBy("public.list.rank", "public.list.id").
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
 					mock.ExpectQuery(regexp.QuoteMeta(sql)).
 						WithArgs(args.id.Value).
 						WillReturnRows(sqlmock.NewRows(allListTaskAggFields).
-							AddRow(nil, nil, nil, nil, nil),
+							AddRow(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil),
 						)
 				},
 			},
@@ -512,6 +513,83 @@ func TestBoardStorage_GetLists(t *testing.T) {
 	}
 }
 
+func TestBoardStorage_GetArchivedTaskIDs(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		id    dto.BoardID
+		query func(mock sqlmock.Sqlmock, args args)
+	}
+	archivedQuery := "SELECT array_remove(array_agg(public.task.id ORDER BY public.task.id), NULL) FROM public.task " +
+		"JOIN public.list ON public.list.id = public.task.id_list WHERE public.list.id_board = $1 " +
+		"AND (public.task.date_archived IS NOT NULL OR public.list.date_archived IS NOT NULL)"
+	tests := []struct {
+		name    string
+		args    args
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "Happy path",
+			args: args{
+				id: dto.BoardID{Value: 1},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectQuery(regexp.QuoteMeta(archivedQuery)).
+						WithArgs(args.id.Value).
+						WillReturnRows(sqlmock.NewRows([]string{"ids"}).AddRow(pq.StringArray{"3", "8"}))
+				},
+			},
+			want:    []string{"3", "8"},
+			wantErr: false,
+		},
+		{
+			name: "Bad request (could not get tasks)",
+			args: args{
+				id: dto.BoardID{Value: 1},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectQuery(regexp.QuoteMeta(archivedQuery)).
+						WithArgs(args.id.Value).
+						WillReturnError(apperrors.ErrCouldNotGetTask)
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			tt.args.query(mock, tt.args)
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			s := NewBoardStorage(db)
+
+			got, err := s.GetArchivedTaskIDs(ctx, tt.args.id)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetArchivedTaskIDs() error = %v, wantErr %v, err = %v", err != nil, tt.wantErr, err)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetArchivedTaskIDs() = %v, want %v", got, tt.want)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
 func TestBoardStorage_GetTags(t *testing.T) {
 	t.Parallel()
 	type args struct {
@@ -696,24 +774,50 @@ func TestBoardStorage_UpdateData(t *testing.T) {
 			name: "Happy path",
 			args: args{
 				info: dto.UpdatedBoardInfo{
-					ID:   1,
-					Name: "New mock name",
+					ID:         1,
+					Name:       "New mock name",
+					RowVersion: 2,
 				},
 				query: func(mock sqlmock.Sqlmock, args args) {
 					sql, _, _ := sq.
 						Update("public.board").
 						Set("name", args.info.Name).
-						Where(sq.Eq{"id": args.info.ID}).
+						Set("row_version", sq.Expr("row_version + 1")).
+						Where(sq.Eq{"id": args.info.ID, "row_version": args.info.RowVersion}).
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
 					mock.ExpectExec(regexp.QuoteMeta(sql)).
-						WithArgs(args.info.Name, args.info.ID).
+						WithArgs(args.info.Name, args.info.ID, args.info.RowVersion).
 						WillReturnResult(sqlmock.NewResult(1, 1))
 				},
 			},
 			wantErr: false,
 			err:     nil,
 		},
+		{
+			name: "Conflict (stale row version)",
+			args: args{
+				info: dto.UpdatedBoardInfo{
+					ID:         1,
+					Name:       "New mock name",
+					RowVersion: 2,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					sql, _, _ := sq.
+						Update("public.board").
+						Set("name", args.info.Name).
+						Set("row_version", sq.Expr("row_version + 1")).
+						Where(sq.Eq{"id": args.info.ID, "row_version": args.info.RowVersion}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectExec(regexp.QuoteMeta(sql)).
+						WithArgs(args.info.Name, args.info.ID, args.info.RowVersion).
+						WillReturnResult(sqlmock.NewResult(0, 0))
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrRowVersionConflict,
+		},
 		{
 			name: "Bad request (could not build query)",
 			args: args{
@@ -735,11 +839,12 @@ func TestBoardStorage_UpdateData(t *testing.T) {
 					sql, _, _ := sq.
 						Update("public.board").
 						Set("name", args.info.Name).
-						Where(sq.Eq{"id": args.info.ID}).
+						Set("row_version", sq.Expr("row_version + 1")).
+						Where(sq.Eq{"id": args.info.ID, "row_version": args.info.RowVersion}).
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
 					mock.ExpectExec(regexp.QuoteMeta(sql)).
-						WithArgs(args.info.Name, args.info.ID).
+						WithArgs(args.info.Name, args.info.ID, args.info.RowVersion).
 						WillReturnError(apperrors.ErrBoardNotUpdated)
 				},
 			},
@@ -2128,3 +2233,124 @@ func TestBoardStorage_RemoveUser(t *testing.T) {
 		})
 	}
 }
+
+func TestBoardStorage_GetStats(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		request dto.BoardStatsRequest
+		query   func(mock sqlmock.Sqlmock, args args)
+	}
+	tests := []struct {
+		name       string
+		args       args
+		completion float64
+		wantErr    bool
+		err        error
+	}{
+		{
+			name: "Happy path",
+			args: args{
+				request: dto.BoardStatsRequest{BoardID: 1, Weeks: 2},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta("SELECT public.list.id, public.list.name, public.list.is_done")).
+						WithArgs(args.request.BoardID).
+						WillReturnRows(sqlmock.NewRows([]string{"id", "name", "is_done", "count"}).
+							AddRow(1, "Todo", false, 2).
+							AddRow(2, "Done", true, 1))
+					mock.ExpectQuery(regexp.QuoteMeta("FROM public.task JOIN public.list")).
+						WithArgs(args.request.BoardID).
+						WillReturnRows(sqlmock.NewRows([]string{"total", "overdue", "due_this_week", "unassigned"}).
+							AddRow(3, 1, 1, 2))
+					mock.ExpectQuery(regexp.QuoteMeta("FROM public.task_user")).
+						WithArgs(args.request.BoardID).
+						WillReturnRows(sqlmock.NewRows([]string{"id", "email", "count"}).
+							AddRow(1, "mock@mail.com", 1))
+					mock.ExpectQuery(regexp.QuoteMeta("FROM public.checklist_item")).
+						WithArgs(args.request.BoardID).
+						WillReturnRows(sqlmock.NewRows([]string{"total", "done"}).
+							AddRow(4, 1))
+					mock.ExpectQuery(regexp.QuoteMeta("FROM public.tag JOIN public.tag_board")).
+						WithArgs(args.request.BoardID).
+						WillReturnRows(sqlmock.NewRows([]string{"id", "name", "color", "count"}))
+					mock.ExpectQuery(regexp.QuoteMeta("interval '1 week', date_trunc('week', LOCALTIMESTAMP), interval '1 week')")).
+						WithArgs(args.request.BoardID, args.request.BoardID).
+						WillReturnRows(sqlmock.NewRows([]string{"start", "created", "completed"}).
+							AddRow(time.Now(), 2, 0).
+							AddRow(time.Now(), 1, 1))
+					mock.ExpectCommit()
+				},
+			},
+			completion: 0.25,
+			wantErr:    false,
+		},
+		{
+			name: "Bad request (could not get stats)",
+			args: args{
+				request: dto.BoardStatsRequest{BoardID: 1, Weeks: 2},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta("SELECT public.list.id, public.list.name, public.list.is_done")).
+						WithArgs(args.request.BoardID).
+						WillReturnError(errors.New("connection reset"))
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrCouldNotGetBoardStats,
+		},
+		{
+			name: "Bad request (could not begin transaction)",
+			args: args{
+				request: dto.BoardStatsRequest{BoardID: 1, Weeks: 2},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin().WillReturnError(apperrors.ErrCouldNotBeginTransaction)
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrCouldNotBeginTransaction,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			tt.args.query(mock, tt.args)
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			s := NewBoardStorage(db)
+
+			stats, err := s.GetStats(ctx, tt.args.request)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetStats() error = %v, wantErr %v, err = %v", err != nil, tt.wantErr, err)
+			}
+			if tt.wantErr && !errors.Is(err, tt.err) {
+				t.Errorf("GetStats() error = %v, want %v", err, tt.err)
+			}
+			if !tt.wantErr {
+				if len(stats.TasksPerList) != 2 || len(stats.Weekly) != 2 || stats.TaskCount != 3 {
+					t.Errorf("GetStats() = %+v, rows were not collected", stats)
+				}
+				if stats.ChecklistCompletion != tt.completion {
+					t.Errorf("GetStats() checklist completion = %v, want %v", stats.ChecklistCompletion, tt.completion)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
@@ -51,7 +51,7 @@ func (s PostgresChecklistStorage) Create(ctx context.Context, info dto.NewCheckl
 		ListPosition: info.ListPosition,
 		Items:        []string{},
 	}
-	query := s.db.QueryRow(sql, args...)
+	query := conn(ctx, s.db).QueryRow(sql, args...)
 	if err := query.Scan(&checklist.ID); err != nil {
 		log.Println("Storage -- Failed to create Checklist")
 		return nil, apperrors.ErrChecklistNotCreated
@@ -84,7 +84,7 @@ func (s PostgresChecklistStorage) ReadMany(ctx context.Context, ids dto.Checklis
 	}
 	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	rows, err := s.db.Query(query, args...)
+	rows, err := conn(ctx, s.db).Query(query, args...)
 	if err != nil {
 		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
 		return nil, apperrors.ErrCouldNotCollectRows
@@ -130,7 +130,7 @@ func (s PostgresChecklistStorage) Update(ctx context.Context, info dto.UpdatedCh
 	}
 	log.Println("Built Checklist query\n\t", sql, "\nwith args\n\t", args)
 
-	_, err = s.db.Exec(sql, args...)
+	_, err = conn(ctx, s.db).Exec(sql, args...)
 
 	if err != nil {
 		log.Println(err)
@@ -159,7 +159,7 @@ func (s PostgresChecklistStorage) Delete(ctx context.Context, id dto.ChecklistID
 	}
 	logger.DebugFmt("Built query\n\t"+sql+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	_, err = s.db.Exec(sql, args...)
+	_, err = conn(ctx, s.db).Exec(sql, args...)
 	if err != nil {
 		logger.DebugFmt("Failed to delete checklist with error "+err.Error(), requestID.String(), funcName, nodeName)
 		return apperrors.ErrChecklistNotDeleted
@@ -3,6 +3,7 @@ package postgresql
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"server/internal/apperrors"
@@ -31,18 +32,38 @@ func NewChecklistItemStorage(db *sql.DB) *PostgresChecklistItemStorage {
 // создает новый чеклист в БД по данным
 // или возвращает ошибки ...
 func (s PostgresChecklistItemStorage) Create(ctx context.Context, info dto.NewChecklistItemInfo) (*dto.ChecklistItemInfo, error) {
-	sql, args, err := sq.
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		log.Println("Storage -- Failed to begin transaction")
+		return nil, apperrors.ErrCouldNotBeginTransaction
+	}
+
+	rollback := func(reason error) error {
+		if err := tx.Rollback(); err != nil {
+			log.Println("Storage -- Failed to rollback transaction")
+			return apperrors.ErrCouldNotRollback
+		}
+		return reason
+	}
+
+	key, err := checklistItemRankScope.appendKey(ctx, tx, info.ChecklistID)
+	if err != nil {
+		log.Println("Storage -- Failed to get ChecklistItem rank")
+		return nil, rollback(apperrors.ErrChecklistItemNotCreated)
+	}
+
+	query, args, err := sq.
 		Insert("public.checklist_item").
-		Columns("name", "list_position", "id_checklist", "done").
-		Values(info.Name, info.ListPosition, info.ChecklistID, info.Done).
+		Columns("name", "list_position", "id_checklist", "done", "rank").
+		Values(info.Name, info.ListPosition, info.ChecklistID, info.Done, key).
 		Suffix("RETURNING id").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		log.Println("Storage -- Failed to build query")
-		return nil, apperrors.ErrCouldNotBuildQuery
+		return nil, rollback(apperrors.ErrCouldNotBuildQuery)
 	}
-	log.Println("Built ChecklistItem query\n\t", sql, "\nwith args\n\t", args)
+	log.Println("Built ChecklistItem query\n\t", query, "\nwith args\n\t", args)
 
 	checklistItem := dto.ChecklistItemInfo{
 		Name:         info.Name,
@@ -50,10 +71,14 @@ func (s PostgresChecklistItemStorage) Create(ctx context.Context, info dto.NewCh
 		ListPosition: info.ListPosition,
 		Done:         info.Done,
 	}
-	query := s.db.QueryRow(sql, args...)
-	if err := query.Scan(&checklistItem.ID); err != nil {
+	if err := tx.QueryRow(query, args...).Scan(&checklistItem.ID); err != nil {
 		log.Println("Storage -- Failed to create ChecklistItem")
-		return nil, apperrors.ErrChecklistItemNotCreated
+		return nil, rollback(apperrors.ErrChecklistItemNotCreated)
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Println("Storage -- Failed to commit ChecklistItem")
+		return nil, apperrors.ErrCouldNotCommit
 	}
 
 	log.Println("Storage -- ChecklistItem created")
@@ -73,6 +98,7 @@ func (s PostgresChecklistItemStorage) ReadMany(ctx context.Context, ids dto.Chec
 		Select("id", "name", "list_position", "id_checklist", "done").
 		From("public.checklist_item").
 		Where(sq.Eq{"id": ids.Values}).
+		OrderBy("id_checklist", "rank", "id").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
@@ -81,7 +107,7 @@ func (s PostgresChecklistItemStorage) ReadMany(ctx context.Context, ids dto.Chec
 	}
 	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	rows, err := s.db.Query(query, args...)
+	rows, err := conn(ctx, s.db).Query(query, args...)
 	if err != nil {
 		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
 		return nil, apperrors.ErrCouldNotCollectRows
@@ -128,7 +154,7 @@ func (s PostgresChecklistItemStorage) Update(ctx context.Context, info dto.Updat
 	}
 	log.Println("Built ChecklistItem query\n\t", sql, "\nwith args\n\t", args)
 
-	_, err = s.db.Exec(sql, args...)
+	_, err = conn(ctx, s.db).Exec(sql, args...)
 
 	if err != nil {
 		log.Println(err)
@@ -152,7 +178,7 @@ func (s PostgresChecklistItemStorage) Delete(ctx context.Context, id dto.Checkli
 		return apperrors.ErrCouldNotBuildQuery
 	}
 
-	_, err = s.db.Exec(sql, args...)
+	_, err = conn(ctx, s.db).Exec(sql, args...)
 	if err != nil {
 		return apperrors.ErrChecklistItemNotDeleted
 	}
@@ -161,32 +187,76 @@ func (s PostgresChecklistItemStorage) Delete(ctx context.Context, id dto.Checkli
 }
 
 // UpdateOrder
-// меняет порядок списков в БД по данным
-// или возвращает ошибки ...
-func (s PostgresChecklistItemStorage) UpdateOrder(ctx context.Context, ids dto.ChecklistItemIDs) error {
-	caseBuilder := sq.Case()
-	for i, id := range ids.Values {
-		caseBuilder = caseBuilder.When(sq.Eq{"id": fmt.Sprintf("%v", id)}, fmt.Sprintf("%v", i)).Else("list_position")
-	}
+// ставит элемент чеклиста сразу после другого элемента того же чеклиста (или в начало);
+// ключ порядка меняется только у перемещаемого элемента
+// или возвращает ошибки apperrors.ErrChecklistItemNotFound, apperrors.ErrInvalidPosition, ...
+func (s PostgresChecklistItemStorage) UpdateOrder(ctx context.Context, info dto.ChecklistItemMoveInfo) error {
+	funcName := "PostgresChecklistItemStorage.UpdateOrder"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
 
-	sql, args, err := sq.
-		Update("public.checklist_item").
-		Set("list_position", caseBuilder).
+	checklistQuery, checklistArgs, err := sq.Select("id_checklist").
+		From("public.checklist_item").
+		Where(sq.Eq{"id": info.ItemID}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
-		log.Println("Storage -- Failed to build query")
 		return apperrors.ErrCouldNotBuildQuery
 	}
-	log.Println("Built list query\n\t", sql, "\nwith args\n\t", args)
+	logger.DebugFmt("Built query\n\t"+checklistQuery+"\nwith args\n\t"+fmt.Sprintf("%+v", checklistArgs), requestID.String(), funcName, nodeName)
 
-	_, err = s.db.Exec(sql, args...)
+	tx, err := beginTx(ctx, s.db)
 	if err != nil {
-		log.Println("Storage -- Failed to update checklist")
-		log.Println("Error", err.Error())
-		return apperrors.ErrCouldNotExecuteQuery
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotBeginTransaction
+	}
+
+	rollback := func(reason error) error {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotRollback
+		}
+		return reason
+	}
+
+	var checklistID uint64
+	err = tx.QueryRow(checklistQuery, checklistArgs...).Scan(&checklistID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return rollback(apperrors.ErrChecklistItemNotFound)
+	}
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrCouldNotExecuteQuery)
+	}
+
+	if err = checklistItemRankScope.lockParent(ctx, tx, checklistID); err != nil {
+		return rollback(err)
+	}
+	key, err := checklistItemRankScope.place(ctx, tx, checklistID, info.ItemID, positionAfter(info.AfterID))
+	if err != nil {
+		return rollback(err)
+	}
+
+	query, args, err := sq.Update("public.checklist_item").
+		Set("rank", key).
+		Where(sq.Eq{"id": info.ItemID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return rollback(apperrors.ErrCouldNotBuildQuery)
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	if _, err = tx.Exec(query, args...); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrCouldNotExecuteQuery)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotCommit
 	}
-	log.Println("Storage -- Checklist order updated")
+	logger.DebugFmt("Checklist item moved", requestID.String(), funcName, nodeName)
 
 	return nil
 }
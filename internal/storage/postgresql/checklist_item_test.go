@@ -2,7 +2,6 @@ package postgresql
 
 import (
 	"context"
-	"fmt"
 	"regexp"
 	"server/internal/apperrors"
 	"server/internal/pkg/dto"
@@ -35,10 +34,13 @@ func TestPostgresChecklistItemStorage_Create(t *testing.T) {
 					ListPosition: 1,
 				},
 				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					expectRankAppend(mock, checklistItemRankScope, args.info.ChecklistID, 4, "r")
+
 					query, _, _ := sq.
 						Insert("public.checklist_item").
-						Columns("name", "list_position", "id_checklist", "done").
-						Values(args.info.Name, args.info.ListPosition, args.info.ChecklistID, args.info.Done).
+						Columns("name", "list_position", "id_checklist", "done", "rank").
+						Values(args.info.Name, args.info.ListPosition, args.info.ChecklistID, args.info.Done, "w").
 						Suffix("RETURNING id").
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
@@ -48,10 +50,12 @@ func TestPostgresChecklistItemStorage_Create(t *testing.T) {
 							args.info.ListPosition,
 							args.info.ChecklistID,
 							args.info.Done,
+							"w",
 						).
 						WillReturnRows(sqlmock.NewRows([]string{"id"}).
 							AddRow(1),
 						)
+					mock.ExpectCommit()
 				},
 			},
 			wantErr: false,
@@ -67,10 +71,13 @@ func TestPostgresChecklistItemStorage_Create(t *testing.T) {
 					ListPosition: 1,
 				},
 				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					expectRankAppend(mock, checklistItemRankScope, args.info.ChecklistID, 4, "r")
+
 					query, _, _ := sq.
 						Insert("public.checklist_item").
-						Columns("name", "list_position", "id_checklist", "done").
-						Values(args.info.Name, args.info.ListPosition, args.info.ChecklistID, args.info.Done).
+						Columns("name", "list_position", "id_checklist", "done", "rank").
+						Values(args.info.Name, args.info.ListPosition, args.info.ChecklistID, args.info.Done, "w").
 						Suffix("RETURNING id").
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
@@ -80,21 +87,38 @@ func TestPostgresChecklistItemStorage_Create(t *testing.T) {
 							args.info.ListPosition,
 							args.info.ChecklistID,
 							args.info.Done,
+							"w",
 						).
 						WillReturnError(apperrors.ErrChecklistItemNotCreated)
+					mock.ExpectRollback()
 				},
 			},
 			wantErr: true,
 			err:     apperrors.ErrChecklistItemNotCreated,
 		},
 		{
-			name: "Building query failed",
+			name: "Checklist not found",
 			args: args{
-				info:  dto.NewChecklistItemInfo{},
-				query: func(mock sqlmock.Sqlmock, args args) {},
+				info: dto.NewChecklistItemInfo{
+					ChecklistID: 1,
+					Name:        "dfdfdfdf",
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					query, _, _ := sq.Select("id").
+						From("public.checklist").
+						Where(sq.Eq{"id": args.info.ChecklistID}).
+						Suffix("FOR UPDATE").
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectQuery(regexp.QuoteMeta(query)).
+						WithArgs(args.info.ChecklistID).
+						WillReturnRows(sqlmock.NewRows([]string{"id"}))
+					mock.ExpectRollback()
+				},
 			},
 			wantErr: true,
-			err:     apperrors.ErrCouldNotBuildQuery,
+			err:     apperrors.ErrChecklistItemNotCreated,
 		},
 	}
 	for _, tt := range tests {
@@ -246,6 +270,7 @@ func TestPostgresChecklistItemStorage_ReadMany(t *testing.T) {
 						Select("id", "name", "list_position", "id_checklist", "done").
 						From("public.checklist_item").
 						Where(sq.Eq{"id": args.info.Values}).
+						OrderBy("id_checklist", "rank", "id").
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
 					mock.ExpectQuery(regexp.QuoteMeta(query)).
@@ -273,6 +298,7 @@ func TestPostgresChecklistItemStorage_ReadMany(t *testing.T) {
 						Select("id", "name", "list_position", "id_checklist", "done").
 						From("public.checklist_item").
 						Where(sq.Eq{"id": args.info.Values}).
+						OrderBy("id_checklist", "rank", "id").
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
 					mock.ExpectQuery(regexp.QuoteMeta(query)).
@@ -444,9 +470,10 @@ func TestPostgresChecklistItemStorage_Update(t *testing.T) {
 func TestPostgresChecklistItemStorage_UpdateOrder(t *testing.T) {
 	t.Parallel()
 	type args struct {
-		info  dto.ChecklistItemIDs
+		info  dto.ChecklistItemMoveInfo
 		query func(mock sqlmock.Sqlmock, args args)
 	}
+	afterID := uint64(2)
 	tests := []struct {
 		name    string
 		args    args
@@ -456,65 +483,93 @@ func TestPostgresChecklistItemStorage_UpdateOrder(t *testing.T) {
 		{
 			name: "Happy path",
 			args: args{
-				info: dto.ChecklistItemIDs{
-					Values: []uint64{1, 2, 3},
+				info: dto.ChecklistItemMoveInfo{
+					ItemID:  3,
+					AfterID: &afterID,
 				},
 				query: func(mock sqlmock.Sqlmock, args args) {
-					caseBuilder := sq.Case()
-					for i, id := range args.info.Values {
-						caseBuilder = caseBuilder.When(sq.Eq{"id": fmt.Sprintf("%v", id)}, fmt.Sprintf("%v", i)).Else("list_position")
-					}
+					mock.ExpectBegin()
+					query, _, _ := sq.Select("id_checklist").
+						From("public.checklist_item").
+						Where(sq.Eq{"id": args.info.ItemID}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectQuery(regexp.QuoteMeta(query)).
+						WithArgs(args.info.ItemID).
+						WillReturnRows(sqlmock.NewRows([]string{"id_checklist"}).AddRow(1))
 
-					query1, _, _ := sq.
-						Update("public.checklist_item").
-						Set("list_position", caseBuilder).
+					expectRankLock(mock, checklistItemRankScope, 1)
+					expectRankPlace(mock, checklistItemRankScope, 1, args.info.ItemID, *args.info.AfterID, "a", "c")
+
+					query, _, _ = sq.Update("public.checklist_item").
+						Set("rank", "b").
+						Where(sq.Eq{"id": args.info.ItemID}).
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
-					mock.ExpectExec(regexp.QuoteMeta(query1)).
-						WithArgs(
-							"1", "2", "3",
-						).
-						WillReturnResult(sqlmock.NewResult(1, 1))
+					mock.ExpectExec(regexp.QuoteMeta(query)).
+						WithArgs("b", args.info.ItemID).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
 				},
 			},
 			wantErr: false,
 			err:     nil,
 		},
 		{
-			name: "Executing query failed",
+			name: "Item not found",
 			args: args{
-				info: dto.ChecklistItemIDs{
-					Values: []uint64{1, 2, 3},
+				info: dto.ChecklistItemMoveInfo{
+					ItemID: 3,
 				},
 				query: func(mock sqlmock.Sqlmock, args args) {
-					caseBuilder := sq.Case()
-					for i, id := range args.info.Values {
-						caseBuilder = caseBuilder.When(sq.Eq{"id": fmt.Sprintf("%v", id)}, fmt.Sprintf("%v", i)).Else("list_position")
-					}
-
-					query1, _, _ := sq.
-						Update("public.checklist_item").
-						Set("list_position", caseBuilder).
+					mock.ExpectBegin()
+					query, _, _ := sq.Select("id_checklist").
+						From("public.checklist_item").
+						Where(sq.Eq{"id": args.info.ItemID}).
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
-					mock.ExpectExec(regexp.QuoteMeta(query1)).
-						WithArgs(
-							"1", "2", "3",
-						).
-						WillReturnError(apperrors.ErrCouldNotExecuteQuery)
+					mock.ExpectQuery(regexp.QuoteMeta(query)).
+						WithArgs(args.info.ItemID).
+						WillReturnRows(sqlmock.NewRows([]string{"id_checklist"}))
+					mock.ExpectRollback()
 				},
 			},
 			wantErr: true,
-			err:     apperrors.ErrCouldNotExecuteQuery,
+			err:     apperrors.ErrChecklistItemNotFound,
 		},
 		{
-			name: "Building query failed",
+			name: "Executing query failed",
 			args: args{
-				info:  dto.ChecklistItemIDs{},
-				query: func(mock sqlmock.Sqlmock, args args) {},
+				info: dto.ChecklistItemMoveInfo{
+					ItemID: 3,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					query, _, _ := sq.Select("id_checklist").
+						From("public.checklist_item").
+						Where(sq.Eq{"id": args.info.ItemID}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectQuery(regexp.QuoteMeta(query)).
+						WithArgs(args.info.ItemID).
+						WillReturnRows(sqlmock.NewRows([]string{"id_checklist"}).AddRow(1))
+
+					expectRankLock(mock, checklistItemRankScope, 1)
+					expectRankPlace(mock, checklistItemRankScope, 1, args.info.ItemID, 0, "", "i")
+
+					query, _, _ = sq.Update("public.checklist_item").
+						Set("rank", "9").
+						Where(sq.Eq{"id": args.info.ItemID}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectExec(regexp.QuoteMeta(query)).
+						WithArgs("9", args.info.ItemID).
+						WillReturnError(apperrors.ErrCouldNotExecuteQuery)
+					mock.ExpectRollback()
+				},
 			},
 			wantErr: true,
-			err:     apperrors.ErrCouldNotBuildQuery,
+			err:     apperrors.ErrCouldNotExecuteQuery,
 		},
 	}
 	for _, tt := range tests {
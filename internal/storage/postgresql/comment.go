@@ -29,11 +29,30 @@ func NewCommentStorage(db *sql.DB) *PostgresCommentStorage {
 }
 
 // Create
-// создает новый комментарий в бд
+// создает новый комментарий в бд и подписывает на изменения задания автора и упомянутых участников доски
 // или возвращает ошибки ...
 func (s PostgresCommentStorage) Create(ctx context.Context, info dto.NewCommentInfo) (*entities.Comment, error) {
+	watchers := sq.Or{sq.Eq{"public.user.id": info.UserID}}
+	if len(info.Mentions) != 0 {
+		watchers = append(watchers, sq.And{
+			sq.Eq{"lower(public.user.email)": info.Mentions},
+			sq.Expr("public.user.id IN (SELECT public.board_user.id_user FROM public.board_user "+
+				"JOIN public.list ON public.list.id_board = public.board_user.id_board "+
+				"JOIN public.task ON public.task.id_list = public.list.id WHERE public.task.id = ?)", info.TaskID),
+		})
+	}
+	watchSQL, watchArgs, err := sq.Insert("public.task_watcher").
+		Columns("id_task", "id_user").
+		Select(sq.Select().Column("?::integer", info.TaskID).Column("public.user.id").From("public.user").Where(watchers)).
+		Suffix("ON CONFLICT DO NOTHING").
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+
 	sql, args, err := sq.
 		Insert("public.comment").
+		Prefix("WITH watched AS ("+watchSQL+")", watchArgs...).
 		Columns(newCommentFields...).
 		Values(info.TaskID, info.UserID, info.Text).
 		PlaceholderFormat(sq.Dollar).
@@ -50,7 +69,7 @@ func (s PostgresCommentStorage) Create(ctx context.Context, info dto.NewCommentI
 		Text:   info.Text,
 	}
 
-	query := s.db.QueryRow(sql, args...)
+	query := conn(ctx, s.db).QueryRow(sql, args...)
 	err = query.Scan(&comment.ID, &comment.DateCreated)
 	if err != nil {
 		return nil, apperrors.ErrCommentNotCreated
@@ -75,7 +94,7 @@ func (s *PostgresCommentStorage) GetFromTask(ctx context.Context, id dto.TaskID)
 	}
 	log.Println("Formed query\n\t", sql, "\nwith args\n\t", args)
 
-	rows, err := s.db.Query(sql, args...)
+	rows, err := conn(ctx, s.db).Query(sql, args...)
 	if err != nil {
 		return nil, apperrors.ErrCouldNotGetTaskComments
 	}
@@ -126,7 +145,7 @@ func (s *PostgresCommentStorage) ReadMany(ctx context.Context, ids dto.CommentID
 	}
 	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	rows, err := s.db.Query(query, args...)
+	rows, err := conn(ctx, s.db).Query(query, args...)
 	if err != nil {
 		return nil, apperrors.ErrCouldNotGetComments
 	}
@@ -34,15 +34,45 @@ func TestPostgresCommentStorage_Create(t *testing.T) {
 					Text:   "dfdfdfdf",
 				},
 				query: func(mock sqlmock.Sqlmock, args args) {
-					query, _, _ := sq.
-						Insert("public.comment").
-						Columns(newCommentFields...).
-						Values(args.info.TaskID, args.info.UserID, args.info.Text).
-						PlaceholderFormat(sq.Dollar).
-						Suffix("RETURNING id, date_created").
-						ToSql()
-					mock.ExpectQuery(regexp.QuoteMeta(query)).
+					mock.ExpectQuery(regexp.QuoteMeta("WITH watched AS (INSERT INTO public.task_watcher (id_task,id_user) "+
+						"SELECT $1::integer, public.user.id FROM public.user WHERE (public.user.id = $2) ON CONFLICT DO NOTHING) "+
+						"INSERT INTO public.comment (id_task,id_user,content) VALUES ($3,$4,$5) RETURNING id, date_created")).
 						WithArgs(
+							args.info.TaskID,
+							args.info.UserID,
+							args.info.TaskID,
+							args.info.UserID,
+							args.info.Text,
+						).
+						WillReturnRows(sqlmock.NewRows([]string{"id", "date_created"}).
+							AddRow(1, time.Now()),
+						)
+				},
+			},
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "Mentioned board members watch the task",
+			args: args{
+				info: dto.NewCommentInfo{
+					UserID:   1,
+					TaskID:   2,
+					Text:     "@bob@example.com look",
+					Mentions: []string{"bob@example.com"},
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectQuery(regexp.QuoteMeta("WITH watched AS (INSERT INTO public.task_watcher (id_task,id_user) "+
+						"SELECT $1::integer, public.user.id FROM public.user WHERE (public.user.id = $2 OR "+
+						"(lower(public.user.email) IN ($3) AND public.user.id IN (SELECT public.board_user.id_user FROM public.board_user "+
+						"JOIN public.list ON public.list.id_board = public.board_user.id_board "+
+						"JOIN public.task ON public.task.id_list = public.list.id WHERE public.task.id = $4))) ON CONFLICT DO NOTHING) "+
+						"INSERT INTO public.comment (id_task,id_user,content) VALUES ($5,$6,$7) RETURNING id, date_created")).
+						WithArgs(
+							args.info.TaskID,
+							args.info.UserID,
+							"bob@example.com",
+							args.info.TaskID,
 							args.info.TaskID,
 							args.info.UserID,
 							args.info.Text,
@@ -64,15 +94,12 @@ func TestPostgresCommentStorage_Create(t *testing.T) {
 					Text:   "dfdfdfdf",
 				},
 				query: func(mock sqlmock.Sqlmock, args args) {
-					query, _, _ := sq.
-						Insert("public.comment").
-						Columns(newCommentFields...).
-						Values(args.info.TaskID, args.info.UserID, args.info.Text).
-						PlaceholderFormat(sq.Dollar).
-						Suffix("RETURNING id, date_created").
-						ToSql()
-					mock.ExpectQuery(regexp.QuoteMeta(query)).
+					mock.ExpectQuery(regexp.QuoteMeta("WITH watched AS (INSERT INTO public.task_watcher (id_task,id_user) "+
+						"SELECT $1::integer, public.user.id FROM public.user WHERE (public.user.id = $2) ON CONFLICT DO NOTHING) "+
+						"INSERT INTO public.comment (id_task,id_user,content) VALUES ($3,$4,$5) RETURNING id, date_created")).
 						WithArgs(
+							args.info.TaskID,
+							args.info.UserID,
 							args.info.TaskID,
 							args.info.UserID,
 							args.info.Text,
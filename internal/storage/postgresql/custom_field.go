@@ -0,0 +1,582 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	"server/internal/pkg/dto"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// customFieldValueCondition
+// подзапрос, проверяющий значение поля у задания; значение доступно под псевдонимом value_filter
+const customFieldValueCondition = "EXISTS (SELECT 1 FROM public.task_custom_field_value AS value_filter " +
+	"WHERE value_filter.id_task = public.task.id AND value_filter.id_field = ? AND %s)"
+
+// PostgresCustomFieldStorage
+// Хранилище данных в PostgreSQL
+type PostgresCustomFieldStorage struct {
+	db *sql.DB
+}
+
+// NewCustomFieldStorage
+// возвращает PostgreSQL хранилище пользовательских полей
+func NewCustomFieldStorage(db *sql.DB) *PostgresCustomFieldStorage {
+	return &PostgresCustomFieldStorage{
+		db: db,
+	}
+}
+
+// Create
+// создает новое пользовательское поле доски
+// или возвращает ошибки ...
+func (s PostgresCustomFieldStorage) Create(ctx context.Context, info dto.NewCustomFieldInfo) (*dto.CustomFieldInfo, error) {
+	funcName := "PostgresCustomFieldStorage.Create"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.
+		Insert("public.custom_field").
+		Columns("id_board", "name", "field_type", "options", "list_position").
+		Values(info.BoardID, info.Name, info.Type, pq.StringArray(info.Options), info.ListPosition).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		logger.DebugFmt("Failed to build query with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	field := dto.CustomFieldInfo{
+		BoardID:      info.BoardID,
+		Name:         info.Name,
+		Type:         info.Type,
+		Options:      info.Options,
+		ListPosition: info.ListPosition,
+	}
+	err = conn(ctx, s.db).QueryRow(query, args...).Scan(&field.ID)
+	if err != nil {
+		logger.DebugFmt("Insert failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCustomFieldNotCreated
+	}
+	logger.DebugFmt("Custom field created", requestID.String(), funcName, nodeName)
+
+	return &field, nil
+}
+
+// Read
+// возвращает пользовательское поле по id
+// или возвращает ошибки ...
+func (s PostgresCustomFieldStorage) Read(ctx context.Context, id dto.CustomFieldID) (*dto.CustomFieldInfo, error) {
+	funcName := "PostgresCustomFieldStorage.Read"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select(allCustomFieldFields...).
+		From("public.custom_field").
+		Where(sq.Eq{"public.custom_field.id": id.Value}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		logger.DebugFmt("Failed to build query with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	field, err := scanCustomField(conn(ctx, s.db).QueryRow(query, args...))
+	if err != nil {
+		logger.DebugFmt("Failed to get custom field with error "+err.Error(), requestID.String(), funcName, nodeName)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrCustomFieldNotFound
+		}
+		return nil, apperrors.ErrCouldNotGetCustomField
+	}
+	logger.DebugFmt("Got custom field", requestID.String(), funcName, nodeName)
+
+	return &field, nil
+}
+
+// ReadMany
+// возвращает пользовательские поля доски
+// или возвращает ошибки ...
+func (s PostgresCustomFieldStorage) ReadMany(ctx context.Context, id dto.BoardID) (*[]dto.CustomFieldInfo, error) {
+	funcName := "PostgresCustomFieldStorage.ReadMany"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select(allCustomFieldFields...).
+		From("public.custom_field").
+		Where(sq.Eq{"public.custom_field.id_board": id.Value}).
+		OrderBy("public.custom_field.list_position", "public.custom_field.id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		logger.DebugFmt("Failed to build query with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	rows, err := conn(ctx, s.db).Query(query, args...)
+	if err != nil {
+		logger.DebugFmt("Query failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotGetCustomField
+	}
+	defer rows.Close()
+	logger.DebugFmt("Got custom field rows", requestID.String(), funcName, nodeName)
+
+	fields := []dto.CustomFieldInfo{}
+	for rows.Next() {
+		field, err := scanCustomField(rows)
+		if err != nil {
+			logger.DebugFmt("Failed to scan custom field with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotGetCustomField
+		}
+		fields = append(fields, field)
+	}
+	logger.DebugFmt("Collected custom field rows", requestID.String(), funcName, nodeName)
+
+	return &fields, nil
+}
+
+// Update
+// обновляет пользовательское поле и удаляет значения, ссылающиеся на удалённые варианты выбора
+// или возвращает ошибки ...
+func (s PostgresCustomFieldStorage) Update(ctx context.Context, info dto.UpdatedCustomFieldInfo) error {
+	funcName := "PostgresCustomFieldStorage.Update"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.
+		Update("public.custom_field").
+		Set("name", info.Name).
+		Set("options", pq.StringArray(info.Options)).
+		Set("list_position", info.ListPosition).
+		Where(sq.Eq{"id": info.ID}).
+		Suffix("RETURNING field_type").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		logger.DebugFmt("Failed to build query with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotBeginTransaction
+	}
+	logger.DebugFmt("Transaction started", requestID.String(), funcName, nodeName)
+
+	var fieldType string
+	err = tx.QueryRow(query, args...).Scan(&fieldType)
+	if err != nil {
+		logger.DebugFmt("Update failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+errRollback.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotRollback
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrCustomFieldNotFound
+		}
+		return apperrors.ErrCustomFieldNotUpdated
+	}
+	logger.DebugFmt("Custom field updated", requestID.String(), funcName, nodeName)
+
+	for _, builder := range staleCustomFieldValueQueries(info, fieldType) {
+		query, args, err := builder.ToSql()
+		if err == nil {
+			logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+			_, err = tx.Exec(query, args...)
+		}
+		if err != nil {
+			logger.DebugFmt("Removing stale values failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			if errRollback := tx.Rollback(); errRollback != nil {
+				logger.DebugFmt("Transaction rollback failed with error "+errRollback.Error(), requestID.String(), funcName, nodeName)
+				return apperrors.ErrCouldNotRollback
+			}
+			return apperrors.ErrCustomFieldNotUpdated
+		}
+	}
+	logger.DebugFmt("Stale values removed", requestID.String(), funcName, nodeName)
+
+	err = tx.Commit()
+	if err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotCommit
+	}
+	logger.DebugFmt("Changes commited", requestID.String(), funcName, nodeName)
+
+	return nil
+}
+
+// Delete
+// удаляет пользовательское поле вместе с его значениями
+// или возвращает ошибки ...
+func (s PostgresCustomFieldStorage) Delete(ctx context.Context, id dto.CustomFieldID) error {
+	funcName := "PostgresCustomFieldStorage.Delete"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.
+		Delete("public.custom_field").
+		Where(sq.Eq{"id": id.Value}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		logger.DebugFmt("Failed to build query with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	result, err := conn(ctx, s.db).Exec(query, args...)
+	if err != nil {
+		logger.DebugFmt("Delete failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCustomFieldNotDeleted
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return apperrors.ErrCustomFieldNotDeleted
+	}
+	if affected == 0 {
+		return apperrors.ErrCustomFieldNotFound
+	}
+	logger.DebugFmt("Custom field deleted", requestID.String(), funcName, nodeName)
+
+	return nil
+}
+
+// SetValue
+// сохраняет значение поля у задания (пустое значение удаляет его) и возвращает предыдущее значение
+// или возвращает ошибки ...
+func (s PostgresCustomFieldStorage) SetValue(ctx context.Context, info dto.CustomFieldValueInfo) (interface{}, error) {
+	funcName := "PostgresCustomFieldStorage.SetValue"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	sameBoard := sq.Select("1").
+		From("public.task").
+		Join("public.list ON public.list.id = public.task.id_list").
+		Join("public.custom_field ON public.custom_field.id_board = public.list.id_board").
+		Where(sq.Eq{"public.task.id": info.TaskID, "public.custom_field.id": info.FieldID}).
+		Prefix("SELECT EXISTS(").
+		Suffix(")")
+	checkQuery, checkArgs, err := sameBoard.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		logger.DebugFmt("Failed to build query with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+checkQuery+"\nwith args\n\t"+fmt.Sprintf("%+v", checkArgs), requestID.String(), funcName, nodeName)
+
+	previousQuery, previousArgs, err := sq.Select("value").
+		From("public.task_custom_field_value").
+		Where(sq.Eq{"id_task": info.TaskID, "id_field": info.FieldID}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		logger.DebugFmt("Failed to build query with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+previousQuery+"\nwith args\n\t"+fmt.Sprintf("%+v", previousArgs), requestID.String(), funcName, nodeName)
+
+	var query string
+	var args []interface{}
+	if info.Value == nil {
+		query, args, err = sq.Delete("public.task_custom_field_value").
+			Where(sq.Eq{"id_task": info.TaskID, "id_field": info.FieldID}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+	} else {
+		var value []byte
+		value, err = json.Marshal(info.Value)
+		if err != nil {
+			logger.DebugFmt("Failed to encode value with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrInvalidCustomFieldValue
+		}
+		query, args, err = sq.Insert("public.task_custom_field_value").
+			Columns("id_task", "id_field", "value").
+			Values(info.TaskID, info.FieldID, sq.Expr("?::jsonb", string(value))).
+			Suffix("ON CONFLICT (id_task, id_field) DO UPDATE SET value = EXCLUDED.value").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+	}
+	if err != nil {
+		logger.DebugFmt("Failed to build query with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBeginTransaction
+	}
+	logger.DebugFmt("Transaction started", requestID.String(), funcName, nodeName)
+
+	rollback := func(reason error) (interface{}, error) {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotRollback
+		}
+		return nil, reason
+	}
+
+	var inBoard bool
+	err = tx.QueryRow(checkQuery, checkArgs...).Scan(&inBoard)
+	if err != nil {
+		logger.DebugFmt("Board check failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrCouldNotSetCustomFieldValue)
+	}
+	if !inBoard {
+		return rollback(apperrors.ErrCustomFieldNotInBoard)
+	}
+	logger.DebugFmt("Field and task belong to the same board", requestID.String(), funcName, nodeName)
+
+	var previous interface{}
+	var rawPrevious []byte
+	err = tx.QueryRow(previousQuery, previousArgs...).Scan(&rawPrevious)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		logger.DebugFmt("Reading previous value failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrCouldNotSetCustomFieldValue)
+	}
+	if err == nil {
+		if err = json.Unmarshal(rawPrevious, &previous); err != nil {
+			logger.DebugFmt("Failed to decode previous value with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return rollback(apperrors.ErrCouldNotSetCustomFieldValue)
+		}
+	}
+	logger.DebugFmt("Got previous value", requestID.String(), funcName, nodeName)
+
+	_, err = tx.Exec(query, args...)
+	if err != nil {
+		logger.DebugFmt("Saving value failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrCouldNotSetCustomFieldValue)
+	}
+	logger.DebugFmt("Value saved", requestID.String(), funcName, nodeName)
+
+	err = tx.Commit()
+	if err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotCommit
+	}
+	logger.DebugFmt("Changes commited", requestID.String(), funcName, nodeName)
+
+	return previous, nil
+}
+
+// ReadValues
+// возвращает значения пользовательских полей у всех заданий доски
+// или возвращает ошибки ...
+func (s PostgresCustomFieldStorage) ReadValues(ctx context.Context, id dto.BoardID) (*[]dto.CustomFieldValueInfo, error) {
+	funcName := "PostgresCustomFieldStorage.ReadValues"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select(allCustomFieldValueFields...).
+		From("public.task_custom_field_value").
+		Join("public.custom_field ON public.custom_field.id = public.task_custom_field_value.id_field").
+		Where(sq.Eq{"public.custom_field.id_board": id.Value}).
+		OrderBy("public.task_custom_field_value.id_task", "public.custom_field.list_position", "public.custom_field.id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		logger.DebugFmt("Failed to build query with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	rows, err := conn(ctx, s.db).Query(query, args...)
+	if err != nil {
+		logger.DebugFmt("Query failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotGetCustomField
+	}
+	defer rows.Close()
+	logger.DebugFmt("Got custom field value rows", requestID.String(), funcName, nodeName)
+
+	values := []dto.CustomFieldValueInfo{}
+	for rows.Next() {
+		var value dto.CustomFieldValueInfo
+		var rawValue []byte
+		err = rows.Scan(&value.TaskID, &value.FieldID, &rawValue)
+		if err == nil {
+			err = json.Unmarshal(rawValue, &value.Value)
+		}
+		if err != nil {
+			logger.DebugFmt("Failed to scan custom field value with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotGetCustomField
+		}
+		values = append(values, value)
+	}
+	logger.DebugFmt("Collected custom field value rows", requestID.String(), funcName, nodeName)
+
+	return &values, nil
+}
+
+// FilterTasks
+// возвращает id заданий, значения полей которых подходят под все условия
+// или возвращает ошибки ...
+func (s PostgresCustomFieldStorage) FilterTasks(ctx context.Context, filter dto.CustomFieldTaskFilter) ([]string, error) {
+	funcName := "PostgresCustomFieldStorage.FilterTasks"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	if len(filter.TaskIDs) == 0 {
+		return []string{}, nil
+	}
+
+	conditions := sq.And{sq.Eq{"public.task.id": filter.TaskIDs}}
+	for _, fieldFilter := range filter.Filters {
+		condition, err := customFieldFilterCondition(fieldFilter)
+		if err != nil {
+			logger.DebugFmt("Failed to build filter with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	query, args, err := sq.Select("public.task.id").
+		From("public.task").
+		Where(conditions).
+		OrderBy("public.task.id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		logger.DebugFmt("Failed to build query with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	rows, err := conn(ctx, s.db).Query(query, args...)
+	if err != nil {
+		logger.DebugFmt("Query failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotGetTask
+	}
+	defer rows.Close()
+
+	taskIDs := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			logger.DebugFmt("Failed to scan task id with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotGetTask
+		}
+		taskIDs = append(taskIDs, id)
+	}
+	logger.DebugFmt("Collected filtered task ids", requestID.String(), funcName, nodeName)
+
+	return taskIDs, nil
+}
+
+// customFieldFilterCondition
+// возвращает условие на задание (public.task) для фильтра по значению пользовательского поля
+func customFieldFilterCondition(filter dto.CustomFieldFilter) (sq.Sqlizer, error) {
+	conditions := sq.And{}
+	negate := false
+
+	switch filter.Type {
+	case dto.CustomFieldText:
+		text, _ := filter.Value.(string)
+		conditions = append(conditions, sq.Expr("value_filter.value #>> '{}' ILIKE ?", "%"+escapeLike(text)+"%"))
+	case dto.CustomFieldNumber:
+		if filter.Value != nil {
+			conditions = append(conditions, sq.Expr("(value_filter.value #>> '{}')::numeric = ?", filter.Value))
+		}
+		if filter.From != nil {
+			conditions = append(conditions, sq.Expr("(value_filter.value #>> '{}')::numeric >= ?", filter.From))
+		}
+		if filter.To != nil {
+			conditions = append(conditions, sq.Expr("(value_filter.value #>> '{}')::numeric <= ?", filter.To))
+		}
+	case dto.CustomFieldDate:
+		if filter.Value != nil {
+			conditions = append(conditions, sq.Expr("value_filter.value #>> '{}' = ?", filter.Value))
+		}
+		if filter.From != nil {
+			conditions = append(conditions, sq.Expr("value_filter.value #>> '{}' >= ?", filter.From))
+		}
+		if filter.To != nil {
+			conditions = append(conditions, sq.Expr("value_filter.value #>> '{}' <= ?", filter.To))
+		}
+	case dto.CustomFieldCheckbox:
+		// незаполненный флажок считается снятым
+		checked, _ := filter.Value.(bool)
+		negate = !checked
+		conditions = append(conditions, sq.Expr("value_filter.value = 'true'::jsonb"))
+	case dto.CustomFieldSingleSelect, dto.CustomFieldMultiSelect:
+		value, err := json.Marshal(filter.Value)
+		if err != nil {
+			return nil, apperrors.ErrInvalidCustomFieldValue
+		}
+		conditions = append(conditions, sq.Expr("value_filter.value @> ?::jsonb", string(value)))
+	default:
+		return nil, apperrors.ErrInvalidCustomFieldValue
+	}
+	if len(conditions) == 0 {
+		return nil, apperrors.ErrInvalidCustomFieldValue
+	}
+
+	inner, args, err := conditions.ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	condition := fmt.Sprintf(customFieldValueCondition, inner)
+	if negate {
+		condition = "NOT " + condition
+	}
+	return sq.Expr(condition, append([]interface{}{filter.FieldID}, args...)...), nil
+}
+
+// staleCustomFieldValueQueries
+// возвращает запросы, удаляющие из значений поля с выбором варианты, которых больше нет
+func staleCustomFieldValueQueries(info dto.UpdatedCustomFieldInfo, fieldType string) []sq.Sqlizer {
+	options := pq.StringArray(info.Options)
+	switch fieldType {
+	case dto.CustomFieldSingleSelect:
+		return []sq.Sqlizer{
+			sq.Delete("public.task_custom_field_value").
+				Where(sq.Eq{"id_field": info.ID}).
+				Where(sq.Expr("NOT (value #>> '{}' = ANY(?))", options)).
+				PlaceholderFormat(sq.Dollar),
+		}
+	case dto.CustomFieldMultiSelect:
+		return []sq.Sqlizer{
+			sq.Update("public.task_custom_field_value").
+				Set("value", sq.Expr("(SELECT COALESCE(jsonb_agg(option), '[]'::jsonb) FROM jsonb_array_elements(value) AS option "+
+					"WHERE option #>> '{}' = ANY(?))", options)).
+				Where(sq.Eq{"id_field": info.ID}).
+				PlaceholderFormat(sq.Dollar),
+			sq.Delete("public.task_custom_field_value").
+				Where(sq.Eq{"id_field": info.ID}).
+				Where(sq.Expr("value = '[]'::jsonb")).
+				PlaceholderFormat(sq.Dollar),
+		}
+	}
+	return nil
+}
+
+func scanCustomField(row interface{ Scan(...interface{}) error }) (dto.CustomFieldInfo, error) {
+	var field dto.CustomFieldInfo
+	err := row.Scan(
+		&field.ID,
+		&field.BoardID,
+		&field.Name,
+		&field.Type,
+		(*pq.StringArray)(&field.Options),
+		&field.ListPosition,
+	)
+	return field, err
+}
+
+func escapeLike(text string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(text)
+}
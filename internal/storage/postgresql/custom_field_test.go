@@ -0,0 +1,324 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"regexp"
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+func TestPostgresCustomFieldStorage_Read(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		id    dto.CustomFieldID
+		query func(mock sqlmock.Sqlmock, args args)
+		ctx   context.Context
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *dto.CustomFieldInfo
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "Happy path",
+			args: args{
+				id: dto.CustomFieldID{Value: 1},
+				ctx: context.WithValue(
+					context.WithValue(
+						context.Background(),
+						dto.LoggerKey, getLogger()),
+					dto.RequestIDKey, uuid.New(),
+				),
+				query: func(mock sqlmock.Sqlmock, args args) {
+					query, _, _ := sq.Select(allCustomFieldFields...).
+						From("public.custom_field").
+						Where(sq.Eq{"public.custom_field.id": args.id.Value}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectQuery(regexp.QuoteMeta(query)).
+						WithArgs(args.id.Value).
+						WillReturnRows(sqlmock.NewRows([]string{"id", "id_board", "name", "field_type", "options", "list_position"}).
+							AddRow(1, 2, "Priority", dto.CustomFieldSingleSelect, "{low,high}", 0),
+						)
+				},
+			},
+			want: &dto.CustomFieldInfo{
+				ID:      1,
+				BoardID: 2,
+				Name:    "Priority",
+				Type:    dto.CustomFieldSingleSelect,
+				Options: []string{"low", "high"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Field not found",
+			args: args{
+				id: dto.CustomFieldID{Value: 1},
+				ctx: context.WithValue(
+					context.WithValue(
+						context.Background(),
+						dto.LoggerKey, getLogger()),
+					dto.RequestIDKey, uuid.New(),
+				),
+				query: func(mock sqlmock.Sqlmock, args args) {
+					query, _, _ := sq.Select(allCustomFieldFields...).
+						From("public.custom_field").
+						Where(sq.Eq{"public.custom_field.id": args.id.Value}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectQuery(regexp.QuoteMeta(query)).
+						WithArgs(args.id.Value).
+						WillReturnRows(sqlmock.NewRows([]string{"id", "id_board", "name", "field_type", "options", "list_position"}))
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrCustomFieldNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			tt.args.query(mock, tt.args)
+
+			s := NewCustomFieldStorage(db)
+
+			got, err := s.Read(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PostgresCustomFieldStorage.Read() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, tt.err) {
+				t.Errorf("PostgresCustomFieldStorage.Read() error = %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PostgresCustomFieldStorage.Read() = %v, want %v", got, tt.want)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPostgresCustomFieldStorage_SetValue(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		info  dto.CustomFieldValueInfo
+		query func(mock sqlmock.Sqlmock, args args)
+		ctx   context.Context
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "Happy path",
+			args: args{
+				info: dto.CustomFieldValueInfo{TaskID: 1, FieldID: 2, Value: "high"},
+				ctx: context.WithValue(
+					context.WithValue(
+						context.Background(),
+						dto.LoggerKey, getLogger()),
+					dto.RequestIDKey, uuid.New(),
+				),
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta("SELECT EXISTS( SELECT 1 FROM public.task")).
+						WithArgs(args.info.FieldID, args.info.TaskID).
+						WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+					mock.ExpectQuery(regexp.QuoteMeta("SELECT value FROM public.task_custom_field_value")).
+						WithArgs(args.info.FieldID, args.info.TaskID).
+						WillReturnRows(sqlmock.NewRows([]string{"value"}).AddRow([]byte(`"low"`)))
+					mock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.task_custom_field_value (id_task,id_field,value) VALUES ($1,$2,$3::jsonb) "+
+						"ON CONFLICT (id_task, id_field) DO UPDATE SET value = EXCLUDED.value")).
+						WithArgs(args.info.TaskID, args.info.FieldID, `"high"`).
+						WillReturnResult(sqlmock.NewResult(1, 1))
+					mock.ExpectCommit()
+				},
+			},
+			want:    "low",
+			wantErr: false,
+		},
+		{
+			name: "Clearing value",
+			args: args{
+				info: dto.CustomFieldValueInfo{TaskID: 1, FieldID: 2},
+				ctx: context.WithValue(
+					context.WithValue(
+						context.Background(),
+						dto.LoggerKey, getLogger()),
+					dto.RequestIDKey, uuid.New(),
+				),
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta("SELECT EXISTS( SELECT 1 FROM public.task")).
+						WithArgs(args.info.FieldID, args.info.TaskID).
+						WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+					mock.ExpectQuery(regexp.QuoteMeta("SELECT value FROM public.task_custom_field_value")).
+						WithArgs(args.info.FieldID, args.info.TaskID).
+						WillReturnRows(sqlmock.NewRows([]string{"value"}))
+					mock.ExpectExec(regexp.QuoteMeta("DELETE FROM public.task_custom_field_value")).
+						WithArgs(args.info.FieldID, args.info.TaskID).
+						WillReturnResult(sqlmock.NewResult(0, 0))
+					mock.ExpectCommit()
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "Field from another board",
+			args: args{
+				info: dto.CustomFieldValueInfo{TaskID: 1, FieldID: 2, Value: true},
+				ctx: context.WithValue(
+					context.WithValue(
+						context.Background(),
+						dto.LoggerKey, getLogger()),
+					dto.RequestIDKey, uuid.New(),
+				),
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta("SELECT EXISTS( SELECT 1 FROM public.task")).
+						WithArgs(args.info.FieldID, args.info.TaskID).
+						WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrCustomFieldNotInBoard,
+		},
+		{
+			name: "Beginning transaction failed",
+			args: args{
+				info: dto.CustomFieldValueInfo{TaskID: 1, FieldID: 2, Value: true},
+				ctx: context.WithValue(
+					context.WithValue(
+						context.Background(),
+						dto.LoggerKey, getLogger()),
+					dto.RequestIDKey, uuid.New(),
+				),
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin().WillReturnError(apperrors.ErrCouldNotBeginTransaction)
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrCouldNotBeginTransaction,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			tt.args.query(mock, tt.args)
+
+			s := NewCustomFieldStorage(db)
+
+			got, err := s.SetValue(tt.args.ctx, tt.args.info)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PostgresCustomFieldStorage.SetValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, tt.err) {
+				t.Errorf("PostgresCustomFieldStorage.SetValue() error = %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PostgresCustomFieldStorage.SetValue() = %v, want %v", got, tt.want)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_customFieldFilterCondition(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		filter   dto.CustomFieldFilter
+		wantSQL  string
+		wantArgs []interface{}
+		wantErr  bool
+	}{
+		{
+			name:     "Number range",
+			filter:   dto.CustomFieldFilter{FieldID: 3, Type: dto.CustomFieldNumber, From: 1.0, To: 5.0},
+			wantSQL:  "(value_filter.value #>> '{}')::numeric >= ? AND (value_filter.value #>> '{}')::numeric <= ?",
+			wantArgs: []interface{}{uint64(3), 1.0, 5.0},
+		},
+		{
+			name:     "Text escapes wildcards",
+			filter:   dto.CustomFieldFilter{FieldID: 3, Type: dto.CustomFieldText, Value: "50%_off"},
+			wantSQL:  "value_filter.value #>> '{}' ILIKE ?",
+			wantArgs: []interface{}{uint64(3), `%50\%\_off%`},
+		},
+		{
+			name:     "Unchecked checkbox",
+			filter:   dto.CustomFieldFilter{FieldID: 3, Type: dto.CustomFieldCheckbox, Value: false},
+			wantSQL:  "NOT EXISTS",
+			wantArgs: []interface{}{uint64(3)},
+		},
+		{
+			name:     "Multi select",
+			filter:   dto.CustomFieldFilter{FieldID: 3, Type: dto.CustomFieldMultiSelect, Value: []string{"a", "b"}},
+			wantSQL:  "value_filter.value @> ?::jsonb",
+			wantArgs: []interface{}{uint64(3), `["a","b"]`},
+		},
+		{
+			name:    "Number without bounds",
+			filter:  dto.CustomFieldFilter{FieldID: 3, Type: dto.CustomFieldNumber},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			condition, err := customFieldFilterCondition(tt.filter)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("customFieldFilterCondition() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			query, args, err := condition.ToSql()
+			if err != nil {
+				t.Fatalf("ToSql() error = %v", err)
+			}
+			if !regexp.MustCompile(regexp.QuoteMeta(tt.wantSQL)).MatchString(query) {
+				t.Errorf("customFieldFilterCondition() sql = %v, want it to contain %v", query, tt.wantSQL)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("customFieldFilterCondition() args = %v, want %v", args, tt.wantArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,1242 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	"server/internal/pkg/dto"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+)
+
+// historySnapshotSource
+// описание того, откуда брать строку сущности и как дойти от неё до доски
+type historySnapshotSource struct {
+	table       string
+	joins       []string
+	boardColumn string
+	// taskColumn задание, к которому относится сущность; его наблюдатели получают уведомления об изменениях сущности
+	taskColumn string
+	// versioned сущность хранит row_version, который нужно увеличивать при восстановлении
+	versioned bool
+	// children зависимые строки, удаляемые вместе с сущностью, в виде "таблица WHERE условие"
+	children []string
+}
+
+// historySnapshotSources
+// источники снимков для каждого типа сущности; строка сущности всегда доступна под псевдонимом entity
+var historySnapshotSources = map[string]historySnapshotSource{
+	dto.HistoryEntityBoard: {
+		table:       "public.board",
+		boardColumn: "entity.id",
+		versioned:   true,
+	},
+	dto.HistoryEntityList: {
+		table:       "public.list",
+		boardColumn: "entity.id_board",
+		versioned:   true,
+		children:    []string{"public.task WHERE public.task.id_list = entity.id"},
+	},
+	dto.HistoryEntityTask: {
+		table:       "public.task",
+		joins:       []string{"public.list ON public.list.id = entity.id_list"},
+		boardColumn: "public.list.id_board",
+		taskColumn:  "entity.id",
+		versioned:   true,
+		children: []string{
+			"public.task AS subtask WHERE subtask.id_parent = entity.id",
+			"public.checklist WHERE public.checklist.id_task = entity.id",
+			"public.comment WHERE public.comment.id_task = entity.id",
+			"public.task_user WHERE public.task_user.id_task = entity.id",
+			"public.tag_task WHERE public.tag_task.id_task = entity.id",
+			"public.task_file WHERE public.task_file.id_task = entity.id",
+			"public.task_custom_field_value WHERE public.task_custom_field_value.id_task = entity.id",
+			"public.task_dependency WHERE entity.id IN (public.task_dependency.id_blocker, public.task_dependency.id_blocked)",
+			"public.worklog WHERE public.worklog.id_task = entity.id",
+		},
+	},
+	dto.HistoryEntityChecklist: {
+		table: "public.checklist",
+		joins: []string{
+			"public.task ON public.task.id = entity.id_task",
+			"public.list ON public.list.id = public.task.id_list",
+		},
+		boardColumn: "public.list.id_board",
+		taskColumn:  "public.task.id",
+		children:    []string{"public.checklist_item WHERE public.checklist_item.id_checklist = entity.id"},
+	},
+	dto.HistoryEntityChecklistItem: {
+		table: "public.checklist_item",
+		joins: []string{
+			"public.checklist ON public.checklist.id = entity.id_checklist",
+			"public.task ON public.task.id = public.checklist.id_task",
+			"public.list ON public.list.id = public.task.id_list",
+		},
+		boardColumn: "public.list.id_board",
+		taskColumn:  "public.task.id",
+	},
+	dto.HistoryEntityComment: {
+		table: "public.comment",
+		joins: []string{
+			"public.task ON public.task.id = entity.id_task",
+			"public.list ON public.list.id = public.task.id_list",
+		},
+		boardColumn: "public.list.id_board",
+		taskColumn:  "public.task.id",
+		children: []string{
+			"public.comment_reply WHERE public.comment_reply.id_comment = entity.id",
+			"public.reaction WHERE public.reaction.id_comment = entity.id",
+		},
+	},
+	dto.HistoryEntityTag: {
+		table:       "public.tag",
+		joins:       []string{"public.tag_board ON public.tag_board.id_tag = entity.id"},
+		boardColumn: "public.tag_board.id_board",
+		children:    []string{"public.tag_task WHERE public.tag_task.id_tag = entity.id"},
+	},
+	dto.HistoryEntityCustomField: {
+		table:       "public.custom_field",
+		boardColumn: "entity.id_board",
+		children:    []string{"public.task_custom_field_value WHERE public.task_custom_field_value.id_field = entity.id"},
+	},
+}
+
+// childrenColumn
+// возвращает выражение с числом зависимых строк сущности или NULL, если их у сущности не бывает
+func (source historySnapshotSource) childrenColumn() string {
+	if len(source.children) == 0 {
+		return "NULL::bigint"
+	}
+	counts := make([]string, 0, len(source.children))
+	for _, child := range source.children {
+		counts = append(counts, "(SELECT count(*) FROM "+child+")")
+	}
+	return strings.Join(counts, " + ")
+}
+
+// watchersColumn
+// возвращает выражение с JSON-массивом наблюдателей задания сущности или NULL, если сущность не относится к заданию
+func (source historySnapshotSource) watchersColumn() string {
+	if source.taskColumn == "" {
+		return "NULL::json"
+	}
+	return "to_json(ARRAY(SELECT id_user FROM public.task_watcher WHERE id_task = " + source.taskColumn + " ORDER BY id_user))"
+}
+
+// PostgresHistoryStorage
+// Хранилище данных в PostgreSQL
+type PostgresHistoryStorage struct {
+	db *sql.DB
+}
+
+// NewHistoryStorage
+// возвращает PostgreSQL хранилище истории изменений
+func NewHistoryStorage(db *sql.DB) *PostgresHistoryStorage {
+	return &PostgresHistoryStorage{
+		db: db,
+	}
+}
+
+// Transaction
+// выполняет fn в одной транзакции: запросы хранилищ, получивших контекст fn, выполняются в ней,
+// а ошибка fn откатывает их все
+// или возвращает ошибки ...
+func (s PostgresHistoryStorage) Transaction(ctx context.Context, fn func(context.Context) error) error {
+	return runInTx(ctx, s.db, fn)
+}
+
+// Snapshot
+// возвращает текущее состояние сущности и ID её доски. В транзакции строка сущности блокируется до её конца,
+// чтобы состояния до и после изменения не включали изменения других запросов
+// или возвращает ошибки ...
+func (s PostgresHistoryStorage) Snapshot(ctx context.Context, ref dto.HistoryEntityRef) (*dto.EntitySnapshot, error) {
+	funcName := "PostgresHistoryStorage.Snapshot"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	source, ok := historySnapshotSources[ref.EntityType]
+	if !ok {
+		return nil, apperrors.ErrUnknownHistoryEntity
+	}
+
+	taskColumn := "NULL::integer"
+	if source.taskColumn != "" {
+		taskColumn = source.taskColumn
+	}
+	builder := sq.Select("row_to_json(entity)", source.boardColumn, taskColumn, source.childrenColumn(), source.watchersColumn()).
+		From(source.table + " AS entity")
+	for _, join := range source.joins {
+		builder = builder.Join(join)
+	}
+	query, args, err := builder.
+		Where(sq.Eq{"entity.id": ref.EntityID}).
+		OrderBy(source.boardColumn).
+		Limit(1).
+		Suffix("FOR UPDATE OF entity").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		logger.DebugFmt("Failed to build query with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	var rawFields, rawWatchers []byte
+	snapshot := dto.EntitySnapshot{}
+	err = conn(ctx, s.db).QueryRow(query, args...).Scan(&rawFields, &snapshot.BoardID, &snapshot.TaskID, &snapshot.Children, &rawWatchers)
+	if err != nil {
+		logger.DebugFmt("Snapshot query failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrHistoryEntityNotFound
+		}
+		return nil, apperrors.ErrCouldNotExecuteQuery
+	}
+	logger.DebugFmt("Got entity row", requestID.String(), funcName, nodeName)
+
+	err = json.Unmarshal(rawFields, &snapshot.Fields)
+	if err != nil {
+		logger.DebugFmt("Failed to decode entity row with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotScanRows
+	}
+	if rawWatchers != nil {
+		err = json.Unmarshal(rawWatchers, &snapshot.Watchers)
+		if err != nil {
+			logger.DebugFmt("Failed to decode task watchers with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotScanRows
+		}
+	}
+
+	return &snapshot, nil
+}
+
+// Record
+// записывает изменение в историю доски и уведомляет о нём наблюдателей доски и затронутых заданий
+// или возвращает ошибки ...
+func (s PostgresHistoryStorage) Record(ctx context.Context, entry dto.NewHistoryEntry) error {
+	funcName := "PostgresHistoryStorage.Record"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := buildHistoryInsert(entry)
+	if err != nil {
+		logger.DebugFmt("Failed to build query with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return err
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotBeginTransaction
+	}
+
+	rollback := func(reason error) error {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotRollback
+		}
+		return reason
+	}
+
+	var entryID uint64
+	if err = tx.QueryRow(query, args...).Scan(&entryID); err != nil {
+		logger.DebugFmt("Insert failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrCouldNotRecordHistory)
+	}
+	logger.DebugFmt("query executed", requestID.String(), funcName, nodeName)
+
+	if err = notifyWatchers(ctx, tx, entryID, entry); err != nil {
+		return rollback(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotCommit
+	}
+
+	return nil
+}
+
+// Read
+// возвращает запись из истории изменений по её ID
+// или возвращает ошибки ...
+func (s PostgresHistoryStorage) Read(ctx context.Context, id dto.HistoryEntryID) (*dto.BoardHistoryEntry, error) {
+	funcName := "PostgresHistoryStorage.Read"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select(append(allHistoryEntryFields, allPublicUserFields...)...).
+		From("public.edit_history").
+		LeftJoin("public.user ON public.user.id = public.edit_history.id_user").
+		Where(sq.Eq{"public.edit_history.id": id.Value}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		logger.DebugFmt("Failed to build query with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	entry, err := scanHistoryEntry(conn(ctx, s.db).QueryRow(query, args...))
+	if err != nil {
+		logger.DebugFmt("Failed to get history entry with error "+err.Error(), requestID.String(), funcName, nodeName)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrHistoryEntryNotFound
+		}
+		return nil, apperrors.ErrCouldNotGetHistory
+	}
+	logger.DebugFmt("Got history entry", requestID.String(), funcName, nodeName)
+
+	return &entry, nil
+}
+
+// ReadMany
+// возвращает историю изменений доски с учётом фильтров и пагинации, начиная с новых записей
+// или возвращает ошибки ...
+func (s PostgresHistoryStorage) ReadMany(ctx context.Context, request dto.BoardHistoryRequest) (*[]dto.BoardHistoryEntry, error) {
+	funcName := "PostgresHistoryStorage.ReadMany"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	conditions := sq.And{sq.Eq{"public.edit_history.id_board": request.BoardID}}
+	if request.EntityType != nil {
+		conditions = append(conditions, sq.Eq{"public.edit_history.entity_type": *request.EntityType})
+	}
+	if request.EntityID != nil {
+		conditions = append(conditions, sq.Eq{"public.edit_history.id_entity": *request.EntityID})
+	}
+	if request.UserID != nil {
+		conditions = append(conditions, sq.Eq{"public.edit_history.id_user": *request.UserID})
+	}
+	if request.From != nil {
+		conditions = append(conditions, sq.GtOrEq{"public.edit_history.edit_date": *request.From})
+	}
+	if request.To != nil {
+		conditions = append(conditions, sq.Lt{"public.edit_history.edit_date": *request.To})
+	}
+
+	query, args, err := sq.Select(append(allHistoryEntryFields, allPublicUserFields...)...).
+		From("public.edit_history").
+		LeftJoin("public.user ON public.user.id = public.edit_history.id_user").
+		Where(conditions).
+		OrderBy("public.edit_history.edit_date DESC", "public.edit_history.id DESC").
+		Limit(request.Limit).
+		Offset(request.Offset).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		logger.DebugFmt("Failed to build query with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	rows, err := conn(ctx, s.db).Query(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotGetHistory
+	}
+	defer rows.Close()
+	logger.DebugFmt("Got history entries", requestID.String(), funcName, nodeName)
+
+	historyEntries := []dto.BoardHistoryEntry{}
+	for rows.Next() {
+		entry, err := scanHistoryEntry(rows)
+		if err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotScanRows
+		}
+		historyEntries = append(historyEntries, entry)
+	}
+	logger.DebugFmt("Collected history entry rows", requestID.String(), funcName, nodeName)
+
+	return &historyEntries, nil
+}
+
+// taskActivityRule
+// правило, по которому запись истории сущности entityType с действием action становится событием kind ленты
+// активности задания; правило с fields срабатывает, только если среди изменений задания есть одно из этих полей
+type taskActivityRule struct {
+	entityType string
+	action     string
+	kind       string
+	fields     []string
+}
+
+// taskActivityRules
+// правила событий ленты активности; события одной записи истории идут в порядке правил
+var taskActivityRules = []taskActivityRule{
+	{entityType: dto.HistoryEntityTask, action: dto.HistoryActionCreate, kind: dto.TaskActivityCreated},
+	{entityType: dto.HistoryEntityTask, action: dto.HistoryActionMove, kind: dto.TaskActivityMoved, fields: []string{"id_list"}},
+	{entityType: dto.HistoryEntityTask, action: dto.HistoryActionUpdate, kind: dto.TaskActivityRenamed, fields: []string{"name"}},
+	{entityType: dto.HistoryEntityTask, action: dto.HistoryActionUpdate, kind: dto.TaskActivityDescriptionEdited, fields: []string{"description"}},
+	{entityType: dto.HistoryEntityTask, action: dto.HistoryActionUpdate, kind: dto.TaskActivityDatesChanged, fields: []string{"task_start", "task_end", "all_day"}},
+	{entityType: dto.HistoryEntityTask, action: dto.HistoryActionAddUser, kind: dto.TaskActivityAssigneeAdded},
+	{entityType: dto.HistoryEntityTask, action: dto.HistoryActionRemoveUser, kind: dto.TaskActivityAssigneeRemoved},
+	{entityType: dto.HistoryEntityTask, action: dto.HistoryActionAddTag, kind: dto.TaskActivityTagAdded},
+	{entityType: dto.HistoryEntityTask, action: dto.HistoryActionRemoveTag, kind: dto.TaskActivityTagRemoved},
+	{entityType: dto.HistoryEntityTask, action: dto.HistoryActionAttachFile, kind: dto.TaskActivityAttachmentAdded},
+	{entityType: dto.HistoryEntityTask, action: dto.HistoryActionRemoveFile, kind: dto.TaskActivityAttachmentRemoved},
+	{entityType: dto.HistoryEntityChecklistItem, action: dto.HistoryActionCreate, kind: dto.TaskActivityChecklistItemAdded},
+	{entityType: dto.HistoryEntityChecklistItem, action: dto.HistoryActionDelete, kind: dto.TaskActivityChecklistItemRemoved},
+}
+
+// taskActivityRulesJoin
+// присоединяет к записям истории подходящие им правила ленты активности в виде таблицы rule
+func taskActivityRulesJoin() sq.Sqlizer {
+	values := make([]string, 0, len(taskActivityRules))
+	args := make([]interface{}, 0, len(taskActivityRules)*5)
+	for position, rule := range taskActivityRules {
+		values = append(values, "(?::integer, ?::text, ?::text, ?::text, ?::text[])")
+		var fields interface{}
+		if rule.fields != nil {
+			fields = pq.Array(rule.fields)
+		}
+		args = append(args, position, rule.entityType, rule.action, rule.kind, fields)
+	}
+
+	return sq.Expr("JOIN (VALUES "+strings.Join(values, ", ")+") AS rule(position, entity_type, action, kind, fields) "+
+		"ON rule.entity_type = public.edit_history.entity_type AND rule.action = public.edit_history.action", args...)
+}
+
+// taskActivityFeed
+// собирает ленту активности задания: события из записей истории по правилам и комментарии задания
+func taskActivityFeed(request dto.TaskActivityRequest) (sq.SelectBuilder, error) {
+	bulkMatch, err := json.Marshal([]map[string]uint64{{"entity_id": request.TaskID}})
+	if err != nil {
+		return sq.SelectBuilder{}, err
+	}
+
+	comments := sq.Select().
+		Column("?::text", dto.TaskActivityComment).
+		Columns("NULL::integer", "public.comment.date_created", "public.comment.id_user", "NULL::integer",
+			"public.comment.id", "public.comment.content").
+		From("public.comment").
+		Where(sq.Eq{"public.comment.id_task": request.TaskID})
+
+	return sq.Select("rule.kind", "rule.position", "public.edit_history.edit_date AS date_event", "public.edit_history.id_user",
+		"public.edit_history.id AS id_history", "NULL::integer AS id_comment", "NULL::text AS content").
+		From("public.edit_history").
+		JoinClause(taskActivityRulesJoin()).
+		Where(sq.Or{
+			sq.Eq{"public.edit_history.id_task": request.TaskID},
+			sq.And{
+				sq.Eq{"public.edit_history.id_board": request.BoardID, "public.edit_history.id_entity": 0},
+				sq.Expr("public.edit_history.changes @> ?::jsonb", string(bulkMatch)),
+			},
+		}).
+		Where(taskActivityMatch, request.TaskID).
+		Suffix("UNION ALL").
+		SuffixExpr(comments), nil
+}
+
+// ReadTaskActivity
+// возвращает страницу ленты активности задания в хронологическом порядке: события из изменений самого задания,
+// его чек-листов и файлов, а также массовых операций доски request.BoardID, затронувших задание, вперемешку
+// с комментариями задания; при равном времени события истории идут раньше комментариев
+// или возвращает ошибки ...
+func (s PostgresHistoryStorage) ReadTaskActivity(ctx context.Context, request dto.TaskActivityRequest) (*dto.TaskActivity, error) {
+	funcName := "PostgresHistoryStorage.ReadTaskActivity"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	feed, err := taskActivityFeed(request)
+	if err != nil {
+		logger.DebugFmt("Failed to build query with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+
+	query, args, err := sq.Select("item.kind", "item.position", "item.date_event", "item.id_user",
+		"item.id_history", "item.id_comment", "item.content").
+		FromSelect(feed, "item").
+		OrderBy("item.date_event", "item.id_comment IS NOT NULL", "item.id_history", "item.position", "item.id_comment").
+		Limit(request.Limit).
+		Offset(request.Offset).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		logger.DebugFmt("Failed to build query with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	rows, err := conn(ctx, s.db).Query(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotGetHistory
+	}
+	defer rows.Close()
+
+	type feedRow struct {
+		kind      string
+		position  sql.NullInt64
+		date      time.Time
+		userID    uint64
+		historyID sql.NullInt64
+		commentID sql.NullInt64
+		content   sql.NullString
+	}
+	page := []feedRow{}
+	historyIDs := []uint64{}
+	for rows.Next() {
+		var row feedRow
+		if err := rows.Scan(&row.kind, &row.position, &row.date, &row.userID, &row.historyID, &row.commentID, &row.content); err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotScanRows
+		}
+		if row.historyID.Valid {
+			historyIDs = append(historyIDs, uint64(row.historyID.Int64))
+		}
+		page = append(page, row)
+	}
+	if err := rows.Err(); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotCollectRows
+	}
+	rows.Close()
+	logger.DebugFmt(fmt.Sprintf("Got %d task activity items", len(page)), requestID.String(), funcName, nodeName)
+
+	query, args, err = sq.Select("count(*)").
+		FromSelect(feed, "item").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		logger.DebugFmt("Failed to build query with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+
+	activity := dto.TaskActivity{TaskID: request.TaskID, Items: []dto.TaskActivityItem{}}
+	if err := conn(ctx, s.db).QueryRow(query, args...).Scan(&activity.Total); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotGetHistory
+	}
+
+	entries := map[uint64]dto.BoardHistoryEntry{}
+	if len(historyIDs) > 0 {
+		query, args, err = sq.Select(append(allHistoryEntryFields, allPublicUserFields...)...).
+			From("public.edit_history").
+			LeftJoin("public.user ON public.user.id = public.edit_history.id_user").
+			Where(sq.Eq{"public.edit_history.id": historyIDs}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			logger.DebugFmt("Failed to build query with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotBuildQuery
+		}
+
+		rows, err := conn(ctx, s.db).Query(query, args...)
+		if err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotGetHistory
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			entry, err := scanHistoryEntry(rows)
+			if err != nil {
+				logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+				return nil, apperrors.ErrCouldNotScanRows
+			}
+			entries[entry.ID] = entry
+		}
+	}
+
+	for _, row := range page {
+		if row.commentID.Valid {
+			activity.Items = append(activity.Items, dto.TaskActivityItem{
+				Type:      row.kind,
+				Timestamp: row.date,
+				UserID:    row.userID,
+				Comment: &dto.CommentInfo{
+					ID:          uint64(row.commentID.Int64),
+					UserID:      row.userID,
+					Text:        row.content.String,
+					DateCreated: row.date,
+				},
+			})
+			continue
+		}
+
+		entry, ok := entries[uint64(row.historyID.Int64)]
+		if !ok {
+			continue
+		}
+		activity.Items = append(activity.Items, dto.TaskActivityItem{
+			Type:      row.kind,
+			Timestamp: entry.DateEdited,
+			UserID:    entry.User.ID,
+			HistoryID: &entry.ID,
+			Changes:   taskActivityChanges(request.TaskID, entry, taskActivityRules[row.position.Int64]),
+		})
+	}
+
+	return &activity, nil
+}
+
+// taskActivityChanges
+// возвращает изменения записи истории, относящиеся к событию правила rule: из записи массовой операции
+// берутся только изменения задания taskID, у создания задания изменений нет
+func taskActivityChanges(taskID uint64, entry dto.BoardHistoryEntry, rule taskActivityRule) []dto.HistoryFieldChange {
+	changes := []dto.HistoryFieldChange{}
+	if rule.kind == dto.TaskActivityCreated {
+		return changes
+	}
+	for _, change := range entry.Changes {
+		if entry.EntityID == 0 && change.EntityID != taskID {
+			continue
+		}
+		if rule.fields != nil && !slices.Contains(rule.fields, change.Field) {
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// ReadChanges
+// возвращает последнее изменение каждой сущности доски, записанное после указанной версии
+// или возвращает ошибки ...
+func (s PostgresHistoryStorage) ReadChanges(ctx context.Context, request dto.BoardChangesRequest) (*[]dto.BoardChange, error) {
+	funcName := "PostgresHistoryStorage.ReadChanges"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select(boardChangeFields...).
+		Options("DISTINCT ON (entity_type, entity_id)").
+		From("public.edit_history").
+		LeftJoin(bulkHistoryChanges).
+		Where(sq.Eq{"id_board": request.BoardID}).
+		Where(sq.Gt{"board_version": request.Since}).
+		OrderBy("entity_type", "entity_id", "board_version DESC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		logger.DebugFmt("Failed to build query with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	rows, err := conn(ctx, s.db).Query(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotGetHistory
+	}
+	defer rows.Close()
+	logger.DebugFmt("Got changed entities", requestID.String(), funcName, nodeName)
+
+	changes := []dto.BoardChange{}
+	for rows.Next() {
+		var change dto.BoardChange
+		err = rows.Scan(&change.EntityType, &change.EntityID, &change.Action, &change.Version)
+		if err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotScanRows
+		}
+		changes = append(changes, change)
+	}
+	logger.DebugFmt("Collected changed entity rows", requestID.String(), funcName, nodeName)
+
+	return &changes, nil
+}
+
+// Revert
+// в одной транзакции проверяет, что изменение ещё можно отменить, применяет обратное изменение и записывает его в историю
+// или возвращает ошибки ...
+func (s PostgresHistoryStorage) Revert(ctx context.Context, info dto.HistoryRevertInfo) error {
+	funcName := "PostgresHistoryStorage.Revert"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	source, ok := historySnapshotSources[info.Original.EntityType]
+	if !ok {
+		return apperrors.ErrUnknownHistoryEntity
+	}
+
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotBeginTransaction
+	}
+	logger.DebugFmt("Transaction started", requestID.String(), funcName, nodeName)
+
+	err = applyHistoryRevert(ctx, tx, source, info.Original, info.Revert.UserID)
+	if err != nil {
+		logger.DebugFmt("Failed to apply revert with error "+err.Error(), requestID.String(), funcName, nodeName)
+		errRollback := tx.Rollback()
+		if errRollback != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+errRollback.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotRollback
+		}
+		return err
+	}
+	logger.DebugFmt("Revert applied", requestID.String(), funcName, nodeName)
+
+	query, args, err := buildHistoryInsert(info.Revert)
+	var entryID uint64
+	if err == nil {
+		logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+		err = tx.QueryRow(query, args...).Scan(&entryID)
+	}
+	if err == nil {
+		err = notifyWatchers(ctx, tx, entryID, info.Revert)
+	}
+	if err != nil {
+		logger.DebugFmt("Failed to record revert with error "+err.Error(), requestID.String(), funcName, nodeName)
+		errRollback := tx.Rollback()
+		if errRollback != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+errRollback.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotRollback
+		}
+		return apperrors.ErrCouldNotRecordHistory
+	}
+	logger.DebugFmt("Revert recorded", requestID.String(), funcName, nodeName)
+
+	err = tx.Commit()
+	if err != nil {
+		logger.DebugFmt("Failed to commit changes", requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotCommit
+	}
+	logger.DebugFmt("Changes commited", requestID.String(), funcName, nodeName)
+
+	return nil
+}
+
+// applyHistoryRevert
+// применяет изменение, обратное записи в истории, проверяя, что сущность находится в записанном состоянии
+// и на доске записи, а после отмены -- на доске, доступной пользователю userID, и не нарушает правил списков
+func applyHistoryRevert(ctx context.Context, tx executor, source historySnapshotSource, entry dto.BoardHistoryEntry, userID uint64) error {
+	switch entry.Action {
+	case dto.HistoryActionUpdate, dto.HistoryActionMove, dto.HistoryActionReorder,
+		dto.HistoryActionArchive, dto.HistoryActionUnarchive:
+		current, err := lockHistoryRow(ctx, tx, source, entry.EntityID, entry.BoardID)
+		if err != nil {
+			return err
+		}
+		reverted := map[string]interface{}{}
+		for _, change := range entry.Changes {
+			value, ok := current[change.Field]
+			if !ok || !reflect.DeepEqual(value, change.After) {
+				return apperrors.ErrHistoryRevertConflict
+			}
+			reverted[change.Field] = change.Before
+		}
+		if err = restoreHistoryFields(ctx, tx, source, entry.EntityID, reverted); err != nil {
+			return err
+		}
+		if err = checkHistoryRevertBoard(ctx, tx, source, entry.EntityID, userID); err != nil {
+			return err
+		}
+		return checkHistoryRevertRules(ctx, tx, entry.EntityType, entry.EntityID, reverted)
+
+	case dto.HistoryActionCreate:
+		if _, err := lockHistoryRow(ctx, tx, source, entry.EntityID, entry.BoardID); err != nil {
+			return err
+		}
+		return execHistoryRevert(ctx, tx, sq.Delete(source.table).Where(sq.Eq{"id": entry.EntityID}))
+
+	case dto.HistoryActionDelete:
+		if err := checkHistoryChildren(source, entry); err != nil {
+			return err
+		}
+		exists, err := historyRowExists(ctx, tx, sq.Select("1").From(source.table).Where(sq.Eq{"id": entry.EntityID}))
+		if err != nil {
+			return err
+		}
+		if exists {
+			return apperrors.ErrHistoryRevertConflict
+		}
+		restored := map[string]interface{}{"id": entry.EntityID}
+		for _, change := range entry.Changes {
+			if change.Field != dto.HistoryChildrenField {
+				restored[change.Field] = change.Before
+			}
+		}
+		if source.versioned {
+			restored["row_version"] = 1
+		}
+		encoded, err := json.Marshal(restored)
+		if err != nil {
+			return apperrors.ErrCouldNotRevertHistory
+		}
+		err = execHistoryRevert(ctx, tx, sq.Insert(source.table).
+			Select(sq.Select().Column(sq.Expr("(jsonb_populate_record(NULL::"+source.table+", ?::jsonb)).*", string(encoded)))))
+		if err != nil {
+			return err
+		}
+		if entry.EntityType == dto.HistoryEntityTag {
+			err = execHistoryRevert(ctx, tx, sq.Insert("public.tag_board").
+				Columns("id_tag", "id_board").
+				Values(entry.EntityID, entry.BoardID))
+			if err != nil {
+				return err
+			}
+		}
+		if err = checkHistoryRevertBoard(ctx, tx, source, entry.EntityID, userID); err != nil {
+			return err
+		}
+		return checkHistoryRevertRules(ctx, tx, entry.EntityType, entry.EntityID, restored)
+
+	case dto.HistoryActionAddUser, dto.HistoryActionRemoveUser:
+		return revertHistoryLink(ctx, tx, entry, "public.task_user", "id_user",
+			sq.Eq{"public.board_user.id_board": entry.BoardID}, "public.board_user", "public.board_user.id_user")
+
+	case dto.HistoryActionAddTag, dto.HistoryActionRemoveTag:
+		return revertHistoryLink(ctx, tx, entry, "public.tag_task", "id_tag",
+			sq.Eq{"public.tag_board.id_board": entry.BoardID}, "public.tag_board", "public.tag_board.id_tag")
+	}
+
+	return apperrors.ErrHistoryNotRevertible
+}
+
+// lockHistoryRow
+// блокирует строку сущности до конца транзакции и возвращает её поля. Сущность должна быть на доске boardID:
+// запись истории одной доски не отменяет изменений сущности, которая с тех пор перешла на другую доску
+func lockHistoryRow(ctx context.Context, tx executor, source historySnapshotSource, id uint64, boardID uint64) (map[string]interface{}, error) {
+	funcName := "lockHistoryRow"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	builder := sq.Select("row_to_json(entity)").
+		From(source.table + " AS entity")
+	for _, join := range source.joins {
+		builder = builder.Join(join)
+	}
+	query, args, err := builder.
+		Where(sq.Eq{"entity.id": id}).
+		Where(sq.Eq{source.boardColumn: boardID}).
+		Suffix("FOR UPDATE OF entity").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	var rawFields []byte
+	err = tx.QueryRow(query, args...).Scan(&rawFields)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.ErrCouldNotExecuteQuery
+		}
+		exists, err := historyRowExists(ctx, tx, sq.Select("1").From(source.table).Where(sq.Eq{"id": id}))
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			logger.DebugFmt("Entity is on another board now", requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrNoBoardAccess
+		}
+		return nil, apperrors.ErrHistoryRevertConflict
+	}
+
+	fields := map[string]interface{}{}
+	if err = json.Unmarshal(rawFields, &fields); err != nil {
+		return nil, apperrors.ErrCouldNotScanRows
+	}
+	return fields, nil
+}
+
+// checkHistoryRevertBoard
+// проверяет, что после отмены сущность находится на доске, участником которой является пользователь:
+// отмена переноса или восстановление в перенесённый список не должны уводить сущность на чужую доску
+func checkHistoryRevertBoard(ctx context.Context, tx executor, source historySnapshotSource, id uint64, userID uint64) error {
+	builder := sq.Select("1").From(source.table + " AS entity")
+	for _, join := range source.joins {
+		builder = builder.Join(join)
+	}
+	onBoard, err := historyRowExists(ctx, tx, builder.
+		Join("public.board_user ON public.board_user.id_board = "+source.boardColumn).
+		Where(sq.Eq{"entity.id": id, "public.board_user.id_user": userID}))
+	if err != nil {
+		return err
+	}
+	if !onBoard {
+		return apperrors.ErrNoBoardAccess
+	}
+	return nil
+}
+
+// checkHistoryRevertRules
+// проверяет после отмены те же правила, что и перенос, возврат из архива и создание: задание, которое вернулось
+// в список или из архива, не может оказаться в архивном списке, превысить жёсткий лимит или нарушить
+// требование выполненных блокирующих заданий; список, вернувшийся из архива или ставший завершающим, -- тоже
+// или возвращает ошибки apperrors.ErrHistoryRevertConflict, apperrors.ErrWipLimitExceeded, apperrors.ErrTaskBlocked, ...
+func checkHistoryRevertRules(ctx context.Context, tx executor, entityType string, id uint64, fields map[string]interface{}) error {
+	_, listChanged := fields["id_list"]
+	archived, archiveChanged := fields["date_archived"]
+	unarchived := archiveChanged && archived == nil
+
+	switch entityType {
+	case dto.HistoryEntityTask:
+		if !listChanged && !unarchived {
+			return nil
+		}
+		listID, taskActive, err := lockHistoryTaskList(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if !taskActive {
+			return nil
+		}
+		if err = checkHardWipLimit(ctx, tx, listID); err != nil {
+			return err
+		}
+		return checkBlockers(ctx, tx, listID, id)
+
+	case dto.HistoryEntityList:
+		_, doneChanged := fields["is_done"]
+		_, requireChanged := fields["require_unblocked"]
+		if unarchived {
+			if err := checkHardWipLimit(ctx, tx, id); err != nil {
+				return err
+			}
+		}
+		if unarchived || doneChanged || requireChanged {
+			return checkBlockers(ctx, tx, id)
+		}
+	}
+	return nil
+}
+
+// lockHistoryTaskList
+// блокирует список задания до конца транзакции, как перенос в него, и возвращает его id и то, не в архиве ли задание;
+// в архивный список отмена задание не возвращает
+// или возвращает ошибки apperrors.ErrHistoryRevertConflict, ...
+func lockHistoryTaskList(ctx context.Context, tx executor, taskID uint64) (uint64, bool, error) {
+	funcName := "lockHistoryTaskList"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select("public.list.id", "public.task.date_archived IS NULL", "public.list.date_archived IS NULL").
+		From("public.task").
+		Join("public.list ON public.list.id = public.task.id_list").
+		Where(sq.Eq{"public.task.id": taskID}).
+		Suffix("FOR UPDATE OF list").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, false, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	var listID uint64
+	var taskActive, listActive bool
+	err = tx.QueryRow(query, args...).Scan(&listID, &taskActive, &listActive)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, apperrors.ErrHistoryRevertConflict
+	}
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return 0, false, apperrors.ErrCouldNotExecuteQuery
+	}
+	if !listActive {
+		logger.DebugFmt(fmt.Sprintf("List %v is archived", listID), requestID.String(), funcName, nodeName)
+		return 0, false, apperrors.ErrHistoryRevertConflict
+	}
+	return listID, taskActive, nil
+}
+
+// checkHistoryChildren
+// проверяет, что вместе с удалённой сущностью не были удалены зависимые строки: отмена восстанавливает
+// только саму строку. В записях без числа зависимых строк оно неизвестно, и такие удаления не отменяются
+func checkHistoryChildren(source historySnapshotSource, entry dto.BoardHistoryEntry) error {
+	if len(source.children) == 0 {
+		return nil
+	}
+	for _, change := range entry.Changes {
+		if change.Field != dto.HistoryChildrenField {
+			continue
+		}
+		if count, ok := change.Before.(float64); ok && count == 0 {
+			return nil
+		}
+		break
+	}
+	return apperrors.ErrHistoryNotRevertible
+}
+
+// restoreHistoryFields
+// записывает в строку сущности значения полей, приводя их к типам колонок через jsonb_populate_record;
+// названия полей берутся из истории, поэтому экранируются как идентификаторы
+func restoreHistoryFields(ctx context.Context, tx executor, source historySnapshotSource, id uint64, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	encoded, err := json.Marshal(fields)
+	if err != nil {
+		return apperrors.ErrCouldNotRevertHistory
+	}
+
+	names := make([]string, 0, len(fields))
+	for field := range fields {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+
+	builder := sq.Update(source.table)
+	for _, field := range names {
+		column := pgx.Identifier{field}.Sanitize()
+		builder = builder.Set(column, sq.Expr("(jsonb_populate_record(NULL::"+source.table+", ?::jsonb))."+column, string(encoded)))
+	}
+	if source.versioned {
+		builder = builder.Set("row_version", sq.Expr("row_version + 1"))
+	}
+	builder = builder.Where(sq.Eq{"id": id})
+
+	return execHistoryRevert(ctx, tx, builder)
+}
+
+// revertHistoryLink
+// удаляет или восстанавливает связь задания с пользователем или тэгом; восстановить можно только связь
+// с объектом, который всё ещё относится к доске
+func revertHistoryLink(ctx context.Context, tx executor, entry dto.BoardHistoryEntry,
+	linkTable string, linkColumn string, boardCondition sq.Eq, boardTable string, boardColumn string) error {
+	if entry.EntityType != dto.HistoryEntityTask || len(entry.Changes) != 1 {
+		return apperrors.ErrHistoryNotRevertible
+	}
+	change := entry.Changes[0]
+	adding := entry.Action == dto.HistoryActionAddUser || entry.Action == dto.HistoryActionAddTag
+	value := change.Before
+	if adding {
+		value = change.After
+	}
+	linkedID, ok := value.(float64)
+	if !ok {
+		return apperrors.ErrHistoryNotRevertible
+	}
+	if _, err := lockHistoryRow(ctx, tx, historySnapshotSources[dto.HistoryEntityTask], entry.EntityID, entry.BoardID); err != nil {
+		return err
+	}
+
+	exists, err := historyRowExists(ctx, tx, sq.Select("1").From(linkTable).
+		Where(sq.Eq{"id_task": entry.EntityID, linkColumn: uint64(linkedID)}))
+	if err != nil {
+		return err
+	}
+
+	if adding {
+		if !exists {
+			return apperrors.ErrHistoryRevertConflict
+		}
+		return execHistoryRevert(ctx, tx, sq.Delete(linkTable).
+			Where(sq.Eq{"id_task": entry.EntityID, linkColumn: uint64(linkedID)}))
+	}
+
+	if exists {
+		return apperrors.ErrHistoryRevertConflict
+	}
+	onBoard, err := historyRowExists(ctx, tx, sq.Select("1").From(boardTable).
+		Where(sq.And{boardCondition, sq.Eq{boardColumn: uint64(linkedID)}}))
+	if err != nil {
+		return err
+	}
+	if !onBoard {
+		return apperrors.ErrHistoryRevertConflict
+	}
+	return execHistoryRevert(ctx, tx, sq.Insert(linkTable).
+		Columns("id_task", linkColumn).
+		Values(entry.EntityID, uint64(linkedID)))
+}
+
+// historyRowExists
+// проверяет, возвращает ли запрос хотя бы одну строку
+func historyRowExists(ctx context.Context, tx executor, builder sq.SelectBuilder) (bool, error) {
+	funcName := "historyRowExists"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select().Column(sq.Expr("EXISTS(?)", builder)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	var exists bool
+	err = tx.QueryRow(query, args...).Scan(&exists)
+	if err != nil {
+		return false, apperrors.ErrCouldNotExecuteQuery
+	}
+	return exists, nil
+}
+
+// execHistoryRevert
+// выполняет запрос отмены; нарушение ограничений БД означает, что отмена конфликтует с текущим состоянием
+func execHistoryRevert(ctx context.Context, tx executor, builder sq.Sqlizer) error {
+	funcName := "execHistoryRevert"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := builder.ToSql()
+	if err == nil {
+		query, err = sq.Dollar.ReplacePlaceholders(query)
+	}
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	_, err = tx.Exec(query, args...)
+	if err != nil {
+		logger.DebugFmt("Revert query failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && strings.HasPrefix(pgErr.Code, "23") {
+			return apperrors.ErrHistoryRevertConflict
+		}
+		return apperrors.ErrCouldNotRevertHistory
+	}
+	return nil
+}
+
+// buildHistoryInsert
+// строит запрос добавления записи в историю, возвращающий её id. Версию доски увеличивает только триггер
+// изменения, а запись выполняется в той же транзакции и сохраняет эту версию: клиент не может получить версию
+// без записи о ней, и по истории можно собрать изменения с любой версии
+func buildHistoryInsert(entry dto.NewHistoryEntry) (string, []interface{}, error) {
+	changes := entry.Changes
+	if changes == nil {
+		changes = []dto.HistoryFieldChange{}
+	}
+	encodedChanges, err := json.Marshal(changes)
+	if err != nil {
+		return "", nil, apperrors.ErrCouldNotRecordHistory
+	}
+
+	query, args, err := sq.
+		Insert("public.edit_history").
+		Columns(newHistoryEntryFields...).
+		Values(entry.UserID, entry.BoardID, time.Now(), entry.Action+" "+entry.EntityType,
+			entry.EntityType, entry.EntityID, entry.Action, string(encodedChanges), entry.RevertedID, entry.TaskID,
+			sq.Expr("(SELECT version FROM public.board WHERE id = ?)", entry.BoardID)).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return "", nil, apperrors.ErrCouldNotBuildQuery
+	}
+	return query, args, nil
+}
+
+// notifyWatchers
+// создает уведомления об изменении из записи истории entryID наблюдателям доски и заданий, к которым относятся
+// изменённые сущности. Строк удалённых сущностей уже нет, поэтому задание берётся и из записи, а наблюдатели
+// удалённых заданий -- из entry.Watchers; такое уведомление ссылается только на историю, а не на задание.
+// Уведомления получают только участники доски; автор изменения уведомление не получает
+func notifyWatchers(ctx context.Context, tx executor, entryID uint64, entry dto.NewHistoryEntry) error {
+	funcName := "notifyWatchers"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	affected := sq.Select("NULL::integer").Where("false")
+	if source := historySnapshotSources[entry.EntityType]; source.taskColumn != "" {
+		affected = sq.Select(source.taskColumn).From(source.table + " AS entity")
+		for _, join := range source.joins {
+			affected = affected.Join(join)
+		}
+		affected = affected.Where(sq.Eq{"entity.id": historyEntityIDs(entry)})
+	}
+	if entry.TaskID != nil {
+		affected = affected.Suffix("UNION SELECT ?::integer", *entry.TaskID)
+	}
+	affectedQuery, affectedArgs, err := affected.ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+
+	watchers := sq.Select("id_user").
+		From("public.task_watcher").
+		Where("id_task IN (SELECT id FROM affected)").
+		Suffix("UNION SELECT id_user FROM public.board_watcher WHERE id_board = ?", entry.BoardID)
+	if len(entry.Watchers) > 0 {
+		removedQuery, removedArgs, err := sq.Select("id").From("public.\"user\"").Where(sq.Eq{"id": entry.Watchers}).ToSql()
+		if err != nil {
+			return apperrors.ErrCouldNotBuildQuery
+		}
+		watchers = watchers.Suffix("UNION "+removedQuery, removedArgs...)
+	}
+
+	query, args, err := sq.Insert("public.notification").
+		Prefix("WITH affected(id) AS ("+affectedQuery+")", affectedArgs...).
+		Columns("id_user", "id_task", "kind", "id_history").
+		Select(sq.Select("watcher.id_user", "(SELECT min(affected.id) FROM affected JOIN public.task ON public.task.id = affected.id)").
+			Column("?", dto.NotificationChange).
+			Column("?::integer", entryID).
+			FromSelect(watchers, "watcher").
+			Join("public.board_user ON public.board_user.id_user = watcher.id_user AND public.board_user.id_board = ?", entry.BoardID).
+			Where(sq.NotEq{"watcher.id_user": entry.UserID})).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	if _, err = tx.Exec(query, args...); err != nil {
+		logger.DebugFmt("Failed to notify watchers with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotRecordHistory
+	}
+	return nil
+}
+
+// historyEntityIDs
+// возвращает id сущностей записи истории: у записи массовой операции они указаны в изменениях
+func historyEntityIDs(entry dto.NewHistoryEntry) []uint64 {
+	if entry.EntityID != 0 {
+		return []uint64{entry.EntityID}
+	}
+	ids := []uint64{}
+	seen := map[uint64]struct{}{}
+	for _, change := range entry.Changes {
+		if _, ok := seen[change.EntityID]; ok || change.EntityID == 0 {
+			continue
+		}
+		seen[change.EntityID] = struct{}{}
+		ids = append(ids, change.EntityID)
+	}
+	return ids
+}
+
+// scanHistoryEntry
+// считывает запись истории вместе с автором изменения
+func scanHistoryEntry(row interface{ Scan(...interface{}) error }) (dto.BoardHistoryEntry, error) {
+	var entry dto.BoardHistoryEntry
+	var rawChanges []byte
+
+	err := row.Scan(
+		&entry.ID,
+		&entry.BoardID,
+		&entry.DateEdited,
+		&entry.Actions,
+		&entry.EntityType,
+		&entry.EntityID,
+		&entry.Action,
+		&rawChanges,
+		&entry.RevertedID,
+		&entry.TaskID,
+		&entry.User.ID,
+		&entry.User.Email,
+		&entry.User.Name,
+		&entry.User.Surname,
+		&entry.User.Description,
+		&entry.User.AvatarURL,
+	)
+	if err != nil {
+		return entry, err
+	}
+
+	err = json.Unmarshal(rawChanges, &entry.Changes)
+	return entry, err
+}
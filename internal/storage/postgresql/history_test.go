@@ -0,0 +1,1017 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+func TestPostgresHistoryStorage_Snapshot(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ref   dto.HistoryEntityRef
+		query func(mock sqlmock.Sqlmock, args args)
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *dto.EntitySnapshot
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "Happy path",
+			args: args{
+				ref: dto.HistoryEntityRef{EntityType: dto.HistoryEntityTask, EntityID: 1},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					query, _, _ := sq.Select("row_to_json(entity)", "public.list.id_board", "entity.id",
+						historySnapshotSources[dto.HistoryEntityTask].childrenColumn(),
+						"to_json(ARRAY(SELECT id_user FROM public.task_watcher WHERE id_task = entity.id ORDER BY id_user))").
+						From("public.task AS entity").
+						Join("public.list ON public.list.id = entity.id_list").
+						Where(sq.Eq{"entity.id": args.ref.EntityID}).
+						OrderBy("public.list.id_board").
+						Limit(1).
+						Suffix("FOR UPDATE OF entity").
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectQuery(regexp.QuoteMeta(query)).
+						WithArgs(args.ref.EntityID).
+						WillReturnRows(sqlmock.NewRows([]string{"row_to_json", "id_board", "id_task", "children", "watchers"}).
+							AddRow([]byte(`{"id":1,"name":"Задача","id_list":2}`), 3, 1, 0, []byte(`[4,5]`)),
+						)
+				},
+			},
+			want: &dto.EntitySnapshot{
+				BoardID:  3,
+				Fields:   map[string]interface{}{"id": float64(1), "name": "Задача", "id_list": float64(2)},
+				Watchers: []uint64{4, 5},
+			},
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "Entity not found",
+			args: args{
+				ref: dto.HistoryEntityRef{EntityType: dto.HistoryEntityList, EntityID: 1},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					query, _, _ := sq.Select("row_to_json(entity)", "entity.id_board", "NULL::integer",
+						historySnapshotSources[dto.HistoryEntityList].childrenColumn(), "NULL::json").
+						From("public.list AS entity").
+						Where(sq.Eq{"entity.id": args.ref.EntityID}).
+						OrderBy("entity.id_board").
+						Limit(1).
+						Suffix("FOR UPDATE OF entity").
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectQuery(regexp.QuoteMeta(query)).
+						WithArgs(args.ref.EntityID).
+						WillReturnError(sql.ErrNoRows)
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrHistoryEntityNotFound,
+		},
+		{
+			name: "Unknown entity type",
+			args: args{
+				ref:   dto.HistoryEntityRef{EntityType: "workspace", EntityID: 1},
+				query: func(mock sqlmock.Sqlmock, args args) {},
+			},
+			wantErr: true,
+			err:     apperrors.ErrUnknownHistoryEntity,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			tt.args.query(mock, tt.args)
+
+			s := NewHistoryStorage(db)
+
+			got, err := s.Snapshot(ctx, tt.args.ref)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PostgresHistoryStorage.Snapshot() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, tt.err) {
+				t.Errorf("PostgresHistoryStorage.Snapshot() error = %v, want %v", err, tt.err)
+			}
+			if !tt.wantErr && (got.BoardID != tt.want.BoardID || len(got.Fields) != len(tt.want.Fields) ||
+				got.Fields["name"] != tt.want.Fields["name"] || !reflect.DeepEqual(got.Watchers, tt.want.Watchers)) {
+				t.Errorf("PostgresHistoryStorage.Snapshot() = %v, want %v", got, tt.want)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+// watcherNotificationsInsert
+// запрос уведомлений наблюдателей после выборки затронутых заданий, параметры которого начинаются с номера first
+func watcherNotificationsInsert(first int) string {
+	return fmt.Sprintf("INSERT INTO public.notification (id_user,id_task,kind,id_history) "+
+		"SELECT watcher.id_user, (SELECT min(affected.id) FROM affected JOIN public.task ON public.task.id = affected.id), $%d, $%d::integer FROM (SELECT id_user FROM public.task_watcher "+
+		"WHERE id_task IN (SELECT id FROM affected) UNION SELECT id_user FROM public.board_watcher WHERE id_board = $%d) AS watcher "+
+		"JOIN public.board_user ON public.board_user.id_user = watcher.id_user AND public.board_user.id_board = $%d "+
+		"WHERE watcher.id_user <> $%d", first, first+1, first+2, first+3, first+4)
+}
+
+func TestPostgresHistoryStorage_Record(t *testing.T) {
+	t.Parallel()
+	deletedTaskID := uint64(3)
+	type args struct {
+		entry dto.NewHistoryEntry
+		query func(mock sqlmock.Sqlmock, args args)
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "Happy path",
+			args: args{
+				entry: dto.NewHistoryEntry{
+					UserID:     1,
+					BoardID:    1,
+					EntityType: dto.HistoryEntityTask,
+					EntityID:   1,
+					Action:     dto.HistoryActionUpdate,
+					Changes:    []dto.HistoryFieldChange{{Field: "name", Before: "a", After: "b"}},
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO public.edit_history")+".*"+
+						regexp.QuoteMeta("(SELECT version FROM public.board WHERE id = $11)) RETURNING id")).
+						WithArgs(
+							args.entry.UserID,
+							args.entry.BoardID,
+							sqlmock.AnyArg(),
+							"update task",
+							args.entry.EntityType,
+							args.entry.EntityID,
+							args.entry.Action,
+							`[{"field":"name","before":"a","after":"b"}]`,
+							nil,
+							nil,
+							args.entry.BoardID,
+						).
+						WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+					mock.ExpectExec(regexp.QuoteMeta("WITH affected(id) AS (SELECT entity.id FROM public.task AS entity "+
+						"JOIN public.list ON public.list.id = entity.id_list WHERE entity.id IN ($1)) "+watcherNotificationsInsert(2))).
+						WithArgs(args.entry.EntityID, dto.NotificationChange, uint64(5), args.entry.BoardID, args.entry.BoardID, args.entry.UserID).
+						WillReturnResult(sqlmock.NewResult(0, 2))
+					mock.ExpectCommit()
+				},
+			},
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "Board watchers notified about a list",
+			args: args{
+				entry: dto.NewHistoryEntry{
+					UserID:     1,
+					BoardID:    2,
+					EntityType: dto.HistoryEntityList,
+					EntityID:   3,
+					Action:     dto.HistoryActionCreate,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO public.edit_history")).
+						WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+					mock.ExpectExec(regexp.QuoteMeta("WITH affected(id) AS (SELECT NULL::integer WHERE false) "+watcherNotificationsInsert(1))).
+						WithArgs(dto.NotificationChange, uint64(5), args.entry.BoardID, args.entry.BoardID, args.entry.UserID).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				},
+			},
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "Bulk entry notifies watchers of every task",
+			args: args{
+				entry: dto.NewHistoryEntry{
+					UserID:     1,
+					BoardID:    2,
+					EntityType: dto.HistoryEntityTask,
+					Action:     dto.HistoryActionAddUser,
+					Changes: []dto.HistoryFieldChange{
+						{EntityID: 3, Field: "users", After: 4},
+						{EntityID: 7, Field: "users", After: 4},
+					},
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO public.edit_history")).
+						WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+					mock.ExpectExec(regexp.QuoteMeta("WHERE entity.id IN ($1,$2)) "+watcherNotificationsInsert(3))).
+						WithArgs(uint64(3), uint64(7), dto.NotificationChange, uint64(5), args.entry.BoardID, args.entry.BoardID, args.entry.UserID).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				},
+			},
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "Task delete notifies watchers recorded before the delete",
+			args: args{
+				entry: dto.NewHistoryEntry{
+					UserID:     1,
+					BoardID:    2,
+					EntityType: dto.HistoryEntityTask,
+					EntityID:   3,
+					Action:     dto.HistoryActionDelete,
+					TaskID:     &deletedTaskID,
+					Watchers:   []uint64{4, 6},
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO public.edit_history")).
+						WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+					mock.ExpectExec(regexp.QuoteMeta("WHERE entity.id IN ($1) UNION SELECT $2::integer) "+
+						"INSERT INTO public.notification (id_user,id_task,kind,id_history) "+
+						"SELECT watcher.id_user, (SELECT min(affected.id) FROM affected JOIN public.task ON public.task.id = affected.id), $3, $4::integer "+
+						"FROM (SELECT id_user FROM public.task_watcher WHERE id_task IN (SELECT id FROM affected) "+
+						"UNION SELECT id_user FROM public.board_watcher WHERE id_board = $5 "+
+						"UNION SELECT id FROM public.\"user\" WHERE id IN ($6,$7)) AS watcher")).
+						WithArgs(args.entry.EntityID, deletedTaskID, dto.NotificationChange, uint64(5), args.entry.BoardID,
+							uint64(4), uint64(6), args.entry.BoardID, args.entry.UserID).
+						WillReturnResult(sqlmock.NewResult(0, 2))
+					mock.ExpectCommit()
+				},
+			},
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "Insert fail",
+			args: args{
+				entry: dto.NewHistoryEntry{
+					UserID:     1,
+					BoardID:    1,
+					EntityType: dto.HistoryEntityList,
+					EntityID:   1,
+					Action:     dto.HistoryActionCreate,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO public.edit_history")).
+						WithArgs(
+							args.entry.UserID,
+							args.entry.BoardID,
+							sqlmock.AnyArg(),
+							"create list",
+							args.entry.EntityType,
+							args.entry.EntityID,
+							args.entry.Action,
+							`[]`,
+							nil,
+							nil,
+							args.entry.BoardID,
+						).
+						WillReturnError(apperrors.ErrCouldNotExecuteQuery)
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrCouldNotRecordHistory,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			tt.args.query(mock, tt.args)
+
+			s := NewHistoryStorage(db)
+
+			err = s.Record(ctx, tt.args.entry)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PostgresHistoryStorage.Record() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, tt.err) {
+				t.Errorf("PostgresHistoryStorage.Record() error = %v, want %v", err, tt.err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPostgresHistoryStorage_ReadMany(t *testing.T) {
+	t.Parallel()
+	entityType := dto.HistoryEntityTask
+	userID := uint64(2)
+	from := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	type args struct {
+		request dto.BoardHistoryRequest
+		query   func(mock sqlmock.Sqlmock, args args)
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantLen int
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "Happy path with filters",
+			args: args{
+				request: dto.BoardHistoryRequest{
+					BoardID:    1,
+					EntityType: &entityType,
+					UserID:     &userID,
+					From:       &from,
+					Limit:      10,
+					Offset:     20,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					query, _, _ := sq.Select(append(allHistoryEntryFields, allPublicUserFields...)...).
+						From("public.edit_history").
+						LeftJoin("public.user ON public.user.id = public.edit_history.id_user").
+						Where(sq.And{
+							sq.Eq{"public.edit_history.id_board": args.request.BoardID},
+							sq.Eq{"public.edit_history.entity_type": *args.request.EntityType},
+							sq.Eq{"public.edit_history.id_user": *args.request.UserID},
+							sq.GtOrEq{"public.edit_history.edit_date": *args.request.From},
+						}).
+						OrderBy("public.edit_history.edit_date DESC", "public.edit_history.id DESC").
+						Limit(args.request.Limit).
+						Offset(args.request.Offset).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectQuery(regexp.QuoteMeta(query)).
+						WithArgs(args.request.BoardID, entityType, userID, from).
+						WillReturnRows(sqlmock.NewRows(append(allHistoryEntryFields, allPublicUserFields...)).
+							AddRow(5, 1, time.Now(), "update task", "task", 1, "update",
+								[]byte(`[{"field":"name","before":"a","after":"b"}]`), nil, nil,
+								2, "ivan@mail.ru", "Ivan", "Ivanov", "", "avatar.png"),
+						)
+				},
+			},
+			wantLen: 1,
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "Query fail",
+			args: args{
+				request: dto.BoardHistoryRequest{
+					BoardID: 1,
+					Limit:   10,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					query, _, _ := sq.Select(append(allHistoryEntryFields, allPublicUserFields...)...).
+						From("public.edit_history").
+						LeftJoin("public.user ON public.user.id = public.edit_history.id_user").
+						Where(sq.And{sq.Eq{"public.edit_history.id_board": args.request.BoardID}}).
+						OrderBy("public.edit_history.edit_date DESC", "public.edit_history.id DESC").
+						Limit(args.request.Limit).
+						Offset(args.request.Offset).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectQuery(regexp.QuoteMeta(query)).
+						WithArgs(args.request.BoardID).
+						WillReturnError(apperrors.ErrCouldNotExecuteQuery)
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrCouldNotGetHistory,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			tt.args.query(mock, tt.args)
+
+			s := NewHistoryStorage(db)
+
+			got, err := s.ReadMany(ctx, tt.args.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PostgresHistoryStorage.ReadMany() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, tt.err) {
+				t.Errorf("PostgresHistoryStorage.ReadMany() error = %v, want %v", err, tt.err)
+			}
+			if !tt.wantErr && (len(*got) != tt.wantLen || len((*got)[0].Changes) != 1) {
+				t.Errorf("PostgresHistoryStorage.ReadMany() = %v, want %v entries", *got, tt.wantLen)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+const (
+	lockedTaskQuery = "SELECT row_to_json(entity) FROM public.task AS entity JOIN public.list ON public.list.id = entity.id_list " +
+		"WHERE entity.id = $1 AND public.list.id_board = $2 FOR UPDATE OF entity"
+	taskOnUserBoardQuery = "SELECT EXISTS(SELECT 1 FROM public.task AS entity JOIN public.list ON public.list.id = entity.id_list " +
+		"JOIN public.board_user ON public.board_user.id_board = public.list.id_board " +
+		"WHERE entity.id = $1 AND public.board_user.id_user = $2)"
+	revertTaskListQuery = "SELECT public.list.id, public.task.date_archived IS NULL, public.list.date_archived IS NULL " +
+		"FROM public.task JOIN public.list ON public.list.id = public.task.id_list WHERE public.task.id = $1 FOR UPDATE OF list"
+)
+
+func TestPostgresHistoryStorage_Revert(t *testing.T) {
+	t.Parallel()
+	revertedID := uint64(5)
+	taskID := uint64(1)
+	type args struct {
+		info  dto.HistoryRevertInfo
+		query func(mock sqlmock.Sqlmock, args args)
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "Happy path (rename back)",
+			args: args{
+				info: dto.HistoryRevertInfo{
+					Original: dto.BoardHistoryEntry{
+						ID:         5,
+						BoardID:    1,
+						EntityType: dto.HistoryEntityTask,
+						EntityID:   1,
+						Action:     dto.HistoryActionUpdate,
+						Changes:    []dto.HistoryFieldChange{{Field: "name", Before: "old", After: "new"}},
+					},
+					Revert: dto.NewHistoryEntry{
+						UserID:     2,
+						BoardID:    1,
+						EntityType: dto.HistoryEntityTask,
+						EntityID:   1,
+						Action:     dto.HistoryActionUpdate,
+						Changes:    []dto.HistoryFieldChange{{Field: "name", Before: "new", After: "old"}},
+						RevertedID: &revertedID,
+						TaskID:     &taskID,
+					},
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta(lockedTaskQuery)).
+						WithArgs(args.info.Original.EntityID, args.info.Original.BoardID).
+						WillReturnRows(sqlmock.NewRows([]string{"row_to_json"}).
+							AddRow([]byte(`{"id":1,"name":"new","id_list":2}`)),
+						)
+					mock.ExpectExec(regexp.QuoteMeta(`UPDATE public.task SET "name" = (jsonb_populate_record(NULL::public.task, $1::jsonb))."name", row_version = row_version + 1 WHERE id = $2`)).
+						WithArgs(`{"name":"old"}`, args.info.Original.EntityID).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectQuery(regexp.QuoteMeta(taskOnUserBoardQuery)).
+						WithArgs(args.info.Original.EntityID, args.info.Revert.UserID).
+						WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+					mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO public.edit_history")).
+						WithArgs(
+							args.info.Revert.UserID,
+							args.info.Revert.BoardID,
+							sqlmock.AnyArg(),
+							"update task",
+							args.info.Revert.EntityType,
+							args.info.Revert.EntityID,
+							args.info.Revert.Action,
+							`[{"field":"name","before":"new","after":"old"}]`,
+							args.info.Revert.RevertedID,
+							args.info.Revert.TaskID,
+							args.info.Revert.BoardID,
+						).
+						WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(6))
+					mock.ExpectExec(regexp.QuoteMeta("UNION SELECT $2::integer) "+watcherNotificationsInsert(3))).
+						WithArgs(args.info.Revert.EntityID, *args.info.Revert.TaskID, dto.NotificationChange, uint64(6),
+							args.info.Revert.BoardID, args.info.Revert.BoardID, args.info.Revert.UserID).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				},
+			},
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "Conflict (changed since)",
+			args: args{
+				info: dto.HistoryRevertInfo{
+					Original: dto.BoardHistoryEntry{
+						ID:         5,
+						BoardID:    1,
+						EntityType: dto.HistoryEntityTask,
+						EntityID:   1,
+						Action:     dto.HistoryActionUpdate,
+						Changes:    []dto.HistoryFieldChange{{Field: "name", Before: "old", After: "new"}},
+					},
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta(lockedTaskQuery)).
+						WithArgs(args.info.Original.EntityID, args.info.Original.BoardID).
+						WillReturnRows(sqlmock.NewRows([]string{"row_to_json"}).
+							AddRow([]byte(`{"id":1,"name":"newer","id_list":2}`)),
+						)
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrHistoryRevertConflict,
+		},
+		{
+			name: "Conflict (deleted entity already restored)",
+			args: args{
+				info: dto.HistoryRevertInfo{
+					Original: dto.BoardHistoryEntry{
+						ID:         5,
+						BoardID:    1,
+						EntityType: dto.HistoryEntityList,
+						EntityID:   1,
+						Action:     dto.HistoryActionDelete,
+						Changes: []dto.HistoryFieldChange{
+							{Field: dto.HistoryChildrenField, Before: float64(0), After: nil},
+							{Field: "name", Before: "list", After: nil},
+						},
+					},
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta("SELECT EXISTS(SELECT 1 FROM public.list WHERE id = $1)")).
+						WithArgs(args.info.Original.EntityID).
+						WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrHistoryRevertConflict,
+		},
+		{
+			name: "No access (entity moved to another board)",
+			args: args{
+				info: dto.HistoryRevertInfo{
+					Original: dto.BoardHistoryEntry{
+						ID:         5,
+						BoardID:    1,
+						EntityType: dto.HistoryEntityTask,
+						EntityID:   1,
+						Action:     dto.HistoryActionCreate,
+						Changes:    []dto.HistoryFieldChange{{Field: "name", Before: nil, After: "new"}},
+					},
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta(lockedTaskQuery)).
+						WithArgs(args.info.Original.EntityID, args.info.Original.BoardID).
+						WillReturnError(sql.ErrNoRows)
+					mock.ExpectQuery(regexp.QuoteMeta("SELECT EXISTS(SELECT 1 FROM public.task WHERE id = $1)")).
+						WithArgs(args.info.Original.EntityID).
+						WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrNoBoardAccess,
+		},
+		{
+			name: "No access (move reverted onto another board)",
+			args: args{
+				info: dto.HistoryRevertInfo{
+					Original: dto.BoardHistoryEntry{
+						ID:         5,
+						BoardID:    1,
+						EntityType: dto.HistoryEntityTask,
+						EntityID:   1,
+						Action:     dto.HistoryActionMove,
+						Changes:    []dto.HistoryFieldChange{{Field: "id_list", Before: float64(7), After: float64(2)}},
+					},
+					Revert: dto.NewHistoryEntry{UserID: 2},
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta(lockedTaskQuery)).
+						WithArgs(args.info.Original.EntityID, args.info.Original.BoardID).
+						WillReturnRows(sqlmock.NewRows([]string{"row_to_json"}).
+							AddRow([]byte(`{"id":1,"name":"new","id_list":2}`)),
+						)
+					mock.ExpectExec(regexp.QuoteMeta(`UPDATE public.task SET "id_list" =`)).
+						WithArgs(`{"id_list":7}`, args.info.Original.EntityID).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectQuery(regexp.QuoteMeta(taskOnUserBoardQuery)).
+						WithArgs(args.info.Original.EntityID, args.info.Revert.UserID).
+						WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrNoBoardAccess,
+		},
+		{
+			name: "WIP limit exceeded (move reverted)",
+			args: args{
+				info: dto.HistoryRevertInfo{
+					Original: dto.BoardHistoryEntry{
+						ID:         5,
+						BoardID:    1,
+						EntityType: dto.HistoryEntityTask,
+						EntityID:   1,
+						Action:     dto.HistoryActionMove,
+						Changes:    []dto.HistoryFieldChange{{Field: "id_list", Before: float64(7), After: float64(2)}},
+					},
+					Revert: dto.NewHistoryEntry{UserID: 2},
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta(lockedTaskQuery)).
+						WithArgs(args.info.Original.EntityID, args.info.Original.BoardID).
+						WillReturnRows(sqlmock.NewRows([]string{"row_to_json"}).
+							AddRow([]byte(`{"id":1,"name":"new","id_list":2}`)),
+						)
+					mock.ExpectExec(regexp.QuoteMeta(`UPDATE public.task SET "id_list" =`)).
+						WithArgs(`{"id_list":7}`, args.info.Original.EntityID).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectQuery(regexp.QuoteMeta(taskOnUserBoardQuery)).
+						WithArgs(args.info.Original.EntityID, args.info.Revert.UserID).
+						WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+					mock.ExpectQuery(regexp.QuoteMeta(revertTaskListQuery)).
+						WithArgs(args.info.Original.EntityID).
+						WillReturnRows(sqlmock.NewRows([]string{"id", "task_active", "list_active"}).AddRow(7, true, true))
+					expectHardWipLimit(mock, 7, true)
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrWipLimitExceeded,
+		},
+		{
+			name: "Conflict (deleted task restored into archived list)",
+			args: args{
+				info: dto.HistoryRevertInfo{
+					Original: dto.BoardHistoryEntry{
+						ID:         5,
+						BoardID:    1,
+						EntityType: dto.HistoryEntityTask,
+						EntityID:   1,
+						Action:     dto.HistoryActionDelete,
+						Changes: []dto.HistoryFieldChange{
+							{Field: dto.HistoryChildrenField, Before: float64(0), After: nil},
+							{Field: "id_list", Before: float64(2), After: nil},
+						},
+					},
+					Revert: dto.NewHistoryEntry{UserID: 2},
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta("SELECT EXISTS(SELECT 1 FROM public.task WHERE id = $1)")).
+						WithArgs(args.info.Original.EntityID).
+						WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+					mock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.task SELECT (jsonb_populate_record(NULL::public.task, $1::jsonb)).*")).
+						WithArgs(`{"id":1,"id_list":2,"row_version":1}`).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectQuery(regexp.QuoteMeta(taskOnUserBoardQuery)).
+						WithArgs(args.info.Original.EntityID, args.info.Revert.UserID).
+						WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+					mock.ExpectQuery(regexp.QuoteMeta(revertTaskListQuery)).
+						WithArgs(args.info.Original.EntityID).
+						WillReturnRows(sqlmock.NewRows([]string{"id", "task_active", "list_active"}).AddRow(2, true, false))
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrHistoryRevertConflict,
+		},
+		{
+			name: "Not revertible (deleted with children)",
+			args: args{
+				info: dto.HistoryRevertInfo{
+					Original: dto.BoardHistoryEntry{
+						ID:         5,
+						BoardID:    1,
+						EntityType: dto.HistoryEntityTask,
+						EntityID:   1,
+						Action:     dto.HistoryActionDelete,
+						Changes: []dto.HistoryFieldChange{
+							{Field: dto.HistoryChildrenField, Before: float64(3), After: nil},
+							{Field: "name", Before: "task", After: nil},
+						},
+					},
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrHistoryNotRevertible,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			tt.args.query(mock, tt.args)
+
+			s := NewHistoryStorage(db)
+
+			err = s.Revert(ctx, tt.args.info)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PostgresHistoryStorage.Revert() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, tt.err) {
+				t.Errorf("PostgresHistoryStorage.Revert() error = %v, want %v", err, tt.err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPostgresHistoryStorage_ReadChanges(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		request dto.BoardChangesRequest
+		query   func(mock sqlmock.Sqlmock, args args)
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *[]dto.BoardChange
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "Happy path",
+			args: args{
+				request: dto.BoardChangesRequest{BoardID: 1, Since: 4},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectQuery(regexp.QuoteMeta("SELECT DISTINCT ON (entity_type, entity_id) entity_type, "+
+						"COALESCE((change.value->>'entity_id')::integer, public.edit_history.id_entity) AS entity_id, action, board_version "+
+						"FROM public.edit_history LEFT JOIN "+bulkHistoryChanges+" "+
+						"WHERE id_board = $1 AND board_version > $2 ORDER BY entity_type, entity_id, board_version DESC")).
+						WithArgs(args.request.BoardID, args.request.Since).
+						WillReturnRows(sqlmock.NewRows(boardChangeFields).
+							AddRow(dto.HistoryEntityTask, 3, dto.HistoryActionDelete, 7),
+						)
+				},
+			},
+			want:    &[]dto.BoardChange{{EntityType: dto.HistoryEntityTask, EntityID: 3, Action: dto.HistoryActionDelete, Version: 7}},
+			wantErr: false,
+		},
+		{
+			name: "Query fail",
+			args: args{
+				request: dto.BoardChangesRequest{BoardID: 1, Since: 4},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectQuery(regexp.QuoteMeta("FROM public.edit_history")).
+						WithArgs(args.request.BoardID, args.request.Since).
+						WillReturnError(apperrors.ErrCouldNotExecuteQuery)
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrCouldNotGetHistory,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			tt.args.query(mock, tt.args)
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			s := NewHistoryStorage(db)
+
+			got, err := s.ReadChanges(ctx, tt.args.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PostgresHistoryStorage.ReadChanges() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, tt.err) {
+				t.Errorf("PostgresHistoryStorage.ReadChanges() error = %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PostgresHistoryStorage.ReadChanges() = %v, want %v", got, tt.want)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPostgresHistoryStorage_ReadTaskActivity(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	ctx := context.WithValue(
+		context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+		dto.RequestIDKey, uuid.New(),
+	)
+	request := dto.TaskActivityRequest{TaskID: 7, BoardID: 2, Limit: 3}
+	taskID := uint64(7)
+	created := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	feedColumns := []string{"kind", "position", "date_event", "id_user", "id_history", "id_comment", "content"}
+
+	mock.ExpectQuery(regexp.QuoteMeta("UNION ALL SELECT $71::text, NULL::integer, public.comment.date_created, public.comment.id_user, " +
+		"NULL::integer, public.comment.id, public.comment.content FROM public.comment WHERE public.comment.id_task = $72) AS item " +
+		"ORDER BY item.date_event, item.id_comment IS NOT NULL, item.id_history, item.position, item.id_comment " +
+		"LIMIT 3 OFFSET 0")).
+		WillReturnRows(sqlmock.NewRows(feedColumns).
+			AddRow(dto.TaskActivityCreated, 0, created, 2, 5, nil, nil).
+			AddRow(dto.TaskActivityComment, nil, created.Add(time.Hour), 3, nil, 4, "готово").
+			AddRow(dto.TaskActivityMoved, 1, created.Add(2*time.Hour), 2, 6, nil, nil),
+		)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM (SELECT rule.kind")).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+	mock.ExpectQuery(regexp.QuoteMeta("WHERE public.edit_history.id IN ($1,$2)")).
+		WithArgs(5, 6).
+		WillReturnRows(sqlmock.NewRows(append(allHistoryEntryFields, allPublicUserFields...)).
+			AddRow(5, 2, created, "create task", "task", 7, "create",
+				[]byte(`[{"field":"name","after":"Задача"}]`), nil, taskID,
+				2, "ivan@mail.ru", "Ivan", "Ivanov", "", "avatar.png").
+			AddRow(6, 2, created.Add(2*time.Hour), "move task", "task", 0, "move",
+				[]byte(`[{"entity_id":6,"field":"id_list","before":1,"after":2},{"entity_id":7,"field":"id_list","before":1,"after":2}]`), nil, nil,
+				2, "ivan@mail.ru", "Ivan", "Ivanov", "", "avatar.png"),
+		)
+
+	s := NewHistoryStorage(db)
+
+	got, err := s.ReadTaskActivity(ctx, request)
+	if err != nil {
+		t.Fatalf("PostgresHistoryStorage.ReadTaskActivity() error = %v", err)
+	}
+	if got.Total != 5 || len(got.Items) != 3 {
+		t.Fatalf("PostgresHistoryStorage.ReadTaskActivity() = %+v", *got)
+	}
+	if got.Items[0].Type != dto.TaskActivityCreated || *got.Items[0].HistoryID != 5 || len(got.Items[0].Changes) != 0 {
+		t.Errorf("PostgresHistoryStorage.ReadTaskActivity() created = %+v", got.Items[0])
+	}
+	if got.Items[1].Comment == nil || got.Items[1].Comment.ID != 4 || got.Items[1].UserID != 3 || got.Items[1].Comment.Text != "готово" {
+		t.Errorf("PostgresHistoryStorage.ReadTaskActivity() comment = %+v", got.Items[1])
+	}
+	if len(got.Items[2].Changes) != 1 || got.Items[2].Changes[0].EntityID != taskID {
+		t.Errorf("PostgresHistoryStorage.ReadTaskActivity() moved = %+v", got.Items[2])
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestTaskActivityChanges(t *testing.T) {
+	t.Parallel()
+
+	rule := func(kind string) taskActivityRule {
+		for _, rule := range taskActivityRules {
+			if rule.kind == kind {
+				return rule
+			}
+		}
+		t.Fatalf("no task activity rule for %s", kind)
+		return taskActivityRule{}
+	}
+
+	update := dto.BoardHistoryEntry{EntityType: dto.HistoryEntityTask, EntityID: 7, Action: dto.HistoryActionUpdate,
+		Changes: []dto.HistoryFieldChange{
+			{Field: "description", Before: nil, After: "текст"},
+			{Field: "name", Before: "Задача", After: "Вёрстка"},
+			{Field: "priority", Before: "none", After: "high"},
+			{Field: "task_end", Before: nil, After: "2024-03-10T18:00:00Z"},
+		}}
+	bulkMove := dto.BoardHistoryEntry{EntityType: dto.HistoryEntityTask, Action: dto.HistoryActionMove,
+		Changes: []dto.HistoryFieldChange{
+			{EntityID: 6, Field: "id_list", Before: 1, After: 2},
+			{EntityID: 7, Field: "id_list", Before: 1, After: 2},
+			{EntityID: 7, Field: "list_position", Before: 1, After: 0},
+		}}
+	create := dto.BoardHistoryEntry{EntityType: dto.HistoryEntityTask, EntityID: 7, Action: dto.HistoryActionCreate,
+		Changes: []dto.HistoryFieldChange{{Field: "name", After: "Задача"}}}
+	bulkTag := dto.BoardHistoryEntry{EntityType: dto.HistoryEntityTask, Action: dto.HistoryActionAddTag,
+		Changes: []dto.HistoryFieldChange{{EntityID: 6, Field: "id_tag", After: 4}, {EntityID: 7, Field: "id_tag", After: 4}}}
+
+	tests := []struct {
+		name  string
+		entry dto.BoardHistoryEntry
+		kind  string
+		want  []dto.HistoryFieldChange
+	}{
+		{
+			name:  "Renamed",
+			entry: update,
+			kind:  dto.TaskActivityRenamed,
+			want:  []dto.HistoryFieldChange{{Field: "name", Before: "Задача", After: "Вёрстка"}},
+		},
+		{
+			name:  "Dates changed",
+			entry: update,
+			kind:  dto.TaskActivityDatesChanged,
+			want:  []dto.HistoryFieldChange{{Field: "task_end", Before: nil, After: "2024-03-10T18:00:00Z"}},
+		},
+		{
+			name:  "Moved by bulk operation",
+			entry: bulkMove,
+			kind:  dto.TaskActivityMoved,
+			want:  []dto.HistoryFieldChange{{EntityID: 7, Field: "id_list", Before: 1, After: 2}},
+		},
+		{
+			name:  "Created",
+			entry: create,
+			kind:  dto.TaskActivityCreated,
+			want:  []dto.HistoryFieldChange{},
+		},
+		{
+			name:  "Tagged by bulk operation",
+			entry: bulkTag,
+			kind:  dto.TaskActivityTagAdded,
+			want:  []dto.HistoryFieldChange{{EntityID: 7, Field: "id_tag", After: 4}},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := taskActivityChanges(7, tt.entry, rule(tt.kind)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("taskActivityChanges() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
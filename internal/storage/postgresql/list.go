@@ -3,6 +3,7 @@ package postgresql
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"server/internal/apperrors"
@@ -33,16 +34,34 @@ func NewListStorage(db *sql.DB) *PostgresListStorage {
 // создает новый список в БД по данным
 // или возвращает ошибки ...
 func (s PostgresListStorage) Create(ctx context.Context, info dto.NewListInfo) (*entities.List, error) {
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		log.Println("Storage -- Failed to begin transaction")
+		return nil, apperrors.ErrCouldNotBeginTransaction
+	}
+
+	key, err := listRankScope.appendKey(ctx, tx, info.BoardID)
+	if err != nil {
+		log.Println("Storage -- Failed to get list rank")
+		if errRollback := tx.Rollback(); errRollback != nil {
+			return nil, apperrors.ErrCouldNotRollback
+		}
+		return nil, apperrors.ErrListNotCreated
+	}
+
 	sql, args, err := sq.
 		Insert("public.list").
-		Columns("name", "list_position", "description", "id_board").
-		Values(info.Name, info.ListPosition, info.Description, info.BoardID).
+		Columns("name", "list_position", "description", "id_board", "rank").
+		Values(info.Name, info.ListPosition, info.Description, info.BoardID, key).
 		Suffix("RETURNING id").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 
 	if err != nil {
 		log.Println("Storage -- Failed to build query")
+		if errRollback := tx.Rollback(); errRollback != nil {
+			return nil, apperrors.ErrCouldNotRollback
+		}
 		return nil, apperrors.ErrCouldNotBuildQuery
 	}
 
@@ -53,16 +72,25 @@ func (s PostgresListStorage) Create(ctx context.Context, info dto.NewListInfo) (
 		BoardID:      info.BoardID,
 		Description:  info.Description,
 		ListPosition: info.ListPosition,
+		WipMode:      dto.WipModeSoft,
 		Tasks:        []entities.Task{},
 	}
 
-	query := s.db.QueryRow(sql, args...)
+	query := tx.QueryRow(sql, args...)
 
 	if err := query.Scan(&list.ID); err != nil {
 		log.Println("Storage -- Failed to create list")
+		if errRollback := tx.Rollback(); errRollback != nil {
+			return nil, apperrors.ErrCouldNotRollback
+		}
 		return nil, apperrors.ErrListNotCreated
 	}
 
+	if err = tx.Commit(); err != nil {
+		log.Println("Storage -- Failed to commit list")
+		return nil, apperrors.ErrCouldNotCommit
+	}
+
 	log.Println("Storage -- List created")
 
 	return &list, nil
@@ -81,7 +109,7 @@ func (s PostgresListStorage) GetTasksWithID(ctx context.Context, ids dto.ListIDs
 		Where(sq.Eq{"public.task.id_list": ids.Values}).
 		LeftJoin("public.task_user ON public.task_user.id_task = public.task.id").
 		GroupBy("public.task.id", "public.task.id_list").
-		OrderBy("public.task.list_position").
+		OrderBy("public.task.rank", "public.task.id").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
@@ -89,7 +117,7 @@ func (s PostgresListStorage) GetTasksWithID(ctx context.Context, ids dto.ListIDs
 	}
 	logger.DebugFmt("Built query\n\t"+taskSql+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	taskRows, err := s.db.Query(taskSql, args...)
+	taskRows, err := conn(ctx, s.db).Query(taskSql, args...)
 	if err != nil {
 		return nil, apperrors.ErrCouldNotGetTask
 	}
@@ -121,16 +149,69 @@ func (s PostgresListStorage) GetTasksWithID(ctx context.Context, ids dto.ListIDs
 	return &tasks, nil
 }
 
-// Update
-// обновляет список в БД
+// Read
+// возвращает список из БД по id
 // или возвращает ошибки ...
+func (s PostgresListStorage) Read(ctx context.Context, id dto.ListID) (*dto.SingleListInfo, error) {
+	funcName := "PostgresListStorage.Read"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select(allListTaskAggFields...).
+		From("public.list").
+		LeftJoin(activeListTasks).
+		Where(sq.Eq{"public.list.id": id.Value}).
+		GroupBy("public.list.id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	list := dto.SingleListInfo{}
+	err = conn(ctx, s.db).QueryRow(query, args...).Scan(
+		&list.ID,
+		&list.BoardID,
+		&list.Name,
+		&list.ListPosition,
+		&list.WipLimit,
+		&list.WipMode,
+		&list.IsDone,
+		&list.RequireUnblocked,
+		&list.RowVersion,
+		&list.DateArchived,
+		&list.TaskCount,
+		(*pq.StringArray)(&list.TaskIDs),
+	)
+	if err == sql.ErrNoRows {
+		return nil, apperrors.ErrListNotFound
+	}
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotGetList
+	}
+	logger.DebugFmt("Got list", requestID.String(), funcName, nodeName)
+
+	return &list, nil
+}
+
+// Update
+// обновляет список в БД, если его версия не изменилась с момента чтения клиентом.
+// Завершающий список, требующий выполнения блокирующих заданий, не может содержать заблокированные задания
+// или возвращает ошибки apperrors.ErrTaskBlocked, ...
 func (s PostgresListStorage) Update(ctx context.Context, info dto.UpdatedListInfo) error {
 	sql, args, err := sq.
 		Update("public.list").
 		Set("name", info.Name).
 		Set("description", info.Description).
 		Set("list_position", info.ListPosition).
-		Where(sq.Eq{"id": info.ID}).
+		Set("wip_limit", info.WipLimit).
+		Set("wip_mode", info.WipMode).
+		Set("is_done", info.IsDone).
+		Set("require_unblocked", info.RequireUnblocked).
+		Set("row_version", sq.Expr("row_version + 1")).
+		Where(sq.Eq{"id": info.ID, "row_version": info.RowVersion}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
@@ -138,12 +219,57 @@ func (s PostgresListStorage) Update(ctx context.Context, info dto.UpdatedListInf
 	}
 	log.Println("Built list query\n\t", sql, "\nwith args\n\t", args)
 
-	_, err = s.db.Exec(sql, args...)
+	// задания в завершающем списке считаются выполненными с момента попадания в него
+	completedSql, completedArgs, err := sq.
+		Update("public.task").
+		Set("date_completed", sq.Expr("CASE WHEN ? THEN COALESCE(date_completed, CURRENT_TIMESTAMP) END", info.IsDone)).
+		Where(sq.Eq{"id_list": info.ID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	log.Println("Built task query\n\t", completedSql, "\nwith args\n\t", completedArgs)
 
+	tx, err := beginTx(ctx, s.db)
 	if err != nil {
 		log.Println(err)
+		return apperrors.ErrCouldNotBeginTransaction
+	}
+
+	result, err := tx.Exec(sql, args...)
+	var updated int64
+	if err == nil {
+		updated, err = result.RowsAffected()
+	}
+	if err == nil && updated != 0 {
+		_, err = tx.Exec(completedSql, completedArgs...)
+	}
+	if err != nil {
+		log.Println(err)
+		if errRollback := tx.Rollback(); errRollback != nil {
+			return apperrors.ErrCouldNotRollback
+		}
 		return apperrors.ErrListNotUpdated
 	}
+	if updated == 0 {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			return apperrors.ErrCouldNotRollback
+		}
+		return apperrors.ErrRowVersionConflict
+	}
+	if err = checkBlockers(ctx, tx, info.ID); err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			return apperrors.ErrCouldNotRollback
+		}
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Println(err)
+		return apperrors.ErrCouldNotCommit
+	}
 	log.Println("list updated")
 
 	return nil
@@ -163,7 +289,7 @@ func (s PostgresListStorage) Delete(ctx context.Context, id dto.ListID) error {
 		return apperrors.ErrCouldNotBuildQuery
 	}
 
-	_, err = s.db.Exec(sql, args...)
+	_, err = conn(ctx, s.db).Exec(sql, args...)
 
 	if err != nil {
 		return apperrors.ErrListNotDeleted
@@ -172,35 +298,562 @@ func (s PostgresListStorage) Delete(ctx context.Context, id dto.ListID) error {
 	return nil
 }
 
+// Archive
+// переносит список в архив: список вместе с заданиями скрывается с доски, но остаётся в БД
+// или возвращает ошибки apperrors.ErrListNotFound, apperrors.ErrListNotArchived, ...
+func (s PostgresListStorage) Archive(ctx context.Context, id dto.ListID) error {
+	affected, err := setArchived(ctx, conn(ctx, s.db), "public.list", sq.Eq{"id": id.Value}, true, apperrors.ErrListNotArchived)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return apperrors.ErrListNotFound
+	}
+	return nil
+}
+
+// Unarchive
+// возвращает список из архива на прежнее место доски. Список с заданиями снова попадает на доску,
+// поэтому в той же транзакции проверяются его жёсткий лимит задач и заблокированные задания
+// или возвращает ошибки apperrors.ErrListNotFound, apperrors.ErrListNotArchived, apperrors.ErrWipLimitExceeded,
+// apperrors.ErrTaskBlocked, ...
+func (s PostgresListStorage) Unarchive(ctx context.Context, id dto.ListID) error {
+	funcName := "PostgresListStorage.Unarchive"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotBeginTransaction
+	}
+
+	rollback := func(reason error) error {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotRollback
+		}
+		return reason
+	}
+
+	affected, err := setArchived(ctx, tx, "public.list", sq.Eq{"id": id.Value}, false, apperrors.ErrListNotArchived)
+	if err != nil {
+		return rollback(err)
+	}
+	if affected == 0 {
+		return rollback(apperrors.ErrListNotFound)
+	}
+	if err = checkHardWipLimit(ctx, tx, id.Value); err != nil {
+		return rollback(err)
+	}
+	if err = checkBlockers(ctx, tx, id.Value); err != nil {
+		return rollback(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotCommit
+	}
+	logger.DebugFmt("List unarchived", requestID.String(), funcName, nodeName)
+
+	return nil
+}
+
+// ArchiveTasks
+// переносит в архив все неархивные задания списка
+// или возвращает ошибки apperrors.ErrTaskNotArchived, ...
+func (s PostgresListStorage) ArchiveTasks(ctx context.Context, id dto.ListID) error {
+	_, err := setArchived(ctx, conn(ctx, s.db), "public.task", sq.Eq{"id_list": id.Value, "date_archived": nil}, true, apperrors.ErrTaskNotArchived)
+	return err
+}
+
 // UpdateOrder
-// меняет порядок списков в БД по данным
-// или возвращает ошибки ...
-func (s PostgresListStorage) UpdateOrder(ctx context.Context, ids dto.ListIDs) error {
-	caseBuilder := sq.Case()
-	for i, id := range ids.Values {
-		caseBuilder = caseBuilder.When(sq.Eq{"id": fmt.Sprintf("%v", id)}, fmt.Sprintf("%v", i)).Else("list_position")
+// ставит список сразу после другого списка той же доски; ключ порядка меняется только у него,
+// остальные списки доски получают новые ключи лишь при перестроении порядка
+// или возвращает ошибки apperrors.ErrListNotFound, apperrors.ErrInvalidPosition, ...
+func (s PostgresListStorage) UpdateOrder(ctx context.Context, info dto.ListMoveInfo) error {
+	funcName := "PostgresListStorage.UpdateOrder"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	boardQuery, boardArgs, err := sq.Select("id_board").
+		From("public.list").
+		Where(sq.Eq{"id": info.ListID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
 	}
+	logger.DebugFmt("Built query\n\t"+boardQuery+"\nwith args\n\t"+fmt.Sprintf("%+v", boardArgs), requestID.String(), funcName, nodeName)
 
-	sql, args, err := sq.
-		Update("public.list").
-		Set("list_position", caseBuilder).
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotBeginTransaction
+	}
+
+	rollback := func(reason error) error {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotRollback
+		}
+		return reason
+	}
+
+	var boardID uint64
+	err = tx.QueryRow(boardQuery, boardArgs...).Scan(&boardID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return rollback(apperrors.ErrListNotFound)
+	}
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrCouldNotChangeListOrder)
+	}
+
+	if err = listRankScope.lockParent(ctx, tx, boardID); err != nil {
+		return rollback(err)
+	}
+	key, err := listRankScope.place(ctx, tx, boardID, info.ListID, positionAfter(info.AfterID))
+	if err != nil {
+		return rollback(err)
+	}
+
+	query, args, err := sq.Update("public.list").
+		Set("rank", key).
+		Where(sq.Eq{"id": info.ListID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return rollback(apperrors.ErrCouldNotBuildQuery)
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	if _, err = tx.Exec(query, args...); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrCouldNotChangeListOrder)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotCommit
+	}
+	logger.DebugFmt("List moved", requestID.String(), funcName, nodeName)
+
+	return nil
+}
+
+// listSortOrders
+// выражения сортировки заданий списка в прямом порядке по ключам dto.ListSort*
+var listSortOrders = map[string]string{
+	dto.ListSortPriority: "array_position(ARRAY['" + dto.TaskPriorityUrgent + "', '" + dto.TaskPriorityHigh + "', '" +
+		dto.TaskPriorityMedium + "', '" + dto.TaskPriorityLow + "', '" + dto.TaskPriorityNone + "'], priority)",
+	dto.ListSortDue:     "task_end",
+	dto.ListSortName:    "lower(name)",
+	dto.ListSortCreated: "date_created",
+}
+
+// SortTasks
+// сортирует задания списка по ключу info.By и раздаёт им ключи порядка в новом порядке;
+// задания с одинаковым значением ключа сохраняют прежний взаимный порядок
+// или возвращает ошибки apperrors.ErrInvalidListSort, apperrors.ErrListNotFound, apperrors.ErrCouldNotChangeTaskOrder, ...
+func (s PostgresListStorage) SortTasks(ctx context.Context, info dto.ListSortInfo) error {
+	funcName := "PostgresListStorage.SortTasks"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	order, ok := listSortOrders[info.By]
+	if !ok {
+		return apperrors.ErrInvalidListSort
+	}
+	if info.Reverse {
+		order += " DESC"
+	}
+
+	query, args, err := sq.Select("id").
+		From("public.task").
+		Where(sq.Eq{"id_list": info.ListID}).
+		OrderBy(order+" NULLS LAST", "rank", "id").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
-		log.Println(err)
-		log.Println("Storage -- Failed to build query")
 		return apperrors.ErrCouldNotBuildQuery
 	}
-	log.Println("Built list query\n\t", sql, "\nwith args\n\t", args)
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	_, err = s.db.Exec(sql, args...)
+	tx, err := beginTx(ctx, s.db)
 	if err != nil {
-		log.Println("Storage -- Failed to create list")
-		log.Println("Error", err.Error())
-		return apperrors.ErrCouldNotExecuteQuery
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotBeginTransaction
 	}
 
-	log.Println("Storage -- List created")
+	rollback := func(reason error) error {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotRollback
+		}
+		return reason
+	}
+
+	err = taskRankScope.lockParent(ctx, tx, info.ListID)
+	if errors.Is(err, apperrors.ErrInvalidPosition) {
+		return rollback(apperrors.ErrListNotFound)
+	}
+	if err != nil {
+		return rollback(err)
+	}
+
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrCouldNotChangeTaskOrder)
+	}
+	sorted := []uint64{}
+	for rows.Next() {
+		var taskID uint64
+		if err = rows.Scan(&taskID); err != nil {
+			rows.Close()
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return rollback(apperrors.ErrCouldNotScanRows)
+		}
+		sorted = append(sorted, taskID)
+	}
+	rows.Close()
 
+	if _, err = taskRankScope.spread(ctx, tx, sorted, 0); err != nil {
+		return rollback(apperrors.ErrCouldNotChangeTaskOrder)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotCommit
+	}
+	logger.DebugFmt(fmt.Sprintf("Sorted %d tasks", len(sorted)), requestID.String(), funcName, nodeName)
+
+	return nil
+}
+
+// GetWipInfo
+// возвращает лимит задач в списке и текущее количество задач в нём
+// или возвращает ошибки apperrors.ErrListNotFound, apperrors.ErrCouldNotGetList
+func (s PostgresListStorage) GetWipInfo(ctx context.Context, id dto.ListID) (*dto.ListWipInfo, error) {
+	funcName := "PostgresListStorage.GetWipInfo"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select(listWipFields...).
+		From("public.list").
+		LeftJoin(activeListTasks).
+		Where(sq.Eq{"public.list.id": id.Value}).
+		GroupBy("public.list.id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	info := dto.ListWipInfo{}
+	err = conn(ctx, s.db).QueryRow(query, args...).Scan(&info.ListID, &info.WipLimit, &info.WipMode, &info.TaskCount)
+	if err == sql.ErrNoRows {
+		return nil, apperrors.ErrListNotFound
+	}
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotGetList
+	}
+	logger.DebugFmt("Got list wip info", requestID.String(), funcName, nodeName)
+
+	return &info, nil
+}
+
+// checkHardWipLimit
+// проверяет, что после изменения в транзакции неархивных заданий в списке не больше его жёсткого лимита.
+// Список должен быть заблокирован до изменения, чтобы параллельные добавления считались последовательно
+// или возвращает ошибки apperrors.ErrWipLimitExceeded, ...
+func checkHardWipLimit(ctx context.Context, tx executor, listID uint64) error {
+	funcName := "checkHardWipLimit"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select("count(public.task.id) > public.list.wip_limit").
+		From("public.list").
+		LeftJoin(activeListTasks).
+		Where(sq.Eq{"public.list.id": listID, "public.list.wip_mode": dto.WipModeHard}).
+		Where(sq.NotEq{"public.list.wip_limit": nil}).
+		GroupBy("public.list.id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	var exceeded bool
+	err = tx.QueryRow(query, args...).Scan(&exceeded)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotGetList
+	}
+	if exceeded {
+		return apperrors.ErrWipLimitExceeded
+	}
 	return nil
 }
+
+// checkBlockers
+// проверяет, что после изменения в транзакции в завершающем списке, требующем выполнения блокирующих заданий,
+// нет неархивных заданий taskIDs с невыполненными блокирующими заданиями; без taskIDs проверяются все задания списка
+// или возвращает ошибки apperrors.ErrTaskBlocked, ...
+func checkBlockers(ctx context.Context, tx executor, listID uint64, taskIDs ...uint64) error {
+	funcName := "checkBlockers"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	blocked := sq.Select("1").
+		From("public.list").
+		Join(activeListTasks).
+		Where(sq.Eq{"public.list.id": listID, "public.list.is_done": true, "public.list.require_unblocked": true}).
+		Where("EXISTS(" + openBlockers + " AND public.task_dependency.id_blocked = public.task.id)")
+	if len(taskIDs) != 0 {
+		blocked = blocked.Where(sq.Eq{"public.task.id": taskIDs})
+	}
+	query, args, err := sq.Select().Column(sq.Expr("EXISTS(?)", blocked)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	var exists bool
+	if err = tx.QueryRow(query, args...).Scan(&exists); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotGetList
+	}
+	if exists {
+		logger.DebugFmt(fmt.Sprintf("Blocked tasks can't be in list %v", listID), requestID.String(), funcName, nodeName)
+		return apperrors.ErrTaskBlocked
+	}
+	return nil
+}
+
+// Move
+// переносит список со всеми заданиями на другую доску, ставя его после списка info.AfterID,
+// и приводит задания к целевой доске: тэги заменяются на тэги с теми же названиями, исполнители
+// не из участников доски снимаются, значения чужих пользовательских полей удаляются
+// или возвращает ошибки apperrors.ErrListNotFound, apperrors.ErrListAlreadyOnBoard, apperrors.ErrInvalidPosition,
+// apperrors.ErrWipLimitExceeded, apperrors.ErrTaskBlocked, ...
+func (s PostgresListStorage) Move(ctx context.Context, info dto.ListTransferInfo) (*dto.ListTransferReport, error) {
+	funcName := "PostgresListStorage.Move"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	boardQuery, boardArgs, err := sq.Select("id_board").
+		From("public.list").
+		Where(sq.Eq{"id": info.ListID}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+boardQuery+"\nwith args\n\t"+fmt.Sprintf("%+v", boardArgs), requestID.String(), funcName, nodeName)
+
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBeginTransaction
+	}
+
+	rollback := func(reason error) error {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotRollback
+		}
+		return reason
+	}
+
+	var sourceBoardID uint64
+	err = tx.QueryRow(boardQuery, boardArgs...).Scan(&sourceBoardID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, rollback(apperrors.ErrListNotFound)
+	}
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, rollback(apperrors.ErrListNotMoved)
+	}
+	if sourceBoardID == info.BoardID {
+		return nil, rollback(apperrors.ErrListAlreadyOnBoard)
+	}
+
+	if err = listRankScope.lockParent(ctx, tx, info.BoardID); err != nil {
+		return nil, rollback(err)
+	}
+	key, err := listRankScope.place(ctx, tx, info.BoardID, info.ListID, positionAfter(info.AfterID))
+	if err != nil {
+		return nil, rollback(err)
+	}
+
+	query, args, err := sq.Update("public.list").
+		Set("id_board", info.BoardID).
+		Set("rank", key).
+		Where(sq.Eq{"id": info.ListID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, rollback(apperrors.ErrCouldNotBuildQuery)
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	if _, err = tx.Exec(query, args...); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, rollback(apperrors.ErrListNotMoved)
+	}
+
+	changes, err := adaptToBoard(ctx, tx, tasksInList(info.ListID), info.BoardID)
+	if err != nil {
+		return nil, rollback(err)
+	}
+	if err = checkHardWipLimit(ctx, tx, info.ListID); err != nil {
+		return nil, rollback(err)
+	}
+	if err = checkBlockers(ctx, tx, info.ListID); err != nil {
+		return nil, rollback(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotCommit
+	}
+	logger.DebugFmt("List moved to another board", requestID.String(), funcName, nodeName)
+
+	return &dto.ListTransferReport{
+		ListID:  info.ListID,
+		BoardID: info.BoardID,
+		Changes: *changes,
+	}, nil
+}
+
+// Copy
+// копирует список с заданиями, их чеклистами, тэгами и исполнителями на доску info.BoardID,
+// ставя копию после списка info.AfterID, и приводит скопированные задания к этой доске так же, как Move.
+// Комментарии и файлы заданий не копируются
+// или возвращает ошибки apperrors.ErrListNotFound, apperrors.ErrInvalidPosition, apperrors.ErrWipLimitExceeded,
+// apperrors.ErrTaskBlocked, ...
+func (s PostgresListStorage) Copy(ctx context.Context, info dto.ListTransferInfo) (*dto.ListTransferReport, error) {
+	funcName := "PostgresListStorage.Copy"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBeginTransaction
+	}
+
+	rollback := func(reason error) error {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotRollback
+		}
+		return reason
+	}
+
+	if err = listRankScope.lockParent(ctx, tx, info.BoardID); err != nil {
+		return nil, rollback(err)
+	}
+	key, err := listRankScope.place(ctx, tx, info.BoardID, 0, positionAfter(info.AfterID))
+	if err != nil {
+		return nil, rollback(err)
+	}
+
+	query, args, err := sq.Insert("public.list").
+		Columns("id_board", "name", "description", "list_position", "wip_limit", "wip_mode", "is_done", "require_unblocked", "rank").
+		Select(sq.Select().
+			Column(sq.Expr("?", info.BoardID)).
+			Columns("name", "description", "list_position", "wip_limit", "wip_mode", "is_done", "require_unblocked").
+			Column(sq.Expr("?", key)).
+			From("public.list").
+			Where(sq.Eq{"id": info.ListID})).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, rollback(apperrors.ErrCouldNotBuildQuery)
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	var copyID uint64
+	err = tx.QueryRow(query, args...).Scan(&copyID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, rollback(apperrors.ErrListNotFound)
+	}
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, rollback(apperrors.ErrListNotCopied)
+	}
+
+	query, args, err = sq.Select("id", "rank").
+		From("public.task").
+		Where(sq.Eq{"id_list": info.ListID}).
+		OrderBy("rank", "id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, rollback(apperrors.ErrCouldNotBuildQuery)
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, rollback(apperrors.ErrListNotCopied)
+	}
+	type rankedTask struct {
+		id   uint64
+		rank string
+	}
+	tasks := []rankedTask{}
+	for rows.Next() {
+		var task rankedTask
+		if err = rows.Scan(&task.id, &task.rank); err != nil {
+			rows.Close()
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return nil, rollback(apperrors.ErrCouldNotScanRows)
+		}
+		tasks = append(tasks, task)
+	}
+	rows.Close()
+
+	for _, task := range tasks {
+		if _, err = copyTask(ctx, tx, task.id, copyID, task.rank, listCopyParts); err != nil {
+			return nil, rollback(err)
+		}
+	}
+
+	changes, err := adaptToBoard(ctx, tx, tasksInList(copyID), info.BoardID)
+	if err != nil {
+		return nil, rollback(err)
+	}
+	if err = checkHardWipLimit(ctx, tx, copyID); err != nil {
+		return nil, rollback(err)
+	}
+	if err = checkBlockers(ctx, tx, copyID); err != nil {
+		return nil, rollback(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotCommit
+	}
+	logger.DebugFmt(fmt.Sprintf("List copied with %d tasks", len(tasks)), requestID.String(), funcName, nodeName)
+
+	return &dto.ListTransferReport{
+		ListID:  copyID,
+		BoardID: info.BoardID,
+		Changes: *changes,
+	}, nil
+}
@@ -2,10 +2,13 @@ package postgresql
 
 import (
 	"context"
-	"fmt"
+	"database/sql/driver"
+	"errors"
+	"reflect"
 	"regexp"
 	"server/internal/apperrors"
 	"server/internal/pkg/dto"
+	"server/internal/pkg/rank"
 	"testing"
 	"time"
 
@@ -13,8 +16,48 @@ import (
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
 )
 
+// expectHardWipLimit
+// ожидает проверку жёсткого лимита задач списка в checkHardWipLimit
+func expectHardWipLimit(mock sqlmock.Sqlmock, listID uint64, exceeded bool) {
+	query, _, _ := sq.Select("count(public.task.id) > public.list.wip_limit").
+		From("public.list").
+		LeftJoin(activeListTasks).
+		Where(sq.Eq{"public.list.id": listID, "public.list.wip_mode": dto.WipModeHard}).
+		Where(sq.NotEq{"public.list.wip_limit": nil}).
+		GroupBy("public.list.id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(listID, dto.WipModeHard).
+		WillReturnRows(sqlmock.NewRows([]string{"exceeded"}).AddRow(exceeded))
+}
+
+// expectBlockers
+// ожидает проверку заблокированных заданий списка в checkBlockers
+func expectBlockers(mock sqlmock.Sqlmock, listID uint64, blocked bool, taskIDs ...uint64) {
+	builder := sq.Select("1").
+		From("public.list").
+		Join(activeListTasks).
+		Where(sq.Eq{"public.list.id": listID, "public.list.is_done": true, "public.list.require_unblocked": true}).
+		Where("EXISTS(" + openBlockers + " AND public.task_dependency.id_blocked = public.task.id)")
+	args := []driver.Value{listID, true, true}
+	if len(taskIDs) != 0 {
+		builder = builder.Where(sq.Eq{"public.task.id": taskIDs})
+		for _, id := range taskIDs {
+			args = append(args, id)
+		}
+	}
+	query, _, _ := sq.Select().Column(sq.Expr("EXISTS(?)", builder)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(args...).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(blocked))
+}
+
 func TestPostgresListStorage_Create(t *testing.T) {
 	t.Parallel()
 	type args struct {
@@ -36,10 +79,13 @@ func TestPostgresListStorage_Create(t *testing.T) {
 					ListPosition: 0,
 				},
 				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					expectRankAppend(mock, listRankScope, args.info.BoardID, 0, "")
+
 					query, _, _ := sq.
 						Insert("public.list").
-						Columns("name", "list_position", "description", "id_board").
-						Values(args.info.Name, args.info.ListPosition, args.info.Description, args.info.BoardID).
+						Columns("name", "list_position", "description", "id_board", "rank").
+						Values(args.info.Name, args.info.ListPosition, args.info.Description, args.info.BoardID, "i").
 						Suffix("RETURNING id").
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
@@ -49,9 +95,11 @@ func TestPostgresListStorage_Create(t *testing.T) {
 							args.info.ListPosition,
 							args.info.Description,
 							args.info.BoardID,
+							"i",
 						).
 						WillReturnRows(sqlmock.NewRows([]string{"id"}).
 							AddRow(1))
+					mock.ExpectCommit()
 				},
 			},
 			wantErr: false,
@@ -66,10 +114,13 @@ func TestPostgresListStorage_Create(t *testing.T) {
 					ListPosition: 0,
 				},
 				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					expectRankAppend(mock, listRankScope, args.info.BoardID, 0, "")
+
 					query, _, _ := sq.
 						Insert("public.list").
-						Columns("name", "list_position", "description", "id_board").
-						Values(args.info.Name, args.info.ListPosition, args.info.Description, args.info.BoardID).
+						Columns("name", "list_position", "description", "id_board", "rank").
+						Values(args.info.Name, args.info.ListPosition, args.info.Description, args.info.BoardID, "i").
 						Suffix("RETURNING id").
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
@@ -79,21 +130,25 @@ func TestPostgresListStorage_Create(t *testing.T) {
 							args.info.ListPosition,
 							args.info.Description,
 							args.info.BoardID,
+							"i",
 						).
 						WillReturnError(apperrors.ErrListNotCreated)
+					mock.ExpectRollback()
 				},
 			},
 			wantErr: true,
 			err:     apperrors.ErrListNotCreated,
 		},
 		{
-			name: "Building query failed",
+			name: "Beginning transaction failed",
 			args: args{
-				info:  &dto.NewListInfo{},
-				query: func(mock sqlmock.Sqlmock, args args) {},
+				info: &dto.NewListInfo{},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin().WillReturnError(apperrors.ErrCouldNotBeginTransaction)
+				},
 			},
 			wantErr: true,
-			err:     apperrors.ErrCouldNotBuildQuery,
+			err:     apperrors.ErrCouldNotBeginTransaction,
 		},
 	}
 	for _, tt := range tests {
@@ -238,8 +293,9 @@ func TestPostgresListStorage_Update(t *testing.T) {
 			name: "Happy path",
 			args: args{
 				info: &dto.UpdatedListInfo{
-					ID:   1,
-					Name: "sdsd",
+					ID:         1,
+					Name:       "sdsd",
+					RowVersion: 2,
 				},
 				query: func(mock sqlmock.Sqlmock, args args) {
 					query, _, _ := sq.
@@ -247,28 +303,93 @@ func TestPostgresListStorage_Update(t *testing.T) {
 						Set("name", args.info.Name).
 						Set("description", args.info.Description).
 						Set("list_position", args.info.ListPosition).
-						Where(sq.Eq{"id": args.info.ID}).
+						Set("wip_limit", args.info.WipLimit).
+						Set("wip_mode", args.info.WipMode).
+						Set("is_done", args.info.IsDone).
+						Set("require_unblocked", args.info.RequireUnblocked).
+						Set("row_version", sq.Expr("row_version + 1")).
+						Where(sq.Eq{"id": args.info.ID, "row_version": args.info.RowVersion}).
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
+					mock.ExpectBegin()
 					mock.ExpectExec(regexp.QuoteMeta(query)).
 						WithArgs(
 							args.info.Name,
 							args.info.Description,
 							args.info.ListPosition,
+							args.info.WipLimit,
+							args.info.WipMode,
+							args.info.IsDone,
+							args.info.RequireUnblocked,
 							args.info.ID,
+							args.info.RowVersion,
 						).
 						WillReturnResult(sqlmock.NewResult(1, 1))
+					mock.ExpectExec(regexp.QuoteMeta("UPDATE public.task SET date_completed = CASE WHEN $1 THEN COALESCE(date_completed, CURRENT_TIMESTAMP) END WHERE id_list = $2")).
+						WithArgs(args.info.IsDone, args.info.ID).
+						WillReturnResult(sqlmock.NewResult(0, 3))
+					expectBlockers(mock, args.info.ID, false)
+					mock.ExpectCommit()
 				},
 			},
 			wantErr: false,
 			err:     nil,
 		},
 		{
-			name: "Happy path",
+			name: "Done list with blocked tasks",
+			args: args{
+				info: &dto.UpdatedListInfo{
+					ID:               1,
+					Name:             "sdsd",
+					RowVersion:       2,
+					IsDone:           true,
+					RequireUnblocked: true,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					query, _, _ := sq.
+						Update("public.list").
+						Set("name", args.info.Name).
+						Set("description", args.info.Description).
+						Set("list_position", args.info.ListPosition).
+						Set("wip_limit", args.info.WipLimit).
+						Set("wip_mode", args.info.WipMode).
+						Set("is_done", args.info.IsDone).
+						Set("require_unblocked", args.info.RequireUnblocked).
+						Set("row_version", sq.Expr("row_version + 1")).
+						Where(sq.Eq{"id": args.info.ID, "row_version": args.info.RowVersion}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectBegin()
+					mock.ExpectExec(regexp.QuoteMeta(query)).
+						WithArgs(
+							args.info.Name,
+							args.info.Description,
+							args.info.ListPosition,
+							args.info.WipLimit,
+							args.info.WipMode,
+							args.info.IsDone,
+							args.info.RequireUnblocked,
+							args.info.ID,
+							args.info.RowVersion,
+						).
+						WillReturnResult(sqlmock.NewResult(1, 1))
+					mock.ExpectExec(regexp.QuoteMeta("UPDATE public.task SET date_completed = CASE WHEN $1 THEN COALESCE(date_completed, CURRENT_TIMESTAMP) END WHERE id_list = $2")).
+						WithArgs(args.info.IsDone, args.info.ID).
+						WillReturnResult(sqlmock.NewResult(0, 3))
+					expectBlockers(mock, args.info.ID, true)
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrTaskBlocked,
+		},
+		{
+			name: "Update failed",
 			args: args{
 				info: &dto.UpdatedListInfo{
-					ID:   1,
-					Name: "sdsd",
+					ID:         1,
+					Name:       "sdsd",
+					RowVersion: 2,
 				},
 				query: func(mock sqlmock.Sqlmock, args args) {
 					query, _, _ := sq.
@@ -276,22 +397,76 @@ func TestPostgresListStorage_Update(t *testing.T) {
 						Set("name", args.info.Name).
 						Set("description", args.info.Description).
 						Set("list_position", args.info.ListPosition).
-						Where(sq.Eq{"id": args.info.ID}).
+						Set("wip_limit", args.info.WipLimit).
+						Set("wip_mode", args.info.WipMode).
+						Set("is_done", args.info.IsDone).
+						Set("require_unblocked", args.info.RequireUnblocked).
+						Set("row_version", sq.Expr("row_version + 1")).
+						Where(sq.Eq{"id": args.info.ID, "row_version": args.info.RowVersion}).
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
+					mock.ExpectBegin()
 					mock.ExpectExec(regexp.QuoteMeta(query)).
 						WithArgs(
 							args.info.Name,
 							args.info.Description,
 							args.info.ListPosition,
+							args.info.WipLimit,
+							args.info.WipMode,
+							args.info.IsDone,
+							args.info.RequireUnblocked,
 							args.info.ID,
+							args.info.RowVersion,
 						).
 						WillReturnError(apperrors.ErrListNotUpdated)
+					mock.ExpectRollback()
 				},
 			},
 			wantErr: true,
 			err:     apperrors.ErrListNotUpdated,
 		},
+		{
+			name: "Stale row version",
+			args: args{
+				info: &dto.UpdatedListInfo{
+					ID:         1,
+					Name:       "sdsd",
+					RowVersion: 2,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					query, _, _ := sq.
+						Update("public.list").
+						Set("name", args.info.Name).
+						Set("description", args.info.Description).
+						Set("list_position", args.info.ListPosition).
+						Set("wip_limit", args.info.WipLimit).
+						Set("wip_mode", args.info.WipMode).
+						Set("is_done", args.info.IsDone).
+						Set("require_unblocked", args.info.RequireUnblocked).
+						Set("row_version", sq.Expr("row_version + 1")).
+						Where(sq.Eq{"id": args.info.ID, "row_version": args.info.RowVersion}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectBegin()
+					mock.ExpectExec(regexp.QuoteMeta(query)).
+						WithArgs(
+							args.info.Name,
+							args.info.Description,
+							args.info.ListPosition,
+							args.info.WipLimit,
+							args.info.WipMode,
+							args.info.IsDone,
+							args.info.RequireUnblocked,
+							args.info.ID,
+							args.info.RowVersion,
+						).
+						WillReturnResult(sqlmock.NewResult(0, 0))
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrRowVersionConflict,
+		},
 		{
 			name: "Building query failed",
 			args: args{
@@ -438,9 +613,10 @@ func TestPostgresListStorage_GetTasksWithID(t *testing.T) {
 func TestPostgresListStorage_UpdateOrder(t *testing.T) {
 	t.Parallel()
 	type args struct {
-		info  dto.ListIDs
+		info  dto.ListMoveInfo
 		query func(mock sqlmock.Sqlmock, args args)
 	}
+	afterID := uint64(1)
 	tests := []struct {
 		name    string
 		args    args
@@ -450,65 +626,93 @@ func TestPostgresListStorage_UpdateOrder(t *testing.T) {
 		{
 			name: "Happy path",
 			args: args{
-				info: dto.ListIDs{
-					Values: []uint64{1, 2, 3},
+				info: dto.ListMoveInfo{
+					ListID:  3,
+					AfterID: &afterID,
 				},
 				query: func(mock sqlmock.Sqlmock, args args) {
-					caseBuilder := sq.Case()
-					for i, id := range args.info.Values {
-						caseBuilder = caseBuilder.When(sq.Eq{"id": fmt.Sprintf("%v", id)}, fmt.Sprintf("%v", i)).Else("list_position")
-					}
+					mock.ExpectBegin()
+					query, _, _ := sq.Select("id_board").
+						From("public.list").
+						Where(sq.Eq{"id": args.info.ListID}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectQuery(regexp.QuoteMeta(query)).
+						WithArgs(args.info.ListID).
+						WillReturnRows(sqlmock.NewRows([]string{"id_board"}).AddRow(1))
 
-					query1, _, _ := sq.
-						Update("public.list").
-						Set("list_position", caseBuilder).
+					expectRankLock(mock, listRankScope, 1)
+					expectRankPlace(mock, listRankScope, 1, args.info.ListID, *args.info.AfterID, "i", "")
+
+					query, _, _ = sq.Update("public.list").
+						Set("rank", "r").
+						Where(sq.Eq{"id": args.info.ListID}).
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
-					mock.ExpectExec(regexp.QuoteMeta(query1)).
-						WithArgs(
-							"1", "2", "3",
-						).
-						WillReturnResult(sqlmock.NewResult(1, 1))
+					mock.ExpectExec(regexp.QuoteMeta(query)).
+						WithArgs("r", args.info.ListID).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
 				},
 			},
 			wantErr: false,
 			err:     nil,
 		},
 		{
-			name: "Building query failed",
+			name: "List not found",
 			args: args{
-				info:  dto.ListIDs{},
-				query: func(mock sqlmock.Sqlmock, args args) {},
+				info: dto.ListMoveInfo{
+					ListID: 3,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					query, _, _ := sq.Select("id_board").
+						From("public.list").
+						Where(sq.Eq{"id": args.info.ListID}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectQuery(regexp.QuoteMeta(query)).
+						WithArgs(args.info.ListID).
+						WillReturnRows(sqlmock.NewRows([]string{"id_board"}))
+					mock.ExpectRollback()
+				},
 			},
 			wantErr: true,
-			err:     apperrors.ErrCouldNotBuildQuery,
+			err:     apperrors.ErrListNotFound,
 		},
 		{
 			name: "Executing query failed",
 			args: args{
-				info: dto.ListIDs{
-					Values: []uint64{1, 2, 3},
+				info: dto.ListMoveInfo{
+					ListID: 3,
 				},
 				query: func(mock sqlmock.Sqlmock, args args) {
-					caseBuilder := sq.Case()
-					for i, id := range args.info.Values {
-						caseBuilder = caseBuilder.When(sq.Eq{"id": fmt.Sprintf("%v", id)}, fmt.Sprintf("%v", i)).Else("list_position")
-					}
+					mock.ExpectBegin()
+					query, _, _ := sq.Select("id_board").
+						From("public.list").
+						Where(sq.Eq{"id": args.info.ListID}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectQuery(regexp.QuoteMeta(query)).
+						WithArgs(args.info.ListID).
+						WillReturnRows(sqlmock.NewRows([]string{"id_board"}).AddRow(1))
 
-					query1, _, _ := sq.
-						Update("public.list").
-						Set("list_position", caseBuilder).
+					expectRankLock(mock, listRankScope, 1)
+					expectRankPlace(mock, listRankScope, 1, args.info.ListID, 0, "", "i")
+
+					query, _, _ = sq.Update("public.list").
+						Set("rank", "9").
+						Where(sq.Eq{"id": args.info.ListID}).
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
-					mock.ExpectExec(regexp.QuoteMeta(query1)).
-						WithArgs(
-							"1", "2", "3",
-						).
+					mock.ExpectExec(regexp.QuoteMeta(query)).
+						WithArgs("9", args.info.ListID).
 						WillReturnError(apperrors.ErrCouldNotExecuteQuery)
+					mock.ExpectRollback()
 				},
 			},
 			wantErr: true,
-			err:     apperrors.ErrCouldNotExecuteQuery,
+			err:     apperrors.ErrCouldNotChangeListOrder,
 		},
 	}
 	for _, tt := range tests {
@@ -540,3 +744,627 @@ func TestPostgresListStorage_UpdateOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestPostgresListStorage_GetWipInfo(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		id    dto.ListID
+		query func(mock sqlmock.Sqlmock, args args)
+	}
+	limit := uint64(3)
+	tests := []struct {
+		name    string
+		args    args
+		want    *dto.ListWipInfo
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "Happy path",
+			args: args{
+				id: dto.ListID{Value: 1},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					query, _, _ := sq.Select(listWipFields...).
+						From("public.list").
+						LeftJoin(activeListTasks).
+						Where(sq.Eq{"public.list.id": args.id.Value}).
+						GroupBy("public.list.id").
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectQuery(regexp.QuoteMeta(query)).
+						WithArgs(args.id.Value).
+						WillReturnRows(sqlmock.NewRows(listWipFields).
+							AddRow(1, 3, "hard", 2),
+						)
+				},
+			},
+			want:    &dto.ListWipInfo{ListID: 1, WipLimit: &limit, WipMode: dto.WipModeHard, TaskCount: 2},
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "List not found",
+			args: args{
+				id: dto.ListID{Value: 1},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					query, _, _ := sq.Select(listWipFields...).
+						From("public.list").
+						LeftJoin(activeListTasks).
+						Where(sq.Eq{"public.list.id": args.id.Value}).
+						GroupBy("public.list.id").
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectQuery(regexp.QuoteMeta(query)).
+						WithArgs(args.id.Value).
+						WillReturnRows(sqlmock.NewRows(listWipFields))
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrListNotFound,
+		},
+		{
+			name: "Could not get list",
+			args: args{
+				id: dto.ListID{Value: 1},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					query, _, _ := sq.Select(listWipFields...).
+						From("public.list").
+						LeftJoin(activeListTasks).
+						Where(sq.Eq{"public.list.id": args.id.Value}).
+						GroupBy("public.list.id").
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectQuery(regexp.QuoteMeta(query)).
+						WithArgs(args.id.Value).
+						WillReturnError(apperrors.ErrCouldNotExecuteQuery)
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrCouldNotGetList,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			tt.args.query(mock, tt.args)
+
+			s := NewListStorage(db)
+
+			got, err := s.GetWipInfo(ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PostgresListStorage.GetWipInfo() error = %v, wantErr %v", err != nil, tt.wantErr)
+			}
+			if tt.wantErr && err != tt.err {
+				t.Errorf("PostgresListStorage.GetWipInfo() error = %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PostgresListStorage.GetWipInfo() = %v, want %v", got, tt.want)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPostgresListStorage_Read(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		id    dto.ListID
+		query func(mock sqlmock.Sqlmock, args args)
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *dto.SingleListInfo
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "Happy path",
+			args: args{
+				id: dto.ListID{Value: 1},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					query, _, _ := sq.Select(allListTaskAggFields...).
+						From("public.list").
+						LeftJoin(activeListTasks).
+						Where(sq.Eq{"public.list.id": args.id.Value}).
+						GroupBy("public.list.id").
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectQuery(regexp.QuoteMeta(query)).
+						WithArgs(args.id.Value).
+						WillReturnRows(sqlmock.NewRows(allListTaskAggFields).
+							AddRow(1, 2, "Mock list", 0, nil, "soft", false, false, 4, nil, 1, pq.StringArray{"7"}),
+						)
+				},
+			},
+			want: &dto.SingleListInfo{
+				ID:         1,
+				BoardID:    2,
+				Name:       "Mock list",
+				WipMode:    dto.WipModeSoft,
+				RowVersion: 4,
+				TaskCount:  1,
+				TaskIDs:    []string{"7"},
+			},
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "List not found",
+			args: args{
+				id: dto.ListID{Value: 1},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectQuery(regexp.QuoteMeta("FROM public.list")).
+						WithArgs(args.id.Value).
+						WillReturnRows(sqlmock.NewRows(allListTaskAggFields))
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrListNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			tt.args.query(mock, tt.args)
+
+			s := NewListStorage(db)
+
+			got, err := s.Read(ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PostgresListStorage.Read() error = %v, wantErr %v", err != nil, tt.wantErr)
+			}
+			if tt.wantErr && err != tt.err {
+				t.Errorf("PostgresListStorage.Read() error = %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PostgresListStorage.Read() = %v, want %v", got, tt.want)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPostgresListStorage_Move(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		info  dto.ListTransferInfo
+		query func(mock sqlmock.Sqlmock, args args)
+	}
+	boardQuery, _, _ := sq.Select("id_board").
+		From("public.list").
+		Where(sq.Eq{"id": uint64(1)}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	tests := []struct {
+		name    string
+		args    args
+		want    *dto.ListTransferReport
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "Happy path",
+			args: args{
+				info: dto.ListTransferInfo{
+					ListID:  1,
+					BoardID: 4,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta(boardQuery)).
+						WithArgs(args.info.ListID).
+						WillReturnRows(sqlmock.NewRows([]string{"id_board"}).AddRow(3))
+
+					expectRankLock(mock, listRankScope, args.info.BoardID)
+					expectRankPlace(mock, listRankScope, args.info.BoardID, args.info.ListID, 0, "", "i")
+
+					query, _, _ := sq.Update("public.list").
+						Set("id_board", args.info.BoardID).
+						Set("rank", "9").
+						Where(sq.Eq{"id": args.info.ListID}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectExec(regexp.QuoteMeta(query)).
+						WithArgs(args.info.BoardID, "9", args.info.ListID).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+
+					expectAdaptToBoard(mock, args.info.ListID, args.info.BoardID)
+					expectHardWipLimit(mock, args.info.ListID, false)
+					expectBlockers(mock, args.info.ListID, false)
+					mock.ExpectCommit()
+				},
+			},
+			want: &dto.ListTransferReport{
+				ListID:  1,
+				BoardID: 4,
+				Changes: dto.TransferChanges{
+					RemappedTags:       []dto.TransferredTag{{FromTagID: 5, ToTagID: 8, Name: "bug", Created: true}},
+					DroppedAssignees:   []dto.DroppedAssignee{{TaskID: 2, UserID: 9}},
+					DroppedFieldValues: []dto.DroppedFieldValue{},
+					DetachedSubtasks:   []uint64{3},
+				},
+			},
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "List not found",
+			args: args{
+				info: dto.ListTransferInfo{
+					ListID:  1,
+					BoardID: 4,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta(boardQuery)).
+						WithArgs(args.info.ListID).
+						WillReturnRows(sqlmock.NewRows([]string{"id_board"}))
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrListNotFound,
+		},
+		{
+			name: "Same board",
+			args: args{
+				info: dto.ListTransferInfo{
+					ListID:  1,
+					BoardID: 3,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta(boardQuery)).
+						WithArgs(args.info.ListID).
+						WillReturnRows(sqlmock.NewRows([]string{"id_board"}).AddRow(3))
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrListAlreadyOnBoard,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			tt.args.query(mock, tt.args)
+
+			s := NewListStorage(db)
+
+			got, err := s.Move(ctx, tt.args.info)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PostgresListStorage.Move() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, tt.err) {
+				t.Errorf("PostgresListStorage.Move() error = %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PostgresListStorage.Move() = %+v, want %+v", got, tt.want)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPostgresListStorage_Copy(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	ctx := context.WithValue(
+		context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+		dto.RequestIDKey, uuid.New(),
+	)
+	info := dto.ListTransferInfo{ListID: 1, BoardID: 3}
+
+	mock.ExpectBegin()
+	expectRankLock(mock, listRankScope, info.BoardID)
+	expectRankPlace(mock, listRankScope, info.BoardID, 0, 0, "", "i")
+
+	query, _, _ := sq.Insert("public.list").
+		Columns("id_board", "name", "description", "list_position", "wip_limit", "wip_mode", "is_done", "require_unblocked", "rank").
+		Select(sq.Select().
+			Column(sq.Expr("?", info.BoardID)).
+			Columns("name", "description", "list_position", "wip_limit", "wip_mode", "is_done", "require_unblocked").
+			Column(sq.Expr("?", "9")).
+			From("public.list").
+			Where(sq.Eq{"id": info.ListID})).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(info.BoardID, "9", info.ListID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(6))
+
+	query, _, _ = sq.Select("id", "rank").
+		From("public.task").
+		Where(sq.Eq{"id_list": info.ListID}).
+		OrderBy("rank", "id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(info.ListID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "rank"}))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT DISTINCT public.tag.id")).
+		WithArgs(uint64(6), info.BoardID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "color"}))
+	mock.ExpectQuery(regexp.QuoteMeta("DELETE FROM public.task_user")).
+		WithArgs(uint64(6), info.BoardID).
+		WillReturnRows(sqlmock.NewRows([]string{"id_task", "id_user"}))
+	mock.ExpectQuery(regexp.QuoteMeta("DELETE FROM public.task_custom_field_value")).
+		WithArgs(uint64(6), info.BoardID).
+		WillReturnRows(sqlmock.NewRows([]string{"id_task", "id_field"}))
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE public.task SET id_parent = $1")).
+		WithArgs(nil, uint64(6), uint64(6)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	expectHardWipLimit(mock, 6, false)
+	expectBlockers(mock, 6, false)
+	mock.ExpectCommit()
+
+	got, err := NewListStorage(db).Copy(ctx, info)
+	if err != nil {
+		t.Fatalf("PostgresListStorage.Copy() error = %v", err)
+	}
+	if got.ListID != 6 || got.BoardID != info.BoardID {
+		t.Errorf("PostgresListStorage.Copy() = %+v, want list 6 on board %d", got, info.BoardID)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostgresListStorage_Archive(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		id    dto.ListID
+		query func(mock sqlmock.Sqlmock, args args)
+	}
+	archiveQuery := "UPDATE public.list SET date_archived = COALESCE(date_archived, CURRENT_TIMESTAMP), row_version = row_version + 1 WHERE id = $1"
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "Happy path",
+			args: args{
+				id: dto.ListID{Value: 1},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectExec(regexp.QuoteMeta(archiveQuery)).
+						WithArgs(args.id.Value).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+				},
+			},
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "List not found",
+			args: args{
+				id: dto.ListID{Value: 1},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectExec(regexp.QuoteMeta(archiveQuery)).
+						WithArgs(args.id.Value).
+						WillReturnResult(sqlmock.NewResult(0, 0))
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrListNotFound,
+		},
+		{
+			name: "Query failed",
+			args: args{
+				id: dto.ListID{Value: 1},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectExec(regexp.QuoteMeta(archiveQuery)).
+						WithArgs(args.id.Value).
+						WillReturnError(errors.New("connection reset"))
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrListNotArchived,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			tt.args.query(mock, tt.args)
+
+			s := NewListStorage(db)
+
+			err = s.Archive(ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PostgresListStorage.Archive() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, tt.err) {
+				t.Errorf("PostgresListStorage.Archive() error = %v, want %v", err, tt.err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPostgresListStorage_ArchiveTasks(t *testing.T) {
+	t.Parallel()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	ctx := context.WithValue(
+		context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+		dto.RequestIDKey, uuid.New(),
+	)
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE public.task SET date_archived = COALESCE(date_archived, CURRENT_TIMESTAMP), row_version = row_version + 1 " +
+		"WHERE date_archived IS NULL AND id_list = $1")).
+		WithArgs(uint64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	s := NewListStorage(db)
+	if err = s.ArchiveTasks(ctx, dto.ListID{Value: 1}); err != nil {
+		t.Errorf("PostgresListStorage.ArchiveTasks() error = %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostgresListStorage_SortTasks(t *testing.T) {
+	t.Parallel()
+
+	lockQuery := "SELECT id FROM public.list WHERE id = $1 FOR UPDATE"
+	keys := rank.Spread(2)
+	tests := []struct {
+		name  string
+		info  dto.ListSortInfo
+		query func(mock sqlmock.Sqlmock)
+		err   error
+	}{
+		{
+			name: "Sorted by priority",
+			info: dto.ListSortInfo{ListID: 1, By: dto.ListSortPriority},
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(lockQuery)).
+					WithArgs(uint64(1)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM public.task WHERE id_list = $1 " +
+					"ORDER BY array_position(ARRAY['urgent', 'high', 'medium', 'low', 'none'], priority) NULLS LAST, rank, id")).
+					WithArgs(uint64(1)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(6).AddRow(5))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE public.task SET rank = CASE id WHEN $1 THEN $2 WHEN $3 THEN $4 END WHERE id IN ($5,$6)")).
+					WithArgs(uint64(6), keys[0], uint64(5), keys[1], uint64(6), uint64(5)).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Due dates reversed",
+			info: dto.ListSortInfo{ListID: 1, By: dto.ListSortDue, Reverse: true},
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(lockQuery)).
+					WithArgs(uint64(1)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM public.task WHERE id_list = $1 ORDER BY task_end DESC NULLS LAST, rank, id")).
+					WithArgs(uint64(1)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "List not found",
+			info: dto.ListSortInfo{ListID: 1, By: dto.ListSortName},
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(lockQuery)).
+					WithArgs(uint64(1)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectRollback()
+			},
+			err: apperrors.ErrListNotFound,
+		},
+		{
+			name:  "Unknown sort key",
+			info:  dto.ListSortInfo{ListID: 1, By: "color"},
+			query: func(mock sqlmock.Sqlmock) {},
+			err:   apperrors.ErrInvalidListSort,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			tt.query(mock)
+
+			s := NewListStorage(db)
+			require.Equal(t, tt.err, s.SortTasks(ctx, tt.info))
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
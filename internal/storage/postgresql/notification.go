@@ -0,0 +1,218 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	"server/internal/pkg/dto"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// dueRemindersLockKey
+// ключ транзакционной advisory-блокировки прохода напоминаний: пока один экземпляр бэкенда
+// создает напоминания, остальные пропускают свой проход
+const dueRemindersLockKey = 7461736
+
+// dueRemindersConflict
+// условие уникального индекса напоминаний: одно напоминание на исполнителя, срок и смещение
+const dueRemindersConflict = "ON CONFLICT (id_user, id_task, due_at, offset_minutes) WHERE kind = 'due_reminder' DO NOTHING"
+
+// PostgresNotificationStorage
+// Хранилище данных в PostgreSQL
+type PostgresNotificationStorage struct {
+	db *sql.DB
+}
+
+// NewNotificationStorage
+// возвращает PostgreSQL хранилище уведомлений
+func NewNotificationStorage(db *sql.DB) *PostgresNotificationStorage {
+	return &PostgresNotificationStorage{
+		db: db,
+	}
+}
+
+// CreateDueReminders
+// создает напоминания исполнителям невыполненных заданий вне архива, срок которых наступит не позже чем
+// через смещение напоминания. Смещения берутся у задания, а если их нет -- у исполнителя.
+// Исполнитель получает одно напоминание за проход -- по ближайшему к сроку смещению, и не получает напоминание,
+// если уже получил такое же или более позднее для этого срока. Повторы исключает уникальный индекс,
+// поэтому проход можно запускать на нескольких экземплярах бэкенда одновременно
+// или возвращает ошибки apperrors.ErrRemindersNotCreated, ...
+func (s PostgresNotificationStorage) CreateDueReminders(ctx context.Context) (int64, error) {
+	funcName := "PostgresNotificationStorage.CreateDueReminders"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return 0, apperrors.ErrCouldNotBeginTransaction
+	}
+
+	rollback := func(reason error) (int64, error) {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return 0, apperrors.ErrCouldNotRollback
+		}
+		return 0, reason
+	}
+
+	var locked bool
+	if err = tx.QueryRow("SELECT pg_try_advisory_xact_lock($1)", dueRemindersLockKey).Scan(&locked); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrRemindersNotCreated)
+	}
+	if !locked {
+		logger.DebugFmt("Reminders are being created by another instance", requestID.String(), funcName, nodeName)
+		return rollback(nil)
+	}
+
+	query, args, err := sq.Insert("public.notification").
+		Columns("id_user", "id_task", "kind", "due_at", "offset_minutes").
+		Select(sq.Select().
+			Options("DISTINCT ON (public.task_user.id_user, public.task.id)").
+			Columns("public.task_user.id_user", "public.task.id").
+			Column("?", dto.NotificationDueReminder).
+			Columns("public.task.task_end", "reminder.offset_minutes").
+			From("public.task").
+			Join("public.list ON public.list.id = public.task.id_list").
+			Join("public.task_user ON public.task_user.id_task = public.task.id").
+			Join("public.user ON public.user.id = public.task_user.id_user").
+			JoinClause("CROSS JOIN LATERAL unnest(COALESCE("+
+				"(SELECT array_agg(public.task_reminder.offset_minutes) FROM public.task_reminder "+
+				"WHERE public.task_reminder.id_task = public.task.id), "+
+				"public.user.reminder_offsets)) AS reminder(offset_minutes)").
+			Where(sq.Eq{
+				"public.task.date_completed": nil,
+				"public.task.date_archived":  nil,
+				"public.list.date_archived":  nil,
+			}).
+			Where("public.task.task_end > CURRENT_TIMESTAMP").
+			Where("public.task.task_end - make_interval(mins => reminder.offset_minutes) <= CURRENT_TIMESTAMP").
+			Where("NOT EXISTS (SELECT 1 FROM public.notification AS sent WHERE sent.kind = ? "+
+				"AND sent.id_user = public.task_user.id_user AND sent.id_task = public.task.id "+
+				"AND sent.due_at = public.task.task_end AND sent.offset_minutes <= reminder.offset_minutes)", dto.NotificationDueReminder).
+			OrderBy("public.task_user.id_user", "public.task.id", "reminder.offset_minutes")).
+		Suffix(dueRemindersConflict).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return rollback(apperrors.ErrCouldNotBuildQuery)
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	result, err := tx.Exec(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrRemindersNotCreated)
+	}
+	created, err := result.RowsAffected()
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrRemindersNotCreated)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return 0, apperrors.ErrCouldNotCommit
+	}
+	logger.DebugFmt(fmt.Sprintf("Created %d reminders", created), requestID.String(), funcName, nodeName)
+
+	return created, nil
+}
+
+// ReadMany
+// возвращает уведомления пользователя, начиная с новых, вместе с названием задания, id доски
+// и, для уведомлений об изменениях, с видом изменения из истории
+// или возвращает ошибки apperrors.ErrCouldNotGetNotifications, ...
+func (s PostgresNotificationStorage) ReadMany(ctx context.Context, request dto.NotificationsRequest) (*[]dto.NotificationInfo, error) {
+	funcName := "PostgresNotificationStorage.ReadMany"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	builder := sq.Select(allNotificationFields...).
+		From("public.notification").
+		LeftJoin("public.task ON public.task.id = public.notification.id_task").
+		LeftJoin("public.list ON public.list.id = public.task.id_list").
+		LeftJoin("public.edit_history ON public.edit_history.id = public.notification.id_history").
+		Where(sq.Eq{"public.notification.id_user": request.UserID})
+	if request.UnreadOnly {
+		builder = builder.Where(sq.Eq{"public.notification.date_read": nil})
+	}
+	query, args, err := builder.
+		OrderBy("public.notification.date_created DESC", "public.notification.id DESC").
+		Limit(request.Limit).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	rows, err := conn(ctx, s.db).Query(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotGetNotifications
+	}
+	defer rows.Close()
+
+	notifications := []dto.NotificationInfo{}
+	for rows.Next() {
+		var notification dto.NotificationInfo
+		err = rows.Scan(
+			&notification.ID,
+			&notification.Kind,
+			&notification.TaskID,
+			&notification.TaskName,
+			&notification.BoardID,
+			&notification.DueAt,
+			&notification.OffsetMinutes,
+			&notification.HistoryID,
+			&notification.EntityType,
+			&notification.Action,
+			&notification.DateCreated,
+			&notification.DateRead,
+		)
+		if err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotScanRows
+		}
+		notifications = append(notifications, notification)
+	}
+	logger.DebugFmt("Got notifications", requestID.String(), funcName, nodeName)
+
+	return &notifications, nil
+}
+
+// MarkRead
+// отмечает прочитанными непрочитанные уведомления пользователя: перечисленные в info.IDs или все
+// или возвращает ошибки apperrors.ErrNotificationsNotUpdated, ...
+func (s PostgresNotificationStorage) MarkRead(ctx context.Context, info dto.NotificationsReadInfo) error {
+	funcName := "PostgresNotificationStorage.MarkRead"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	builder := sq.Update("public.notification").
+		Set("date_read", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id_user": info.UserID, "date_read": nil})
+	if len(info.IDs) != 0 {
+		builder = builder.Where(sq.Eq{"id": info.IDs})
+	}
+	query, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	if _, err = conn(ctx, s.db).Exec(query, args...); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrNotificationsNotUpdated
+	}
+	logger.DebugFmt("Notifications marked as read", requestID.String(), funcName, nodeName)
+
+	return nil
+}
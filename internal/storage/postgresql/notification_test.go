@@ -0,0 +1,195 @@
+package postgresql
+
+import (
+	"context"
+	"regexp"
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresNotificationStorage_CreateDueReminders(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		query   func(mock sqlmock.Sqlmock)
+		created int64
+		err     error
+	}{
+		{
+			name: "Happy path",
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT pg_try_advisory_xact_lock($1)")).
+					WithArgs(dueRemindersLockKey).
+					WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(true))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.notification (id_user,id_task,kind,due_at,offset_minutes) SELECT DISTINCT ON")).
+					WithArgs(dto.NotificationDueReminder, dto.NotificationDueReminder).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectCommit()
+			},
+			created: 3,
+		},
+		{
+			name: "Locked by another instance",
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT pg_try_advisory_xact_lock($1)")).
+					WithArgs(dueRemindersLockKey).
+					WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(false))
+				mock.ExpectRollback()
+			},
+			created: 0,
+		},
+		{
+			name: "Insert fail",
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT pg_try_advisory_xact_lock($1)")).
+					WithArgs(dueRemindersLockKey).
+					WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(true))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.notification")).
+					WillReturnError(apperrors.ErrRemindersNotCreated)
+				mock.ExpectRollback()
+			},
+			err: apperrors.ErrRemindersNotCreated,
+		},
+		{
+			name: "Commit fail",
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT pg_try_advisory_xact_lock($1)")).
+					WithArgs(dueRemindersLockKey).
+					WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(true))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.notification")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit().WillReturnError(apperrors.ErrCouldNotCommit)
+			},
+			err: apperrors.ErrCouldNotCommit,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			tt.query(mock)
+
+			s := NewNotificationStorage(db)
+
+			created, err := s.CreateDueReminders(ctx)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.created, created)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPostgresNotificationStorage_MarkRead(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		info  dto.NotificationsReadInfo
+		query func(mock sqlmock.Sqlmock, args args)
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "All unread",
+			args: args{
+				info: dto.NotificationsReadInfo{UserID: 1},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					query, _, _ := sq.Update("public.notification").
+						Set("date_read", sq.Expr("CURRENT_TIMESTAMP")).
+						Where(sq.Eq{"id_user": args.info.UserID, "date_read": nil}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectExec(regexp.QuoteMeta(query)).
+						WithArgs(args.info.UserID).
+						WillReturnResult(sqlmock.NewResult(0, 2))
+				},
+			},
+		},
+		{
+			name: "Selected notifications",
+			args: args{
+				info: dto.NotificationsReadInfo{UserID: 1, IDs: []uint64{4, 5}},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					query, _, _ := sq.Update("public.notification").
+						Set("date_read", sq.Expr("CURRENT_TIMESTAMP")).
+						Where(sq.Eq{"id_user": args.info.UserID, "date_read": nil}).
+						Where(sq.Eq{"id": args.info.IDs}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectExec(regexp.QuoteMeta(query)).
+						WithArgs(args.info.UserID, args.info.IDs[0], args.info.IDs[1]).
+						WillReturnResult(sqlmock.NewResult(0, 2))
+				},
+			},
+		},
+		{
+			name: "Query fail",
+			args: args{
+				info: dto.NotificationsReadInfo{UserID: 1},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectExec(regexp.QuoteMeta("UPDATE public.notification")).
+						WillReturnError(pq.ErrNotSupported)
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrNotificationsNotUpdated,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			tt.args.query(mock, tt.args)
+
+			s := NewNotificationStorage(db)
+
+			err = s.MarkRead(ctx, tt.args.info)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PostgresNotificationStorage.MarkRead() error = %v, wantErr %v", err != nil, tt.wantErr)
+			}
+			require.Equal(t, tt.err, err)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
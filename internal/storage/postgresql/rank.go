@@ -0,0 +1,279 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	"server/internal/pkg/dto"
+	"server/internal/pkg/rank"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// rankScope
+// упорядочиваемая таблица: её строки сортируются по ключу rank внутри родительской строки
+type rankScope struct {
+	table        string
+	parentTable  string
+	parentColumn string
+}
+
+var (
+	listRankScope = rankScope{
+		table:        "public.list",
+		parentTable:  "public.board",
+		parentColumn: "id_board",
+	}
+	taskRankScope = rankScope{
+		table:        "public.task",
+		parentTable:  "public.list",
+		parentColumn: "id_list",
+	}
+	checklistItemRankScope = rankScope{
+		table:        "public.checklist_item",
+		parentTable:  "public.checklist",
+		parentColumn: "id_checklist",
+	}
+)
+
+// lockParent
+// блокирует родительскую строку до конца транзакции, чтобы порядок внутри неё менялся последовательно
+// или возвращает ошибки apperrors.ErrInvalidPosition, ...
+func (scope rankScope) lockParent(ctx context.Context, tx executor, parentID uint64) error {
+	funcName := "rankScope.lockParent"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select("id").
+		From(scope.parentTable).
+		Where(sq.Eq{"id": parentID}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	var id uint64
+	err = tx.QueryRow(query, args...).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return apperrors.ErrInvalidPosition
+	}
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotExecuteQuery
+	}
+	return nil
+}
+
+// appendKey
+// блокирует родителя и возвращает ключ для новой строки в конце его порядка
+// или возвращает ошибки apperrors.ErrInvalidPosition, ...
+func (scope rankScope) appendKey(ctx context.Context, tx executor, parentID uint64) (string, error) {
+	if err := scope.lockParent(ctx, tx, parentID); err != nil {
+		return "", err
+	}
+	last, err := scope.lastID(ctx, tx, parentID, 0)
+	if err != nil {
+		return "", err
+	}
+	return scope.place(ctx, tx, parentID, 0, last)
+}
+
+// lastID
+// возвращает id последней строки родителя, не считая строки id, или 0, если других строк нет
+func (scope rankScope) lastID(ctx context.Context, tx executor, parentID uint64, id uint64) (uint64, error) {
+	funcName := "rankScope.lastID"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select("id").
+		From(scope.table).
+		Where(sq.Eq{scope.parentColumn: parentID}).
+		Where(sq.NotEq{"id": id}).
+		OrderBy("rank DESC", "id DESC").
+		Limit(1).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	var last uint64
+	err = tx.QueryRow(query, args...).Scan(&last)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return 0, apperrors.ErrCouldNotExecuteQuery
+	}
+	return last, nil
+}
+
+// place
+// возвращает ключ, ставящий строку id сразу после строки afterID того же родителя (0 — в начало).
+// Если подходящий ключ получается слишком длинным или соседи делят один ключ, порядок всех строк
+// родителя строится заново. Родитель должен быть заблокирован через lockParent
+// или возвращает ошибки apperrors.ErrInvalidPosition, ...
+func (scope rankScope) place(ctx context.Context, tx executor, parentID uint64, id uint64, afterID uint64) (string, error) {
+	funcName := "rankScope.place"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	if afterID != 0 && afterID == id {
+		return "", apperrors.ErrInvalidPosition
+	}
+
+	prev := ""
+	if afterID != 0 {
+		query, args, err := sq.Select("rank").
+			From(scope.table).
+			Where(sq.Eq{"id": afterID, scope.parentColumn: parentID}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return "", apperrors.ErrCouldNotBuildQuery
+		}
+		logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+		err = tx.QueryRow(query, args...).Scan(&prev)
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", apperrors.ErrInvalidPosition
+		}
+		if err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return "", apperrors.ErrCouldNotExecuteQuery
+		}
+	}
+
+	query, args, err := sq.Select("rank").
+		From(scope.table).
+		Where(sq.Eq{scope.parentColumn: parentID}).
+		Where(sq.NotEq{"id": []uint64{id, afterID}}).
+		Where(sq.GtOrEq{"rank": prev}).
+		OrderBy("rank", "id").
+		Limit(1).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return "", apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	next := ""
+	err = tx.QueryRow(query, args...).Scan(&next)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return "", apperrors.ErrCouldNotExecuteQuery
+	}
+
+	key, err := rank.Between(prev, next)
+	if err == nil && len(key) <= rank.MaxLength {
+		return key, nil
+	}
+	logger.DebugFmt("No room between "+prev+" and "+next+", rebalancing", requestID.String(), funcName, nodeName)
+
+	return scope.rebalance(ctx, tx, parentID, id, afterID)
+}
+
+// rebalance
+// раздаёт строкам родителя равномерно распределённые ключи в текущем порядке, ставя строку id после afterID,
+// и возвращает ключ строки id; саму строку id записывает вызывающий
+func (scope rankScope) rebalance(ctx context.Context, tx executor, parentID uint64, id uint64, afterID uint64) (string, error) {
+	funcName := "rankScope.rebalance"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select("id").
+		From(scope.table).
+		Where(sq.Eq{scope.parentColumn: parentID}).
+		Where(sq.NotEq{"id": id}).
+		OrderBy("rank", "id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return "", apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return "", apperrors.ErrCouldNotExecuteQuery
+	}
+	order := []uint64{}
+	if afterID == 0 {
+		order = append(order, id)
+	}
+	for rows.Next() {
+		var rowID uint64
+		if err = rows.Scan(&rowID); err != nil {
+			rows.Close()
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return "", apperrors.ErrCouldNotScanRows
+		}
+		order = append(order, rowID)
+		if rowID == afterID {
+			order = append(order, id)
+		}
+	}
+	rows.Close()
+
+	return scope.spread(ctx, tx, order, id)
+}
+
+// spread
+// раздаёт строкам order равномерно распределённые ключи в этом порядке и возвращает ключ строки id;
+// саму строку id записывает вызывающий, для записи всех строк id -- 0
+func (scope rankScope) spread(ctx context.Context, tx executor, order []uint64, id uint64) (string, error) {
+	funcName := "rankScope.spread"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	keys := rank.Spread(len(order))
+	placed := ""
+	updated := []uint64{}
+	caseBuilder := sq.Case("id")
+	for i, rowID := range order {
+		if rowID == id {
+			placed = keys[i]
+			continue
+		}
+		caseBuilder = caseBuilder.When(sq.Expr("?", rowID), sq.Expr("?", keys[i]))
+		updated = append(updated, rowID)
+	}
+	if len(updated) == 0 {
+		return placed, nil
+	}
+
+	query, args, err := sq.Update(scope.table).
+		Set("rank", caseBuilder).
+		Where(sq.Eq{"id": updated}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return "", apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	if _, err = tx.Exec(query, args...); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return "", apperrors.ErrCouldNotExecuteQuery
+	}
+	logger.DebugFmt(fmt.Sprintf("Rebalanced %d rows", len(updated)), requestID.String(), funcName, nodeName)
+
+	return placed, nil
+}
+
+// positionAfter
+// возвращает id строки, после которой ставится элемент, или 0 для начала контейнера
+func positionAfter(afterID *uint64) uint64 {
+	if afterID == nil {
+		return 0
+	}
+	return *afterID
+}
@@ -0,0 +1,223 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// expectRankLock
+// ожидает блокировку родительской строки в rankScope.lockParent
+func expectRankLock(mock sqlmock.Sqlmock, scope rankScope, parentID uint64) {
+	query, _, _ := sq.Select("id").
+		From(scope.parentTable).
+		Where(sq.Eq{"id": parentID}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(parentID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(parentID))
+}
+
+// expectRankAppend
+// ожидает запросы rankScope.appendKey для родителя, последняя строка которого last (0 -- строк нет)
+func expectRankAppend(mock sqlmock.Sqlmock, scope rankScope, parentID uint64, last uint64, lastRank string) {
+	expectRankLock(mock, scope, parentID)
+
+	query, _, _ := sq.Select("id").
+		From(scope.table).
+		Where(sq.Eq{scope.parentColumn: parentID}).
+		Where(sq.NotEq{"id": uint64(0)}).
+		OrderBy("rank DESC", "id DESC").
+		Limit(1).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	rows := sqlmock.NewRows([]string{"id"})
+	if last != 0 {
+		rows.AddRow(last)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(parentID, uint64(0)).
+		WillReturnRows(rows)
+
+	expectRankPlace(mock, scope, parentID, 0, last, lastRank, "")
+}
+
+// expectRankPlace
+// ожидает запросы соседних ключей в rankScope.place; пустой next означает, что строк после afterID нет
+func expectRankPlace(mock sqlmock.Sqlmock, scope rankScope, parentID uint64, id uint64, afterID uint64, prev string, next string) {
+	if afterID != 0 {
+		query, _, _ := sq.Select("rank").
+			From(scope.table).
+			Where(sq.Eq{"id": afterID, scope.parentColumn: parentID}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		mock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(afterID, parentID).
+			WillReturnRows(sqlmock.NewRows([]string{"rank"}).AddRow(prev))
+	}
+
+	query, _, _ := sq.Select("rank").
+		From(scope.table).
+		Where(sq.Eq{scope.parentColumn: parentID}).
+		Where(sq.NotEq{"id": []uint64{id, afterID}}).
+		Where(sq.GtOrEq{"rank": prev}).
+		OrderBy("rank", "id").
+		Limit(1).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	rows := sqlmock.NewRows([]string{"rank"})
+	if next != "" {
+		rows.AddRow(next)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(parentID, id, afterID, prev).
+		WillReturnRows(rows)
+}
+
+func TestRankScope_place(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		parentID uint64
+		id       uint64
+		afterID  uint64
+		query    func(mock sqlmock.Sqlmock, args args)
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "Between neighbours",
+			args: args{
+				parentID: 1,
+				id:       5,
+				afterID:  2,
+				query: func(mock sqlmock.Sqlmock, args args) {
+					expectRankPlace(mock, taskRankScope, args.parentID, args.id, args.afterID, "a", "c")
+				},
+			},
+			want: "b",
+		},
+		{
+			name: "First position",
+			args: args{
+				parentID: 1,
+				id:       5,
+				query: func(mock sqlmock.Sqlmock, args args) {
+					expectRankPlace(mock, taskRankScope, args.parentID, args.id, args.afterID, "", "i")
+				},
+			},
+			want: "9",
+		},
+		{
+			name: "After itself",
+			args: args{
+				parentID: 1,
+				id:       5,
+				afterID:  5,
+				query:    func(mock sqlmock.Sqlmock, args args) {},
+			},
+			wantErr: true,
+			err:     apperrors.ErrInvalidPosition,
+		},
+		{
+			name: "Anchor in another parent",
+			args: args{
+				parentID: 1,
+				id:       5,
+				afterID:  7,
+				query: func(mock sqlmock.Sqlmock, args args) {
+					query, _, _ := sq.Select("rank").
+						From(taskRankScope.table).
+						Where(sq.Eq{"id": args.afterID, taskRankScope.parentColumn: args.parentID}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectQuery(regexp.QuoteMeta(query)).
+						WithArgs(args.afterID, args.parentID).
+						WillReturnRows(sqlmock.NewRows([]string{"rank"}))
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrInvalidPosition,
+		},
+		{
+			name: "Equal neighbours are rebalanced",
+			args: args{
+				parentID: 1,
+				id:       5,
+				afterID:  2,
+				query: func(mock sqlmock.Sqlmock, args args) {
+					expectRankPlace(mock, taskRankScope, args.parentID, args.id, args.afterID, "i", "i")
+
+					query, _, _ := sq.Select("id").
+						From(taskRankScope.table).
+						Where(sq.Eq{taskRankScope.parentColumn: args.parentID}).
+						Where(sq.NotEq{"id": args.id}).
+						OrderBy("rank", "id").
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectQuery(regexp.QuoteMeta(query)).
+						WithArgs(args.parentID, args.id).
+						WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2).AddRow(3))
+
+					mock.ExpectExec(regexp.QuoteMeta("UPDATE public.task SET rank = CASE id WHEN $1 THEN $2 WHEN $3 THEN $4 END WHERE id IN ($5,$6)")).
+						WithArgs(uint64(2), "9", uint64(3), "r", uint64(2), uint64(3)).
+						WillReturnResult(sqlmock.NewResult(0, 2))
+				},
+			},
+			want: "i",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			mock.ExpectBegin()
+			tt.args.query(mock, tt.args)
+
+			tx, err := db.Begin()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when starting a transaction", err)
+			}
+
+			got, err := taskRankScope.place(ctx, tx, tt.args.parentID, tt.args.id, tt.args.afterID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("rankScope.place() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !errors.Is(err, tt.err) {
+				t.Errorf("rankScope.place() error = %v, want %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("rankScope.place() = %v, want %v", got, tt.want)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
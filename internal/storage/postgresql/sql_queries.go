@@ -1,7 +1,29 @@
 package postgresql
 
 const (
-// userDataQuery = "SELECT id, email, password_hash, name, surname, avatar_url, description FROM user"
+	// userDataQuery = "SELECT id, email, password_hash, name, surname, avatar_url, description FROM user"
+
+	// activeListTasks присоединяет к списку его неархивные задания
+	activeListTasks = "public.task ON public.task.id_list = public.list.id AND public.task.date_archived IS NULL"
+
+	// openBlockers выбирает зависимости, блокирующее задание которых не выполнено
+	openBlockers = "SELECT 1 FROM public.task_dependency JOIN public.task AS blocker " +
+		"ON blocker.id = public.task_dependency.id_blocker WHERE blocker.date_completed IS NULL"
+
+	// activeRecurrenceRule правило действующей серии, к которой относится задание
+	activeRecurrenceRule = "(SELECT public.task_recurrence.rule FROM public.task_recurrence " +
+		"WHERE public.task_recurrence.id = public.task.id_recurrence AND public.task_recurrence.date_stopped IS NULL)"
+
+	// taskActivityMatch оставляет записи истории, в которых есть изменения задания для правила ленты rule;
+	// в записи массовой операции учитываются только изменения этого задания
+	taskActivityMatch = "((rule.fields IS NULL AND public.edit_history.id_entity <> 0) OR " +
+		"EXISTS(SELECT 1 FROM jsonb_array_elements(public.edit_history.changes) AS change " +
+		"WHERE (public.edit_history.id_entity <> 0 OR (change->>'entity_id')::bigint = ?) " +
+		"AND (rule.fields IS NULL OR change->>'field' = ANY(rule.fields))))"
+
+	// worklogFields поля записи учёта времени; длительность запущенного таймера считается до текущего момента
+	worklogFields = "id, id_task, id_user, date_started, date_stopped, " +
+		"extract(epoch FROM COALESCE(date_stopped, CURRENT_TIMESTAMP) - date_started)::bigint, note"
 )
 
 var (
@@ -12,20 +34,38 @@ var (
 
 	// allBoardFields     = []string{"id", "id_workspace", "name", "date_created", "thumbnail_url"}
 	allBoardFields = []string{"public.board.id", "public.board.id_workspace", "public.workspace.id_creator",
-		"public.board.name", "public.board.date_created", "public.board.thumbnail_url"}
+		"public.board.name", "public.board.date_created", "public.board.thumbnail_url", "public.board.version",
+		"public.board.row_version"}
 
 	// allListFields     = []string{"id", "id_board", "name", "list_position"}
 	allListTaskAggFields = []string{"public.list.id", "public.list.id_board", "public.list.name", "public.list.list_position",
-		"array_remove(array_agg(public.task.id ORDER BY public.task.list_position), NULL)"}
+		"public.list.wip_limit", "public.list.wip_mode", "public.list.is_done", "public.list.require_unblocked", "public.list.row_version",
+		"public.list.date_archived", "count(public.task.id)",
+		"array_remove(array_agg(public.task.id ORDER BY public.task.rank, public.task.id), NULL)"}
+	listWipFields = []string{"public.list.id", "public.list.wip_limit", "public.list.wip_mode", "count(public.task.id)"}
 
 	allTagFields = []string{"public.tag.id", "public.tag.name", "public.tag.color"}
 
 	allTaskFields = []string{"public.task.id", "public.task.id_list", "public.task.date_created",
 		"public.task.name", "public.task.description", "public.task.list_position", "public.task.task_start", "public.task.task_end",
+		"public.task.all_day", "public.task.priority", "public.task.estimate", "public.task.row_version", "public.task.date_archived",
 		"array_remove(array_agg(public.task_user.id_user ORDER BY public.task_user.id_user), NULL)",
 		"array_remove(array_agg(public.comment.id ORDER BY public.comment.date_created), NULL)",
 		"array_remove(array_agg(public.checklist.id ORDER BY public.checklist.list_position), NULL)",
 		"array_remove(array_agg(public.tag_task.id_tag ORDER BY public.tag_task.id_tag), NULL)",
+		"ARRAY(SELECT public.task_reminder.offset_minutes FROM public.task_reminder " +
+			"WHERE public.task_reminder.id_task = public.task.id ORDER BY public.task_reminder.offset_minutes)",
+		"(SELECT public.task_recurrence.rule FROM public.task_recurrence " +
+			"WHERE public.task_recurrence.id_task = public.task.id AND public.task_recurrence.date_stopped IS NULL)",
+		"ARRAY(SELECT public.task_dependency.id_blocker FROM public.task_dependency " +
+			"WHERE public.task_dependency.id_blocked = public.task.id ORDER BY public.task_dependency.id_blocker)",
+		"ARRAY(SELECT public.task_dependency.id_blocked FROM public.task_dependency " +
+			"WHERE public.task_dependency.id_blocker = public.task.id ORDER BY public.task_dependency.id_blocked)",
+		"EXISTS(" + openBlockers + " AND public.task_dependency.id_blocked = public.task.id)",
+		"public.task.id_parent",
+		"ARRAY(SELECT subtask.id FROM public.task AS subtask WHERE subtask.id_parent = public.task.id ORDER BY subtask.id)",
+		"(SELECT count(*) FROM public.task AS subtask " +
+			"WHERE subtask.id_parent = public.task.id AND subtask.date_completed IS NOT NULL)",
 	}
 	allTaskFields2 = []string{
 		"public.task.id",
@@ -36,18 +76,33 @@ var (
 		"public.task.list_position",
 		"public.task.task_start",
 		"public.task.task_end",
+		"public.task.all_day",
 		"array_remove(array_agg(public.task_user.id_user ORDER BY public.task_user.id_user), NULL)",
 		"array_remove(array_agg(public.comment.id ORDER BY public.comment.date_created), NULL)",
 		"array_remove(array_agg(public.tag_task.id_tag ORDER BY public.tag_task.id_tag), NULL)",
 	}
 	allChecklistFields = []string{"public.checklist.id", "public.checklist.id_task", "public.checklist.name", "public.checklist.list_position",
-		"array_remove(array_agg(public.checklist_item.id ORDER BY public.checklist_item.list_position), NULL)",
+		"array_remove(array_agg(public.checklist_item.id ORDER BY public.checklist_item.rank, public.checklist_item.id), NULL)",
 	}
 	allTaskAggFields = []string{"public.task.id", "public.task.id_list", "public.task.date_created", "public.task.name",
 		"public.task.description", "public.task.list_position", "public.task.task_start", "public.task.task_end",
 		"array_remove(array_agg(public.task_user.id_user ORDER BY public.task_user.id_user), NULL)",
 	}
-	newTaskFields    = []string{"id_list", "name", "list_position"}
+	allNotificationFields = []string{"public.notification.id", "public.notification.kind", "public.notification.id_task",
+		"public.task.name", "COALESCE(public.list.id_board, public.edit_history.id_board)",
+		"public.notification.due_at", "public.notification.offset_minutes",
+		"public.notification.id_history", "public.edit_history.entity_type", "public.edit_history.action",
+		"public.notification.date_created", "public.notification.date_read",
+	}
+	dueRecurrenceFields = []string{"public.task_recurrence.id", "public.task_recurrence.id_task", "public.task_recurrence.id_user", "public.task_recurrence.rule",
+		"public.task_recurrence.timezone", "public.task_recurrence.due_at",
+		"public.task.task_start", "public.task.task_end", "public.task.all_day",
+	}
+	timeReportFields = []string{"public.user.id", "public.user.email", "public.user.name", "public.user.surname",
+		"public.task.id", "public.task.name",
+		"sum(extract(epoch FROM public.worklog.date_stopped - public.worklog.date_started))::bigint",
+	}
+	newTaskFields    = []string{"id_list", "name", "list_position", "date_completed", "rank", "id_parent"}
 	allSessionFields = []string{"id_user", "expiration_date"}
 
 	// allWorkspaceAndBoardFields = []string{
@@ -84,7 +139,47 @@ var (
 	}
 
 	allHistoryEntryFields = []string{
-		"public.edit_history.edit_date", "public.edit_history.edit_summary",
+		"public.edit_history.id", "public.edit_history.id_board", "public.edit_history.edit_date",
+		"public.edit_history.edit_summary", "public.edit_history.entity_type", "public.edit_history.id_entity",
+		"public.edit_history.action", "public.edit_history.changes", "public.edit_history.id_reverted",
+		"public.edit_history.id_task",
+	}
+
+	newHistoryEntryFields = []string{
+		"id_user", "id_board", "edit_date", "edit_summary", "entity_type", "id_entity", "action", "changes", "id_reverted",
+		"id_task", "board_version",
+	}
+	boardChangeFields = []string{
+		"entity_type",
+		"COALESCE((change.value->>'entity_id')::integer, public.edit_history.id_entity) AS entity_id",
+		"action",
+		"board_version",
+	}
+	// bulkHistoryChanges -- изменения массовой операции, у записи которой нет id_entity:
+	// каждое изменение указывает затронутую сущность
+	bulkHistoryChanges = "LATERAL jsonb_array_elements(CASE WHEN public.edit_history.id_entity = 0 " +
+		"THEN public.edit_history.changes ELSE '[]'::jsonb END) AS change ON true"
+
+	boardStatsListFields = []string{"public.list.id", "public.list.name", "public.list.is_done", "count(public.task.id)"}
+	boardStatsTaskFields = []string{
+		"count(public.task.id)",
+		"count(public.task.id) FILTER (WHERE public.task.date_completed IS NULL AND public.task.task_end < CURRENT_TIMESTAMP)",
+		"count(public.task.id) FILTER (WHERE public.task.date_completed IS NULL " +
+			"AND public.task.task_end >= date_trunc('week', CURRENT_TIMESTAMP) " +
+			"AND public.task.task_end < date_trunc('week', CURRENT_TIMESTAMP) + interval '1 week')",
+		"count(public.task.id) FILTER (WHERE NOT EXISTS (SELECT 1 FROM public.task_user WHERE public.task_user.id_task = public.task.id))",
+	}
+	boardStatsAssigneeFields  = []string{"public.user.id", "public.user.email", "count(public.task.id)"}
+	boardStatsChecklistFields = []string{"count(public.checklist_item.id)", "count(public.checklist_item.id) FILTER (WHERE public.checklist_item.done)"}
+	boardStatsTagFields       = []string{"public.tag.id", "public.tag.name", "public.tag.color", "count(public.list.id)"}
+
+	allCustomFieldFields = []string{
+		"public.custom_field.id", "public.custom_field.id_board", "public.custom_field.name",
+		"public.custom_field.field_type", "public.custom_field.options", "public.custom_field.list_position",
+	}
+
+	allCustomFieldValueFields = []string{
+		"public.task_custom_field_value.id_task", "public.task_custom_field_value.id_field", "public.task_custom_field_value.value",
 	}
 
 	// taskUserFields = []string{
@@ -56,7 +56,7 @@ func (s PostgresTagStorage) Create(ctx context.Context, info dto.NewTagInfo) (*e
 	}
 	logger.DebugFmt("Built query\n\t"+query1+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	tx, err := beginTx(ctx, s.db)
 	if err != nil {
 		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
 		return nil, apperrors.ErrCouldNotBeginTransaction
@@ -177,7 +177,7 @@ func (s PostgresTagStorage) Update(ctx context.Context, info dto.UpdatedTagInfo)
 	}
 	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	_, err = s.db.Exec(query, args...)
+	_, err = conn(ctx, s.db).Exec(query, args...)
 	if err != nil {
 		logger.DebugFmt("Failed to update tag with error "+err.Error(), requestID.String(), funcName, nodeName)
 		return apperrors.ErrCouldNotExecuteQuery
@@ -214,7 +214,7 @@ func (s PostgresTagStorage) AddToTask(ctx context.Context, ids dto.TagAndTaskIDs
 	}
 	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	_, err = s.db.Exec(query, args...)
+	_, err = conn(ctx, s.db).Exec(query, args...)
 	if err != nil {
 		logger.DebugFmt("Failed to update tag_task with error "+err.Error(), requestID.String(), funcName, nodeName)
 		return apperrors.ErrCouldNotExecuteQuery
@@ -253,7 +253,7 @@ func (s PostgresTagStorage) RemoveFromTask(ctx context.Context, ids dto.TagAndTa
 	}
 	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	_, err = s.db.Exec(query, args...)
+	_, err = conn(ctx, s.db).Exec(query, args...)
 	if err != nil {
 		logger.DebugFmt("Failed to update tag_task with error "+err.Error(), requestID.String(), funcName, nodeName)
 		return apperrors.ErrCouldNotExecuteQuery
@@ -289,7 +289,7 @@ func (s PostgresTagStorage) Delete(ctx context.Context, id dto.TagID) error {
 	}
 	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	_, err = s.db.Exec(query, args...)
+	_, err = conn(ctx, s.db).Exec(query, args...)
 	if err != nil {
 		logger.DebugFmt("Failed to update tag with error "+err.Error(), requestID.String(), funcName, nodeName)
 		return apperrors.ErrCouldNotExecuteQuery
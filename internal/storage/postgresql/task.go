@@ -3,6 +3,7 @@ package postgresql
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"server/internal/apperrors"
@@ -30,8 +31,8 @@ func NewTaskStorage(db *sql.DB) *PostgresTaskStorage {
 }
 
 // Create
-// создает новое задание в БД по данным
-// или возвращает ошибки ...
+// создает новое задание в БД по данным, не превышая жёсткий лимит задач списка
+// или возвращает ошибки apperrors.ErrWipLimitExceeded, ...
 func (s PostgresTaskStorage) Create(ctx context.Context, info dto.NewTaskInfo) (*entities.Task, error) {
 	funcName := "PostgresTaskStorage.Create"
 	errorMessage := "Creating task failed with error: "
@@ -41,34 +42,64 @@ func (s PostgresTaskStorage) Create(ctx context.Context, info dto.NewTaskInfo) (
 
 	logger.Debug(">>>>>>>>>>>>>>>> PostgresTaskStorage.Create <<<<<<<<<<<<<<<<<<<")
 
-	sql, args, err := sq.
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt(errorMessage+err.Error(), requestID.String(), funcName, nodeName)
+		logger.Debug(failBorder)
+		return nil, apperrors.ErrCouldNotBeginTransaction
+	}
+
+	rollback := func(reason error) error {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotRollback
+		}
+		logger.Debug(failBorder)
+		return reason
+	}
+
+	key, err := taskRankScope.appendKey(ctx, tx, info.ListID)
+	if err != nil {
+		logger.DebugFmt(errorMessage+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, rollback(apperrors.ErrTaskNotCreated)
+	}
+
+	query, args, err := sq.
 		Insert("public.task").
 		Columns(newTaskFields...).
-		Values(info.ListID, info.Name, info.ListPosition).
+		Values(info.ListID, info.Name, info.ListPosition, completedInList(info.ListID), key, info.ParentID).
 		PlaceholderFormat(sq.Dollar).
 		Suffix("RETURNING id, date_created").
 		ToSql()
 	if err != nil {
 		logger.DebugFmt(errorMessage+err.Error(), requestID.String(), funcName, nodeName)
-		logger.Debug(failBorder)
-		return nil, apperrors.ErrCouldNotBuildQuery
+		return nil, rollback(apperrors.ErrCouldNotBuildQuery)
 	}
-	logger.DebugFmt("Built query\n\t"+sql+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
 	task := entities.Task{
 		Name:         info.Name,
 		ListID:       info.ListID,
 		ListPosition: info.ListPosition,
+		Priority:     dto.TaskPriorityNone,
+		ParentID:     info.ParentID,
 		Users:        []uint64{},
 		Checklists:   []uint64{},
 		Comments:     []uint64{},
 	}
-	query := s.db.QueryRow(sql, args...)
-	err = query.Scan(&task.ID, &task.DateCreated)
+	err = tx.QueryRow(query, args...).Scan(&task.ID, &task.DateCreated)
 	if err != nil {
 		logger.DebugFmt(errorMessage+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, rollback(apperrors.ErrTaskNotCreated)
+	}
+	if err = checkHardWipLimit(ctx, tx, info.ListID); err != nil {
+		return nil, rollback(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
 		logger.Debug(failBorder)
-		return nil, apperrors.ErrTaskNotCreated
+		return nil, apperrors.ErrCouldNotCommit
 	}
 	logger.DebugFmt("Created task", requestID.String(), funcName, nodeName)
 
@@ -101,7 +132,7 @@ func (s *PostgresTaskStorage) Read(ctx context.Context, id dto.TaskID) (*dto.Sin
 	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
 	task := dto.SingleTaskInfo{}
-	row := s.db.QueryRow(query, args...)
+	row := conn(ctx, s.db).QueryRow(query, args...)
 	if err = row.Scan(
 		&task.ID,
 		&task.ListID,
@@ -111,6 +142,7 @@ func (s *PostgresTaskStorage) Read(ctx context.Context, id dto.TaskID) (*dto.Sin
 		&task.ListPosition,
 		&task.Start,
 		&task.End,
+		&task.AllDay,
 		(*pq.StringArray)(&task.UserIDs),
 		(*pq.StringArray)(&task.CommentIDs),
 		(*pq.StringArray)(&task.TagIDs),
@@ -124,21 +156,25 @@ func (s *PostgresTaskStorage) Read(ctx context.Context, id dto.TaskID) (*dto.Sin
 }
 
 // ReadMany
-// находит задание в БД по их id
+// находит задания в БД по их id, отбирая их по условиям фильтра
 // или возвращает ошибки ...
-func (s *PostgresTaskStorage) ReadMany(ctx context.Context, id dto.TaskIDs) (*[]dto.SingleTaskInfo, error) {
+func (s *PostgresTaskStorage) ReadMany(ctx context.Context, filter dto.TaskFilter) (*[]dto.SingleTaskInfo, error) {
 	funcName := "PostgresTaskStorage.ReadMany"
 	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
 	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
 
-	query, args, err := sq.
+	builder := sq.
 		Select(allTaskFields...).
 		From("public.task").
 		LeftJoin("public.task_user ON public.task.id = public.task_user.id_task").
 		LeftJoin("public.comment ON public.task.id = public.comment.id_task").
 		LeftJoin("public.checklist ON public.task.id = public.checklist.id_task").
 		LeftJoin("public.tag_task ON public.task.id = public.tag_task.id_task").
-		Where(sq.Eq{"public.task.id": id.Values}).
+		Where(sq.Eq{"public.task.id": filter.TaskIDs})
+	if conditions := taskFilterConditions(filter); len(conditions) != 0 {
+		builder = builder.Where(conditions)
+	}
+	query, args, err := builder.
 		GroupBy("public.task.id", "public.task.id_list").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
@@ -148,7 +184,7 @@ func (s *PostgresTaskStorage) ReadMany(ctx context.Context, id dto.TaskIDs) (*[]
 	}
 	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	rows, err := s.db.Query(query, args...)
+	rows, err := conn(ctx, s.db).Query(query, args...)
 	if err != nil {
 		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
 		return nil, apperrors.ErrCouldNotGetBoardUsers
@@ -169,10 +205,23 @@ func (s *PostgresTaskStorage) ReadMany(ctx context.Context, id dto.TaskIDs) (*[]
 			&task.ListPosition,
 			&task.Start,
 			&task.End,
+			&task.AllDay,
+			&task.Priority,
+			&task.Estimate,
+			&task.RowVersion,
+			&task.DateArchived,
 			(*pq.StringArray)(&task.UserIDs),
 			(*pq.StringArray)(&task.CommentIDs),
 			(*pq.StringArray)(&task.ChecklistIDs),
 			(*pq.StringArray)(&task.TagIDs),
+			(*pq.StringArray)(&task.Reminders),
+			&task.Recurrence,
+			(*pq.StringArray)(&task.Blockers),
+			(*pq.StringArray)(&task.Blocking),
+			&task.Blocked,
+			&task.ParentID,
+			(*pq.StringArray)(&task.Subtasks),
+			&task.SubtasksDone,
 		)
 		if err != nil {
 			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
@@ -186,28 +235,19 @@ func (s *PostgresTaskStorage) ReadMany(ctx context.Context, id dto.TaskIDs) (*[]
 }
 
 // Update
-// обновляет задание в БД
+// обновляет задание в БД, если его версия не изменилась с момента чтения клиентом
 // или возвращает ошибки ...
 func (s PostgresTaskStorage) Update(ctx context.Context, info dto.UpdatedTaskInfo) error {
-	query := sq.Update("public.task")
-	/*
-		if info.Start != nil {
-			query = query.Set("task_start", &info.Start)
-		}
-		if info.End != nil {
-			query = query.Set("task_end", &info.End)
-		}
-		log.Println(info.Start)
-		log.Println(info.End)
-	*/
-
-	finalQuery, args, err := query.
+	finalQuery, args, err := sq.Update("public.task").
 		Set("name", info.Name).
 		Set("description", info.Description).
-		Set("list_position", info.ListPosition).
-		Set("task_start", &info.Start).
-		Set("task_end", &info.End).
-		Where(sq.Eq{"id": info.ID}).
+		Set("task_start", info.Start).
+		Set("task_end", info.End).
+		Set("all_day", info.AllDay).
+		Set("priority", info.Priority).
+		Set("estimate", info.Estimate).
+		Set("row_version", sq.Expr("row_version + 1")).
+		Where(sq.Eq{"id": info.ID, "row_version": info.RowVersion}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 
@@ -216,13 +256,22 @@ func (s PostgresTaskStorage) Update(ctx context.Context, info dto.UpdatedTaskInf
 	}
 	log.Println("Formed query\n\t", finalQuery, "\nwith args\n\t", args)
 
-	_, err = s.db.Exec(finalQuery, args...)
+	result, err := conn(ctx, s.db).Exec(finalQuery, args...)
 
 	if err != nil {
 		log.Println(err)
 		return apperrors.ErrTaskNotUpdated
 	}
 
+	updated, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return apperrors.ErrTaskNotUpdated
+	}
+	if updated == 0 {
+		return apperrors.ErrRowVersionConflict
+	}
+
 	return nil
 }
 
@@ -241,7 +290,7 @@ func (s PostgresTaskStorage) Delete(ctx context.Context, id dto.TaskID) error {
 	}
 	log.Println("Built board query\n\t", sql, "\nwith args\n\t", args)
 
-	_, err = s.db.Exec(sql, args...)
+	_, err = conn(ctx, s.db).Exec(sql, args...)
 
 	if err != nil {
 		log.Println("Failed to exec query with error", err.Error())
@@ -251,8 +300,92 @@ func (s PostgresTaskStorage) Delete(ctx context.Context, id dto.TaskID) error {
 	return nil
 }
 
+// Archive
+// переносит задание в архив: задание скрывается с доски, но остаётся в БД
+// или возвращает ошибки apperrors.ErrTaskNotFound, apperrors.ErrTaskNotArchived, ...
+func (s PostgresTaskStorage) Archive(ctx context.Context, id dto.TaskID) error {
+	affected, err := setArchived(ctx, conn(ctx, s.db), "public.task", sq.Eq{"id": id.Value}, true, apperrors.ErrTaskNotArchived)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return apperrors.ErrTaskNotFound
+	}
+	return nil
+}
+
+// Unarchive
+// возвращает задание из архива на прежнее место в списке. Задание снова попадает в список,
+// поэтому в той же транзакции проверяются жёсткий лимит задач списка и блокирующие задания
+// или возвращает ошибки apperrors.ErrTaskNotFound, apperrors.ErrTaskNotArchived, apperrors.ErrWipLimitExceeded,
+// apperrors.ErrTaskBlocked, ...
+func (s PostgresTaskStorage) Unarchive(ctx context.Context, id dto.TaskID) error {
+	funcName := "PostgresTaskStorage.Unarchive"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	listQuery, listArgs, err := sq.Select("id_list").
+		From("public.task").
+		Where(sq.Eq{"id": id.Value}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+listQuery+"\nwith args\n\t"+fmt.Sprintf("%+v", listArgs), requestID.String(), funcName, nodeName)
+
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotBeginTransaction
+	}
+
+	rollback := func(reason error) error {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotRollback
+		}
+		return reason
+	}
+
+	var listID uint64
+	err = tx.QueryRow(listQuery, listArgs...).Scan(&listID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return rollback(apperrors.ErrTaskNotFound)
+	}
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrTaskNotArchived)
+	}
+	if err = taskRankScope.lockParent(ctx, tx, listID); err != nil {
+		return rollback(err)
+	}
+
+	affected, err := setArchived(ctx, tx, "public.task", sq.Eq{"id": id.Value}, false, apperrors.ErrTaskNotArchived)
+	if err != nil {
+		return rollback(err)
+	}
+	if affected == 0 {
+		return rollback(apperrors.ErrTaskNotFound)
+	}
+	if err = checkHardWipLimit(ctx, tx, listID); err != nil {
+		return rollback(err)
+	}
+	if err = checkBlockers(ctx, tx, listID, id.Value); err != nil {
+		return rollback(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotCommit
+	}
+	logger.DebugFmt("Task unarchived", requestID.String(), funcName, nodeName)
+
+	return nil
+}
+
 // AddUser
-// добавляет пользователя в карточку
+// добавляет пользователя в карточку и подписывает его на изменения задания
 // или возвращает ошибки ...
 func (s PostgresTaskStorage) AddUser(ctx context.Context, info dto.AddTaskUserInfo) error {
 	funcName := "PostgreSQLTaskStorage.AddUser"
@@ -261,6 +394,7 @@ func (s PostgresTaskStorage) AddUser(ctx context.Context, info dto.AddTaskUserIn
 
 	sql, args, err := sq.
 		Insert("public.task_user").
+		Prefix("WITH watched AS ("+watchTaskOnAssign+")", info.TaskID, info.UserID).
 		Columns("id_task", "id_user").
 		Values(info.TaskID, info.UserID).
 		PlaceholderFormat(sq.Dollar).
@@ -271,7 +405,7 @@ func (s PostgresTaskStorage) AddUser(ctx context.Context, info dto.AddTaskUserIn
 	}
 	logger.DebugFmt("Built query\n\t"+sql+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	_, err = s.db.Exec(sql, args...)
+	_, err = conn(ctx, s.db).Exec(sql, args...)
 	if err != nil {
 		logger.DebugFmt("Insert failed with error "+err.Error(), requestID.String(), funcName, nodeName)
 		return apperrors.ErrCouldNotAddTaskUser
@@ -303,7 +437,7 @@ func (s PostgresTaskStorage) RemoveUser(ctx context.Context, info dto.RemoveTask
 	}
 	logger.DebugFmt("Built query\n\t"+sql+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	_, err = s.db.Exec(sql, args...)
+	_, err = conn(ctx, s.db).Exec(sql, args...)
 	if err != nil {
 		logger.DebugFmt("Delete failed with error "+err.Error(), requestID.String(), funcName, nodeName)
 		return apperrors.ErrCouldNotRemoveTaskUser
@@ -334,7 +468,7 @@ func (s *PostgresTaskStorage) CheckAccess(ctx context.Context, info dto.CheckTas
 	}
 	logger.DebugFmt("Built query\n\t"+listSql+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	row := s.db.QueryRow(listSql, args...)
+	row := conn(ctx, s.db).QueryRow(listSql, args...)
 	logger.DebugFmt("Got user row", requestID.String(), funcName, nodeName)
 
 	var count uint64
@@ -347,64 +481,282 @@ func (s *PostgresTaskStorage) CheckAccess(ctx context.Context, info dto.CheckTas
 }
 
 // Move
-// переносит задание в другой список
-// или возвращает ошибки ...
-func (s PostgresTaskStorage) Move(ctx context.Context, taskMoveInfo dto.TaskMoveInfo) error {
-	funcName := "PostgreSQLBoardStorage.Move"
+// ставит задание сразу после другого задания списка info.ListID (или в начало списка);
+// ключ порядка меняется только у перемещаемого задания. Целевой список должен быть на той же доске
+// или возвращает ошибки apperrors.ErrInvalidPosition, apperrors.ErrWipLimitExceeded, apperrors.ErrTaskBlocked, ...
+func (s PostgresTaskStorage) Move(ctx context.Context, info dto.TaskMoveInfo) error {
+	funcName := "PostgresTaskStorage.Move"
 	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
 	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
 
-	taskIDs := make(map[uint64]int)
-	for i, id := range taskMoveInfo.OldList.TaskIDs {
-		taskIDs[id] = i
-	}
-	for i, id := range taskMoveInfo.NewList.TaskIDs {
-		taskIDs[id] = i
+	listQuery, listArgs, err := sq.Select("current.id").
+		From("public.list AS target").
+		Join("public.list AS current ON current.id_board = target.id_board").
+		Join("public.task ON public.task.id_list = current.id").
+		Where(sq.Eq{"public.task.id": info.TaskID, "target.id": info.ListID}).
+		Suffix("FOR UPDATE OF target").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
 	}
-	keys := make([]uint64, 0, len(taskIDs))
-	for k := range taskIDs {
-		keys = append(keys, k)
+	logger.DebugFmt("Built query\n\t"+listQuery+"\nwith args\n\t"+fmt.Sprintf("%+v", listArgs), requestID.String(), funcName, nodeName)
+
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotBeginTransaction
 	}
 
-	log.Println(keys)
+	rollback := func(reason error) error {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotRollback
+		}
+		return reason
+	}
 
-	caseBuilder := sq.Case()
-	for _, id := range keys {
-		caseBuilder = caseBuilder.
-			When(sq.Eq{"id": fmt.Sprintf("%v", id)}, fmt.Sprintf("%v", taskIDs[id])).
-			Else("list_position")
+	var currentListID uint64
+	err = tx.QueryRow(listQuery, listArgs...).Scan(&currentListID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return rollback(apperrors.ErrInvalidPosition)
+	}
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrCouldNotChangeTaskOrder)
 	}
 
-	updateBuilder := sq.
-		Update("public.task").
-		Set("list_position", caseBuilder)
+	key, err := taskRankScope.place(ctx, tx, info.ListID, info.TaskID, positionAfter(info.AfterID))
+	if err != nil {
+		return rollback(err)
+	}
 
-	if taskMoveInfo.NewList.ListID != taskMoveInfo.OldList.ListID {
+	updateBuilder := sq.Update("public.task").
+		Set("rank", key)
+	if currentListID != info.ListID {
 		updateBuilder = updateBuilder.
-			Set("id_list", sq.Case().
-				When(sq.Eq{"id": fmt.Sprintf("%v", taskMoveInfo.TaskID)}, fmt.Sprintf("%v", taskMoveInfo.NewList.ListID)).
-				Else("id_list"))
+			Set("id_list", info.ListID).
+			Set("date_completed", completedInList(info.ListID))
 	}
-
 	query, args, err := updateBuilder.
+		Where(sq.Eq{"id": info.TaskID}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
-		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
-		return apperrors.ErrCouldNotBuildQuery
+		return rollback(apperrors.ErrCouldNotBuildQuery)
 	}
-	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t", requestID.String(), funcName, nodeName)
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	_, err = s.db.Exec(query, args...)
-	if err != nil {
+	if _, err = tx.Exec(query, args...); err != nil {
 		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
-		return apperrors.ErrCouldNotChangeTaskOrder
+		return rollback(apperrors.ErrCouldNotChangeTaskOrder)
+	}
+	if currentListID != info.ListID {
+		if err = checkHardWipLimit(ctx, tx, info.ListID); err != nil {
+			return rollback(err)
+		}
+		if err = checkBlockers(ctx, tx, info.ListID, info.TaskID); err != nil {
+			return rollback(err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotCommit
 	}
 	logger.DebugFmt("Commited changes", requestID.String(), funcName, nodeName)
 
 	return nil
 }
 
+// MoveToBoard
+// переносит задание в список info.ListID другой доски, ставя его после задания info.AfterID, и приводит
+// задание к этой доске так же, как перенос списка. Чеклисты, комментарии и файлы остаются у задания
+// или возвращает ошибки apperrors.ErrTaskNotFound, apperrors.ErrTaskAlreadyOnBoard, apperrors.ErrInvalidPosition,
+// apperrors.ErrWipLimitExceeded, apperrors.ErrTaskBlocked, ...
+func (s PostgresTaskStorage) MoveToBoard(ctx context.Context, info dto.TaskTransferInfo) (*dto.TaskTransferReport, error) {
+	funcName := "PostgresTaskStorage.MoveToBoard"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	boardQuery, boardArgs, err := sq.Select("current.id_board", "target.id_board").
+		From("public.task").
+		Join("public.list AS current ON current.id = public.task.id_list").
+		Join("public.list AS target ON target.id = ?", info.ListID).
+		Where(sq.Eq{"public.task.id": info.TaskID}).
+		Suffix("FOR UPDATE OF public.task").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+boardQuery+"\nwith args\n\t"+fmt.Sprintf("%+v", boardArgs), requestID.String(), funcName, nodeName)
+
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBeginTransaction
+	}
+
+	rollback := func(reason error) error {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotRollback
+		}
+		return reason
+	}
+
+	var sourceBoardID, targetBoardID uint64
+	err = tx.QueryRow(boardQuery, boardArgs...).Scan(&sourceBoardID, &targetBoardID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, rollback(apperrors.ErrTaskNotFound)
+	}
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, rollback(apperrors.ErrTaskNotMoved)
+	}
+	if sourceBoardID == targetBoardID {
+		return nil, rollback(apperrors.ErrTaskAlreadyOnBoard)
+	}
+
+	if err = taskRankScope.lockParent(ctx, tx, info.ListID); err != nil {
+		return nil, rollback(err)
+	}
+	key, err := taskRankScope.place(ctx, tx, info.ListID, info.TaskID, positionAfter(info.AfterID))
+	if err != nil {
+		return nil, rollback(err)
+	}
+
+	query, args, err := sq.Update("public.task").
+		Set("id_list", info.ListID).
+		Set("rank", key).
+		Set("date_completed", completedInList(info.ListID)).
+		Where(sq.Eq{"id": info.TaskID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, rollback(apperrors.ErrCouldNotBuildQuery)
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	if _, err = tx.Exec(query, args...); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, rollback(apperrors.ErrTaskNotMoved)
+	}
+	if err = checkHardWipLimit(ctx, tx, info.ListID); err != nil {
+		return nil, rollback(err)
+	}
+	if err = checkBlockers(ctx, tx, info.ListID, info.TaskID); err != nil {
+		return nil, rollback(err)
+	}
+
+	changes, err := adaptToBoard(ctx, tx, sq.Select("id").From("public.task").Where(sq.Eq{"id": info.TaskID}), targetBoardID)
+	if err != nil {
+		return nil, rollback(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotCommit
+	}
+	logger.DebugFmt("Task moved to another board", requestID.String(), funcName, nodeName)
+
+	return &dto.TaskTransferReport{
+		TaskID:  info.TaskID,
+		ListID:  info.ListID,
+		BoardID: targetBoardID,
+		Changes: *changes,
+	}, nil
+}
+
+// Copy
+// копирует задание с выбранными частями info.Parts в список info.ListID, ставя копию после задания info.AfterID.
+// Если список на другой доске, копия приводится к ней так же, как при переносе
+// или возвращает ошибки apperrors.ErrTaskNotFound, apperrors.ErrInvalidPosition, apperrors.ErrWipLimitExceeded, ...
+func (s PostgresTaskStorage) Copy(ctx context.Context, info dto.TaskCopyInfo) (*dto.TaskTransferReport, error) {
+	funcName := "PostgresTaskStorage.Copy"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	boardQuery, boardArgs, err := sq.Select("current.id_board", "target.id_board").
+		From("public.task").
+		Join("public.list AS current ON current.id = public.task.id_list").
+		Join("public.list AS target ON target.id = ?", info.ListID).
+		Where(sq.Eq{"public.task.id": info.TaskID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+boardQuery+"\nwith args\n\t"+fmt.Sprintf("%+v", boardArgs), requestID.String(), funcName, nodeName)
+
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBeginTransaction
+	}
+
+	rollback := func(reason error) error {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotRollback
+		}
+		return reason
+	}
+
+	var sourceBoardID, targetBoardID uint64
+	err = tx.QueryRow(boardQuery, boardArgs...).Scan(&sourceBoardID, &targetBoardID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, rollback(apperrors.ErrTaskNotFound)
+	}
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, rollback(apperrors.ErrTaskNotCreated)
+	}
+
+	if err = taskRankScope.lockParent(ctx, tx, info.ListID); err != nil {
+		return nil, rollback(err)
+	}
+	key, err := taskRankScope.place(ctx, tx, info.ListID, 0, positionAfter(info.AfterID))
+	if err != nil {
+		return nil, rollback(err)
+	}
+
+	copyID, err := copyTask(ctx, tx, info.TaskID, info.ListID, key, info.Parts)
+	if err != nil {
+		return nil, rollback(err)
+	}
+	if err = checkHardWipLimit(ctx, tx, info.ListID); err != nil {
+		return nil, rollback(err)
+	}
+
+	changes := &dto.TransferChanges{
+		RemappedTags:       []dto.TransferredTag{},
+		DroppedAssignees:   []dto.DroppedAssignee{},
+		DroppedFieldValues: []dto.DroppedFieldValue{},
+		DetachedSubtasks:   []uint64{},
+	}
+	if sourceBoardID != targetBoardID {
+		changes, err = adaptToBoard(ctx, tx, sq.Select("id").From("public.task").Where(sq.Eq{"id": copyID}), targetBoardID)
+		if err != nil {
+			return nil, rollback(err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotCommit
+	}
+	logger.DebugFmt("Task copied", requestID.String(), funcName, nodeName)
+
+	return &dto.TaskTransferReport{
+		TaskID:  copyID,
+		ListID:  info.ListID,
+		BoardID: targetBoardID,
+		Changes: *changes,
+	}, nil
+}
+
 // GetFileList
 // добавляет файл в задание
 // или возвращает ошибки ...
@@ -426,7 +778,7 @@ func (s PostgresTaskStorage) GetFileList(ctx context.Context, id dto.TaskID) (*[
 	}
 	logger.DebugFmt("Built query\n\t"+sql+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	rows, err := s.db.Query(sql, args...)
+	rows, err := conn(ctx, s.db).Query(sql, args...)
 	if err != nil {
 		logger.DebugFmt("Failed to get task files with error "+err.Error(), requestID.String(), funcName, nodeName)
 		return nil, apperrors.ErrCouldNotExecuteQuery
@@ -459,7 +811,7 @@ func (s PostgresTaskStorage) AttachFile(ctx context.Context, info dto.AttachedFi
 	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
 	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
 
-	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	tx, err := beginTx(ctx, s.db)
 	if err != nil {
 		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
 		return apperrors.ErrCouldNotBeginTransaction
@@ -537,11 +889,13 @@ func (s PostgresTaskStorage) RemoveFile(ctx context.Context, info dto.RemoveFile
 	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
 
 	query1, args, err := sq.
-		Select("id").
+		Select("public.file.id").
 		From("public.file").
+		Join("public.task_file ON public.task_file.id_file = public.file.id").
 		Where(sq.And{
-			sq.Eq{"name": info.OriginalName},
-			sq.Eq{"filepath": info.FilePath},
+			sq.Eq{"public.task_file.id_task": info.TaskID},
+			sq.Eq{"public.file.name": info.OriginalName},
+			sq.Eq{"public.file.filepath": info.FilePath},
 		}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
@@ -552,7 +906,7 @@ func (s PostgresTaskStorage) RemoveFile(ctx context.Context, info dto.RemoveFile
 	logger.DebugFmt("Built query\n\t"+query1+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
 	var fileID int
-	row := s.db.QueryRow(query1, args...)
+	row := conn(ctx, s.db).QueryRow(query1, args...)
 	if err := row.Scan(&fileID); err != nil {
 		logger.DebugFmt("Failed to get file ID with error "+err.Error(), requestID.String(), funcName, nodeName)
 		return apperrors.ErrCouldNotExecuteQuery
@@ -570,7 +924,7 @@ func (s PostgresTaskStorage) RemoveFile(ctx context.Context, info dto.RemoveFile
 	}
 	logger.DebugFmt("Built query\n\t"+query2+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
 
-	_, err = s.db.Exec(query2, args...)
+	_, err = conn(ctx, s.db).Exec(query2, args...)
 	if err != nil {
 		logger.DebugFmt("Failed to execute query with error "+err.Error(), requestID.String(), funcName, nodeName)
 		return apperrors.ErrCouldNotExecuteQuery
@@ -579,3 +933,133 @@ func (s PostgresTaskStorage) RemoveFile(ctx context.Context, info dto.RemoveFile
 
 	return nil
 }
+
+// FileInUse
+// проверяет, остались ли записи о файлах с путём filePath: после копирования заданий
+// на один файл могут ссылаться несколько записей
+// или возвращает ошибки ...
+func (s PostgresTaskStorage) FileInUse(ctx context.Context, filePath string) (bool, error) {
+	funcName := "PostgresTaskStorage.FileInUse"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select("count(*) > 0").
+		From("public.file").
+		Where(sq.Eq{"filepath": filePath}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	var inUse bool
+	if err = conn(ctx, s.db).QueryRow(query, args...).Scan(&inUse); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return false, apperrors.ErrCouldNotExecuteQuery
+	}
+	return inUse, nil
+}
+
+// SetReminders
+// заменяет смещения напоминаний о сроке задания и возвращает прежние по возрастанию
+// или возвращает ошибки apperrors.ErrTaskNotUpdated, ...
+func (s PostgresTaskStorage) SetReminders(ctx context.Context, info dto.TaskRemindersInfo) ([]uint64, error) {
+	funcName := "PostgresTaskStorage.SetReminders"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	removed := sq.Delete("public.task_reminder").
+		Where(sq.Eq{"id_task": info.TaskID}).
+		Suffix("RETURNING offset_minutes")
+	deleteQuery, deleteArgs, err := sq.Select("offset_minutes").
+		PrefixExpr(sq.Expr("WITH removed AS (?)", removed)).
+		From("removed").
+		OrderBy("offset_minutes").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+deleteQuery+"\nwith args\n\t"+fmt.Sprintf("%+v", deleteArgs), requestID.String(), funcName, nodeName)
+
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBeginTransaction
+	}
+
+	rollback := func(reason error) ([]uint64, error) {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotRollback
+		}
+		return nil, reason
+	}
+
+	previous, err := scanIDs(tx, deleteQuery, deleteArgs)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrTaskNotUpdated)
+	}
+
+	if len(info.Offsets) != 0 {
+		insert := sq.Insert("public.task_reminder").Columns("id_task", "offset_minutes")
+		for _, offset := range info.Offsets {
+			insert = insert.Values(info.TaskID, offset)
+		}
+		query, args, err := insert.PlaceholderFormat(sq.Dollar).ToSql()
+		if err != nil {
+			return rollback(apperrors.ErrCouldNotBuildQuery)
+		}
+		logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+		if _, err = tx.Exec(query, args...); err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return rollback(apperrors.ErrTaskNotUpdated)
+		}
+	}
+	logger.DebugFmt("Reminders replaced", requestID.String(), funcName, nodeName)
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotCommit
+	}
+	logger.DebugFmt("Commited changes", requestID.String(), funcName, nodeName)
+
+	return previous, nil
+}
+
+// completedInList
+// возвращает дату выполнения задания, попадающего в список: текущую для завершающего списка, иначе NULL
+func completedInList(listID uint64) sq.Sqlizer {
+	return sq.Expr("(SELECT CASE WHEN public.list.is_done THEN CURRENT_TIMESTAMP END FROM public.list WHERE public.list.id = ?)", listID)
+}
+
+// taskFilterConditions
+// возвращает условия отбора заданий; пустые условия не добавляются
+func taskFilterConditions(filter dto.TaskFilter) sq.And {
+	conditions := sq.And{}
+	if len(filter.AssigneeIDs) != 0 {
+		conditions = append(conditions, sq.Expr("EXISTS (SELECT 1 FROM public.task_user AS assignee_filter "+
+			"WHERE assignee_filter.id_task = public.task.id AND assignee_filter.id_user = ANY(?))", pq.Array(filter.AssigneeIDs)))
+	}
+	if len(filter.TagIDs) != 0 {
+		conditions = append(conditions, sq.Expr("EXISTS (SELECT 1 FROM public.tag_task AS tag_filter "+
+			"WHERE tag_filter.id_task = public.task.id AND tag_filter.id_tag = ANY(?))", pq.Array(filter.TagIDs)))
+	}
+	if filter.DueFrom != nil {
+		conditions = append(conditions, sq.GtOrEq{"public.task.task_end": *filter.DueFrom})
+	}
+	if filter.DueTo != nil {
+		conditions = append(conditions, sq.LtOrEq{"public.task.task_end": *filter.DueTo})
+	}
+	if filter.Text != "" {
+		pattern := "%" + escapeLike(filter.Text) + "%"
+		conditions = append(conditions, sq.Or{
+			sq.ILike{"public.task.name": pattern},
+			sq.ILike{"public.task.description": pattern},
+		})
+	}
+	return conditions
+}
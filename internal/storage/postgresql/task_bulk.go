@@ -0,0 +1,269 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	"server/internal/pkg/dto"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Bulk
+// в одной транзакции блокирует задания, проверяет доступ пользователя к доске каждого из них
+// и применяет к ним операцию; задания должны лежать на одной доске. Назначенный исполнитель подписывается на изменения заданий
+// или возвращает ошибки apperrors.ErrTaskNotFound, apperrors.ErrNoBoardAccess, apperrors.ErrBulkTasksOnDifferentBoards,
+// apperrors.ErrInvalidPosition, apperrors.ErrWipLimitExceeded, apperrors.ErrTaskBlocked, apperrors.ErrUserNotInBoard,
+// apperrors.ErrTagNotInBoard, apperrors.ErrInvalidTaskSchedule, ...
+func (s PostgresTaskStorage) Bulk(ctx context.Context, info dto.TaskBulkInfo) error {
+	funcName := "PostgresTaskStorage.Bulk"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotBeginTransaction
+	}
+
+	rollback := func(reason error) error {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotRollback
+		}
+		return reason
+	}
+
+	boardID, err := lockBulkTasks(ctx, tx, info)
+	if err != nil {
+		return rollback(err)
+	}
+	logger.DebugFmt("Tasks locked, access checked", requestID.String(), funcName, nodeName)
+
+	onTasks := sq.Eq{"id": info.TaskIDs}
+	switch info.Operation {
+	case dto.TaskBulkMove:
+		err = moveBulkTasks(ctx, tx, boardID, *info.ListID, info.TaskIDs)
+	case dto.TaskBulkAssign:
+		err = requireOnBoard(ctx, tx, sq.Select("1").From("public.board_user").
+			Where(sq.Eq{"id_board": boardID, "id_user": *info.UserID}), apperrors.ErrUserNotInBoard)
+		if err == nil {
+			err = execBulk(ctx, tx, sq.Insert("public.task_user").
+				Columns("id_task", "id_user").
+				Select(sq.Select("id").Column("?::integer", *info.UserID).From("public.task").Where(onTasks)).
+				Suffix("ON CONFLICT DO NOTHING"))
+		}
+		if err == nil {
+			err = execBulk(ctx, tx, sq.Insert("public.task_watcher").
+				Columns("id_task", "id_user").
+				Select(sq.Select("id").Column("?::integer", *info.UserID).From("public.task").Where(onTasks)).
+				Suffix("ON CONFLICT DO NOTHING"))
+		}
+	case dto.TaskBulkUnassign:
+		err = execBulk(ctx, tx, sq.Delete("public.task_user").
+			Where(sq.Eq{"id_task": info.TaskIDs, "id_user": *info.UserID}))
+	case dto.TaskBulkAddTag:
+		err = requireOnBoard(ctx, tx, sq.Select("1").From("public.tag_board").
+			Where(sq.Eq{"id_board": boardID, "id_tag": *info.TagID}), apperrors.ErrTagNotInBoard)
+		if err == nil {
+			err = execBulk(ctx, tx, sq.Insert("public.tag_task").
+				Columns("id_tag", "id_task").
+				Select(sq.Select().Column("?::integer", *info.TagID).Column("id").From("public.task").Where(onTasks)).
+				Suffix("ON CONFLICT DO NOTHING"))
+		}
+	case dto.TaskBulkRemoveTag:
+		err = execBulk(ctx, tx, sq.Delete("public.tag_task").
+			Where(sq.Eq{"id_task": info.TaskIDs, "id_tag": *info.TagID}))
+	case dto.TaskBulkSetDue:
+		err = execBulk(ctx, tx, sq.Update("public.task").
+			Set("task_end", info.Due).
+			Set("row_version", sq.Expr("row_version + 1")).
+			Where(onTasks))
+	case dto.TaskBulkArchive:
+		err = execBulk(ctx, tx, sq.Update("public.task").
+			Set("date_archived", archivedAt(true)).
+			Set("row_version", sq.Expr("row_version + 1")).
+			Where(onTasks))
+	case dto.TaskBulkDelete:
+		err = execBulk(ctx, tx, sq.Delete("public.task").Where(onTasks))
+	default:
+		err = apperrors.ErrInvalidBulkOperation
+	}
+	if err != nil {
+		return rollback(err)
+	}
+	logger.DebugFmt("Operation "+info.Operation+" applied", requestID.String(), funcName, nodeName)
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotCommit
+	}
+	logger.DebugFmt("Commited changes", requestID.String(), funcName, nodeName)
+
+	return nil
+}
+
+// lockBulkTasks
+// блокирует задания массовой операции и проверяет, что все они существуют, лежат на одной доске
+// и пользователь состоит в ней; возвращает id доски
+func lockBulkTasks(ctx context.Context, tx executor, info dto.TaskBulkInfo) (uint64, error) {
+	funcName := "lockBulkTasks"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select("public.task.id", "public.list.id_board").
+		Column("EXISTS (SELECT 1 FROM public.board_user WHERE public.board_user.id_board = public.list.id_board "+
+			"AND public.board_user.id_user = ?)", info.RequesterID).
+		From("public.task").
+		Join("public.list ON public.list.id = public.task.id_list").
+		Where(sq.Eq{"public.task.id": info.TaskIDs}).
+		Suffix("FOR UPDATE OF public.task").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return 0, apperrors.ErrBulkOperationFailed
+	}
+	defer rows.Close()
+
+	var boardID uint64
+	found := 0
+	for rows.Next() {
+		var taskID, taskBoardID uint64
+		var access bool
+		if err = rows.Scan(&taskID, &taskBoardID, &access); err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return 0, apperrors.ErrCouldNotScanRows
+		}
+		if !access {
+			return 0, apperrors.ErrNoBoardAccess
+		}
+		if boardID != 0 && boardID != taskBoardID {
+			return 0, apperrors.ErrBulkTasksOnDifferentBoards
+		}
+		boardID = taskBoardID
+		found++
+	}
+	if found != len(info.TaskIDs) {
+		return 0, apperrors.ErrTaskNotFound
+	}
+	return boardID, nil
+}
+
+// moveBulkTasks
+// переносит задания в конец списка той же доски в порядке taskIDs, не превышая жёсткий лимит задач списка.
+// Задания, которые попадают в список из других, не должны быть заблокированы, если список этого требует
+func moveBulkTasks(ctx context.Context, tx executor, boardID uint64, listID uint64, taskIDs []uint64) error {
+	err := requireOnBoard(ctx, tx, sq.Select("1").From("public.list").
+		Where(sq.Eq{"id": listID, "id_board": boardID}), apperrors.ErrInvalidPosition)
+	if err != nil {
+		return err
+	}
+	if err = taskRankScope.lockParent(ctx, tx, listID); err != nil {
+		return err
+	}
+
+	query, args, err := sq.Select("id").
+		From("public.task").
+		Where(sq.Eq{"id": taskIDs}).
+		Where(sq.NotEq{"id_list": listID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	arriving, err := scanIDs(tx, query, args)
+	if err != nil {
+		return err
+	}
+
+	for _, taskID := range taskIDs {
+		last, err := taskRankScope.lastID(ctx, tx, listID, taskID)
+		if err != nil {
+			return err
+		}
+		key, err := taskRankScope.place(ctx, tx, listID, taskID, last)
+		if err != nil {
+			return err
+		}
+		err = execBulk(ctx, tx, sq.Update("public.task").
+			Set("id_list", listID).
+			Set("rank", key).
+			Set("date_completed", sq.Expr("CASE WHEN id_list = ? THEN date_completed ELSE ? END", listID, completedInList(listID))).
+			Where(sq.Eq{"id": taskID}))
+		if err != nil {
+			return err
+		}
+	}
+	if err = checkHardWipLimit(ctx, tx, listID); err != nil {
+		return err
+	}
+	if len(arriving) == 0 {
+		return nil
+	}
+	return checkBlockers(ctx, tx, listID, arriving...)
+}
+
+// requireOnBoard
+// проверяет, что запрос находит строку, или возвращает ошибку missing
+func requireOnBoard(ctx context.Context, tx executor, builder sq.SelectBuilder, missing error) error {
+	funcName := "requireOnBoard"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	var found int
+	err = tx.QueryRow(query, args...).Scan(&found)
+	if errors.Is(err, sql.ErrNoRows) {
+		return missing
+	}
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrBulkOperationFailed
+	}
+	return nil
+}
+
+// execBulk
+// выполняет изменяющий запрос массовой операции
+// или возвращает ошибки apperrors.ErrInvalidTaskSchedule, если срок оказался раньше начала задания, ...
+func execBulk(ctx context.Context, tx executor, builder sq.Sqlizer) error {
+	funcName := "execBulk"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := builder.ToSql()
+	if err == nil {
+		query, err = sq.Dollar.ReplacePlaceholders(query)
+	}
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	if _, err = tx.Exec(query, args...); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "task_schedule_order" {
+			return apperrors.ErrInvalidTaskSchedule
+		}
+		return apperrors.ErrBulkOperationFailed
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// expectBulkLock
+// ожидает блокировку заданий массовой операции; rows -- id задания, id доски и наличие доступа
+func expectBulkLock(mock sqlmock.Sqlmock, info dto.TaskBulkInfo, rows *sqlmock.Rows) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT public.task.id, public.list.id_board, EXISTS (SELECT 1 FROM public.board_user "+
+		"WHERE public.board_user.id_board = public.list.id_board AND public.board_user.id_user = $1) "+
+		"FROM public.task JOIN public.list ON public.list.id = public.task.id_list "+
+		"WHERE public.task.id IN ($2,$3) FOR UPDATE OF public.task")).
+		WithArgs(info.RequesterID, info.TaskIDs[0], info.TaskIDs[1]).
+		WillReturnRows(rows)
+}
+
+func TestPostgresTaskStorage_Bulk(t *testing.T) {
+	t.Parallel()
+	userID := uint64(4)
+	due := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	type args struct {
+		info  dto.TaskBulkInfo
+		query func(mock sqlmock.Sqlmock, args args)
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "Archive",
+			args: args{
+				info: dto.TaskBulkInfo{
+					TaskBulkRequest: dto.TaskBulkRequest{TaskIDs: []uint64{1, 2}, Operation: dto.TaskBulkArchive},
+					RequesterID:     3,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					expectBulkLock(mock, args.info, sqlmock.NewRows([]string{"id", "id_board", "exists"}).
+						AddRow(1, 7, true).
+						AddRow(2, 7, true))
+					query, _, _ := sq.Update("public.task").
+						Set("date_archived", archivedAt(true)).
+						Set("row_version", sq.Expr("row_version + 1")).
+						Where(sq.Eq{"id": args.info.TaskIDs}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectExec(regexp.QuoteMeta(query)).
+						WithArgs(uint64(1), uint64(2)).
+						WillReturnResult(sqlmock.NewResult(0, 2))
+					mock.ExpectCommit()
+				},
+			},
+		},
+		{
+			name: "Assign user from another board",
+			args: args{
+				info: dto.TaskBulkInfo{
+					TaskBulkRequest: dto.TaskBulkRequest{TaskIDs: []uint64{1, 2}, Operation: dto.TaskBulkAssign, UserID: &userID},
+					RequesterID:     3,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					expectBulkLock(mock, args.info, sqlmock.NewRows([]string{"id", "id_board", "exists"}).
+						AddRow(1, 7, true).
+						AddRow(2, 7, true))
+					mock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM public.board_user WHERE id_board = $1 AND id_user = $2")).
+						WithArgs(uint64(7), userID).
+						WillReturnRows(sqlmock.NewRows([]string{"1"}))
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrUserNotInBoard,
+		},
+		{
+			name: "No access to one of the tasks",
+			args: args{
+				info: dto.TaskBulkInfo{
+					TaskBulkRequest: dto.TaskBulkRequest{TaskIDs: []uint64{1, 2}, Operation: dto.TaskBulkDelete},
+					RequesterID:     3,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					expectBulkLock(mock, args.info, sqlmock.NewRows([]string{"id", "id_board", "exists"}).
+						AddRow(1, 7, true).
+						AddRow(2, 8, false))
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrNoBoardAccess,
+		},
+		{
+			name: "Tasks on different boards",
+			args: args{
+				info: dto.TaskBulkInfo{
+					TaskBulkRequest: dto.TaskBulkRequest{TaskIDs: []uint64{1, 2}, Operation: dto.TaskBulkDelete},
+					RequesterID:     3,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					expectBulkLock(mock, args.info, sqlmock.NewRows([]string{"id", "id_board", "exists"}).
+						AddRow(1, 7, true).
+						AddRow(2, 8, true))
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrBulkTasksOnDifferentBoards,
+		},
+		{
+			name: "Due before task start",
+			args: args{
+				info: dto.TaskBulkInfo{
+					TaskBulkRequest: dto.TaskBulkRequest{TaskIDs: []uint64{1, 2}, Operation: dto.TaskBulkSetDue, Due: &due},
+					RequesterID:     3,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					expectBulkLock(mock, args.info, sqlmock.NewRows([]string{"id", "id_board", "exists"}).
+						AddRow(1, 7, true).
+						AddRow(2, 7, true))
+					mock.ExpectExec(regexp.QuoteMeta("UPDATE public.task SET task_end = $1, row_version = row_version + 1")).
+						WithArgs(args.info.Due, uint64(1), uint64(2)).
+						WillReturnError(&pgconn.PgError{Code: "23514", ConstraintName: "task_schedule_order"})
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrInvalidTaskSchedule,
+		},
+		{
+			name: "Missing task",
+			args: args{
+				info: dto.TaskBulkInfo{
+					TaskBulkRequest: dto.TaskBulkRequest{TaskIDs: []uint64{1, 2}, Operation: dto.TaskBulkDelete},
+					RequesterID:     3,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					expectBulkLock(mock, args.info, sqlmock.NewRows([]string{"id", "id_board", "exists"}).
+						AddRow(1, 7, true))
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrTaskNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			tt.args.query(mock, tt.args)
+
+			s := NewTaskStorage(db)
+
+			err = s.Bulk(ctx, tt.args.info)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PostgresTaskStorage.Bulk() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !errors.Is(err, tt.err) {
+				t.Errorf("PostgresTaskStorage.Bulk() error = %v, want %v", err, tt.err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	"server/internal/pkg/dto"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// dependencyReach
+// задания, которые прямо или через другие задания блокирует задание из параметра запроса
+const dependencyReach = "WITH RECURSIVE reach(id) AS (" +
+	"SELECT id_blocked FROM public.task_dependency WHERE id_blocker = ? " +
+	"UNION SELECT public.task_dependency.id_blocked FROM public.task_dependency " +
+	"JOIN reach ON public.task_dependency.id_blocker = reach.id) "
+
+// AddDependency
+// сохраняет зависимость между заданиями одного рабочего пространства; повторная связь ничего не меняет.
+// Проверка цикла и вставка делаются под блокировкой таблицы зависимостей,
+// чтобы две встречные связи не замкнули цикл одновременно
+// или возвращает ошибки apperrors.ErrDependencyAcrossWorkspaces, apperrors.ErrDependencyCycle,
+// apperrors.ErrDependencyNotUpdated, ...
+func (s PostgresTaskStorage) AddDependency(ctx context.Context, info dto.TaskDependencyInfo) error {
+	funcName := "PostgresTaskStorage.AddDependency"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	workspaceQuery, workspaceArgs, err := sq.Select("count(DISTINCT public.board.id_workspace) = 1").
+		From("public.task").
+		Join("public.list ON public.list.id = public.task.id_list").
+		Join("public.board ON public.board.id = public.list.id_board").
+		Where(sq.Eq{"public.task.id": []uint64{info.BlockerID, info.BlockedID}}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+workspaceQuery+"\nwith args\n\t"+fmt.Sprintf("%+v", workspaceArgs), requestID.String(), funcName, nodeName)
+
+	cycleQuery, cycleArgs, err := sq.Select().
+		Column(sq.Expr("EXISTS(SELECT 1 FROM reach WHERE id = ?)", info.BlockerID)).
+		Prefix(dependencyReach, info.BlockedID).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+cycleQuery+"\nwith args\n\t"+fmt.Sprintf("%+v", cycleArgs), requestID.String(), funcName, nodeName)
+
+	insertQuery, insertArgs, err := sq.Insert("public.task_dependency").
+		Columns("id_blocker", "id_blocked").
+		Values(info.BlockerID, info.BlockedID).
+		Suffix("ON CONFLICT DO NOTHING").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+insertQuery+"\nwith args\n\t"+fmt.Sprintf("%+v", insertArgs), requestID.String(), funcName, nodeName)
+
+	var sameWorkspace bool
+	if err = conn(ctx, s.db).QueryRow(workspaceQuery, workspaceArgs...).Scan(&sameWorkspace); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrDependencyNotUpdated
+	}
+	if !sameWorkspace {
+		return apperrors.ErrDependencyAcrossWorkspaces
+	}
+
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotBeginTransaction
+	}
+
+	rollback := func(reason error) error {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotRollback
+		}
+		return reason
+	}
+
+	if _, err = tx.Exec("LOCK TABLE public.task_dependency IN SHARE ROW EXCLUSIVE MODE"); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrDependencyNotUpdated)
+	}
+
+	var cycle bool
+	if err = tx.QueryRow(cycleQuery, cycleArgs...).Scan(&cycle); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrDependencyNotUpdated)
+	}
+	if cycle {
+		return rollback(apperrors.ErrDependencyCycle)
+	}
+
+	if _, err = tx.Exec(insertQuery, insertArgs...); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrDependencyNotUpdated)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotCommit
+	}
+	logger.DebugFmt("Dependency saved", requestID.String(), funcName, nodeName)
+
+	return nil
+}
+
+// RemoveDependency
+// удаляет зависимость между заданиями
+// или возвращает ошибки apperrors.ErrDependencyNotFound, apperrors.ErrDependencyNotUpdated, ...
+func (s PostgresTaskStorage) RemoveDependency(ctx context.Context, info dto.TaskDependencyInfo) error {
+	funcName := "PostgresTaskStorage.RemoveDependency"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Delete("public.task_dependency").
+		Where(sq.Eq{"id_blocker": info.BlockerID, "id_blocked": info.BlockedID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	result, err := conn(ctx, s.db).Exec(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrDependencyNotUpdated
+	}
+	removed, err := result.RowsAffected()
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrDependencyNotUpdated
+	}
+	if removed == 0 {
+		return apperrors.ErrDependencyNotFound
+	}
+	logger.DebugFmt("Dependency removed", requestID.String(), funcName, nodeName)
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package postgresql
+
+import (
+	"context"
+	"regexp"
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresTaskStorage_AddDependency(t *testing.T) {
+	t.Parallel()
+
+	info := dto.TaskDependencyInfo{BlockerID: 1, BlockedID: 2}
+	workspaceQuery := "SELECT count(DISTINCT public.board.id_workspace) = 1 FROM public.task"
+	cycleQuery := "WITH RECURSIVE reach(id) AS ("
+	tests := []struct {
+		name  string
+		query func(mock sqlmock.Sqlmock)
+		err   error
+	}{
+		{
+			name: "Happy path",
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(workspaceQuery)).
+					WithArgs(info.BlockerID, info.BlockedID).
+					WillReturnRows(sqlmock.NewRows([]string{"same"}).AddRow(true))
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("LOCK TABLE public.task_dependency")).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(regexp.QuoteMeta(cycleQuery)).
+					WithArgs(info.BlockedID, info.BlockerID).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.task_dependency (id_blocker,id_blocked) VALUES ($1,$2) ON CONFLICT DO NOTHING")).
+					WithArgs(info.BlockerID, info.BlockedID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Tasks in different workspaces",
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(workspaceQuery)).
+					WithArgs(info.BlockerID, info.BlockedID).
+					WillReturnRows(sqlmock.NewRows([]string{"same"}).AddRow(false))
+			},
+			err: apperrors.ErrDependencyAcrossWorkspaces,
+		},
+		{
+			name: "Dependency closes a cycle",
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(workspaceQuery)).
+					WithArgs(info.BlockerID, info.BlockedID).
+					WillReturnRows(sqlmock.NewRows([]string{"same"}).AddRow(true))
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("LOCK TABLE public.task_dependency")).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(regexp.QuoteMeta(cycleQuery)).
+					WithArgs(info.BlockedID, info.BlockerID).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+				mock.ExpectRollback()
+			},
+			err: apperrors.ErrDependencyCycle,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			tt.query(mock)
+
+			s := NewTaskStorage(db)
+
+			require.Equal(t, tt.err, s.AddDependency(ctx, info))
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPostgresTaskStorage_RemoveDependency(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		removed int64
+		err     error
+	}{
+		{
+			name:    "Happy path",
+			removed: 1,
+		},
+		{
+			name:    "No such dependency",
+			removed: 0,
+			err:     apperrors.ErrDependencyNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			mock.ExpectExec(regexp.QuoteMeta("DELETE FROM public.task_dependency WHERE id_blocked = $1 AND id_blocker = $2")).
+				WithArgs(uint64(2), uint64(1)).
+				WillReturnResult(sqlmock.NewResult(0, tt.removed))
+
+			s := NewTaskStorage(db)
+
+			require.Equal(t, tt.err, s.RemoveDependency(ctx, dto.TaskDependencyInfo{BlockerID: 1, BlockedID: 2}))
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
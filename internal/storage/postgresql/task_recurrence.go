@@ -0,0 +1,324 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	"server/internal/pkg/dto"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// recurrenceCopyParts
+// части текущего задания серии, которые переходят в следующее; сроки задаются по правилу
+var recurrenceCopyParts = dto.TaskCopyParts{
+	Description: true,
+	Checklists:  true,
+	Tags:        true,
+	Assignees:   true,
+}
+
+// SetRecurrence
+// задаёт заданию серию повторений от имени пользователя info.UserID. Если задание уже относится к серии,
+// у неё меняются правило, часовой пояс и пользователь, и остановленная серия возобновляется; список и срок серии меняются, только если задание -- текущее в серии.
+// Возвращает прежнее правило действующей серии или nil, если её не было
+// или возвращает ошибки apperrors.ErrTaskNotFound, apperrors.ErrRecurrenceNotUpdated, ...
+func (s PostgresTaskStorage) SetRecurrence(ctx context.Context, info dto.TaskRecurrenceInfo) (*string, error) {
+	funcName := "PostgresTaskStorage.SetRecurrence"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBeginTransaction
+	}
+
+	rollback := func(reason error) (*string, error) {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotRollback
+		}
+		return nil, reason
+	}
+
+	query, args, err := sq.Select("id_recurrence", activeRecurrenceRule).
+		From("public.task").
+		Where(sq.Eq{"id": info.TaskID}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return rollback(apperrors.ErrCouldNotBuildQuery)
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	var recurrenceID *uint64
+	var previous *string
+	err = tx.QueryRow(query, args...).Scan(&recurrenceID, &previous)
+	if errors.Is(err, sql.ErrNoRows) {
+		return rollback(apperrors.ErrTaskNotFound)
+	}
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrRecurrenceNotUpdated)
+	}
+
+	var builder sq.UpdateBuilder
+	if recurrenceID != nil {
+		builder = sq.Update("public.task_recurrence").
+			Set("rule", info.Rule).
+			Set("timezone", info.Timezone).
+			Set("id_user", info.UserID).
+			Set("date_stopped", nil).
+			Set("id_list", sq.Expr("CASE WHEN id_task = ? THEN ? ELSE id_list END", info.TaskID, info.ListID)).
+			Set("due_at", sq.Expr("CASE WHEN id_task = ? THEN ? ELSE due_at END", info.TaskID, info.DueAt)).
+			Where(sq.Eq{"id": *recurrenceID})
+	} else {
+		query, args, err := sq.Insert("public.task_recurrence").
+			Columns("id_task", "id_list", "id_user", "rule", "timezone", "due_at").
+			Values(info.TaskID, info.ListID, info.UserID, info.Rule, info.Timezone, info.DueAt).
+			Suffix("RETURNING id").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return rollback(apperrors.ErrCouldNotBuildQuery)
+		}
+		logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+		var createdID uint64
+		if err = tx.QueryRow(query, args...).Scan(&createdID); err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return rollback(apperrors.ErrRecurrenceNotUpdated)
+		}
+		builder = sq.Update("public.task").
+			Set("id_recurrence", createdID).
+			Where(sq.Eq{"id": info.TaskID})
+	}
+
+	query, args, err = builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return rollback(apperrors.ErrCouldNotBuildQuery)
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	if _, err = tx.Exec(query, args...); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrRecurrenceNotUpdated)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotCommit
+	}
+	logger.DebugFmt("Recurrence saved", requestID.String(), funcName, nodeName)
+
+	return previous, nil
+}
+
+// StopRecurrence
+// останавливает действующую серию повторений, к которой относится задание, и возвращает её правило; созданные задания остаются
+// или возвращает ошибки apperrors.ErrRecurrenceNotFound, apperrors.ErrRecurrenceNotUpdated, ...
+func (s PostgresTaskStorage) StopRecurrence(ctx context.Context, id dto.TaskID) (string, error) {
+	funcName := "PostgresTaskStorage.StopRecurrence"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Update("public.task_recurrence").
+		Set("date_stopped", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Expr("id = (SELECT id_recurrence FROM public.task WHERE id = ?)", id.Value)).
+		Where(sq.Eq{"date_stopped": nil}).
+		Suffix("RETURNING rule").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return "", apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	var rule string
+	err = conn(ctx, s.db).QueryRow(query, args...).Scan(&rule)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", apperrors.ErrRecurrenceNotFound
+	}
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return "", apperrors.ErrRecurrenceNotUpdated
+	}
+	logger.DebugFmt("Recurrence stopped", requestID.String(), funcName, nodeName)
+
+	return rule, nil
+}
+
+// ReadDueRecurrences
+// возвращает действующие серии, текущее задание которых выполнено или срок которого по правилу наступил
+// или возвращает ошибки apperrors.ErrCouldNotGetTask, ...
+func (s PostgresTaskStorage) ReadDueRecurrences(ctx context.Context) (*[]dto.DueRecurrence, error) {
+	funcName := "PostgresTaskStorage.ReadDueRecurrences"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select(dueRecurrenceFields...).
+		From("public.task_recurrence").
+		Join("public.task ON public.task.id = public.task_recurrence.id_task").
+		Where(sq.Eq{"public.task_recurrence.date_stopped": nil}).
+		Where(sq.Or{
+			sq.NotEq{"public.task.date_completed": nil},
+			sq.Expr("public.task_recurrence.due_at <= CURRENT_TIMESTAMP"),
+		}).
+		OrderBy("public.task_recurrence.id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	rows, err := conn(ctx, s.db).Query(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotGetTask
+	}
+	defer rows.Close()
+
+	recurrences := []dto.DueRecurrence{}
+	for rows.Next() {
+		var recurrence dto.DueRecurrence
+		err = rows.Scan(
+			&recurrence.ID,
+			&recurrence.TaskID,
+			&recurrence.UserID,
+			&recurrence.Rule,
+			&recurrence.Timezone,
+			&recurrence.DueAt,
+			&recurrence.TaskStart,
+			&recurrence.TaskEnd,
+			&recurrence.AllDay,
+		)
+		if err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotScanRows
+		}
+		recurrences = append(recurrences, recurrence)
+	}
+	logger.DebugFmt(fmt.Sprintf("Got %d due recurrences", len(recurrences)), requestID.String(), funcName, nodeName)
+
+	return &recurrences, nil
+}
+
+// GenerateRecurrence
+// создаёт следующее задание серии в конце её списка и делает его текущим. Задание копирует описание,
+// чеклисты с неотмеченными элементами, тэги, исполнителей, напоминания и значения пользовательских полей текущего задания.
+// Список серии -- список текущего задания, если он не завершающий. Если серию уже продвинул другой проход,
+// ничего не создаётся и возвращается 0. Задание не создаётся, если список заполнен до жёсткого лимита
+// или возвращает ошибки apperrors.ErrRecurrenceNotGenerated, apperrors.ErrWipLimitExceeded, ...
+func (s PostgresTaskStorage) GenerateRecurrence(ctx context.Context, info dto.RecurrenceInstanceInfo) (uint64, error) {
+	funcName := "PostgresTaskStorage.GenerateRecurrence"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return 0, apperrors.ErrCouldNotBeginTransaction
+	}
+
+	rollback := func(reason error) (uint64, error) {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return 0, apperrors.ErrCouldNotRollback
+		}
+		return 0, reason
+	}
+
+	query, args, err := sq.Update("public.task_recurrence").
+		Set("due_at", info.NextDueAt).
+		Set("id_list", sq.Expr("COALESCE((SELECT public.list.id FROM public.task "+
+			"JOIN public.list ON public.list.id = public.task.id_list "+
+			"WHERE public.task.id = public.task_recurrence.id_task AND NOT public.list.is_done), id_list)")).
+		Where(sq.Eq{
+			"id":           info.RecurrenceID,
+			"id_task":      info.TaskID,
+			"due_at":       info.DueAt,
+			"date_stopped": nil,
+		}).
+		Suffix("RETURNING id_list").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return rollback(apperrors.ErrCouldNotBuildQuery)
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	var listID uint64
+	err = tx.QueryRow(query, args...).Scan(&listID)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.DebugFmt("Recurrence was advanced by another pass", requestID.String(), funcName, nodeName)
+		return rollback(nil)
+	}
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrRecurrenceNotGenerated)
+	}
+
+	key, err := taskRankScope.appendKey(ctx, tx, listID)
+	if err != nil {
+		return rollback(err)
+	}
+	nextID, err := copyTask(ctx, tx, info.TaskID, listID, key, recurrenceCopyParts)
+	if err != nil {
+		return rollback(err)
+	}
+	if err = checkHardWipLimit(ctx, tx, listID); err != nil {
+		return rollback(err)
+	}
+
+	builders := []sq.Sqlizer{
+		sq.Update("public.checklist_item").
+			Set("done", false).
+			Where(sq.Expr("id_checklist IN (SELECT id FROM public.checklist WHERE id_task = ?)", nextID)),
+		sq.Insert("public.task_reminder").
+			Columns("id_task", "offset_minutes").
+			Select(sq.Select().
+				Column(sq.Expr("?", nextID)).
+				Column("offset_minutes").
+				From("public.task_reminder").
+				Where(sq.Eq{"id_task": info.TaskID})),
+		sq.Update("public.task").
+			Set("task_start", info.NextStart).
+			Set("task_end", info.NextDueAt).
+			Set("all_day", sq.Expr("(SELECT current.all_day FROM public.task AS current WHERE current.id = ?)", info.TaskID)).
+			Set("id_recurrence", info.RecurrenceID).
+			Where(sq.Eq{"id": nextID}),
+		sq.Update("public.task_recurrence").
+			Set("id_task", nextID).
+			Where(sq.Eq{"id": info.RecurrenceID}),
+	}
+	for _, builder := range builders {
+		query, args, err := builder.ToSql()
+		if err == nil {
+			query, err = sq.Dollar.ReplacePlaceholders(query)
+		}
+		if err != nil {
+			return rollback(apperrors.ErrCouldNotBuildQuery)
+		}
+		logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+		if _, err = tx.Exec(query, args...); err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return rollback(apperrors.ErrRecurrenceNotGenerated)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return 0, apperrors.ErrCouldNotCommit
+	}
+	logger.DebugFmt(fmt.Sprintf("Generated task %d of recurrence %d", nextID, info.RecurrenceID), requestID.String(), funcName, nodeName)
+
+	return nextID, nil
+}
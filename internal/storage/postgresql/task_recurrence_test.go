@@ -0,0 +1,240 @@
+package postgresql
+
+import (
+	"context"
+	"regexp"
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresTaskStorage_SetRecurrence(t *testing.T) {
+	t.Parallel()
+
+	due := time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+	info := dto.TaskRecurrenceInfo{TaskID: 1, ListID: 2, UserID: 5, Rule: "FREQ=DAILY;INTERVAL=1", Timezone: "UTC", DueAt: due}
+	selectQuery := "SELECT id_recurrence, " + activeRecurrenceRule + " FROM public.task WHERE id = $1 FOR UPDATE"
+	previous := "FREQ=WEEKLY;INTERVAL=1;BYDAY=MO"
+	tests := []struct {
+		name  string
+		query func(mock sqlmock.Sqlmock)
+		want  *string
+		err   error
+	}{
+		{
+			name: "New series",
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).
+					WithArgs(info.TaskID).
+					WillReturnRows(sqlmock.NewRows([]string{"id_recurrence", "rule"}).AddRow(nil, nil))
+				mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO public.task_recurrence (id_task,id_list,id_user,rule,timezone,due_at) VALUES ($1,$2,$3,$4,$5,$6) RETURNING id")).
+					WithArgs(info.TaskID, info.ListID, info.UserID, info.Rule, info.Timezone, info.DueAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE public.task SET id_recurrence = $1 WHERE id = $2")).
+					WithArgs(7, info.TaskID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Existing series",
+			query: func(mock sqlmock.Sqlmock) {
+				query, _, _ := sq.Update("public.task_recurrence").
+					Set("rule", info.Rule).
+					Set("timezone", info.Timezone).
+					Set("id_user", info.UserID).
+					Set("date_stopped", nil).
+					Set("id_list", sq.Expr("CASE WHEN id_task = ? THEN ? ELSE id_list END", info.TaskID, info.ListID)).
+					Set("due_at", sq.Expr("CASE WHEN id_task = ? THEN ? ELSE due_at END", info.TaskID, info.DueAt)).
+					Where(sq.Eq{"id": 7}).
+					PlaceholderFormat(sq.Dollar).
+					ToSql()
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).
+					WithArgs(info.TaskID).
+					WillReturnRows(sqlmock.NewRows([]string{"id_recurrence", "rule"}).AddRow(7, previous))
+				mock.ExpectExec(regexp.QuoteMeta(query)).
+					WithArgs(info.Rule, info.Timezone, info.UserID, nil, info.TaskID, info.ListID, info.TaskID, info.DueAt, 7).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			want: &previous,
+		},
+		{
+			name: "Task not found",
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).
+					WithArgs(info.TaskID).
+					WillReturnRows(sqlmock.NewRows([]string{"id_recurrence", "rule"}))
+				mock.ExpectRollback()
+			},
+			err: apperrors.ErrTaskNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			tt.query(mock)
+
+			s := NewTaskStorage(db)
+
+			got, err := s.SetRecurrence(ctx, info)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPostgresTaskStorage_StopRecurrence(t *testing.T) {
+	t.Parallel()
+
+	stopQuery := "UPDATE public.task_recurrence SET date_stopped = CURRENT_TIMESTAMP " +
+		"WHERE id = (SELECT id_recurrence FROM public.task WHERE id = $1) AND date_stopped IS NULL RETURNING rule"
+	tests := []struct {
+		name string
+		rows *sqlmock.Rows
+		want string
+		err  error
+	}{
+		{
+			name: "Happy path",
+			rows: sqlmock.NewRows([]string{"rule"}).AddRow("FREQ=DAILY;INTERVAL=1"),
+			want: "FREQ=DAILY;INTERVAL=1",
+		},
+		{
+			name: "No active series",
+			rows: sqlmock.NewRows([]string{"rule"}),
+			err:  apperrors.ErrRecurrenceNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			mock.ExpectQuery(regexp.QuoteMeta(stopQuery)).
+				WithArgs(uint64(1)).
+				WillReturnRows(tt.rows)
+
+			s := NewTaskStorage(db)
+
+			got, err := s.StopRecurrence(ctx, dto.TaskID{Value: 1})
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPostgresTaskStorage_GenerateRecurrence(t *testing.T) {
+	t.Parallel()
+
+	due := time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+	info := dto.RecurrenceInstanceInfo{RecurrenceID: 7, TaskID: 1, DueAt: due, NextDueAt: due.AddDate(0, 0, 7)}
+	advanceQuery := "UPDATE public.task_recurrence SET due_at = $1"
+	tests := []struct {
+		name  string
+		query func(mock sqlmock.Sqlmock)
+		want  uint64
+		err   error
+	}{
+		{
+			name: "Advanced by another pass",
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(advanceQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id_list"}))
+				mock.ExpectRollback()
+			},
+			want: 0,
+		},
+		{
+			name: "Advance fail",
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(advanceQuery)).
+					WillReturnError(apperrors.ErrCouldNotExecuteQuery)
+				mock.ExpectRollback()
+			},
+			err: apperrors.ErrRecurrenceNotGenerated,
+		},
+		{
+			name: "List of the series is gone",
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(advanceQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id_list"}).AddRow(2))
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM public.list WHERE id = $1 FOR UPDATE")).
+					WithArgs(uint64(2)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectRollback()
+			},
+			err: apperrors.ErrInvalidPosition,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			tt.query(mock)
+
+			s := NewTaskStorage(db)
+
+			got, err := s.GenerateRecurrence(ctx, info)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
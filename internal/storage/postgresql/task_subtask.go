@@ -0,0 +1,184 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	"server/internal/pkg/dto"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// subtaskLockKey
+// ключ транзакционной advisory-блокировки привязки подзадач: проверка цикла и привязка
+// делаются под ней, чтобы встречные привязки не замкнули цикл одновременно
+const subtaskLockKey = 7461737
+
+// taskAncestors
+// родитель задания из параметра запроса и все его предки
+const taskAncestors = "WITH RECURSIVE ancestors(id) AS (" +
+	"SELECT id_parent FROM public.task WHERE id = ? " +
+	"UNION SELECT public.task.id_parent FROM public.task JOIN ancestors ON public.task.id = ancestors.id) "
+
+// taskSubtree
+// подзадачи задания из параметра запроса на всех уровнях вложенности
+const taskSubtree = "WITH RECURSIVE subtree(id) AS (" +
+	"SELECT id FROM public.task WHERE id_parent = ? " +
+	"UNION SELECT public.task.id FROM public.task JOIN subtree ON public.task.id_parent = subtree.id) "
+
+// AttachSubtask
+// делает задание info.TaskID подзадачей задания info.ParentID; прежний родитель подзадачи заменяется
+// или возвращает ошибки apperrors.ErrSubtaskCycle, apperrors.ErrTaskNotFound, apperrors.ErrSubtaskNotUpdated, ...
+func (s PostgresTaskStorage) AttachSubtask(ctx context.Context, info dto.SubtaskInfo) error {
+	funcName := "PostgresTaskStorage.AttachSubtask"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	cycleQuery, cycleArgs, err := sq.Select().
+		Column(sq.Expr("EXISTS(SELECT 1 FROM ancestors WHERE id = ?)", info.TaskID)).
+		Prefix(taskAncestors, info.ParentID).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+cycleQuery+"\nwith args\n\t"+fmt.Sprintf("%+v", cycleArgs), requestID.String(), funcName, nodeName)
+
+	query, args, err := sq.Update("public.task").
+		Set("id_parent", info.ParentID).
+		Where(sq.Eq{"id": info.TaskID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotBeginTransaction
+	}
+
+	rollback := func(reason error) error {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotRollback
+		}
+		return reason
+	}
+
+	if _, err = tx.Exec("SELECT pg_advisory_xact_lock($1)", subtaskLockKey); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrSubtaskNotUpdated)
+	}
+
+	var cycle bool
+	if err = tx.QueryRow(cycleQuery, cycleArgs...).Scan(&cycle); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrSubtaskNotUpdated)
+	}
+	if cycle {
+		return rollback(apperrors.ErrSubtaskCycle)
+	}
+
+	result, err := tx.Exec(query, args...)
+	var attached int64
+	if err == nil {
+		attached, err = result.RowsAffected()
+	}
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return rollback(apperrors.ErrSubtaskNotUpdated)
+	}
+	if attached == 0 {
+		return rollback(apperrors.ErrTaskNotFound)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotCommit
+	}
+	logger.DebugFmt("Subtask attached", requestID.String(), funcName, nodeName)
+
+	return nil
+}
+
+// DetachSubtask
+// отвязывает подзадачу от родительского задания; сама подзадача остаётся на месте
+// или возвращает ошибки apperrors.ErrNotSubtask, apperrors.ErrSubtaskNotUpdated, ...
+func (s PostgresTaskStorage) DetachSubtask(ctx context.Context, id dto.TaskID) error {
+	funcName := "PostgresTaskStorage.DetachSubtask"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Update("public.task").
+		Set("id_parent", nil).
+		Where(sq.Eq{"id": id.Value}).
+		Where(sq.NotEq{"id_parent": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	result, err := conn(ctx, s.db).Exec(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrSubtaskNotUpdated
+	}
+	detached, err := result.RowsAffected()
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrSubtaskNotUpdated
+	}
+	if detached == 0 {
+		return apperrors.ErrNotSubtask
+	}
+	logger.DebugFmt("Subtask detached", requestID.String(), funcName, nodeName)
+
+	return nil
+}
+
+// ReadSubtasks
+// возвращает id подзадач задания на всех уровнях вложенности
+// или возвращает ошибки apperrors.ErrCouldNotGetTask, ...
+func (s PostgresTaskStorage) ReadSubtasks(ctx context.Context, id dto.TaskID) ([]uint64, error) {
+	funcName := "PostgresTaskStorage.ReadSubtasks"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select("id").
+		From("subtree").
+		Prefix(taskSubtree, id.Value).
+		OrderBy("id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	rows, err := conn(ctx, s.db).Query(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotGetTask
+	}
+	defer rows.Close()
+
+	subtasks := []uint64{}
+	for rows.Next() {
+		var subtaskID uint64
+		if err = rows.Scan(&subtaskID); err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotScanRows
+		}
+		subtasks = append(subtasks, subtaskID)
+	}
+	logger.DebugFmt(fmt.Sprintf("Got %d subtasks", len(subtasks)), requestID.String(), funcName, nodeName)
+
+	return subtasks, nil
+}
@@ -0,0 +1,176 @@
+package postgresql
+
+import (
+	"context"
+	"regexp"
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresTaskStorage_AttachSubtask(t *testing.T) {
+	t.Parallel()
+
+	info := dto.SubtaskInfo{ParentID: 1, TaskID: 2}
+	lockQuery := "SELECT pg_advisory_xact_lock($1)"
+	cycleQuery := "WITH RECURSIVE ancestors(id) AS ("
+	updateQuery := "UPDATE public.task SET id_parent = $1 WHERE id = $2"
+	tests := []struct {
+		name  string
+		query func(mock sqlmock.Sqlmock)
+		err   error
+	}{
+		{
+			name: "Happy path",
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(lockQuery)).
+					WithArgs(subtaskLockKey).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(regexp.QuoteMeta(cycleQuery)).
+					WithArgs(info.ParentID, info.TaskID).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+				mock.ExpectExec(regexp.QuoteMeta(updateQuery)).
+					WithArgs(info.ParentID, info.TaskID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Parent is a subtask of the task",
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(lockQuery)).
+					WithArgs(subtaskLockKey).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(regexp.QuoteMeta(cycleQuery)).
+					WithArgs(info.ParentID, info.TaskID).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+				mock.ExpectRollback()
+			},
+			err: apperrors.ErrSubtaskCycle,
+		},
+		{
+			name: "Task not found",
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(lockQuery)).
+					WithArgs(subtaskLockKey).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(regexp.QuoteMeta(cycleQuery)).
+					WithArgs(info.ParentID, info.TaskID).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+				mock.ExpectExec(regexp.QuoteMeta(updateQuery)).
+					WithArgs(info.ParentID, info.TaskID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			err: apperrors.ErrTaskNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			tt.query(mock)
+
+			s := NewTaskStorage(db)
+
+			require.Equal(t, tt.err, s.AttachSubtask(ctx, info))
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPostgresTaskStorage_DetachSubtask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		detached int64
+		err      error
+	}{
+		{
+			name:     "Happy path",
+			detached: 1,
+		},
+		{
+			name:     "Task has no parent",
+			detached: 0,
+			err:      apperrors.ErrNotSubtask,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE public.task SET id_parent = $1 WHERE id = $2 AND id_parent IS NOT NULL")).
+				WithArgs(nil, uint64(2)).
+				WillReturnResult(sqlmock.NewResult(0, tt.detached))
+
+			s := NewTaskStorage(db)
+
+			require.Equal(t, tt.err, s.DetachSubtask(ctx, dto.TaskID{Value: 2}))
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPostgresTaskStorage_ReadSubtasks(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	ctx := context.WithValue(
+		context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+		dto.RequestIDKey, uuid.New(),
+	)
+
+	mock.ExpectQuery(regexp.QuoteMeta("WITH RECURSIVE subtree(id) AS (")).
+		WithArgs(uint64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2).AddRow(5))
+
+	got, err := NewTaskStorage(db).ReadSubtasks(ctx, dto.TaskID{Value: 1})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 5}, got)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -2,6 +2,8 @@ package postgresql
 
 import (
 	"context"
+	"errors"
+	"reflect"
 	"regexp"
 	"server/internal/apperrors"
 	"server/internal/pkg/dto"
@@ -35,10 +37,13 @@ func TestPostgresTaskStorage_Create(t *testing.T) {
 					ListPosition: 0,
 				},
 				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					expectRankAppend(mock, taskRankScope, args.info.ListID, 2, "i")
+
 					query, _, _ := sq.
 						Insert("public.task").
 						Columns(newTaskFields...).
-						Values(args.info.ListID, args.info.Name, args.info.ListPosition).
+						Values(args.info.ListID, args.info.Name, args.info.ListPosition, completedInList(args.info.ListID), "r", args.info.ParentID).
 						PlaceholderFormat(sq.Dollar).
 						Suffix("RETURNING id, date_created").
 						ToSql()
@@ -47,14 +52,56 @@ func TestPostgresTaskStorage_Create(t *testing.T) {
 							args.info.ListID,
 							args.info.Name,
 							args.info.ListPosition,
+							args.info.ListID,
+							"r",
+							args.info.ParentID,
 						).
 						WillReturnRows(sqlmock.NewRows([]string{"id", "date_created"}).
 							AddRow(1, time.Now()))
+					expectHardWipLimit(mock, args.info.ListID, false)
+					mock.ExpectCommit()
 				},
 			},
 			wantErr: false,
 			err:     nil,
 		},
+		{
+			name: "Hard wip limit exceeded",
+			args: args{
+				info: &dto.NewTaskInfo{
+					ListID:       1,
+					Name:         "sdfgsdfgsdfgsdfgsdfgsdf",
+					ListPosition: 0,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					expectRankAppend(mock, taskRankScope, args.info.ListID, 2, "i")
+
+					query, _, _ := sq.
+						Insert("public.task").
+						Columns(newTaskFields...).
+						Values(args.info.ListID, args.info.Name, args.info.ListPosition, completedInList(args.info.ListID), "r", args.info.ParentID).
+						PlaceholderFormat(sq.Dollar).
+						Suffix("RETURNING id, date_created").
+						ToSql()
+					mock.ExpectQuery(regexp.QuoteMeta(query)).
+						WithArgs(
+							args.info.ListID,
+							args.info.Name,
+							args.info.ListPosition,
+							args.info.ListID,
+							"r",
+							args.info.ParentID,
+						).
+						WillReturnRows(sqlmock.NewRows([]string{"id", "date_created"}).
+							AddRow(1, time.Now()))
+					expectHardWipLimit(mock, args.info.ListID, true)
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrWipLimitExceeded,
+		},
 		{
 			name: "Query fail",
 			args: args{
@@ -64,10 +111,13 @@ func TestPostgresTaskStorage_Create(t *testing.T) {
 					ListPosition: 0,
 				},
 				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					expectRankAppend(mock, taskRankScope, args.info.ListID, 2, "i")
+
 					query, _, _ := sq.
 						Insert("public.task").
 						Columns(newTaskFields...).
-						Values(args.info.ListID, args.info.Name, args.info.ListPosition).
+						Values(args.info.ListID, args.info.Name, args.info.ListPosition, completedInList(args.info.ListID), "r", args.info.ParentID).
 						PlaceholderFormat(sq.Dollar).
 						Suffix("RETURNING id, date_created").
 						ToSql()
@@ -76,8 +126,12 @@ func TestPostgresTaskStorage_Create(t *testing.T) {
 							args.info.ListID,
 							args.info.Name,
 							args.info.ListPosition,
+							args.info.ListID,
+							"r",
+							args.info.ParentID,
 						).
 						WillReturnError(apperrors.ErrTaskNotCreated)
+					mock.ExpectRollback()
 				},
 			},
 			wantErr: true,
@@ -114,6 +168,172 @@ func TestPostgresTaskStorage_Create(t *testing.T) {
 	}
 }
 
+func TestPostgresTaskStorage_Move(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		info  dto.TaskMoveInfo
+		query func(mock sqlmock.Sqlmock, args args)
+	}
+	afterID := uint64(4)
+	listQuery, _, _ := sq.Select("current.id").
+		From("public.list AS target").
+		Join("public.list AS current ON current.id_board = target.id_board").
+		Join("public.task ON public.task.id_list = current.id").
+		Where(sq.Eq{"public.task.id": uint64(1), "target.id": uint64(2)}).
+		Suffix("FOR UPDATE OF target").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "Within the same list",
+			args: args{
+				info: dto.TaskMoveInfo{
+					TaskID:  1,
+					ListID:  2,
+					AfterID: &afterID,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta(listQuery)).
+						WithArgs(args.info.TaskID, args.info.ListID).
+						WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(args.info.ListID))
+
+					expectRankPlace(mock, taskRankScope, args.info.ListID, args.info.TaskID, afterID, "a", "c")
+
+					query, _, _ := sq.Update("public.task").
+						Set("rank", "b").
+						Where(sq.Eq{"id": args.info.TaskID}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectExec(regexp.QuoteMeta(query)).
+						WithArgs("b", args.info.TaskID).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				},
+			},
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "To another list",
+			args: args{
+				info: dto.TaskMoveInfo{
+					TaskID: 1,
+					ListID: 2,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta(listQuery)).
+						WithArgs(args.info.TaskID, args.info.ListID).
+						WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+
+					expectRankPlace(mock, taskRankScope, args.info.ListID, args.info.TaskID, 0, "", "")
+
+					query, _, _ := sq.Update("public.task").
+						Set("rank", "i").
+						Set("id_list", args.info.ListID).
+						Set("date_completed", completedInList(args.info.ListID)).
+						Where(sq.Eq{"id": args.info.TaskID}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectExec(regexp.QuoteMeta(query)).
+						WithArgs("i", args.info.ListID, args.info.ListID, args.info.TaskID).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					expectHardWipLimit(mock, args.info.ListID, false)
+					expectBlockers(mock, args.info.ListID, false, args.info.TaskID)
+					mock.ExpectCommit()
+				},
+			},
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "List on another board",
+			args: args{
+				info: dto.TaskMoveInfo{
+					TaskID: 1,
+					ListID: 2,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta(listQuery)).
+						WithArgs(args.info.TaskID, args.info.ListID).
+						WillReturnRows(sqlmock.NewRows([]string{"id"}))
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrInvalidPosition,
+		},
+		{
+			name: "Executing query failed",
+			args: args{
+				info: dto.TaskMoveInfo{
+					TaskID: 1,
+					ListID: 2,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta(listQuery)).
+						WithArgs(args.info.TaskID, args.info.ListID).
+						WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(args.info.ListID))
+
+					expectRankPlace(mock, taskRankScope, args.info.ListID, args.info.TaskID, 0, "", "i")
+
+					query, _, _ := sq.Update("public.task").
+						Set("rank", "9").
+						Where(sq.Eq{"id": args.info.TaskID}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectExec(regexp.QuoteMeta(query)).
+						WithArgs("9", args.info.TaskID).
+						WillReturnError(apperrors.ErrCouldNotExecuteQuery)
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrCouldNotChangeTaskOrder,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			tt.args.query(mock, tt.args)
+
+			s := NewTaskStorage(db)
+
+			err = s.Move(ctx, tt.args.info)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PostgresTaskStorage.Move() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, tt.err) {
+				t.Errorf("PostgresTaskStorage.Move() error = %v, want %v", err, tt.err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
 func TestPostgresTaskStorage_CheckAccess(t *testing.T) {
 	t.Parallel()
 	type args struct {
@@ -331,6 +551,7 @@ func TestPostgresTaskStorage_AddUser(t *testing.T) {
 				query: func(mock sqlmock.Sqlmock, args args) {
 					query, _, _ := sq.
 						Insert("public.task_user").
+						Prefix("WITH watched AS ("+watchTaskOnAssign+")", args.info.TaskID, args.info.UserID).
 						Columns("id_task", "id_user").
 						Values(args.info.TaskID, args.info.UserID).
 						PlaceholderFormat(sq.Dollar).
@@ -339,6 +560,8 @@ func TestPostgresTaskStorage_AddUser(t *testing.T) {
 						WithArgs(
 							args.info.TaskID,
 							args.info.UserID,
+							args.info.TaskID,
+							args.info.UserID,
 						).
 						WillReturnResult(sqlmock.NewResult(1, 1))
 				},
@@ -356,6 +579,7 @@ func TestPostgresTaskStorage_AddUser(t *testing.T) {
 				query: func(mock sqlmock.Sqlmock, args args) {
 					query, _, _ := sq.
 						Insert("public.task_user").
+						Prefix("WITH watched AS ("+watchTaskOnAssign+")", args.info.TaskID, args.info.UserID).
 						Columns("id_task", "id_user").
 						Values(args.info.TaskID, args.info.UserID).
 						PlaceholderFormat(sq.Dollar).
@@ -364,6 +588,8 @@ func TestPostgresTaskStorage_AddUser(t *testing.T) {
 						WithArgs(
 							args.info.TaskID,
 							args.info.UserID,
+							args.info.TaskID,
+							args.info.UserID,
 						).
 						WillReturnError(apperrors.ErrCouldNotAddTaskUser)
 				},
@@ -523,7 +749,7 @@ func TestPostgresTaskStorage_Read(t *testing.T) {
 							args.id.Value,
 						).
 						WillReturnRows(sqlmock.NewRows(allTaskFields2).
-							AddRow(1, 1, time.Now(), "ame", "dsd", 1, time.Now(), time.Now(), pq.StringArray([]string{}), pq.StringArray([]string{}), pq.StringArray([]string{})))
+							AddRow(1, 1, time.Now(), "ame", "dsd", 1, time.Now(), time.Now(), false, pq.StringArray([]string{}), pq.StringArray([]string{}), pq.StringArray([]string{})))
 				},
 			},
 			wantErr: false,
@@ -589,8 +815,9 @@ func TestPostgresTaskStorage_Read(t *testing.T) {
 
 func TestPostgresTaskStorage_ReadMany(t *testing.T) {
 	t.Parallel()
+	dueTo := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
 	type args struct {
-		id    *dto.TaskIDs
+		id    *dto.TaskFilter
 		query func(mock sqlmock.Sqlmock, args args)
 	}
 	tests := []struct {
@@ -602,8 +829,8 @@ func TestPostgresTaskStorage_ReadMany(t *testing.T) {
 		{
 			name: "Happy path",
 			args: args{
-				id: &dto.TaskIDs{
-					Values: []string{"1", "2"},
+				id: &dto.TaskFilter{
+					TaskIDs: []string{"1", "2"},
 				},
 				query: func(mock sqlmock.Sqlmock, args args) {
 					query, _, _ := sq.
@@ -613,7 +840,7 @@ func TestPostgresTaskStorage_ReadMany(t *testing.T) {
 						LeftJoin("public.comment ON public.task.id = public.comment.id_task").
 						LeftJoin("public.checklist ON public.task.id = public.checklist.id_task").
 						LeftJoin("public.tag_task ON public.task.id = public.tag_task.id_task").
-						Where(sq.Eq{"public.task.id": args.id.Values}).
+						Where(sq.Eq{"public.task.id": args.id.TaskIDs}).
 						GroupBy("public.task.id", "public.task.id_list").
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
@@ -627,7 +854,31 @@ func TestPostgresTaskStorage_ReadMany(t *testing.T) {
 							"1", "2",
 						).
 						WillReturnRows(sqlmock.NewRows(allTaskFields).
-							AddRow(1, 1, time.Now(), "ame", "dsd", 1, time.Now(), time.Now(), userIDS, commentIDs, checklistIDs, tagIDs))
+							AddRow(1, 1, time.Now(), "ame", "dsd", 1, time.Now(), time.Now(), false, dto.TaskPriorityNone, nil, 1, nil, userIDS, commentIDs, checklistIDs, tagIDs, pq.StringArray([]string{}), nil,
+								pq.StringArray([]string{}), pq.StringArray([]string{}), false, nil, pq.StringArray([]string{}), 0))
+				},
+			},
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "Filtered by assignee, tag, due date and text",
+			args: args{
+				id: &dto.TaskFilter{
+					TaskIDs:     []string{"1", "2"},
+					AssigneeIDs: []uint64{3},
+					TagIDs:      []uint64{4, 5},
+					DueTo:       &dueTo,
+					Text:        "50%",
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectQuery(regexp.QuoteMeta("WHERE public.task.id IN ($1,$2) AND ("+
+						"EXISTS (SELECT 1 FROM public.task_user AS assignee_filter WHERE assignee_filter.id_task = public.task.id AND assignee_filter.id_user = ANY($3)) AND "+
+						"EXISTS (SELECT 1 FROM public.tag_task AS tag_filter WHERE tag_filter.id_task = public.task.id AND tag_filter.id_tag = ANY($4)) AND "+
+						"public.task.task_end <= $5 AND "+
+						"(public.task.name ILIKE $6 OR public.task.description ILIKE $7)) GROUP BY")).
+						WithArgs("1", "2", pq.Array(args.id.AssigneeIDs), pq.Array(args.id.TagIDs), dueTo, `%50\%%`, `%50\%%`).
+						WillReturnRows(sqlmock.NewRows(allTaskFields))
 				},
 			},
 			wantErr: false,
@@ -636,8 +887,8 @@ func TestPostgresTaskStorage_ReadMany(t *testing.T) {
 		{
 			name: "Query fail",
 			args: args{
-				id: &dto.TaskIDs{
-					Values: []string{"1", "2"},
+				id: &dto.TaskFilter{
+					TaskIDs: []string{"1", "2"},
 				},
 				query: func(mock sqlmock.Sqlmock, args args) {
 					query, _, _ := sq.
@@ -647,7 +898,7 @@ func TestPostgresTaskStorage_ReadMany(t *testing.T) {
 						LeftJoin("public.comment ON public.task.id = public.comment.id_task").
 						LeftJoin("public.checklist ON public.task.id = public.checklist.id_task").
 						LeftJoin("public.tag_task ON public.task.id = public.tag_task.id_task").
-						Where(sq.Eq{"public.task.id": args.id.Values}).
+						Where(sq.Eq{"public.task.id": args.id.TaskIDs}).
 						GroupBy("public.task.id", "public.task.id_list").
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
@@ -665,7 +916,7 @@ func TestPostgresTaskStorage_ReadMany(t *testing.T) {
 		{
 			name: "Building query failed",
 			args: args{
-				id:    &dto.TaskIDs{},
+				id:    &dto.TaskFilter{},
 				query: func(mock sqlmock.Sqlmock, args args) {},
 			},
 			wantErr: true,
@@ -718,27 +969,34 @@ func TestPostgresTaskStorage_Update(t *testing.T) {
 			name: "Happy path",
 			args: args{
 				info: &dto.UpdatedTaskInfo{
-					ID: 1,
+					ID:         1,
+					RowVersion: 3,
 				},
 				query: func(mock sqlmock.Sqlmock, args args) {
 					query, _, _ := sq.
 						Update("public.task").
 						Set("name", args.info.Name).
 						Set("description", args.info.Description).
-						Set("list_position", args.info.ListPosition).
-						Set("task_start", &args.info.Start).
-						Set("task_end", &args.info.End).
-						Where(sq.Eq{"id": args.info.ID}).
+						Set("task_start", args.info.Start).
+						Set("task_end", args.info.End).
+						Set("all_day", args.info.AllDay).
+						Set("priority", args.info.Priority).
+						Set("estimate", args.info.Estimate).
+						Set("row_version", sq.Expr("row_version + 1")).
+						Where(sq.Eq{"id": args.info.ID, "row_version": args.info.RowVersion}).
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
 					mock.ExpectExec(regexp.QuoteMeta(query)).
 						WithArgs(
 							args.info.Name,
 							args.info.Description,
-							args.info.ListPosition,
 							args.info.Start,
 							args.info.End,
+							args.info.AllDay,
+							args.info.Priority,
+							args.info.Estimate,
 							args.info.ID,
+							args.info.RowVersion,
 						).
 						WillReturnResult(sqlmock.NewResult(1, 1))
 				},
@@ -746,31 +1004,77 @@ func TestPostgresTaskStorage_Update(t *testing.T) {
 			wantErr: false,
 			err:     nil,
 		},
+		{
+			name: "Stale row version",
+			args: args{
+				info: &dto.UpdatedTaskInfo{
+					ID:         1,
+					RowVersion: 3,
+				},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					query, _, _ := sq.
+						Update("public.task").
+						Set("name", args.info.Name).
+						Set("description", args.info.Description).
+						Set("task_start", args.info.Start).
+						Set("task_end", args.info.End).
+						Set("all_day", args.info.AllDay).
+						Set("priority", args.info.Priority).
+						Set("estimate", args.info.Estimate).
+						Set("row_version", sq.Expr("row_version + 1")).
+						Where(sq.Eq{"id": args.info.ID, "row_version": args.info.RowVersion}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectExec(regexp.QuoteMeta(query)).
+						WithArgs(
+							args.info.Name,
+							args.info.Description,
+							args.info.Start,
+							args.info.End,
+							args.info.AllDay,
+							args.info.Priority,
+							args.info.Estimate,
+							args.info.ID,
+							args.info.RowVersion,
+						).
+						WillReturnResult(sqlmock.NewResult(0, 0))
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrRowVersionConflict,
+		},
 		{
 			name: "Query fail",
 			args: args{
 				info: &dto.UpdatedTaskInfo{
-					ID: 1,
+					ID:         1,
+					RowVersion: 3,
 				},
 				query: func(mock sqlmock.Sqlmock, args args) {
 					query, _, _ := sq.
 						Update("public.task").
 						Set("name", args.info.Name).
 						Set("description", args.info.Description).
-						Set("list_position", args.info.ListPosition).
-						Set("task_start", &args.info.Start).
-						Set("task_end", &args.info.End).
-						Where(sq.Eq{"id": args.info.ID}).
+						Set("task_start", args.info.Start).
+						Set("task_end", args.info.End).
+						Set("all_day", args.info.AllDay).
+						Set("priority", args.info.Priority).
+						Set("estimate", args.info.Estimate).
+						Set("row_version", sq.Expr("row_version + 1")).
+						Where(sq.Eq{"id": args.info.ID, "row_version": args.info.RowVersion}).
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
 					mock.ExpectExec(regexp.QuoteMeta(query)).
 						WithArgs(
 							args.info.Name,
 							args.info.Description,
-							args.info.ListPosition,
 							args.info.Start,
 							args.info.End,
+							args.info.AllDay,
+							args.info.Priority,
+							args.info.Estimate,
 							args.info.ID,
+							args.info.RowVersion,
 						).
 						WillReturnError(apperrors.ErrTaskNotUpdated)
 				},
@@ -1161,16 +1465,19 @@ func TestPostgresTaskStorage_RemoveFile(t *testing.T) {
 					fileID := 1
 
 					query1, _, _ := sq.
-						Select("id").
+						Select("public.file.id").
 						From("public.file").
+						Join("public.task_file ON public.task_file.id_file = public.file.id").
 						Where(sq.And{
-							sq.Eq{"name": args.info.OriginalName},
-							sq.Eq{"filepath": args.info.FilePath},
+							sq.Eq{"public.task_file.id_task": args.info.TaskID},
+							sq.Eq{"public.file.name": args.info.OriginalName},
+							sq.Eq{"public.file.filepath": args.info.FilePath},
 						}).
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
 					mock.ExpectQuery(regexp.QuoteMeta(query1)).
 						WithArgs(
+							args.info.TaskID,
 							args.info.OriginalName,
 							args.info.FilePath,
 						).
@@ -1212,16 +1519,19 @@ func TestPostgresTaskStorage_RemoveFile(t *testing.T) {
 				},
 				query: func(mock sqlmock.Sqlmock, args args) {
 					query1, _, _ := sq.
-						Select("id").
+						Select("public.file.id").
 						From("public.file").
+						Join("public.task_file ON public.task_file.id_file = public.file.id").
 						Where(sq.And{
-							sq.Eq{"name": args.info.OriginalName},
-							sq.Eq{"filepath": args.info.FilePath},
+							sq.Eq{"public.task_file.id_task": args.info.TaskID},
+							sq.Eq{"public.file.name": args.info.OriginalName},
+							sq.Eq{"public.file.filepath": args.info.FilePath},
 						}).
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
 					mock.ExpectQuery(regexp.QuoteMeta(query1)).
 						WithArgs(
+							args.info.TaskID,
 							args.info.OriginalName,
 							args.info.FilePath,
 						).
@@ -1243,16 +1553,19 @@ func TestPostgresTaskStorage_RemoveFile(t *testing.T) {
 					fileID := 1
 
 					query1, _, _ := sq.
-						Select("id").
+						Select("public.file.id").
 						From("public.file").
+						Join("public.task_file ON public.task_file.id_file = public.file.id").
 						Where(sq.And{
-							sq.Eq{"name": args.info.OriginalName},
-							sq.Eq{"filepath": args.info.FilePath},
+							sq.Eq{"public.task_file.id_task": args.info.TaskID},
+							sq.Eq{"public.file.name": args.info.OriginalName},
+							sq.Eq{"public.file.filepath": args.info.FilePath},
 						}).
 						PlaceholderFormat(sq.Dollar).
 						ToSql()
 					mock.ExpectQuery(regexp.QuoteMeta(query1)).
 						WithArgs(
+							args.info.TaskID,
 							args.info.OriginalName,
 							args.info.FilePath,
 						).
@@ -1430,3 +1743,290 @@ func TestPostgresTaskStorage_GetFileList(t *testing.T) {
 		})
 	}
 }
+
+func TestPostgresTaskStorage_MoveToBoard(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		info  dto.TaskTransferInfo
+		query func(mock sqlmock.Sqlmock, args args)
+	}
+	boardQuery := "SELECT current.id_board, target.id_board FROM public.task " +
+		"JOIN public.list AS current ON current.id = public.task.id_list " +
+		"JOIN public.list AS target ON target.id = $1 " +
+		"WHERE public.task.id = $2 FOR UPDATE OF public.task"
+	tests := []struct {
+		name    string
+		args    args
+		want    *dto.TaskTransferReport
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "Happy path",
+			args: args{
+				info: dto.TaskTransferInfo{TaskID: 2, ListID: 6},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta(boardQuery)).
+						WithArgs(args.info.ListID, args.info.TaskID).
+						WillReturnRows(sqlmock.NewRows([]string{"id_board", "id_board"}).AddRow(3, 4))
+
+					expectRankLock(mock, taskRankScope, args.info.ListID)
+					expectRankPlace(mock, taskRankScope, args.info.ListID, args.info.TaskID, 0, "", "i")
+
+					query, _, _ := sq.Update("public.task").
+						Set("id_list", args.info.ListID).
+						Set("rank", "9").
+						Set("date_completed", completedInList(args.info.ListID)).
+						Where(sq.Eq{"id": args.info.TaskID}).
+						PlaceholderFormat(sq.Dollar).
+						ToSql()
+					mock.ExpectExec(regexp.QuoteMeta(query)).
+						WithArgs(args.info.ListID, "9", args.info.ListID, args.info.TaskID).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					expectHardWipLimit(mock, args.info.ListID, false)
+					expectBlockers(mock, args.info.ListID, false, args.info.TaskID)
+
+					mock.ExpectQuery(regexp.QuoteMeta("FROM public.tag JOIN public.tag_task")).
+						WithArgs(args.info.TaskID, uint64(4)).
+						WillReturnRows(sqlmock.NewRows([]string{"id", "name", "color"}))
+					mock.ExpectQuery(regexp.QuoteMeta("DELETE FROM public.task_user WHERE id_task IN (SELECT id FROM public.task WHERE id = $1)")).
+						WithArgs(args.info.TaskID, uint64(4)).
+						WillReturnRows(sqlmock.NewRows([]string{"id_task", "id_user"}).AddRow(2, 9))
+					mock.ExpectQuery(regexp.QuoteMeta("DELETE FROM public.task_custom_field_value WHERE id_task IN (SELECT id FROM public.task WHERE id = $1)")).
+						WithArgs(args.info.TaskID, uint64(4)).
+						WillReturnRows(sqlmock.NewRows([]string{"id_task", "id_field"}))
+					mock.ExpectQuery(regexp.QuoteMeta("UPDATE public.task SET id_parent = $1")).
+						WithArgs(nil, args.info.TaskID, args.info.TaskID).
+						WillReturnRows(sqlmock.NewRows([]string{"id"}))
+					mock.ExpectCommit()
+				},
+			},
+			want: &dto.TaskTransferReport{
+				TaskID:  2,
+				ListID:  6,
+				BoardID: 4,
+				Changes: dto.TransferChanges{
+					RemappedTags:       []dto.TransferredTag{},
+					DroppedAssignees:   []dto.DroppedAssignee{{TaskID: 2, UserID: 9}},
+					DroppedFieldValues: []dto.DroppedFieldValue{},
+					DetachedSubtasks:   []uint64{},
+				},
+			},
+		},
+		{
+			name: "Task or list not found",
+			args: args{
+				info: dto.TaskTransferInfo{TaskID: 2, ListID: 6},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta(boardQuery)).
+						WithArgs(args.info.ListID, args.info.TaskID).
+						WillReturnRows(sqlmock.NewRows([]string{"id_board", "id_board"}))
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrTaskNotFound,
+		},
+		{
+			name: "Same board",
+			args: args{
+				info: dto.TaskTransferInfo{TaskID: 2, ListID: 6},
+				query: func(mock sqlmock.Sqlmock, args args) {
+					mock.ExpectBegin()
+					mock.ExpectQuery(regexp.QuoteMeta(boardQuery)).
+						WithArgs(args.info.ListID, args.info.TaskID).
+						WillReturnRows(sqlmock.NewRows([]string{"id_board", "id_board"}).AddRow(3, 3))
+					mock.ExpectRollback()
+				},
+			},
+			wantErr: true,
+			err:     apperrors.ErrTaskAlreadyOnBoard,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			tt.args.query(mock, tt.args)
+
+			s := NewTaskStorage(db)
+
+			got, err := s.MoveToBoard(ctx, tt.args.info)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PostgresTaskStorage.MoveToBoard() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, tt.err) {
+				t.Errorf("PostgresTaskStorage.MoveToBoard() error = %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PostgresTaskStorage.MoveToBoard() = %+v, want %+v", got, tt.want)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPostgresTaskStorage_Unarchive(t *testing.T) {
+	t.Parallel()
+	unarchiveQuery := "UPDATE public.task SET date_archived = $1, row_version = row_version + 1 WHERE id = $2"
+	tests := []struct {
+		name    string
+		query   func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "Happy path",
+			query: func(mock sqlmock.Sqlmock) {
+				expectHardWipLimit(mock, 3, false)
+				expectBlockers(mock, 3, false, 1)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Hard wip limit exceeded",
+			query: func(mock sqlmock.Sqlmock) {
+				expectHardWipLimit(mock, 3, true)
+				mock.ExpectRollback()
+			},
+			wantErr: apperrors.ErrWipLimitExceeded,
+		},
+		{
+			name: "Task blocked in done list",
+			query: func(mock sqlmock.Sqlmock) {
+				expectHardWipLimit(mock, 3, false)
+				expectBlockers(mock, 3, true, 1)
+				mock.ExpectRollback()
+			},
+			wantErr: apperrors.ErrTaskBlocked,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT id_list FROM public.task WHERE id = $1")).
+				WithArgs(uint64(1)).
+				WillReturnRows(sqlmock.NewRows([]string{"id_list"}).AddRow(uint64(3)))
+			expectRankLock(mock, taskRankScope, 3)
+			mock.ExpectExec(regexp.QuoteMeta(unarchiveQuery)).
+				WithArgs(nil, uint64(1)).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			tt.query(mock)
+
+			err = NewTaskStorage(db).Unarchive(ctx, dto.TaskID{Value: 1})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("PostgresTaskStorage.Unarchive() error = %v, want %v", err, tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPostgresTaskStorage_SetReminders(t *testing.T) {
+	t.Parallel()
+
+	replaceQuery := "WITH removed AS (DELETE FROM public.task_reminder WHERE id_task = $1 RETURNING offset_minutes) " +
+		"SELECT offset_minutes FROM removed ORDER BY offset_minutes"
+	tests := []struct {
+		name    string
+		offsets []uint64
+		query   func(mock sqlmock.Sqlmock)
+		want    []uint64
+		wantErr error
+	}{
+		{
+			name:    "Happy path",
+			offsets: []uint64{15, 60},
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(replaceQuery)).
+					WithArgs(uint64(1)).
+					WillReturnRows(sqlmock.NewRows([]string{"offset_minutes"}).AddRow(30).AddRow(1440))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.task_reminder (id_task,offset_minutes) VALUES ($1,$2),($3,$4)")).
+					WithArgs(uint64(1), uint64(15), uint64(1), uint64(60)).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+			want: []uint64{30, 1440},
+		},
+		{
+			name: "Reminders cleared",
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(replaceQuery)).
+					WithArgs(uint64(1)).
+					WillReturnRows(sqlmock.NewRows([]string{"offset_minutes"}).AddRow(30))
+				mock.ExpectCommit()
+			},
+			want: []uint64{30},
+		},
+		{
+			name: "Delete fail",
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(replaceQuery)).
+					WithArgs(uint64(1)).
+					WillReturnError(apperrors.ErrCouldNotExecuteQuery)
+				mock.ExpectRollback()
+			},
+			wantErr: apperrors.ErrTaskNotUpdated,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			mock.ExpectBegin()
+			tt.query(mock)
+
+			got, err := NewTaskStorage(db).SetReminders(ctx, dto.TaskRemindersInfo{TaskID: 1, Offsets: tt.offsets})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("PostgresTaskStorage.SetReminders() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PostgresTaskStorage.SetReminders() = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,598 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	"server/internal/pkg/dto"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// tasksInList
+// подзапрос id заданий списка
+func tasksInList(listID uint64) sq.SelectBuilder {
+	return sq.Select("id").From("public.task").Where(sq.Eq{"id_list": listID})
+}
+
+// adaptToBoard
+// приводит задания из подзапроса tasks к доске boardID: тэги заменяются на тэги доски с тем же названием
+// (недостающие создаются), исполнители не из участников доски снимаются, значения чужих пользовательских полей удаляются,
+// связи с родительскими заданиями и подзадачами на других досках снимаются
+// или возвращает ошибки ...
+func adaptToBoard(ctx context.Context, tx executor, tasks sq.SelectBuilder, boardID uint64) (*dto.TransferChanges, error) {
+	tags, err := remapTags(ctx, tx, tasks, boardID)
+	if err != nil {
+		return nil, err
+	}
+	assignees, err := dropForeignAssignees(ctx, tx, tasks, boardID)
+	if err != nil {
+		return nil, err
+	}
+	values, err := dropForeignFieldValues(ctx, tx, tasks, boardID)
+	if err != nil {
+		return nil, err
+	}
+	subtasks, err := detachForeignSubtasks(ctx, tx, tasks)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.TransferChanges{
+		RemappedTags:       tags,
+		DroppedAssignees:   assignees,
+		DroppedFieldValues: values,
+		DetachedSubtasks:   subtasks,
+	}, nil
+}
+
+// remapTags
+// заменяет у заданий тэги, не привязанные к доске boardID, на тэги доски с тем же названием
+// или возвращает ошибки ...
+func remapTags(ctx context.Context, tx executor, tasks sq.SelectBuilder, boardID uint64) ([]dto.TransferredTag, error) {
+	funcName := "remapTags"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select("DISTINCT public.tag.id", "public.tag.name", "public.tag.color").
+		From("public.tag").
+		Join("public.tag_task ON public.tag_task.id_tag = public.tag.id").
+		Where(sq.Expr("public.tag_task.id_task IN (?)", tasks)).
+		Where(sq.Expr("public.tag.id NOT IN (?)", sq.Select("id_tag").From("public.tag_board").Where(sq.Eq{"id_board": boardID}))).
+		OrderBy("public.tag.id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotExecuteQuery
+	}
+	type foreignTag struct {
+		id    uint64
+		name  string
+		color string
+	}
+	foreign := []foreignTag{}
+	for rows.Next() {
+		var tag foreignTag
+		if err = rows.Scan(&tag.id, &tag.name, &tag.color); err != nil {
+			rows.Close()
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotScanRows
+		}
+		foreign = append(foreign, tag)
+	}
+	rows.Close()
+
+	remapped := []dto.TransferredTag{}
+	for _, tag := range foreign {
+		target, created, err := boardTag(ctx, tx, boardID, tag.name, tag.color)
+		if err != nil {
+			return nil, err
+		}
+
+		query, args, err := sq.Insert("public.tag_task").
+			Columns("id_tag", "id_task").
+			Select(sq.Select(fmt.Sprintf("%d", target), "id_task").
+				From("public.tag_task").
+				Where(sq.Eq{"id_tag": tag.id}).
+				Where(sq.Expr("id_task IN (?)", tasks))).
+			Suffix("ON CONFLICT DO NOTHING").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return nil, apperrors.ErrCouldNotBuildQuery
+		}
+		logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+		if _, err = tx.Exec(query, args...); err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrTaskTagConnectionNotCreated
+		}
+
+		query, args, err = sq.Delete("public.tag_task").
+			Where(sq.Eq{"id_tag": tag.id}).
+			Where(sq.Expr("id_task IN (?)", tasks)).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return nil, apperrors.ErrCouldNotBuildQuery
+		}
+		logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+		if _, err = tx.Exec(query, args...); err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrTagNotRemovedFromTask
+		}
+
+		remapped = append(remapped, dto.TransferredTag{
+			FromTagID: tag.id,
+			ToTagID:   target,
+			Name:      tag.name,
+			Created:   created,
+		})
+	}
+	logger.DebugFmt(fmt.Sprintf("Remapped %d tags", len(remapped)), requestID.String(), funcName, nodeName)
+
+	return remapped, nil
+}
+
+// boardTag
+// возвращает id тэга доски с названием name, создавая его с цветом color, если такого тэга нет
+// или возвращает ошибки ...
+func boardTag(ctx context.Context, tx executor, boardID uint64, name string, color string) (uint64, bool, error) {
+	funcName := "boardTag"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select("public.tag.id").
+		From("public.tag").
+		Join("public.tag_board ON public.tag_board.id_tag = public.tag.id").
+		Where(sq.Eq{"public.tag_board.id_board": boardID, "public.tag.name": name}).
+		OrderBy("public.tag.id").
+		Limit(1).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, false, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	var id uint64
+	err = tx.QueryRow(query, args...).Scan(&id)
+	if err == nil {
+		return id, false, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return 0, false, apperrors.ErrCouldNotExecuteQuery
+	}
+
+	query, args, err = sq.Insert("public.tag").
+		Columns("name", "color").
+		Values(name, color).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, false, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	if err = tx.QueryRow(query, args...).Scan(&id); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return 0, false, apperrors.ErrTagNotCreated
+	}
+
+	query, args, err = sq.Insert("public.tag_board").
+		Columns("id_tag", "id_board").
+		Values(id, boardID).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, false, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	if _, err = tx.Exec(query, args...); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return 0, false, apperrors.ErrBoardTagConnectionNotCreated
+	}
+	logger.DebugFmt("Created tag "+name+" on board", requestID.String(), funcName, nodeName)
+
+	return id, true, nil
+}
+
+// dropForeignAssignees
+// снимает с заданий исполнителей, не состоящих в доске boardID
+// или возвращает ошибки ...
+func dropForeignAssignees(ctx context.Context, tx executor, tasks sq.SelectBuilder, boardID uint64) ([]dto.DroppedAssignee, error) {
+	funcName := "dropForeignAssignees"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Delete("public.task_user").
+		Where(sq.Expr("id_task IN (?)", tasks)).
+		Where(sq.Expr("id_user NOT IN (?)", sq.Select("id_user").From("public.board_user").Where(sq.Eq{"id_board": boardID}))).
+		Suffix("RETURNING id_task, id_user").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotExecuteQuery
+	}
+	defer rows.Close()
+
+	dropped := []dto.DroppedAssignee{}
+	for rows.Next() {
+		var assignee dto.DroppedAssignee
+		if err = rows.Scan(&assignee.TaskID, &assignee.UserID); err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotScanRows
+		}
+		dropped = append(dropped, assignee)
+	}
+	logger.DebugFmt(fmt.Sprintf("Dropped %d assignees", len(dropped)), requestID.String(), funcName, nodeName)
+
+	return dropped, nil
+}
+
+// dropForeignFieldValues
+// удаляет у заданий значения пользовательских полей, которых нет на доске boardID
+// или возвращает ошибки ...
+func dropForeignFieldValues(ctx context.Context, tx executor, tasks sq.SelectBuilder, boardID uint64) ([]dto.DroppedFieldValue, error) {
+	funcName := "dropForeignFieldValues"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Delete("public.task_custom_field_value").
+		Where(sq.Expr("id_task IN (?)", tasks)).
+		Where(sq.Expr("id_field NOT IN (?)", sq.Select("id").From("public.custom_field").Where(sq.Eq{"id_board": boardID}))).
+		Suffix("RETURNING id_task, id_field").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotExecuteQuery
+	}
+	defer rows.Close()
+
+	dropped := []dto.DroppedFieldValue{}
+	for rows.Next() {
+		var value dto.DroppedFieldValue
+		if err = rows.Scan(&value.TaskID, &value.FieldID); err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotScanRows
+		}
+		dropped = append(dropped, value)
+	}
+	logger.DebugFmt(fmt.Sprintf("Dropped %d custom field values", len(dropped)), requestID.String(), funcName, nodeName)
+
+	return dropped, nil
+}
+
+// detachForeignSubtasks
+// снимает связи между заданиями из подзапроса tasks и их родителями или подзадачами, оказавшимися на другой доске;
+// возвращает id отвязанных подзадач
+// или возвращает ошибки ...
+func detachForeignSubtasks(ctx context.Context, tx executor, tasks sq.SelectBuilder) ([]uint64, error) {
+	funcName := "detachForeignSubtasks"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Update("public.task").
+		Set("id_parent", nil).
+		Where(sq.Or{sq.Expr("id IN (?)", tasks), sq.Expr("id_parent IN (?)", tasks)}).
+		Where("(SELECT parent_list.id_board FROM public.task AS parent " +
+			"JOIN public.list AS parent_list ON parent_list.id = parent.id_list WHERE parent.id = public.task.id_parent) " +
+			"<> (SELECT public.list.id_board FROM public.list WHERE public.list.id = public.task.id_list)").
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotExecuteQuery
+	}
+	defer rows.Close()
+
+	detached := []uint64{}
+	for rows.Next() {
+		var taskID uint64
+		if err = rows.Scan(&taskID); err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotScanRows
+		}
+		detached = append(detached, taskID)
+	}
+	logger.DebugFmt(fmt.Sprintf("Detached %d subtasks", len(detached)), requestID.String(), funcName, nodeName)
+
+	return detached, nil
+}
+
+// listCopyParts
+// части заданий, которые копируются вместе со списком
+var listCopyParts = dto.TaskCopyParts{
+	Description: true,
+	Checklists:  true,
+	Tags:        true,
+	Assignees:   true,
+	Dates:       true,
+}
+
+// copiedColumn
+// возвращает столбец, если он копируется, иначе NULL
+func copiedColumn(column string, copied bool) string {
+	if copied {
+		return column
+	}
+	return "NULL"
+}
+
+// copyTask
+// копирует задание в список listID с ключом порядка rank вместе с выбранными частями parts
+// и значениями пользовательских полей и возвращает id копии
+// или возвращает ошибки ...
+func copyTask(ctx context.Context, tx executor, taskID uint64, listID uint64, rank string, parts dto.TaskCopyParts) (uint64, error) {
+	funcName := "copyTask"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Insert("public.task").
+		Columns("id_list", "name", "description", "list_position", "task_start", "task_end", "all_day", "priority", "estimate", "date_completed", "rank").
+		Select(sq.Select().
+			Column(sq.Expr("?", listID)).
+			Columns(
+				"name",
+				copiedColumn("description", parts.Description),
+				"list_position",
+				copiedColumn("task_start", parts.Dates),
+				copiedColumn("task_end", parts.Dates),
+			).
+			Column(sq.Expr("all_day AND ?", parts.Dates)).
+			Columns("priority", "estimate").
+			Column(completedInList(listID)).
+			Column(sq.Expr("?", rank)).
+			From("public.task").
+			Where(sq.Eq{"id": taskID})).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	var copyID uint64
+	if err = tx.QueryRow(query, args...).Scan(&copyID); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return 0, apperrors.ErrTaskNotCreated
+	}
+
+	if parts.Checklists {
+		if err = copyChecklists(ctx, tx, taskID, copyID); err != nil {
+			return 0, err
+		}
+	}
+	if parts.Attachments {
+		if err = copyFiles(ctx, tx, taskID, copyID); err != nil {
+			return 0, err
+		}
+	}
+
+	links := []struct {
+		table   string
+		columns []string
+		copied  bool
+	}{
+		{table: "public.tag_task", columns: []string{"id_tag"}, copied: parts.Tags},
+		{table: "public.task_user", columns: []string{"id_user"}, copied: parts.Assignees},
+		{table: "public.task_custom_field_value", columns: []string{"id_field", "value"}, copied: true},
+		{table: "public.comment", columns: []string{"id_user", "content", "date_created"}, copied: parts.Comments},
+	}
+	for _, link := range links {
+		if !link.copied {
+			continue
+		}
+		query, args, err := sq.Insert(link.table).
+			Columns(append([]string{"id_task"}, link.columns...)...).
+			Select(sq.Select().
+				Column(sq.Expr("?", copyID)).
+				Columns(link.columns...).
+				From(link.table).
+				Where(sq.Eq{"id_task": taskID})).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return 0, apperrors.ErrCouldNotBuildQuery
+		}
+		logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+		if _, err = tx.Exec(query, args...); err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return 0, apperrors.ErrTaskNotCreated
+		}
+	}
+	logger.DebugFmt(fmt.Sprintf("Copied task %d to %d", taskID, copyID), requestID.String(), funcName, nodeName)
+
+	return copyID, nil
+}
+
+// copyFiles
+// прикрепляет к заданию copyID новые записи о файлах задания taskID, ссылающиеся на те же файлы
+// или возвращает ошибки ...
+func copyFiles(ctx context.Context, tx executor, taskID uint64, copyID uint64) error {
+	funcName := "copyFiles"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select("id_file").
+		From("public.task_file").
+		Where(sq.Eq{"id_task": taskID}).
+		OrderBy("id_file").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	fileIDs, err := scanIDs(tx, query, args)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return err
+	}
+
+	for _, fileID := range fileIDs {
+		query, args, err := sq.Insert("public.file").
+			Columns(allFileInfoFields...).
+			Select(sq.Select(allFileInfoFields...).
+				From("public.file").
+				Where(sq.Eq{"id": fileID})).
+			Suffix("RETURNING id").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return apperrors.ErrCouldNotBuildQuery
+		}
+		logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+		var copiedFileID uint64
+		if err = tx.QueryRow(query, args...).Scan(&copiedFileID); err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotExecuteQuery
+		}
+
+		query, args, err = sq.Insert("public.task_file").
+			Columns("id_task", "id_file").
+			Values(copyID, copiedFileID).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return apperrors.ErrCouldNotBuildQuery
+		}
+		logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+		if _, err = tx.Exec(query, args...); err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotExecuteQuery
+		}
+	}
+
+	return nil
+}
+
+// copyChecklists
+// копирует чеклисты задания taskID вместе с их элементами в задание copyID
+// или возвращает ошибки ...
+func copyChecklists(ctx context.Context, tx executor, taskID uint64, copyID uint64) error {
+	funcName := "copyChecklists"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select("id").
+		From("public.checklist").
+		Where(sq.Eq{"id_task": taskID}).
+		OrderBy("list_position", "id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	checklistIDs, err := scanIDs(tx, query, args)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return err
+	}
+
+	for _, checklistID := range checklistIDs {
+		query, args, err := sq.Insert("public.checklist").
+			Columns("id_task", "name", "list_position").
+			Select(sq.Select().
+				Column(sq.Expr("?", copyID)).
+				Columns("name", "list_position").
+				From("public.checklist").
+				Where(sq.Eq{"id": checklistID})).
+			Suffix("RETURNING id").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return apperrors.ErrCouldNotBuildQuery
+		}
+		logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+		var copiedChecklistID uint64
+		if err = tx.QueryRow(query, args...).Scan(&copiedChecklistID); err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrChecklistNotCreated
+		}
+
+		query, args, err = sq.Insert("public.checklist_item").
+			Columns("id_checklist", "name", "list_position", "done", "rank").
+			Select(sq.Select().
+				Column(sq.Expr("?", copiedChecklistID)).
+				Columns("name", "list_position", "done", "rank").
+				From("public.checklist_item").
+				Where(sq.Eq{"id_checklist": checklistID})).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return apperrors.ErrCouldNotBuildQuery
+		}
+		logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+		if _, err = tx.Exec(query, args...); err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrChecklistItemNotCreated
+		}
+	}
+
+	return nil
+}
+
+// scanIDs
+// выполняет запрос в транзакции и возвращает id из первого столбца результата
+func scanIDs(tx executor, query string, args []interface{}) ([]uint64, error) {
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		return nil, apperrors.ErrCouldNotExecuteQuery
+	}
+	defer rows.Close()
+
+	ids := []uint64{}
+	for rows.Next() {
+		var id uint64
+		if err = rows.Scan(&id); err != nil {
+			return nil, apperrors.ErrCouldNotScanRows
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
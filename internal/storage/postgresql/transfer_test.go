@@ -0,0 +1,172 @@
+package postgresql
+
+import (
+	"context"
+	"regexp"
+	"server/internal/pkg/dto"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// expectAdaptToBoard
+// ожидает запросы adaptToBoard для заданий списка listID: тэг 5 "bug" заменяется на созданный тэг 8,
+// с задания 2 снимается исполнитель 9, значений чужих полей нет
+func expectAdaptToBoard(mock sqlmock.Sqlmock, listID uint64, boardID uint64) {
+	tasks := tasksInList(listID)
+
+	query, _, _ := sq.Select("DISTINCT public.tag.id", "public.tag.name", "public.tag.color").
+		From("public.tag").
+		Join("public.tag_task ON public.tag_task.id_tag = public.tag.id").
+		Where(sq.Expr("public.tag_task.id_task IN (?)", tasks)).
+		Where(sq.Expr("public.tag.id NOT IN (?)", sq.Select("id_tag").From("public.tag_board").Where(sq.Eq{"id_board": boardID}))).
+		OrderBy("public.tag.id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(listID, boardID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "color"}).AddRow(5, "bug", "FF0000"))
+
+	query, _, _ = sq.Select("public.tag.id").
+		From("public.tag").
+		Join("public.tag_board ON public.tag_board.id_tag = public.tag.id").
+		Where(sq.Eq{"public.tag_board.id_board": boardID, "public.tag.name": "bug"}).
+		OrderBy("public.tag.id").
+		Limit(1).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs("bug", boardID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO public.tag (name,color) VALUES ($1,$2) RETURNING id")).
+		WithArgs("bug", "FF0000").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(8))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.tag_board (id_tag,id_board) VALUES ($1,$2)")).
+		WithArgs(uint64(8), boardID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.tag_task (id_tag,id_task) SELECT 8, id_task FROM public.tag_task WHERE id_tag = $1 AND id_task IN (SELECT id FROM public.task WHERE id_list = $2) ON CONFLICT DO NOTHING")).
+		WithArgs(uint64(5), listID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM public.tag_task WHERE id_tag = $1 AND id_task IN (SELECT id FROM public.task WHERE id_list = $2)")).
+		WithArgs(uint64(5), listID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	mock.ExpectQuery(regexp.QuoteMeta("DELETE FROM public.task_user WHERE id_task IN (SELECT id FROM public.task WHERE id_list = $1) AND id_user NOT IN (SELECT id_user FROM public.board_user WHERE id_board = $2) RETURNING id_task, id_user")).
+		WithArgs(listID, boardID).
+		WillReturnRows(sqlmock.NewRows([]string{"id_task", "id_user"}).AddRow(2, 9))
+	mock.ExpectQuery(regexp.QuoteMeta("DELETE FROM public.task_custom_field_value WHERE id_task IN (SELECT id FROM public.task WHERE id_list = $1) AND id_field NOT IN (SELECT id FROM public.custom_field WHERE id_board = $2) RETURNING id_task, id_field")).
+		WithArgs(listID, boardID).
+		WillReturnRows(sqlmock.NewRows([]string{"id_task", "id_field"}))
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE public.task SET id_parent = $1 WHERE (id IN (SELECT id FROM public.task WHERE id_list = $2) "+
+		"OR id_parent IN (SELECT id FROM public.task WHERE id_list = $3))")).
+		WithArgs(nil, listID, listID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+}
+
+func TestCopyTask(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	ctx := context.WithValue(
+		context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+		dto.RequestIDKey, uuid.New(),
+	)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO public.task (id_list,name,description,list_position,task_start,task_end,all_day,priority,estimate,date_completed,rank) SELECT $1, name, description, list_position, task_start, task_end, all_day AND $2, priority, estimate, (SELECT CASE WHEN public.list.is_done THEN CURRENT_TIMESTAMP END FROM public.list WHERE public.list.id = $3), $4 FROM public.task WHERE id = $5 RETURNING id")).
+		WithArgs(uint64(6), true, uint64(6), "i", uint64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM public.checklist WHERE id_task = $1 ORDER BY list_position, id")).
+		WithArgs(uint64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO public.checklist (id_task,name,list_position) SELECT $1, name, list_position FROM public.checklist WHERE id = $2 RETURNING id")).
+		WithArgs(uint64(10), uint64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(11))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.checklist_item (id_checklist,name,list_position,done,rank) SELECT $1, name, list_position, done, rank FROM public.checklist_item WHERE id_checklist = $2")).
+		WithArgs(uint64(11), uint64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.tag_task (id_task,id_tag) SELECT $1, id_tag FROM public.tag_task WHERE id_task = $2")).
+		WithArgs(uint64(10), uint64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.task_user (id_task,id_user) SELECT $1, id_user FROM public.task_user WHERE id_task = $2")).
+		WithArgs(uint64(10), uint64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.task_custom_field_value (id_task,id_field,value) SELECT $1, id_field, value FROM public.task_custom_field_value WHERE id_task = $2")).
+		WithArgs(uint64(10), uint64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when starting a transaction", err)
+	}
+
+	got, err := copyTask(ctx, tx, 1, 6, "i", listCopyParts)
+	if err != nil {
+		t.Fatalf("copyTask() error = %v", err)
+	}
+	if got != 10 {
+		t.Errorf("copyTask() = %v, want 10", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestCopyTask_Parts(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	ctx := context.WithValue(
+		context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+		dto.RequestIDKey, uuid.New(),
+	)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO public.task (id_list,name,description,list_position,task_start,task_end,all_day,priority,estimate,date_completed,rank) SELECT $1, name, NULL, list_position, NULL, NULL, all_day AND $2, priority, estimate, (SELECT CASE WHEN public.list.is_done THEN CURRENT_TIMESTAMP END FROM public.list WHERE public.list.id = $3), $4 FROM public.task WHERE id = $5 RETURNING id")).
+		WithArgs(uint64(6), false, uint64(6), "i", uint64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id_file FROM public.task_file WHERE id_task = $1 ORDER BY id_file")).
+		WithArgs(uint64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id_file"}).AddRow(4))
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO public.file (name,filepath,date_created) SELECT name, filepath, date_created FROM public.file WHERE id = $1 RETURNING id")).
+		WithArgs(uint64(4)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(12))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.task_file (id_task,id_file) VALUES ($1,$2)")).
+		WithArgs(uint64(10), uint64(12)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.task_custom_field_value (id_task,id_field,value) SELECT $1, id_field, value FROM public.task_custom_field_value WHERE id_task = $2")).
+		WithArgs(uint64(10), uint64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.comment (id_task,id_user,content,date_created) SELECT $1, id_user, content, date_created FROM public.comment WHERE id_task = $2")).
+		WithArgs(uint64(10), uint64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when starting a transaction", err)
+	}
+
+	got, err := copyTask(ctx, tx, 1, 6, "i", dto.TaskCopyParts{Attachments: true, Comments: true})
+	if err != nil {
+		t.Fatalf("copyTask() error = %v", err)
+	}
+	if got != 10 {
+		t.Errorf("copyTask() = %v, want 10", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -0,0 +1,133 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	"server/internal/pkg/dto"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// executor
+// выполняет запросы к БД: общий интерфейс пула соединений и транзакции
+type executor interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// txKey
+// ключ контекста, под которым хранится транзакция, открытая через runInTx
+type txKey struct{}
+
+// contextTx
+// транзакция контекста и счётчик точек сохранения, открытых в ней хранилищами
+type contextTx struct {
+	tx         *sql.Tx
+	savepoints int
+}
+
+// conn
+// возвращает транзакцию контекста, если она открыта, или пул соединений db
+func conn(ctx context.Context, db *sql.DB) executor {
+	if outer, ok := ctx.Value(txKey{}).(*contextTx); ok {
+		return outer.tx
+	}
+	return db
+}
+
+// storageTx
+// транзакция метода хранилища. Внутри транзакции контекста она становится точкой сохранения:
+// её откат отменяет только изменения метода, а фиксирует всё транзакция контекста
+type storageTx struct {
+	executor
+	tx        *sql.Tx
+	savepoint string
+	done      bool
+}
+
+// beginTx
+// начинает транзакцию метода хранилища или точку сохранения в транзакции контекста
+func beginTx(ctx context.Context, db *sql.DB) (*storageTx, error) {
+	outer, ok := ctx.Value(txKey{}).(*contextTx)
+	if !ok {
+		tx, err := db.BeginTx(ctx, &sql.TxOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &storageTx{executor: tx, tx: tx}, nil
+	}
+
+	outer.savepoints++
+	savepoint := "storage_" + strconv.Itoa(outer.savepoints)
+	if _, err := outer.tx.Exec("SAVEPOINT " + savepoint); err != nil {
+		return nil, err
+	}
+	return &storageTx{executor: outer.tx, savepoint: savepoint}, nil
+}
+
+// Commit
+// фиксирует транзакцию метода или освобождает его точку сохранения
+func (tx *storageTx) Commit() error {
+	if tx.done {
+		return sql.ErrTxDone
+	}
+	tx.done = true
+	if tx.tx != nil {
+		return tx.tx.Commit()
+	}
+	_, err := tx.Exec("RELEASE SAVEPOINT " + tx.savepoint)
+	return err
+}
+
+// Rollback
+// откатывает транзакцию метода или изменения после его точки сохранения
+func (tx *storageTx) Rollback() error {
+	if tx.done {
+		return sql.ErrTxDone
+	}
+	tx.done = true
+	if tx.tx != nil {
+		return tx.tx.Rollback()
+	}
+	_, err := tx.Exec("ROLLBACK TO SAVEPOINT " + tx.savepoint)
+	return err
+}
+
+// runInTx
+// выполняет fn в одной транзакции: хранилища, получившие контекст fn, выполняют запросы в ней.
+// Ошибка fn откатывает транзакцию; если транзакция уже открыта в ctx, fn выполняется в ней
+func runInTx(ctx context.Context, db *sql.DB, fn func(ctx context.Context) error) error {
+	funcName := "runInTx"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	if _, ok := ctx.Value(txKey{}).(*contextTx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotBeginTransaction
+	}
+	logger.DebugFmt("Transaction started", requestID.String(), funcName, nodeName)
+
+	if err = fn(context.WithValue(ctx, txKey{}, &contextTx{tx: tx})); err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+errRollback.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotRollback
+		}
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotCommit
+	}
+	logger.DebugFmt("Changes commited", requestID.String(), funcName, nodeName)
+	return nil
+}
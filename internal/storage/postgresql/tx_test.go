@@ -0,0 +1,108 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+)
+
+func TestRunInTx(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		fn      func(ctx context.Context, db *sql.DB) error
+		query   func(mock sqlmock.Sqlmock)
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "Storage transactions become savepoints",
+			fn: func(ctx context.Context, db *sql.DB) error {
+				tx, err := beginTx(ctx, db)
+				if err != nil {
+					return err
+				}
+				if _, err = tx.Exec("UPDATE public.task SET name = $1 WHERE id = $2", "task", 1); err != nil {
+					return err
+				}
+				if err = tx.Commit(); err != nil {
+					return err
+				}
+				_, err = conn(ctx, db).Exec("INSERT INTO public.edit_history DEFAULT VALUES")
+				return err
+			},
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("SAVEPOINT storage_1")).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE public.task SET name = $1 WHERE id = $2")).
+					WithArgs("task", 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta("RELEASE SAVEPOINT storage_1")).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.edit_history DEFAULT VALUES")).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error rolls back everything",
+			fn: func(ctx context.Context, db *sql.DB) error {
+				tx, err := beginTx(ctx, db)
+				if err != nil {
+					return err
+				}
+				if err = tx.Rollback(); err != nil {
+					return err
+				}
+				return apperrors.ErrCouldNotRecordHistory
+			},
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("SAVEPOINT storage_1")).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta("ROLLBACK TO SAVEPOINT storage_1")).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+			err:     apperrors.ErrCouldNotRecordHistory,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			tt.query(mock)
+
+			err = runInTx(ctx, db, func(ctx context.Context) error {
+				return tt.fn(ctx, db)
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("runInTx() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, tt.err) {
+				t.Errorf("runInTx() error = %v, want %v", err, tt.err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
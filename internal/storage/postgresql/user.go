@@ -12,6 +12,7 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 // LocalUserStorage
@@ -317,6 +318,97 @@ func (s *PostgresUserStorage) UpdateAvatarUrl(ctx context.Context, info dto.User
 	return nil
 }
 
+// GetTimezone
+// находит в БД часовой пояс, в котором пользователю показываются сроки заданий
+// или возвращает ошибки apperrors.ErrUserNotFound, ...
+func (s *PostgresUserStorage) GetTimezone(ctx context.Context, id dto.UserID) (string, error) {
+	funcName := "PostgresUserStorage.GetTimezone"
+	errorMessage := "Getting user timezone failed with error: "
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.
+		Select("timezone").
+		From("public.user").
+		Where(sq.Eq{"id": id.Value}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		logger.DebugFmt(errorMessage+err.Error(), requestID.String(), funcName, nodeName)
+		return "", apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	var timezone string
+	if err = s.db.QueryRow(query, args...).Scan(&timezone); err != nil {
+		logger.DebugFmt(errorMessage+err.Error(), requestID.String(), funcName, nodeName)
+		return "", apperrors.ErrUserNotFound
+	}
+	logger.DebugFmt("Got user timezone", requestID.String(), funcName, nodeName)
+
+	return timezone, nil
+}
+
+// UpdateTimezone
+// обновляет часовой пояс пользователя в БД
+// или возвращает ошибки apperrors.ErrUserNotUpdated, ...
+func (s *PostgresUserStorage) UpdateTimezone(ctx context.Context, info dto.UserTimezoneInfo) error {
+	funcName := "PostgresUserStorage.UpdateTimezone"
+	errorMessage := "Updating user timezone failed with error: "
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.
+		Update("public.user").
+		Set("timezone", info.Timezone).
+		Where(sq.Eq{"id": info.UserID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		logger.DebugFmt(errorMessage+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	if _, err = s.db.Exec(query, args...); err != nil {
+		logger.DebugFmt(errorMessage+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrUserNotUpdated
+	}
+	logger.DebugFmt("Timezone updated", requestID.String(), funcName, nodeName)
+
+	return nil
+}
+
+// UpdateReminders
+// обновляет смещения напоминаний пользователя по умолчанию в БД
+// или возвращает ошибки apperrors.ErrUserNotUpdated, ...
+func (s *PostgresUserStorage) UpdateReminders(ctx context.Context, info dto.UserRemindersInfo) error {
+	funcName := "PostgresUserStorage.UpdateReminders"
+	errorMessage := "Updating user reminders failed with error: "
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.
+		Update("public.user").
+		Set("reminder_offsets", pq.Array(info.Offsets)).
+		Where(sq.Eq{"id": info.UserID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		logger.DebugFmt(errorMessage+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	if _, err = s.db.Exec(query, args...); err != nil {
+		logger.DebugFmt(errorMessage+err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrUserNotUpdated
+	}
+	logger.DebugFmt("Reminders updated", requestID.String(), funcName, nodeName)
+
+	return nil
+}
+
 // Delete
 // удаляет данного пользователя в БД по id
 // или возвращает ошибки ...
@@ -0,0 +1,165 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	"server/internal/pkg/dto"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// watchTaskOnAssign
+// подписка исполнителя на задание из параметров запроса (id задания, id пользователя) при назначении
+const watchTaskOnAssign = "INSERT INTO public.task_watcher (id_task, id_user) VALUES (?, ?) ON CONFLICT DO NOTHING"
+
+// WatchTask
+// подписывает пользователя на изменения задания; повторная подписка ничего не меняет
+// или возвращает ошибки apperrors.ErrWatchersNotUpdated, ...
+func (s PostgresTaskStorage) WatchTask(ctx context.Context, info dto.TaskWatcherInfo) error {
+	return watch(ctx, s.db, "PostgresTaskStorage.WatchTask", "public.task_watcher", "id_task", info.TaskID, info.UserID)
+}
+
+// UnwatchTask
+// отписывает пользователя от изменений задания
+// или возвращает ошибки apperrors.ErrNotWatching, apperrors.ErrWatchersNotUpdated, ...
+func (s PostgresTaskStorage) UnwatchTask(ctx context.Context, info dto.TaskWatcherInfo) error {
+	return unwatch(ctx, s.db, "PostgresTaskStorage.UnwatchTask", "public.task_watcher", "id_task", info.TaskID, info.UserID)
+}
+
+// ReadTaskWatchers
+// возвращает наблюдателей задания в порядке подписки
+// или возвращает ошибки apperrors.ErrCouldNotGetWatchers, ...
+func (s PostgresTaskStorage) ReadTaskWatchers(ctx context.Context, id dto.TaskID) (*[]dto.UserPublicInfo, error) {
+	return readWatchers(ctx, s.db, "PostgresTaskStorage.ReadTaskWatchers", "public.task_watcher", "id_task", id.Value)
+}
+
+// WatchBoard
+// подписывает пользователя на изменения доски; повторная подписка ничего не меняет
+// или возвращает ошибки apperrors.ErrWatchersNotUpdated, ...
+func (s *PostgreSQLBoardStorage) WatchBoard(ctx context.Context, info dto.BoardWatcherInfo) error {
+	return watch(ctx, s.db, "PostgreSQLBoardStorage.WatchBoard", "public.board_watcher", "id_board", info.BoardID, info.UserID)
+}
+
+// UnwatchBoard
+// отписывает пользователя от изменений доски
+// или возвращает ошибки apperrors.ErrNotWatching, apperrors.ErrWatchersNotUpdated, ...
+func (s *PostgreSQLBoardStorage) UnwatchBoard(ctx context.Context, info dto.BoardWatcherInfo) error {
+	return unwatch(ctx, s.db, "PostgreSQLBoardStorage.UnwatchBoard", "public.board_watcher", "id_board", info.BoardID, info.UserID)
+}
+
+// ReadBoardWatchers
+// возвращает наблюдателей доски в порядке подписки
+// или возвращает ошибки apperrors.ErrCouldNotGetWatchers, ...
+func (s *PostgreSQLBoardStorage) ReadBoardWatchers(ctx context.Context, id dto.BoardID) (*[]dto.UserPublicInfo, error) {
+	return readWatchers(ctx, s.db, "PostgreSQLBoardStorage.ReadBoardWatchers", "public.board_watcher", "id_board", id.Value)
+}
+
+// watch
+// добавляет пользователя в таблицу наблюдателей table за объектом id из столбца column
+func watch(ctx context.Context, db *sql.DB, funcName string, table string, column string, id uint64, userID uint64) error {
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Insert(table).
+		Columns(column, "id_user").
+		Values(id, userID).
+		Suffix("ON CONFLICT DO NOTHING").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	if _, err = conn(ctx, db).Exec(query, args...); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrWatchersNotUpdated
+	}
+	logger.DebugFmt("Watcher added", requestID.String(), funcName, nodeName)
+
+	return nil
+}
+
+// unwatch
+// удаляет пользователя из таблицы наблюдателей table за объектом id из столбца column
+func unwatch(ctx context.Context, db *sql.DB, funcName string, table string, column string, id uint64, userID uint64) error {
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Delete(table).
+		Where(sq.Eq{column: id, "id_user": userID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	result, err := conn(ctx, db).Exec(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrWatchersNotUpdated
+	}
+	removed, err := result.RowsAffected()
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrWatchersNotUpdated
+	}
+	if removed == 0 {
+		return apperrors.ErrNotWatching
+	}
+	logger.DebugFmt("Watcher removed", requestID.String(), funcName, nodeName)
+
+	return nil
+}
+
+// readWatchers
+// возвращает пользователей из таблицы наблюдателей table за объектом id из столбца column
+func readWatchers(ctx context.Context, db *sql.DB, funcName string, table string, column string, id uint64) (*[]dto.UserPublicInfo, error) {
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select(allPublicUserFields...).
+		From("public.user").
+		Join(table+" ON "+table+".id_user = public.user.id").
+		Where(sq.Eq{table + "." + column: id}).
+		OrderBy(table+".date_created", "public.user.id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	rows, err := conn(ctx, db).Query(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotGetWatchers
+	}
+	defer rows.Close()
+
+	watchers := []dto.UserPublicInfo{}
+	for rows.Next() {
+		var watcher dto.UserPublicInfo
+		err = rows.Scan(
+			&watcher.ID,
+			&watcher.Email,
+			&watcher.Name,
+			&watcher.Surname,
+			&watcher.Description,
+			&watcher.AvatarURL,
+		)
+		if err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotScanRows
+		}
+		watchers = append(watchers, watcher)
+	}
+	logger.DebugFmt(fmt.Sprintf("Got %d watchers", len(watchers)), requestID.String(), funcName, nodeName)
+
+	return &watchers, nil
+}
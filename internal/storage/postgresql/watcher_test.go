@@ -0,0 +1,120 @@
+package postgresql
+
+import (
+	"context"
+	"regexp"
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresTaskStorage_WatchTask(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	ctx := context.WithValue(
+		context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+		dto.RequestIDKey, uuid.New(),
+	)
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.task_watcher (id_task,id_user) VALUES ($1,$2) ON CONFLICT DO NOTHING")).
+		WithArgs(uint64(1), uint64(5)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	s := NewTaskStorage(db)
+
+	require.NoError(t, s.WatchTask(ctx, dto.TaskWatcherInfo{TaskID: 1, UserID: 5}))
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostgresBoardStorage_UnwatchBoard(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		removed int64
+		err     error
+	}{
+		{
+			name:    "Happy path",
+			removed: 1,
+		},
+		{
+			name:    "Not watching",
+			removed: 0,
+			err:     apperrors.ErrNotWatching,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			mock.ExpectExec(regexp.QuoteMeta("DELETE FROM public.board_watcher WHERE id_board = $1 AND id_user = $2")).
+				WithArgs(uint64(2), uint64(5)).
+				WillReturnResult(sqlmock.NewResult(0, tt.removed))
+
+			s := NewBoardStorage(db)
+
+			require.Equal(t, tt.err, s.UnwatchBoard(ctx, dto.BoardWatcherInfo{BoardID: 2, UserID: 5}))
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPostgresTaskStorage_ReadTaskWatchers(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	ctx := context.WithValue(
+		context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+		dto.RequestIDKey, uuid.New(),
+	)
+
+	name := "Bob"
+	mock.ExpectQuery(regexp.QuoteMeta("FROM public.user JOIN public.task_watcher ON public.task_watcher.id_user = public.user.id " +
+		"WHERE public.task_watcher.id_task = $1 ORDER BY public.task_watcher.date_created, public.user.id")).
+		WithArgs(uint64(1)).
+		WillReturnRows(sqlmock.NewRows(allPublicUserFields).
+			AddRow(5, "bob@example.com", name, nil, nil, nil))
+
+	s := NewTaskStorage(db)
+
+	watchers, err := s.ReadTaskWatchers(ctx, dto.TaskID{Value: 1})
+	require.NoError(t, err)
+	require.Equal(t, &[]dto.UserPublicInfo{{ID: 5, Email: "bob@example.com", Name: &name}}, watchers)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
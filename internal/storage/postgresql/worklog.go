@@ -0,0 +1,346 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"server/internal/apperrors"
+	logger "server/internal/logging"
+	"server/internal/pkg/dto"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// StartTimer
+// запускает таймер пользователя на задании; запущенный ранее таймер пользователя останавливается в той же транзакции.
+// Запуски одного пользователя идут последовательно под блокировкой его строки
+// или возвращает ошибки apperrors.ErrWorklogNotUpdated, ...
+func (s PostgresTaskStorage) StartTimer(ctx context.Context, info dto.TimerInfo) (*dto.WorklogInfo, error) {
+	funcName := "PostgresTaskStorage.StartTimer"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	lockQuery, lockArgs, err := sq.Select("id").
+		From("public.user").
+		Where(sq.Eq{"id": info.UserID}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+lockQuery+"\nwith args\n\t"+fmt.Sprintf("%+v", lockArgs), requestID.String(), funcName, nodeName)
+
+	stopQuery, stopArgs, err := sq.Update("public.worklog").
+		Set("date_stopped", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id_user": info.UserID, "date_stopped": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+stopQuery+"\nwith args\n\t"+fmt.Sprintf("%+v", stopArgs), requestID.String(), funcName, nodeName)
+
+	startQuery, startArgs, err := sq.Insert("public.worklog").
+		Columns("id_task", "id_user").
+		Values(info.TaskID, info.UserID).
+		Suffix("RETURNING " + worklogFields).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+startQuery+"\nwith args\n\t"+fmt.Sprintf("%+v", startArgs), requestID.String(), funcName, nodeName)
+
+	tx, err := beginTx(ctx, s.db)
+	if err != nil {
+		logger.DebugFmt("Failed to start transaction with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotBeginTransaction
+	}
+
+	rollback := func(reason error) error {
+		if err := tx.Rollback(); err != nil {
+			logger.DebugFmt("Transaction rollback failed with error "+err.Error(), requestID.String(), funcName, nodeName)
+			return apperrors.ErrCouldNotRollback
+		}
+		return reason
+	}
+
+	var userID uint64
+	if err = tx.QueryRow(lockQuery, lockArgs...).Scan(&userID); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, rollback(apperrors.ErrWorklogNotUpdated)
+	}
+
+	if _, err = tx.Exec(stopQuery, stopArgs...); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, rollback(apperrors.ErrWorklogNotUpdated)
+	}
+
+	var timer dto.WorklogInfo
+	if err = tx.QueryRow(startQuery, startArgs...).Scan(worklogDest(&timer)...); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, rollback(apperrors.ErrWorklogNotUpdated)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.DebugFmt("Failed to commit changes with error "+err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotCommit
+	}
+	logger.DebugFmt("Timer started", requestID.String(), funcName, nodeName)
+
+	return &timer, nil
+}
+
+// StopTimer
+// останавливает запущенный таймер пользователя и возвращает получившуюся запись
+// или возвращает ошибки apperrors.ErrTimerNotRunning, apperrors.ErrWorklogNotUpdated, ...
+func (s PostgresTaskStorage) StopTimer(ctx context.Context, info dto.TimerStopInfo) (*dto.WorklogInfo, error) {
+	funcName := "PostgresTaskStorage.StopTimer"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	builder := sq.Update("public.worklog").
+		Set("date_stopped", sq.Expr("CURRENT_TIMESTAMP"))
+	if info.Note != nil {
+		builder = builder.Set("note", *info.Note)
+	}
+	query, args, err := builder.
+		Where(sq.Eq{"id_user": info.UserID, "date_stopped": nil}).
+		Suffix("RETURNING " + worklogFields).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	var entry dto.WorklogInfo
+	err = conn(ctx, s.db).QueryRow(query, args...).Scan(worklogDest(&entry)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrTimerNotRunning
+	}
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrWorklogNotUpdated
+	}
+	logger.DebugFmt("Timer stopped", requestID.String(), funcName, nodeName)
+
+	return &entry, nil
+}
+
+// ReadTimer
+// возвращает запущенный таймер пользователя или nil, если таймер не запущен
+// или возвращает ошибки apperrors.ErrCouldNotGetWorklogs, ...
+func (s PostgresTaskStorage) ReadTimer(ctx context.Context, id dto.UserID) (*dto.WorklogInfo, error) {
+	funcName := "PostgresTaskStorage.ReadTimer"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select(worklogFields).
+		From("public.worklog").
+		Where(sq.Eq{"id_user": id.Value, "date_stopped": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	var timer dto.WorklogInfo
+	err = conn(ctx, s.db).QueryRow(query, args...).Scan(worklogDest(&timer)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotGetWorklogs
+	}
+
+	return &timer, nil
+}
+
+// AddWorklog
+// сохраняет запись учёта времени, внесённую вручную, с началом info.Start и длительностью info.DurationSeconds
+// или возвращает ошибки apperrors.ErrWorklogNotUpdated, ...
+func (s PostgresTaskStorage) AddWorklog(ctx context.Context, info dto.NewWorklogInfo) (*dto.WorklogInfo, error) {
+	funcName := "PostgresTaskStorage.AddWorklog"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Insert("public.worklog").
+		Columns("id_task", "id_user", "date_started", "date_stopped", "note").
+		Values(info.TaskID, info.UserID, *info.Start, info.Start.Add(time.Duration(info.DurationSeconds)*time.Second), info.Note).
+		Suffix("RETURNING " + worklogFields).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	var entry dto.WorklogInfo
+	if err = conn(ctx, s.db).QueryRow(query, args...).Scan(worklogDest(&entry)...); err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrWorklogNotUpdated
+	}
+	logger.DebugFmt("Worklog saved", requestID.String(), funcName, nodeName)
+
+	return &entry, nil
+}
+
+// DeleteWorklog
+// удаляет запись учёта времени, если её внёс пользователь info.UserID
+// или возвращает ошибки apperrors.ErrWorklogNotFound, apperrors.ErrWorklogNotUpdated, ...
+func (s PostgresTaskStorage) DeleteWorklog(ctx context.Context, info dto.WorklogDeleteInfo) error {
+	funcName := "PostgresTaskStorage.DeleteWorklog"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Delete("public.worklog").
+		Where(sq.Eq{"id": info.ID, "id_user": info.UserID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	result, err := conn(ctx, s.db).Exec(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrWorklogNotUpdated
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return apperrors.ErrWorklogNotUpdated
+	}
+	if deleted == 0 {
+		return apperrors.ErrWorklogNotFound
+	}
+	logger.DebugFmt("Worklog deleted", requestID.String(), funcName, nodeName)
+
+	return nil
+}
+
+// ReadWorklogs
+// возвращает записи учёта времени по заданию в порядке начала, включая запущенные таймеры
+// или возвращает ошибки apperrors.ErrCouldNotGetWorklogs, ...
+func (s PostgresTaskStorage) ReadWorklogs(ctx context.Context, id dto.TaskID) (*[]dto.WorklogInfo, error) {
+	funcName := "PostgresTaskStorage.ReadWorklogs"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	query, args, err := sq.Select(worklogFields).
+		From("public.worklog").
+		Where(sq.Eq{"id_task": id.Value}).
+		OrderBy("date_started", "id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	rows, err := conn(ctx, s.db).Query(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotGetWorklogs
+	}
+	defer rows.Close()
+
+	entries := []dto.WorklogInfo{}
+	for rows.Next() {
+		var entry dto.WorklogInfo
+		if err = rows.Scan(worklogDest(&entry)...); err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotScanRows
+		}
+		entries = append(entries, entry)
+	}
+	logger.DebugFmt(fmt.Sprintf("Got %d worklogs", len(entries)), requestID.String(), funcName, nodeName)
+
+	return &entries, nil
+}
+
+// ReadTimeReport
+// возвращает время по заданиям доски за период по дням в часовом поясе filter.Timezone, пользователям и заданиям.
+// Учитываются завершённые записи, начатые в этом периоде
+// или возвращает ошибки apperrors.ErrCouldNotGetWorklogs, ...
+func (s *PostgreSQLBoardStorage) ReadTimeReport(ctx context.Context, filter dto.TimeReportRequest) (*[]dto.TimeReportRow, error) {
+	funcName := "PostgreSQLBoardStorage.ReadTimeReport"
+	logger := ctx.Value(dto.LoggerKey).(logger.ILogger)
+	requestID := ctx.Value(dto.RequestIDKey).(uuid.UUID)
+
+	builder := sq.Select().
+		Column(sq.Expr("to_char(public.worklog.date_started AT TIME ZONE ?, 'YYYY-MM-DD') AS day", filter.Timezone)).
+		Columns(timeReportFields...).
+		From("public.worklog").
+		Join("public.task ON public.task.id = public.worklog.id_task").
+		Join("public.list ON public.list.id = public.task.id_list").
+		Join("public.user ON public.user.id = public.worklog.id_user").
+		Where(sq.Eq{"public.list.id_board": filter.BoardID}).
+		Where(sq.NotEq{"public.worklog.date_stopped": nil}).
+		Where(sq.GtOrEq{"public.worklog.date_started": filter.From}).
+		Where(sq.Lt{"public.worklog.date_started": filter.To})
+	if filter.UserID != nil {
+		builder = builder.Where(sq.Eq{"public.worklog.id_user": *filter.UserID})
+	}
+	query, args, err := builder.
+		GroupBy("day", "public.user.id", "public.task.id").
+		OrderBy("day", "public.user.id", "public.task.id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, apperrors.ErrCouldNotBuildQuery
+	}
+	logger.DebugFmt("Built query\n\t"+query+"\nwith args\n\t"+fmt.Sprintf("%+v", args), requestID.String(), funcName, nodeName)
+
+	rows, err := conn(ctx, s.db).Query(query, args...)
+	if err != nil {
+		logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+		return nil, apperrors.ErrCouldNotGetWorklogs
+	}
+	defer rows.Close()
+
+	report := []dto.TimeReportRow{}
+	for rows.Next() {
+		var row dto.TimeReportRow
+		err = rows.Scan(
+			&row.Date,
+			&row.UserID,
+			&row.Email,
+			&row.Name,
+			&row.Surname,
+			&row.TaskID,
+			&row.TaskName,
+			&row.Seconds,
+		)
+		if err != nil {
+			logger.DebugFmt(err.Error(), requestID.String(), funcName, nodeName)
+			return nil, apperrors.ErrCouldNotScanRows
+		}
+		report = append(report, row)
+	}
+	logger.DebugFmt(fmt.Sprintf("Got %d time report rows", len(report)), requestID.String(), funcName, nodeName)
+
+	return &report, nil
+}
+
+// worklogDest
+// возвращает приёмники для полей worklogFields
+func worklogDest(entry *dto.WorklogInfo) []interface{} {
+	return []interface{}{
+		&entry.ID,
+		&entry.TaskID,
+		&entry.UserID,
+		&entry.Start,
+		&entry.End,
+		&entry.DurationSeconds,
+		&entry.Note,
+	}
+}
@@ -0,0 +1,316 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"server/internal/apperrors"
+	"server/internal/pkg/dto"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+var worklogColumns = []string{"id", "id_task", "id_user", "date_started", "date_stopped", "duration", "note"}
+
+func TestPostgresTaskStorage_StartTimer(t *testing.T) {
+	t.Parallel()
+
+	info := dto.TimerInfo{TaskID: 1, UserID: 2}
+	started := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	lockQuery := "SELECT id FROM public.user WHERE id = $1 FOR UPDATE"
+	stopQuery := "UPDATE public.worklog SET date_stopped = CURRENT_TIMESTAMP WHERE date_stopped IS NULL AND id_user = $1"
+	startQuery := "INSERT INTO public.worklog (id_task,id_user) VALUES ($1,$2) RETURNING " + worklogFields
+	tests := []struct {
+		name  string
+		query func(mock sqlmock.Sqlmock)
+		want  *dto.WorklogInfo
+		err   error
+	}{
+		{
+			name: "Happy path",
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(lockQuery)).
+					WithArgs(info.UserID).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(info.UserID))
+				mock.ExpectExec(regexp.QuoteMeta(stopQuery)).
+					WithArgs(info.UserID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(regexp.QuoteMeta(startQuery)).
+					WithArgs(info.TaskID, info.UserID).
+					WillReturnRows(sqlmock.NewRows(worklogColumns).AddRow(3, info.TaskID, info.UserID, started, nil, 0, nil))
+				mock.ExpectCommit()
+			},
+			want: &dto.WorklogInfo{ID: 3, TaskID: info.TaskID, UserID: info.UserID, Start: started},
+		},
+		{
+			name: "Insert failed",
+			query: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(lockQuery)).
+					WithArgs(info.UserID).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(info.UserID))
+				mock.ExpectExec(regexp.QuoteMeta(stopQuery)).
+					WithArgs(info.UserID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(regexp.QuoteMeta(startQuery)).
+					WithArgs(info.TaskID, info.UserID).
+					WillReturnError(apperrors.ErrWorklogNotUpdated)
+				mock.ExpectRollback()
+			},
+			err: apperrors.ErrWorklogNotUpdated,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			tt.query(mock)
+
+			s := NewTaskStorage(db)
+
+			timer, err := s.StartTimer(ctx, info)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, timer)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPostgresTaskStorage_StopTimer(t *testing.T) {
+	t.Parallel()
+
+	note := "созвон с клиентом"
+	started := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	stopped := started.Add(90 * time.Minute)
+	tests := []struct {
+		name  string
+		info  dto.TimerStopInfo
+		query string
+		args  []driver.Value
+		rows  *sqlmock.Rows
+		want  *dto.WorklogInfo
+		err   error
+	}{
+		{
+			name:  "Happy path",
+			info:  dto.TimerStopInfo{UserID: 2},
+			query: "UPDATE public.worklog SET date_stopped = CURRENT_TIMESTAMP WHERE date_stopped IS NULL AND id_user = $1 RETURNING",
+			args:  []driver.Value{2},
+			rows:  sqlmock.NewRows(worklogColumns).AddRow(3, 1, 2, started, stopped, 5400, nil),
+			want:  &dto.WorklogInfo{ID: 3, TaskID: 1, UserID: 2, Start: started, End: &stopped, DurationSeconds: 5400},
+		},
+		{
+			name:  "With note",
+			info:  dto.TimerStopInfo{UserID: 2, Note: &note},
+			query: "UPDATE public.worklog SET date_stopped = CURRENT_TIMESTAMP, note = $1 WHERE date_stopped IS NULL AND id_user = $2 RETURNING",
+			args:  []driver.Value{note, 2},
+			rows:  sqlmock.NewRows(worklogColumns).AddRow(3, 1, 2, started, stopped, 5400, note),
+			want:  &dto.WorklogInfo{ID: 3, TaskID: 1, UserID: 2, Start: started, End: &stopped, DurationSeconds: 5400, Note: &note},
+		},
+		{
+			name:  "Timer not running",
+			info:  dto.TimerStopInfo{UserID: 2},
+			query: "UPDATE public.worklog SET date_stopped = CURRENT_TIMESTAMP WHERE date_stopped IS NULL AND id_user = $1 RETURNING",
+			args:  []driver.Value{2},
+			rows:  sqlmock.NewRows(worklogColumns),
+			err:   apperrors.ErrTimerNotRunning,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			mock.ExpectQuery(regexp.QuoteMeta(tt.query)).
+				WithArgs(tt.args...).
+				WillReturnRows(tt.rows)
+
+			s := NewTaskStorage(db)
+
+			entry, err := s.StopTimer(ctx, tt.info)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, entry)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPostgresTaskStorage_AddWorklog(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+	info := dto.NewWorklogInfo{TaskID: 1, UserID: 2, Start: &start, DurationSeconds: 7200}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	ctx := context.WithValue(
+		context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+		dto.RequestIDKey, uuid.New(),
+	)
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO public.worklog (id_task,id_user,date_started,date_stopped,note) VALUES ($1,$2,$3,$4,$5) RETURNING")).
+		WithArgs(info.TaskID, info.UserID, start, end, nil).
+		WillReturnRows(sqlmock.NewRows(worklogColumns).AddRow(3, 1, 2, start, end, 7200, nil))
+
+	s := NewTaskStorage(db)
+
+	entry, err := s.AddWorklog(ctx, info)
+	require.NoError(t, err)
+	require.Equal(t, &dto.WorklogInfo{ID: 3, TaskID: 1, UserID: 2, Start: start, End: &end, DurationSeconds: 7200}, entry)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPostgresTaskStorage_DeleteWorklog(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		deleted int64
+		err     error
+	}{
+		{
+			name:    "Happy path",
+			deleted: 1,
+		},
+		{
+			name:    "Not own worklog",
+			deleted: 0,
+			err:     apperrors.ErrWorklogNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			mock.ExpectExec(regexp.QuoteMeta("DELETE FROM public.worklog WHERE id = $1 AND id_user = $2")).
+				WithArgs(3, 2).
+				WillReturnResult(sqlmock.NewResult(0, tt.deleted))
+
+			s := NewTaskStorage(db)
+
+			require.Equal(t, tt.err, s.DeleteWorklog(ctx, dto.WorklogDeleteInfo{ID: 3, UserID: 2}))
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPostgreSQLBoardStorage_ReadTimeReport(t *testing.T) {
+	t.Parallel()
+
+	userID := uint64(2)
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	name := "Иван"
+	tests := []struct {
+		name   string
+		filter dto.TimeReportRequest
+		where  string
+		args   []driver.Value
+	}{
+		{
+			name:   "Whole board",
+			filter: dto.TimeReportRequest{BoardID: 1, From: from, To: to, Timezone: "Europe/Moscow"},
+			where:  "public.worklog.date_started < $4 GROUP BY day",
+			args:   []driver.Value{"Europe/Moscow", 1, from, to},
+		},
+		{
+			name:   "Single user",
+			filter: dto.TimeReportRequest{BoardID: 1, UserID: &userID, From: from, To: to, Timezone: "Europe/Moscow"},
+			where:  "public.worklog.date_started < $4 AND public.worklog.id_user = $5 GROUP BY day",
+			args:   []driver.Value{"Europe/Moscow", 1, from, to, userID},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer db.Close()
+
+			ctx := context.WithValue(
+				context.WithValue(context.Background(), dto.LoggerKey, getLogger()),
+				dto.RequestIDKey, uuid.New(),
+			)
+
+			mock.ExpectQuery(regexp.QuoteMeta("to_char(public.worklog.date_started AT TIME ZONE $1, 'YYYY-MM-DD') AS day") + ".*" + regexp.QuoteMeta(tt.where)).
+				WithArgs(tt.args...).
+				WillReturnRows(sqlmock.NewRows([]string{"day", "id", "email", "name", "surname", "task_id", "task_name", "seconds"}).
+					AddRow("2024-03-01", userID, "ivan@example.com", name, nil, 5, "Вёрстка", 3600))
+
+			s := NewBoardStorage(db)
+
+			report, err := s.ReadTimeReport(ctx, tt.filter)
+			require.NoError(t, err)
+			require.Equal(t, &[]dto.TimeReportRow{{
+				Date:     "2024-03-01",
+				UserID:   userID,
+				Email:    "ivan@example.com",
+				Name:     &name,
+				TaskID:   5,
+				TaskName: "Вёрстка",
+				Seconds:  3600,
+			}}, report)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
@@ -19,6 +19,9 @@ type Storages struct {
 	CSATAnswer    ICSATAnswerStorage
 	CSATQuestion  ICSATQuestionStorage
 	Tag           ITagStorage
+	History       IHistoryStorage
+	CustomField   ICustomFieldStorage
+	Notification  INotificationStorage
 }
 
 func NewPostgresStorages(db *sql.DB) *Storages {
@@ -36,5 +39,8 @@ func NewPostgresStorages(db *sql.DB) *Storages {
 		CSATAnswer:    postgresql.NewCSATAnswerStorage(db),
 		CSATQuestion:  postgresql.NewCSATQuestionStorage(db),
 		Tag:           postgresql.NewTagStorage(db),
+		History:       postgresql.NewHistoryStorage(db),
+		CustomField:   postgresql.NewCustomFieldStorage(db),
+		Notification:  postgresql.NewNotificationStorage(db),
 	}
 }
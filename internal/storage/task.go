@@ -12,31 +12,51 @@ import (
 type ITaskStorage interface {
 	// Create
 	// создает новую задачу по данным
-	// или возвращает ошибки ...
+	// или возвращает ошибки apperrors.ErrWipLimitExceeded, ...
 	Create(context.Context, dto.NewTaskInfo) (*entities.Task, error)
 	// Read
 	// возвращает заание с привязанными пользователями
 	// или возвращает ошибки ...
 	Read(context.Context, dto.TaskID) (*dto.SingleTaskInfo, error)
 	// ReadMany
-	// возвращает задания
+	// возвращает задания из списка id, подходящие под условия отбора
 	// или возвращает ошибки ...
-	ReadMany(context.Context, dto.TaskIDs) (*[]dto.SingleTaskInfo, error)
+	ReadMany(context.Context, dto.TaskFilter) (*[]dto.SingleTaskInfo, error)
 	// CheckAccess
 	// находит пользователя в задании
 	// или возвращает ошибки ...
 	CheckAccess(context.Context, dto.CheckTaskAccessInfo) (bool, error)
 	// Update
-	// обновляет задачу
+	// обновляет задачу, если её версия не изменилась
 	// или возвращает ошибки ...
 	Update(context.Context, dto.UpdatedTaskInfo) error
 	// Delete
 	// удаляет задачу
 	// или возвращает ошибки ...
 	Delete(context.Context, dto.TaskID) error
-	// Move
-	// переносит задание в другой список
+	// Archive
+	// переносит задание в архив
+	// или возвращает ошибки ...
+	Archive(context.Context, dto.TaskID) error
+	// Unarchive
+	// возвращает задание из архива
 	// или возвращает ошибки ...
+	Unarchive(context.Context, dto.TaskID) error
+	// MoveToBoard
+	// переносит задание в список другой доски и приводит его тэги, исполнителей и значения полей к этой доске
+	// или возвращает ошибки apperrors.ErrWipLimitExceeded, ...
+	MoveToBoard(context.Context, dto.TaskTransferInfo) (*dto.TaskTransferReport, error)
+	// Copy
+	// копирует задание с выбранными частями в список и приводит копию к доске этого списка
+	// или возвращает ошибки apperrors.ErrWipLimitExceeded, ...
+	Copy(context.Context, dto.TaskCopyInfo) (*dto.TaskTransferReport, error)
+	// Bulk
+	// в одной транзакции применяет операцию к заданиям одной доски, проверяя доступ к каждому из них
+	// или возвращает ошибки apperrors.ErrWipLimitExceeded, ...
+	Bulk(context.Context, dto.TaskBulkInfo) error
+	// Move
+	// ставит задание в список после другого задания этого списка, меняя ключ порядка только у него
+	// или возвращает ошибки apperrors.ErrWipLimitExceeded, ...
 	Move(context.Context, dto.TaskMoveInfo) error
 	// AddUser
 	// добавляет пользователя в карточку
@@ -58,4 +78,84 @@ type ITaskStorage interface {
 	// добавляет файл в задание
 	// или возвращает ошибки ...
 	RemoveFile(context.Context, dto.RemoveFileInfo) error
+	// FileInUse
+	// проверяет, ссылается ли ещё какое-нибудь задание на файл по этому пути
+	// или возвращает ошибки ...
+	FileInUse(context.Context, string) (bool, error)
+	// SetReminders
+	// заменяет смещения напоминаний о сроке задания и возвращает прежние
+	// или возвращает ошибки ...
+	SetReminders(context.Context, dto.TaskRemindersInfo) ([]uint64, error)
+	// SetRecurrence
+	// задаёт заданию серию повторений или меняет правило серии, к которой оно относится, и возвращает прежнее правило
+	// или возвращает ошибки ...
+	SetRecurrence(context.Context, dto.TaskRecurrenceInfo) (*string, error)
+	// StopRecurrence
+	// останавливает серию повторений, к которой относится задание, и возвращает её правило
+	// или возвращает ошибки ...
+	StopRecurrence(context.Context, dto.TaskID) (string, error)
+	// ReadDueRecurrences
+	// возвращает серии, которым пора создать следующее задание
+	// или возвращает ошибки ...
+	ReadDueRecurrences(context.Context) (*[]dto.DueRecurrence, error)
+	// GenerateRecurrence
+	// создаёт следующее задание серии и возвращает его id или 0, если серию уже продвинул другой проход
+	// или возвращает ошибки apperrors.ErrWipLimitExceeded, ...
+	GenerateRecurrence(context.Context, dto.RecurrenceInstanceInfo) (uint64, error)
+	// AddDependency
+	// сохраняет зависимость между заданиями, если она не замыкает цикл
+	// или возвращает ошибки ...
+	AddDependency(context.Context, dto.TaskDependencyInfo) error
+	// RemoveDependency
+	// удаляет зависимость между заданиями
+	// или возвращает ошибки ...
+	RemoveDependency(context.Context, dto.TaskDependencyInfo) error
+	// AttachSubtask
+	// делает задание подзадачей другого задания, если это не замыкает цикл
+	// или возвращает ошибки ...
+	AttachSubtask(context.Context, dto.SubtaskInfo) error
+	// DetachSubtask
+	// отвязывает подзадачу от родительского задания
+	// или возвращает ошибки ...
+	DetachSubtask(context.Context, dto.TaskID) error
+	// ReadSubtasks
+	// возвращает id подзадач задания на всех уровнях вложенности
+	// или возвращает ошибки ...
+	ReadSubtasks(context.Context, dto.TaskID) ([]uint64, error)
+	// WatchTask
+	// подписывает пользователя на изменения задания
+	// или возвращает ошибки ...
+	WatchTask(context.Context, dto.TaskWatcherInfo) error
+	// UnwatchTask
+	// отписывает пользователя от изменений задания
+	// или возвращает ошибки ...
+	UnwatchTask(context.Context, dto.TaskWatcherInfo) error
+	// ReadTaskWatchers
+	// возвращает наблюдателей задания
+	// или возвращает ошибки ...
+	ReadTaskWatchers(context.Context, dto.TaskID) (*[]dto.UserPublicInfo, error)
+	// StartTimer
+	// запускает таймер пользователя на задании, останавливая запущенный ранее
+	// или возвращает ошибки ...
+	StartTimer(context.Context, dto.TimerInfo) (*dto.WorklogInfo, error)
+	// StopTimer
+	// останавливает запущенный таймер пользователя
+	// или возвращает ошибки ...
+	StopTimer(context.Context, dto.TimerStopInfo) (*dto.WorklogInfo, error)
+	// ReadTimer
+	// возвращает запущенный таймер пользователя или nil
+	// или возвращает ошибки ...
+	ReadTimer(context.Context, dto.UserID) (*dto.WorklogInfo, error)
+	// AddWorklog
+	// сохраняет запись учёта времени, внесённую вручную
+	// или возвращает ошибки ...
+	AddWorklog(context.Context, dto.NewWorklogInfo) (*dto.WorklogInfo, error)
+	// DeleteWorklog
+	// удаляет запись учёта времени её автора
+	// или возвращает ошибки ...
+	DeleteWorklog(context.Context, dto.WorklogDeleteInfo) error
+	// ReadWorklogs
+	// возвращает записи учёта времени по заданию
+	// или возвращает ошибки ...
+	ReadWorklogs(context.Context, dto.TaskID) (*[]dto.WorklogInfo, error)
 }
@@ -38,6 +38,18 @@ type IUserStorage interface {
 	// обновляет аватарку пользователя в БД
 	// или возвращает ошибки ...
 	UpdateAvatarUrl(context.Context, dto.UserImageUrlInfo) error
+	// GetTimezone
+	// находит в БД часовой пояс, в котором пользователю показываются сроки заданий
+	// или возвращает ошибки ...
+	GetTimezone(context.Context, dto.UserID) (string, error)
+	// UpdateTimezone
+	// обновляет часовой пояс пользователя в БД
+	// или возвращает ошибки ...
+	UpdateTimezone(context.Context, dto.UserTimezoneInfo) error
+	// UpdateReminders
+	// обновляет смещения напоминаний пользователя по умолчанию в БД
+	// или возвращает ошибки ...
+	UpdateReminders(context.Context, dto.UserRemindersInfo) error
 	// Delete
 	// удаляет данного пользователя в БД по id
 	// или возвращает ошибки ...
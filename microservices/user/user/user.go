@@ -9,6 +9,7 @@ import (
 	logger "server/internal/logging"
 	"server/internal/pkg/dto"
 	"server/internal/pkg/entities"
+	"server/internal/pkg/images"
 	"server/internal/storage"
 	"strconv"
 
@@ -17,6 +18,8 @@ import (
 
 const nodeName = "service"
 
+const userAvatarsDir = "img/user_avatars/"
+
 type UserService struct {
 	storage storage.IUserStorage
 	logger  *logger.LogrusLogger
@@ -36,6 +39,8 @@ var UserServiceErrorCodes = map[error]ErrorCode{
 	apperrors.ErrFailedToCreateFile: ErrorCode_FAILED_TO_CREATE_FILE,
 	apperrors.ErrFailedToSaveFile:   ErrorCode_FAILED_TO_SAVE_FILE,
 	apperrors.ErrFailedToDeleteFile: ErrorCode_FAILED_TO_DELETE_FILE,
+	apperrors.ErrInvalidImage:       ErrorCode_INVALID_IMAGE,
+	apperrors.ErrImageTooLarge:      ErrorCode_IMAGE_TOO_LARGE,
 }
 
 // NewUserService
@@ -213,7 +218,8 @@ func (us UserService) UpdateProfile(ctx context.Context, request *UpdateProfileR
 }
 
 // UpdateAvatar
-// обновляет аватарку пользователя
+// обрабатывает загруженное изображение, сохраняет его варианты и обновляет аватарку пользователя,
+// удаляя варианты предыдущей аватарки
 // или возвращает ошибку apperrors.ErrUserNotFound (409)
 func (us UserService) UpdateAvatar(ctx context.Context, request *UpdateAvatarRequest) (*UpdateAvatarResponse, error) {
 	funcName := "UserService.UpdateAvatar"
@@ -226,49 +232,52 @@ func (us UserService) UpdateAvatar(ctx context.Context, request *UpdateAvatarReq
 		dto.RequestIDKey, requestID,
 	)
 
-	fileName := hashFromFileInfo(info.Filename, strconv.FormatUint(info.UserID, 10), info.Mimetype)
-
-	fileLocation := "img/user_avatars/" + fileName + ".png"
-	cwd, _ := os.Getwd()
-	us.logger.DebugFmt("Relative path: "+fileLocation, requestID.String(), funcName, nodeName)
-	us.logger.DebugFmt("CWD: "+cwd, requestID.String(), funcName, nodeName)
-	avatarUrlInfo := dto.UserImageUrlInfo{
-		ID:  info.UserID,
-		Url: fileLocation,
-	}
-	us.logger.DebugFmt("Full URL: "+avatarUrlInfo.Url, requestID.String(), funcName, nodeName)
-	f, err := os.Create(fileLocation)
+	processed, err := images.Process(info.Avatar)
 	if err != nil {
-		us.logger.DebugFmt("Failed to create file with error: "+err.Error(), requestID.String(), funcName, nodeName)
-		response.Code = UserServiceErrorCodes[apperrors.ErrFailedToCreateFile]
+		us.logger.DebugFmt("Failed to process avatar with error: "+err.Error(), requestID.String(), funcName, nodeName)
+		response.Code = UserServiceErrorCodes[err]
 		response.Response = &UrlObj{}
 		return response, nil
 	}
+	us.logger.DebugFmt("Avatar processed, source format "+processed.Format, requestID.String(), funcName, nodeName)
 
-	us.logger.DebugFmt(fmt.Sprintf("Writing %v bytes", len(info.Avatar)), requestID.String(), funcName, nodeName)
-	_, err = f.Write(info.Avatar)
+	dir := userAvatarsDir + strconv.FormatUint(info.UserID, 10)
+	existed := processed.Exists(dir)
+	err = processed.Save(dir)
 	if err != nil {
-		response.Code = UserServiceErrorCodes[apperrors.ErrFailedToSaveFile]
+		us.logger.DebugFmt("Failed to save avatar variants with error: "+err.Error(), requestID.String(), funcName, nodeName)
+		response.Code = UserServiceErrorCodes[err]
 		response.Response = &UrlObj{}
 		return response, nil
 	}
 
-	defer f.Close()
+	avatarUrlInfo := dto.UserImageUrlInfo{
+		ID:  info.UserID,
+		Url: processed.Path(dir, images.VariantMedium),
+	}
+	us.logger.DebugFmt("Full URL: "+avatarUrlInfo.Url, requestID.String(), funcName, nodeName)
 
 	err = us.storage.UpdateAvatarUrl(sCtx, avatarUrlInfo)
 	if err != nil {
-		errDelete := os.Remove(fileLocation)
-		if errDelete != nil {
-			us.logger.DebugFmt("Failed to remove file after unsuccessful update with error: "+err.Error(), requestID.String(), funcName, nodeName)
-			response.Code = UserServiceErrorCodes[apperrors.ErrFailedToDeleteFile]
-			response.Response = &UrlObj{}
-			return response, nil
+		if !existed {
+			errDelete := processed.Remove(dir)
+			if errDelete != nil {
+				us.logger.DebugFmt("Failed to remove file after unsuccessful update with error: "+errDelete.Error(), requestID.String(), funcName, nodeName)
+				response.Code = UserServiceErrorCodes[apperrors.ErrFailedToDeleteFile]
+				response.Response = &UrlObj{}
+				return response, nil
+			}
 		}
 		response.Code = UserServiceErrorCodes[err]
 		response.Response = &UrlObj{}
 		return response, nil
 	}
 
+	err = images.Prune(dir, processed.Hash)
+	if err != nil {
+		us.logger.DebugFmt("Failed to remove previous avatar variants with error: "+err.Error(), requestID.String(), funcName, nodeName)
+	}
+
 	response.Code = UserServiceErrorCodes[nil]
 	response.Response = &UrlObj{Value: avatarUrlInfo.Url}
 
@@ -297,6 +306,11 @@ func (us UserService) DeleteAvatar(ctx context.Context, request *DeleteAvatarReq
 		return response, nil
 	}
 
+	err = images.Prune(userAvatarsDir+strconv.FormatUint(info.UserID, 10), "")
+	if err != nil {
+		us.logger.DebugFmt("Failed to remove avatar variants with error: "+err.Error(), request.RequestID, funcName, nodeName)
+	}
+
 	url := dto.UserImageUrlInfo{
 		ID:  info.UserID,
 		Url: "img/user_avatars/avatar.jpg",
@@ -341,12 +355,6 @@ func hashFromAuthInfo(email string, password string) string {
 	return fmt.Sprintf("%x", hasher.Sum(nil))
 }
 
-func hashFromFileInfo(filename string, id string, mimetype string) string {
-	hasher := sha256.New()
-	hasher.Write([]byte(filename + id + mimetype))
-	return fmt.Sprintf("%x", hasher.Sum(nil))
-}
-
 func convertUser(user *entities.User) *User {
 	convertedUser := User{
 		ID:           user.ID,
@@ -84,6 +84,81 @@ func (mr *MockIBoardServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIBoardService)(nil).Delete), arg0, arg1)
 }
 
+// ExportCSV mocks base method.
+func (m *MockIBoardService) ExportCSV(arg0 context.Context, arg1 dto.IndividualBoardRequest) (*dto.ExportedBoard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCSV", arg0, arg1)
+	ret0, _ := ret[0].(*dto.ExportedBoard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportCSV indicates an expected call of ExportCSV.
+func (mr *MockIBoardServiceMockRecorder) ExportCSV(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCSV", reflect.TypeOf((*MockIBoardService)(nil).ExportCSV), arg0, arg1)
+}
+
+// ExportMarkdown mocks base method.
+func (m *MockIBoardService) ExportMarkdown(arg0 context.Context, arg1 dto.IndividualBoardRequest) (*dto.ExportedBoard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportMarkdown", arg0, arg1)
+	ret0, _ := ret[0].(*dto.ExportedBoard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportMarkdown indicates an expected call of ExportMarkdown.
+func (mr *MockIBoardServiceMockRecorder) ExportMarkdown(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportMarkdown", reflect.TypeOf((*MockIBoardService)(nil).ExportMarkdown), arg0, arg1)
+}
+
+// ExportTimeReportCSV mocks base method.
+func (m *MockIBoardService) ExportTimeReportCSV(arg0 context.Context, arg1 dto.TimeReportRequest) (*dto.ExportedBoard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportTimeReportCSV", arg0, arg1)
+	ret0, _ := ret[0].(*dto.ExportedBoard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportTimeReportCSV indicates an expected call of ExportTimeReportCSV.
+func (mr *MockIBoardServiceMockRecorder) ExportTimeReportCSV(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportTimeReportCSV", reflect.TypeOf((*MockIBoardService)(nil).ExportTimeReportCSV), arg0, arg1)
+}
+
+// GetArchive mocks base method.
+func (m *MockIBoardService) GetArchive(arg0 context.Context, arg1 dto.BoardID) (*dto.BoardArchive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchive", arg0, arg1)
+	ret0, _ := ret[0].(*dto.BoardArchive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchive indicates an expected call of GetArchive.
+func (mr *MockIBoardServiceMockRecorder) GetArchive(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchive", reflect.TypeOf((*MockIBoardService)(nil).GetArchive), arg0, arg1)
+}
+
+// GetChanges mocks base method.
+func (m *MockIBoardService) GetChanges(arg0 context.Context, arg1 dto.BoardChangesRequest) (*dto.BoardChanges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChanges", arg0, arg1)
+	ret0, _ := ret[0].(*dto.BoardChanges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChanges indicates an expected call of GetChanges.
+func (mr *MockIBoardServiceMockRecorder) GetChanges(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChanges", reflect.TypeOf((*MockIBoardService)(nil).GetChanges), arg0, arg1)
+}
+
 // GetFullBoard mocks base method.
 func (m *MockIBoardService) GetFullBoard(arg0 context.Context, arg1 dto.IndividualBoardRequest) (*dto.FullBoardResult, error) {
 	m.ctrl.T.Helper()
@@ -100,7 +175,7 @@ func (mr *MockIBoardServiceMockRecorder) GetFullBoard(arg0, arg1 any) *gomock.Ca
 }
 
 // GetHistory mocks base method.
-func (m *MockIBoardService) GetHistory(arg0 context.Context, arg1 dto.BoardID) (*[]dto.BoardHistoryEntry, error) {
+func (m *MockIBoardService) GetHistory(arg0 context.Context, arg1 dto.BoardHistoryRequest) (*[]dto.BoardHistoryEntry, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetHistory", arg0, arg1)
 	ret0, _ := ret[0].(*[]dto.BoardHistoryEntry)
@@ -114,6 +189,66 @@ func (mr *MockIBoardServiceMockRecorder) GetHistory(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockIBoardService)(nil).GetHistory), arg0, arg1)
 }
 
+// GetStats mocks base method.
+func (m *MockIBoardService) GetStats(arg0 context.Context, arg1 dto.BoardStatsRequest) (*dto.BoardStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStats", arg0, arg1)
+	ret0, _ := ret[0].(*dto.BoardStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStats indicates an expected call of GetStats.
+func (mr *MockIBoardServiceMockRecorder) GetStats(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStats", reflect.TypeOf((*MockIBoardService)(nil).GetStats), arg0, arg1)
+}
+
+// GetTimeReport mocks base method.
+func (m *MockIBoardService) GetTimeReport(arg0 context.Context, arg1 dto.TimeReportRequest) (*dto.TimeReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeReport", arg0, arg1)
+	ret0, _ := ret[0].(*dto.TimeReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimeReport indicates an expected call of GetTimeReport.
+func (mr *MockIBoardServiceMockRecorder) GetTimeReport(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeReport", reflect.TypeOf((*MockIBoardService)(nil).GetTimeReport), arg0, arg1)
+}
+
+// GetVersion mocks base method.
+func (m *MockIBoardService) GetVersion(arg0 context.Context, arg1 dto.BoardID) (*dto.BoardVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersion", arg0, arg1)
+	ret0, _ := ret[0].(*dto.BoardVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersion indicates an expected call of GetVersion.
+func (mr *MockIBoardServiceMockRecorder) GetVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockIBoardService)(nil).GetVersion), arg0, arg1)
+}
+
+// GetWatchers mocks base method.
+func (m *MockIBoardService) GetWatchers(arg0 context.Context, arg1 dto.BoardID) (*[]dto.UserPublicInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWatchers", arg0, arg1)
+	ret0, _ := ret[0].(*[]dto.UserPublicInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWatchers indicates an expected call of GetWatchers.
+func (mr *MockIBoardServiceMockRecorder) GetWatchers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatchers", reflect.TypeOf((*MockIBoardService)(nil).GetWatchers), arg0, arg1)
+}
+
 // RemoveUser mocks base method.
 func (m *MockIBoardService) RemoveUser(arg0 context.Context, arg1 dto.RemoveBoardUserInfo) error {
 	m.ctrl.T.Helper()
@@ -128,28 +263,43 @@ func (mr *MockIBoardServiceMockRecorder) RemoveUser(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUser", reflect.TypeOf((*MockIBoardService)(nil).RemoveUser), arg0, arg1)
 }
 
-// SubmitEdit mocks base method.
-func (m *MockIBoardService) SubmitEdit(arg0 context.Context, arg1 dto.NewHistoryEntry) error {
+// RevertHistory mocks base method.
+func (m *MockIBoardService) RevertHistory(arg0 context.Context, arg1 dto.HistoryEntryID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SubmitEdit", arg0, arg1)
+	ret := m.ctrl.Call(m, "RevertHistory", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SubmitEdit indicates an expected call of SubmitEdit.
-func (mr *MockIBoardServiceMockRecorder) SubmitEdit(arg0, arg1 any) *gomock.Call {
+// RevertHistory indicates an expected call of RevertHistory.
+func (mr *MockIBoardServiceMockRecorder) RevertHistory(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitEdit", reflect.TypeOf((*MockIBoardService)(nil).SubmitEdit), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertHistory", reflect.TypeOf((*MockIBoardService)(nil).RevertHistory), arg0, arg1)
 }
 
-// UpdateData mocks base method.
-func (m *MockIBoardService) UpdateData(arg0 context.Context, arg1 dto.UpdatedBoardInfo) error {
+// Unwatch mocks base method.
+func (m *MockIBoardService) Unwatch(arg0 context.Context, arg1 dto.BoardID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateData", arg0, arg1)
+	ret := m.ctrl.Call(m, "Unwatch", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// Unwatch indicates an expected call of Unwatch.
+func (mr *MockIBoardServiceMockRecorder) Unwatch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unwatch", reflect.TypeOf((*MockIBoardService)(nil).Unwatch), arg0, arg1)
+}
+
+// UpdateData mocks base method.
+func (m *MockIBoardService) UpdateData(arg0 context.Context, arg1 dto.UpdatedBoardInfo) (*dto.SingleBoardInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateData", arg0, arg1)
+	ret0, _ := ret[0].(*dto.SingleBoardInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // UpdateData indicates an expected call of UpdateData.
 func (mr *MockIBoardServiceMockRecorder) UpdateData(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
@@ -170,3 +320,17 @@ func (mr *MockIBoardServiceMockRecorder) UpdateThumbnail(arg0, arg1 any) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateThumbnail", reflect.TypeOf((*MockIBoardService)(nil).UpdateThumbnail), arg0, arg1)
 }
+
+// Watch mocks base method.
+func (m *MockIBoardService) Watch(arg0 context.Context, arg1 dto.BoardID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockIBoardServiceMockRecorder) Watch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockIBoardService)(nil).Watch), arg0, arg1)
+}
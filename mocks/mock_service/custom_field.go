@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: P:\VK Образование\Web\Sem_2\Project\2023_2_yablonka\internal\service\custom_field.go
+//
+// Generated by this command:
+//
+//	mockgen.exe --source=P:\VK Образование\Web\Sem_2\Project\2023_2_yablonka\internal\service\custom_field.go --destination=./mocks/mock_service/custom_field.go --package=mock_service
+//
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+	dto "server/internal/pkg/dto"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockICustomFieldService is a mock of ICustomFieldService interface.
+type MockICustomFieldService struct {
+	ctrl     *gomock.Controller
+	recorder *MockICustomFieldServiceMockRecorder
+}
+
+// MockICustomFieldServiceMockRecorder is the mock recorder for MockICustomFieldService.
+type MockICustomFieldServiceMockRecorder struct {
+	mock *MockICustomFieldService
+}
+
+// NewMockICustomFieldService creates a new mock instance.
+func NewMockICustomFieldService(ctrl *gomock.Controller) *MockICustomFieldService {
+	mock := &MockICustomFieldService{ctrl: ctrl}
+	mock.recorder = &MockICustomFieldServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICustomFieldService) EXPECT() *MockICustomFieldServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockICustomFieldService) Create(arg0 context.Context, arg1 dto.NewCustomFieldInfo) (*dto.CustomFieldInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*dto.CustomFieldInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockICustomFieldServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockICustomFieldService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockICustomFieldService) Delete(arg0 context.Context, arg1 dto.CustomFieldID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockICustomFieldServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockICustomFieldService)(nil).Delete), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockICustomFieldService) Update(arg0 context.Context, arg1 dto.UpdatedCustomFieldInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockICustomFieldServiceMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockICustomFieldService)(nil).Update), arg0, arg1)
+}
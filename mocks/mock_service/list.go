@@ -40,6 +40,49 @@ func (m *MockIListService) EXPECT() *MockIListServiceMockRecorder {
 	return m.recorder
 }
 
+// Archive mocks base method.
+func (m *MockIListService) Archive(arg0 context.Context, arg1 dto.ListID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockIListServiceMockRecorder) Archive(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockIListService)(nil).Archive), arg0, arg1)
+}
+
+// ArchiveTasks mocks base method.
+func (m *MockIListService) ArchiveTasks(arg0 context.Context, arg1 dto.ListID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveTasks", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveTasks indicates an expected call of ArchiveTasks.
+func (mr *MockIListServiceMockRecorder) ArchiveTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveTasks", reflect.TypeOf((*MockIListService)(nil).ArchiveTasks), arg0, arg1)
+}
+
+// Copy mocks base method.
+func (m *MockIListService) Copy(arg0 context.Context, arg1 dto.ListTransferInfo) (*dto.ListTransferReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", arg0, arg1)
+	ret0, _ := ret[0].(*dto.ListTransferReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Copy indicates an expected call of Copy.
+func (mr *MockIListServiceMockRecorder) Copy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockIListService)(nil).Copy), arg0, arg1)
+}
+
 // Create mocks base method.
 func (m *MockIListService) Create(arg0 context.Context, arg1 dto.NewListInfo) (*entities.List, error) {
 	m.ctrl.T.Helper()
@@ -69,14 +112,59 @@ func (mr *MockIListServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIListService)(nil).Delete), arg0, arg1)
 }
 
-// Update mocks base method.
-func (m *MockIListService) Update(arg0 context.Context, arg1 dto.UpdatedListInfo) error {
+// Move mocks base method.
+func (m *MockIListService) Move(arg0 context.Context, arg1 dto.ListTransferInfo) (*dto.ListTransferReport, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret := m.ctrl.Call(m, "Move", arg0, arg1)
+	ret0, _ := ret[0].(*dto.ListTransferReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockIListServiceMockRecorder) Move(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockIListService)(nil).Move), arg0, arg1)
+}
+
+// SortTasks mocks base method.
+func (m *MockIListService) SortTasks(arg0 context.Context, arg1 dto.ListSortInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SortTasks", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// SortTasks indicates an expected call of SortTasks.
+func (mr *MockIListServiceMockRecorder) SortTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SortTasks", reflect.TypeOf((*MockIListService)(nil).SortTasks), arg0, arg1)
+}
+
+// Unarchive mocks base method.
+func (m *MockIListService) Unarchive(arg0 context.Context, arg1 dto.ListID) (*dto.WipLimitWarning, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unarchive", arg0, arg1)
+	ret0, _ := ret[0].(*dto.WipLimitWarning)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unarchive indicates an expected call of Unarchive.
+func (mr *MockIListServiceMockRecorder) Unarchive(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unarchive", reflect.TypeOf((*MockIListService)(nil).Unarchive), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockIListService) Update(arg0 context.Context, arg1 dto.UpdatedListInfo) (*dto.SingleListInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(*dto.SingleListInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // Update indicates an expected call of Update.
 func (mr *MockIListServiceMockRecorder) Update(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
@@ -84,7 +172,7 @@ func (mr *MockIListServiceMockRecorder) Update(arg0, arg1 any) *gomock.Call {
 }
 
 // UpdateOrder mocks base method.
-func (m *MockIListService) UpdateOrder(arg0 context.Context, arg1 dto.ListIDs) error {
+func (m *MockIListService) UpdateOrder(arg0 context.Context, arg1 dto.ListMoveInfo) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateOrder", arg0, arg1)
 	ret0, _ := ret[0].(error)
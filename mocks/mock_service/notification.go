@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: P:\VK Образование\Web\Sem_2\Project\2023_2_yablonka\internal\service\notification.go
+//
+// Generated by this command:
+//
+//	mockgen.exe --source=P:\VK Образование\Web\Sem_2\Project\2023_2_yablonka\internal\service\notification.go --destination=./mocks/mock_service/notification.go --package=mock_service
+//
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+	dto "server/internal/pkg/dto"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockINotificationService is a mock of INotificationService interface.
+type MockINotificationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockINotificationServiceMockRecorder
+}
+
+// MockINotificationServiceMockRecorder is the mock recorder for MockINotificationService.
+type MockINotificationServiceMockRecorder struct {
+	mock *MockINotificationService
+}
+
+// NewMockINotificationService creates a new mock instance.
+func NewMockINotificationService(ctrl *gomock.Controller) *MockINotificationService {
+	mock := &MockINotificationService{ctrl: ctrl}
+	mock.recorder = &MockINotificationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockINotificationService) EXPECT() *MockINotificationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetNotifications mocks base method.
+func (m *MockINotificationService) GetNotifications(arg0 context.Context, arg1 dto.NotificationsRequest) (*[]dto.NotificationInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", arg0, arg1)
+	ret0, _ := ret[0].(*[]dto.NotificationInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockINotificationServiceMockRecorder) GetNotifications(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockINotificationService)(nil).GetNotifications), arg0, arg1)
+}
+
+// MarkRead mocks base method.
+func (m *MockINotificationService) MarkRead(arg0 context.Context, arg1 dto.NotificationsReadInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockINotificationServiceMockRecorder) MarkRead(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockINotificationService)(nil).MarkRead), arg0, arg1)
+}
@@ -54,6 +54,35 @@ func (mr *MockITaskServiceMockRecorder) AddUser(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUser", reflect.TypeOf((*MockITaskService)(nil).AddUser), arg0, arg1)
 }
 
+// AddWorklog mocks base method.
+func (m *MockITaskService) AddWorklog(arg0 context.Context, arg1 dto.NewWorklogInfo) (*dto.WorklogInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorklog", arg0, arg1)
+	ret0, _ := ret[0].(*dto.WorklogInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorklog indicates an expected call of AddWorklog.
+func (mr *MockITaskServiceMockRecorder) AddWorklog(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorklog", reflect.TypeOf((*MockITaskService)(nil).AddWorklog), arg0, arg1)
+}
+
+// Archive mocks base method.
+func (m *MockITaskService) Archive(arg0 context.Context, arg1 dto.TaskCascadeInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockITaskServiceMockRecorder) Archive(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockITaskService)(nil).Archive), arg0, arg1)
+}
+
 // Attach mocks base method.
 func (m *MockITaskService) Attach(arg0 context.Context, arg1 dto.NewFileInfo) (*dto.AttachedFileInfo, error) {
 	m.ctrl.T.Helper()
@@ -69,13 +98,59 @@ func (mr *MockITaskServiceMockRecorder) Attach(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attach", reflect.TypeOf((*MockITaskService)(nil).Attach), arg0, arg1)
 }
 
+// AttachSubtask mocks base method.
+func (m *MockITaskService) AttachSubtask(arg0 context.Context, arg1 dto.SubtaskInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachSubtask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachSubtask indicates an expected call of AttachSubtask.
+func (mr *MockITaskServiceMockRecorder) AttachSubtask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachSubtask", reflect.TypeOf((*MockITaskService)(nil).AttachSubtask), arg0, arg1)
+}
+
+// Bulk mocks base method.
+func (m *MockITaskService) Bulk(arg0 context.Context, arg1 dto.TaskBulkRequest) (*dto.WipLimitWarning, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bulk", arg0, arg1)
+	ret0, _ := ret[0].(*dto.WipLimitWarning)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Bulk indicates an expected call of Bulk.
+func (mr *MockITaskServiceMockRecorder) Bulk(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bulk", reflect.TypeOf((*MockITaskService)(nil).Bulk), arg0, arg1)
+}
+
+// Copy mocks base method.
+func (m *MockITaskService) Copy(arg0 context.Context, arg1 dto.TaskCopyInfo) (*dto.TaskTransferReport, *dto.WipLimitWarning, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", arg0, arg1)
+	ret0, _ := ret[0].(*dto.TaskTransferReport)
+	ret1, _ := ret[1].(*dto.WipLimitWarning)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Copy indicates an expected call of Copy.
+func (mr *MockITaskServiceMockRecorder) Copy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockITaskService)(nil).Copy), arg0, arg1)
+}
+
 // Create mocks base method.
-func (m *MockITaskService) Create(arg0 context.Context, arg1 dto.NewTaskInfo) (*entities.Task, error) {
+func (m *MockITaskService) Create(arg0 context.Context, arg1 dto.NewTaskInfo) (*entities.Task, *dto.WipLimitWarning, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Create", arg0, arg1)
 	ret0, _ := ret[0].(*entities.Task)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*dto.WipLimitWarning)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // Create indicates an expected call of Create.
@@ -85,7 +160,7 @@ func (mr *MockITaskServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockITaskService) Delete(arg0 context.Context, arg1 dto.TaskID) error {
+func (m *MockITaskService) Delete(arg0 context.Context, arg1 dto.TaskCascadeInfo) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -98,6 +173,49 @@ func (mr *MockITaskServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITaskService)(nil).Delete), arg0, arg1)
 }
 
+// DeleteWorklog mocks base method.
+func (m *MockITaskService) DeleteWorklog(arg0 context.Context, arg1 dto.WorklogDeleteInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorklog", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorklog indicates an expected call of DeleteWorklog.
+func (mr *MockITaskServiceMockRecorder) DeleteWorklog(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorklog", reflect.TypeOf((*MockITaskService)(nil).DeleteWorklog), arg0, arg1)
+}
+
+// DetachSubtask mocks base method.
+func (m *MockITaskService) DetachSubtask(arg0 context.Context, arg1 dto.TaskID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachSubtask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachSubtask indicates an expected call of DetachSubtask.
+func (mr *MockITaskServiceMockRecorder) DetachSubtask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachSubtask", reflect.TypeOf((*MockITaskService)(nil).DetachSubtask), arg0, arg1)
+}
+
+// GetActivity mocks base method.
+func (m *MockITaskService) GetActivity(arg0 context.Context, arg1 dto.TaskActivityRequest) (*dto.TaskActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivity", arg0, arg1)
+	ret0, _ := ret[0].(*dto.TaskActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivity indicates an expected call of GetActivity.
+func (mr *MockITaskServiceMockRecorder) GetActivity(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivity", reflect.TypeOf((*MockITaskService)(nil).GetActivity), arg0, arg1)
+}
+
 // GetFileList mocks base method.
 func (m *MockITaskService) GetFileList(arg0 context.Context, arg1 dto.TaskID) (*[]dto.AttachedFileInfo, error) {
 	m.ctrl.T.Helper()
@@ -113,20 +231,96 @@ func (mr *MockITaskServiceMockRecorder) GetFileList(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileList", reflect.TypeOf((*MockITaskService)(nil).GetFileList), arg0, arg1)
 }
 
-// Move mocks base method.
-func (m *MockITaskService) Move(arg0 context.Context, arg1 dto.TaskMoveInfo) error {
+// GetTimer mocks base method.
+func (m *MockITaskService) GetTimer(arg0 context.Context) (*dto.WorklogInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Move", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetTimer", arg0)
+	ret0, _ := ret[0].(*dto.WorklogInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimer indicates an expected call of GetTimer.
+func (mr *MockITaskServiceMockRecorder) GetTimer(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimer", reflect.TypeOf((*MockITaskService)(nil).GetTimer), arg0)
+}
+
+// GetWatchers mocks base method.
+func (m *MockITaskService) GetWatchers(arg0 context.Context, arg1 dto.TaskID) (*[]dto.UserPublicInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWatchers", arg0, arg1)
+	ret0, _ := ret[0].(*[]dto.UserPublicInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWatchers indicates an expected call of GetWatchers.
+func (mr *MockITaskServiceMockRecorder) GetWatchers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatchers", reflect.TypeOf((*MockITaskService)(nil).GetWatchers), arg0, arg1)
+}
+
+// GetWorklogs mocks base method.
+func (m *MockITaskService) GetWorklogs(arg0 context.Context, arg1 dto.TaskID) (*dto.TaskTimeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorklogs", arg0, arg1)
+	ret0, _ := ret[0].(*dto.TaskTimeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorklogs indicates an expected call of GetWorklogs.
+func (mr *MockITaskServiceMockRecorder) GetWorklogs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorklogs", reflect.TypeOf((*MockITaskService)(nil).GetWorklogs), arg0, arg1)
+}
+
+// Link mocks base method.
+func (m *MockITaskService) Link(arg0 context.Context, arg1 dto.TaskDependencyInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Link", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// Link indicates an expected call of Link.
+func (mr *MockITaskServiceMockRecorder) Link(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Link", reflect.TypeOf((*MockITaskService)(nil).Link), arg0, arg1)
+}
+
+// Move mocks base method.
+func (m *MockITaskService) Move(arg0 context.Context, arg1 dto.TaskMoveInfo) (*dto.WipLimitWarning, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", arg0, arg1)
+	ret0, _ := ret[0].(*dto.WipLimitWarning)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // Move indicates an expected call of Move.
 func (mr *MockITaskServiceMockRecorder) Move(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockITaskService)(nil).Move), arg0, arg1)
 }
 
+// MoveToBoard mocks base method.
+func (m *MockITaskService) MoveToBoard(arg0 context.Context, arg1 dto.TaskTransferInfo) (*dto.TaskTransferReport, *dto.WipLimitWarning, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveToBoard", arg0, arg1)
+	ret0, _ := ret[0].(*dto.TaskTransferReport)
+	ret1, _ := ret[1].(*dto.WipLimitWarning)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MoveToBoard indicates an expected call of MoveToBoard.
+func (mr *MockITaskServiceMockRecorder) MoveToBoard(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveToBoard", reflect.TypeOf((*MockITaskService)(nil).MoveToBoard), arg0, arg1)
+}
+
 // Read mocks base method.
 func (m *MockITaskService) Read(arg0 context.Context, arg1 dto.TaskID) (*dto.SingleTaskInfo, error) {
 	m.ctrl.T.Helper()
@@ -170,16 +364,162 @@ func (mr *MockITaskServiceMockRecorder) RemoveUser(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUser", reflect.TypeOf((*MockITaskService)(nil).RemoveUser), arg0, arg1)
 }
 
-// Update mocks base method.
-func (m *MockITaskService) Update(arg0 context.Context, arg1 dto.UpdatedTaskInfo) error {
+// SetCustomFieldValue mocks base method.
+func (m *MockITaskService) SetCustomFieldValue(arg0 context.Context, arg1 dto.CustomFieldValueInfo) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret := m.ctrl.Call(m, "SetCustomFieldValue", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCustomFieldValue indicates an expected call of SetCustomFieldValue.
+func (mr *MockITaskServiceMockRecorder) SetCustomFieldValue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCustomFieldValue", reflect.TypeOf((*MockITaskService)(nil).SetCustomFieldValue), arg0, arg1)
+}
+
+// SetRecurrence mocks base method.
+func (m *MockITaskService) SetRecurrence(arg0 context.Context, arg1 dto.TaskRecurrenceRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRecurrence", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRecurrence indicates an expected call of SetRecurrence.
+func (mr *MockITaskServiceMockRecorder) SetRecurrence(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRecurrence", reflect.TypeOf((*MockITaskService)(nil).SetRecurrence), arg0, arg1)
+}
+
+// SetReminders mocks base method.
+func (m *MockITaskService) SetReminders(arg0 context.Context, arg1 dto.TaskRemindersInfo) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReminders", arg0, arg1)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetReminders indicates an expected call of SetReminders.
+func (mr *MockITaskServiceMockRecorder) SetReminders(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReminders", reflect.TypeOf((*MockITaskService)(nil).SetReminders), arg0, arg1)
+}
+
+// StartTimer mocks base method.
+func (m *MockITaskService) StartTimer(arg0 context.Context, arg1 dto.TaskID) (*dto.WorklogInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTimer", arg0, arg1)
+	ret0, _ := ret[0].(*dto.WorklogInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartTimer indicates an expected call of StartTimer.
+func (mr *MockITaskServiceMockRecorder) StartTimer(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTimer", reflect.TypeOf((*MockITaskService)(nil).StartTimer), arg0, arg1)
+}
+
+// StopRecurrence mocks base method.
+func (m *MockITaskService) StopRecurrence(arg0 context.Context, arg1 dto.TaskID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopRecurrence", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// StopRecurrence indicates an expected call of StopRecurrence.
+func (mr *MockITaskServiceMockRecorder) StopRecurrence(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopRecurrence", reflect.TypeOf((*MockITaskService)(nil).StopRecurrence), arg0, arg1)
+}
+
+// StopTimer mocks base method.
+func (m *MockITaskService) StopTimer(arg0 context.Context, arg1 dto.TimerStopInfo) (*dto.WorklogInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopTimer", arg0, arg1)
+	ret0, _ := ret[0].(*dto.WorklogInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopTimer indicates an expected call of StopTimer.
+func (mr *MockITaskServiceMockRecorder) StopTimer(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTimer", reflect.TypeOf((*MockITaskService)(nil).StopTimer), arg0, arg1)
+}
+
+// Unarchive mocks base method.
+func (m *MockITaskService) Unarchive(arg0 context.Context, arg1 dto.TaskID) (*dto.WipLimitWarning, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unarchive", arg0, arg1)
+	ret0, _ := ret[0].(*dto.WipLimitWarning)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unarchive indicates an expected call of Unarchive.
+func (mr *MockITaskServiceMockRecorder) Unarchive(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unarchive", reflect.TypeOf((*MockITaskService)(nil).Unarchive), arg0, arg1)
+}
+
+// Unlink mocks base method.
+func (m *MockITaskService) Unlink(arg0 context.Context, arg1 dto.TaskDependencyInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlink", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unlink indicates an expected call of Unlink.
+func (mr *MockITaskServiceMockRecorder) Unlink(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlink", reflect.TypeOf((*MockITaskService)(nil).Unlink), arg0, arg1)
+}
+
+// Unwatch mocks base method.
+func (m *MockITaskService) Unwatch(arg0 context.Context, arg1 dto.TaskID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unwatch", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unwatch indicates an expected call of Unwatch.
+func (mr *MockITaskServiceMockRecorder) Unwatch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unwatch", reflect.TypeOf((*MockITaskService)(nil).Unwatch), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockITaskService) Update(arg0 context.Context, arg1 dto.UpdatedTaskInfo) (*dto.SingleTaskInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(*dto.SingleTaskInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // Update indicates an expected call of Update.
 func (mr *MockITaskServiceMockRecorder) Update(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockITaskService)(nil).Update), arg0, arg1)
 }
+
+// Watch mocks base method.
+func (m *MockITaskService) Watch(arg0 context.Context, arg1 dto.TaskID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockITaskServiceMockRecorder) Watch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockITaskService)(nil).Watch), arg0, arg1)
+}
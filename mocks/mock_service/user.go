@@ -156,3 +156,32 @@ func (mr *MockIUserServiceMockRecorder) UpdateProfile(arg0, arg1 any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockIUserService)(nil).UpdateProfile), arg0, arg1)
 }
+
+// UpdateReminders mocks base method.
+func (m *MockIUserService) UpdateReminders(arg0 context.Context, arg1 dto.UserRemindersInfo) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReminders", arg0, arg1)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReminders indicates an expected call of UpdateReminders.
+func (mr *MockIUserServiceMockRecorder) UpdateReminders(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReminders", reflect.TypeOf((*MockIUserService)(nil).UpdateReminders), arg0, arg1)
+}
+
+// UpdateTimezone mocks base method.
+func (m *MockIUserService) UpdateTimezone(arg0 context.Context, arg1 dto.UserTimezoneInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimezone", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTimezone indicates an expected call of UpdateTimezone.
+func (mr *MockIUserServiceMockRecorder) UpdateTimezone(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimezone", reflect.TypeOf((*MockIUserService)(nil).UpdateTimezone), arg0, arg1)
+}
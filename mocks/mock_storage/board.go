@@ -99,34 +99,49 @@ func (mr *MockIBoardStorageMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIBoardStorage)(nil).Delete), arg0, arg1)
 }
 
-// GetById mocks base method.
-func (m *MockIBoardStorage) GetById(arg0 context.Context, arg1 dto.BoardID) (*dto.SingleBoardInfo, error) {
+// GetArchivedLists mocks base method.
+func (m *MockIBoardStorage) GetArchivedLists(arg0 context.Context, arg1 dto.BoardID) (*[]dto.SingleListInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetById", arg0, arg1)
-	ret0, _ := ret[0].(*dto.SingleBoardInfo)
+	ret := m.ctrl.Call(m, "GetArchivedLists", arg0, arg1)
+	ret0, _ := ret[0].(*[]dto.SingleListInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetById indicates an expected call of GetById.
-func (mr *MockIBoardStorageMockRecorder) GetById(arg0, arg1 any) *gomock.Call {
+// GetArchivedLists indicates an expected call of GetArchivedLists.
+func (mr *MockIBoardStorageMockRecorder) GetArchivedLists(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIBoardStorage)(nil).GetById), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivedLists", reflect.TypeOf((*MockIBoardStorage)(nil).GetArchivedLists), arg0, arg1)
+}
+
+// GetArchivedTaskIDs mocks base method.
+func (m *MockIBoardStorage) GetArchivedTaskIDs(arg0 context.Context, arg1 dto.BoardID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchivedTaskIDs", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchivedTaskIDs indicates an expected call of GetArchivedTaskIDs.
+func (mr *MockIBoardStorageMockRecorder) GetArchivedTaskIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivedTaskIDs", reflect.TypeOf((*MockIBoardStorage)(nil).GetArchivedTaskIDs), arg0, arg1)
 }
 
-// GetHistory mocks base method.
-func (m *MockIBoardStorage) GetHistory(arg0 context.Context, arg1 dto.BoardID) (*[]dto.BoardHistoryEntry, error) {
+// GetById mocks base method.
+func (m *MockIBoardStorage) GetById(arg0 context.Context, arg1 dto.BoardID) (*dto.SingleBoardInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHistory", arg0, arg1)
-	ret0, _ := ret[0].(*[]dto.BoardHistoryEntry)
+	ret := m.ctrl.Call(m, "GetById", arg0, arg1)
+	ret0, _ := ret[0].(*dto.SingleBoardInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetHistory indicates an expected call of GetHistory.
-func (mr *MockIBoardStorageMockRecorder) GetHistory(arg0, arg1 any) *gomock.Call {
+// GetById indicates an expected call of GetById.
+func (mr *MockIBoardStorageMockRecorder) GetById(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockIBoardStorage)(nil).GetHistory), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIBoardStorage)(nil).GetById), arg0, arg1)
 }
 
 // GetLists mocks base method.
@@ -144,6 +159,21 @@ func (mr *MockIBoardStorageMockRecorder) GetLists(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLists", reflect.TypeOf((*MockIBoardStorage)(nil).GetLists), arg0, arg1)
 }
 
+// GetStats mocks base method.
+func (m *MockIBoardStorage) GetStats(arg0 context.Context, arg1 dto.BoardStatsRequest) (*dto.BoardStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStats", arg0, arg1)
+	ret0, _ := ret[0].(*dto.BoardStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStats indicates an expected call of GetStats.
+func (mr *MockIBoardStorageMockRecorder) GetStats(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStats", reflect.TypeOf((*MockIBoardStorage)(nil).GetStats), arg0, arg1)
+}
+
 // GetTags mocks base method.
 func (m *MockIBoardStorage) GetTags(arg0 context.Context, arg1 dto.BoardID) (*[]dto.TagInfo, error) {
 	m.ctrl.T.Helper()
@@ -174,6 +204,36 @@ func (mr *MockIBoardStorageMockRecorder) GetUsers(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockIBoardStorage)(nil).GetUsers), arg0, arg1)
 }
 
+// ReadBoardWatchers mocks base method.
+func (m *MockIBoardStorage) ReadBoardWatchers(arg0 context.Context, arg1 dto.BoardID) (*[]dto.UserPublicInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadBoardWatchers", arg0, arg1)
+	ret0, _ := ret[0].(*[]dto.UserPublicInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadBoardWatchers indicates an expected call of ReadBoardWatchers.
+func (mr *MockIBoardStorageMockRecorder) ReadBoardWatchers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadBoardWatchers", reflect.TypeOf((*MockIBoardStorage)(nil).ReadBoardWatchers), arg0, arg1)
+}
+
+// ReadTimeReport mocks base method.
+func (m *MockIBoardStorage) ReadTimeReport(arg0 context.Context, arg1 dto.TimeReportRequest) (*[]dto.TimeReportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTimeReport", arg0, arg1)
+	ret0, _ := ret[0].(*[]dto.TimeReportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTimeReport indicates an expected call of ReadTimeReport.
+func (mr *MockIBoardStorageMockRecorder) ReadTimeReport(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTimeReport", reflect.TypeOf((*MockIBoardStorage)(nil).ReadTimeReport), arg0, arg1)
+}
+
 // RemoveUser mocks base method.
 func (m *MockIBoardStorage) RemoveUser(arg0 context.Context, arg1 dto.RemoveBoardUserInfo) error {
 	m.ctrl.T.Helper()
@@ -188,18 +248,18 @@ func (mr *MockIBoardStorageMockRecorder) RemoveUser(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUser", reflect.TypeOf((*MockIBoardStorage)(nil).RemoveUser), arg0, arg1)
 }
 
-// SubmitEdit mocks base method.
-func (m *MockIBoardStorage) SubmitEdit(arg0 context.Context, arg1 dto.NewHistoryEntry) error {
+// UnwatchBoard mocks base method.
+func (m *MockIBoardStorage) UnwatchBoard(arg0 context.Context, arg1 dto.BoardWatcherInfo) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SubmitEdit", arg0, arg1)
+	ret := m.ctrl.Call(m, "UnwatchBoard", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SubmitEdit indicates an expected call of SubmitEdit.
-func (mr *MockIBoardStorageMockRecorder) SubmitEdit(arg0, arg1 any) *gomock.Call {
+// UnwatchBoard indicates an expected call of UnwatchBoard.
+func (mr *MockIBoardStorageMockRecorder) UnwatchBoard(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitEdit", reflect.TypeOf((*MockIBoardStorage)(nil).SubmitEdit), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnwatchBoard", reflect.TypeOf((*MockIBoardStorage)(nil).UnwatchBoard), arg0, arg1)
 }
 
 // UpdateData mocks base method.
@@ -229,3 +289,17 @@ func (mr *MockIBoardStorageMockRecorder) UpdateThumbnailUrl(arg0, arg1 any) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateThumbnailUrl", reflect.TypeOf((*MockIBoardStorage)(nil).UpdateThumbnailUrl), arg0, arg1)
 }
+
+// WatchBoard mocks base method.
+func (m *MockIBoardStorage) WatchBoard(arg0 context.Context, arg1 dto.BoardWatcherInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchBoard", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchBoard indicates an expected call of WatchBoard.
+func (mr *MockIBoardStorageMockRecorder) WatchBoard(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchBoard", reflect.TypeOf((*MockIBoardStorage)(nil).WatchBoard), arg0, arg1)
+}
@@ -98,7 +98,7 @@ func (mr *MockIChecklistItemStorageMockRecorder) Update(arg0, arg1 any) *gomock.
 }
 
 // UpdateOrder mocks base method.
-func (m *MockIChecklistItemStorage) UpdateOrder(arg0 context.Context, arg1 dto.ChecklistItemIDs) error {
+func (m *MockIChecklistItemStorage) UpdateOrder(arg0 context.Context, arg1 dto.ChecklistItemMoveInfo) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateOrder", arg0, arg1)
 	ret0, _ := ret[0].(error)
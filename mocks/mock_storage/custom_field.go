@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: P:\VK Образование\Web\Sem_2\Project\2023_2_yablonka\internal\storage\custom_field.go
+//
+// Generated by this command:
+//
+//	mockgen.exe --source=P:\VK Образование\Web\Sem_2\Project\2023_2_yablonka\internal\storage\custom_field.go --destination=./mocks/mock_storage/custom_field.go --package=mock_storage
+//
+// Package mock_storage is a generated GoMock package.
+package mock_storage
+
+import (
+	context "context"
+	reflect "reflect"
+	dto "server/internal/pkg/dto"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockICustomFieldStorage is a mock of ICustomFieldStorage interface.
+type MockICustomFieldStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockICustomFieldStorageMockRecorder
+}
+
+// MockICustomFieldStorageMockRecorder is the mock recorder for MockICustomFieldStorage.
+type MockICustomFieldStorageMockRecorder struct {
+	mock *MockICustomFieldStorage
+}
+
+// NewMockICustomFieldStorage creates a new mock instance.
+func NewMockICustomFieldStorage(ctrl *gomock.Controller) *MockICustomFieldStorage {
+	mock := &MockICustomFieldStorage{ctrl: ctrl}
+	mock.recorder = &MockICustomFieldStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICustomFieldStorage) EXPECT() *MockICustomFieldStorageMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockICustomFieldStorage) Create(arg0 context.Context, arg1 dto.NewCustomFieldInfo) (*dto.CustomFieldInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*dto.CustomFieldInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockICustomFieldStorageMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockICustomFieldStorage)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockICustomFieldStorage) Delete(arg0 context.Context, arg1 dto.CustomFieldID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockICustomFieldStorageMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockICustomFieldStorage)(nil).Delete), arg0, arg1)
+}
+
+// FilterTasks mocks base method.
+func (m *MockICustomFieldStorage) FilterTasks(arg0 context.Context, arg1 dto.CustomFieldTaskFilter) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterTasks", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterTasks indicates an expected call of FilterTasks.
+func (mr *MockICustomFieldStorageMockRecorder) FilterTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterTasks", reflect.TypeOf((*MockICustomFieldStorage)(nil).FilterTasks), arg0, arg1)
+}
+
+// Read mocks base method.
+func (m *MockICustomFieldStorage) Read(arg0 context.Context, arg1 dto.CustomFieldID) (*dto.CustomFieldInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0, arg1)
+	ret0, _ := ret[0].(*dto.CustomFieldInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockICustomFieldStorageMockRecorder) Read(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockICustomFieldStorage)(nil).Read), arg0, arg1)
+}
+
+// ReadMany mocks base method.
+func (m *MockICustomFieldStorage) ReadMany(arg0 context.Context, arg1 dto.BoardID) (*[]dto.CustomFieldInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadMany", arg0, arg1)
+	ret0, _ := ret[0].(*[]dto.CustomFieldInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadMany indicates an expected call of ReadMany.
+func (mr *MockICustomFieldStorageMockRecorder) ReadMany(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMany", reflect.TypeOf((*MockICustomFieldStorage)(nil).ReadMany), arg0, arg1)
+}
+
+// ReadValues mocks base method.
+func (m *MockICustomFieldStorage) ReadValues(arg0 context.Context, arg1 dto.BoardID) (*[]dto.CustomFieldValueInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadValues", arg0, arg1)
+	ret0, _ := ret[0].(*[]dto.CustomFieldValueInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadValues indicates an expected call of ReadValues.
+func (mr *MockICustomFieldStorageMockRecorder) ReadValues(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadValues", reflect.TypeOf((*MockICustomFieldStorage)(nil).ReadValues), arg0, arg1)
+}
+
+// SetValue mocks base method.
+func (m *MockICustomFieldStorage) SetValue(arg0 context.Context, arg1 dto.CustomFieldValueInfo) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetValue", arg0, arg1)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetValue indicates an expected call of SetValue.
+func (mr *MockICustomFieldStorageMockRecorder) SetValue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetValue", reflect.TypeOf((*MockICustomFieldStorage)(nil).SetValue), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockICustomFieldStorage) Update(arg0 context.Context, arg1 dto.UpdatedCustomFieldInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockICustomFieldStorageMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockICustomFieldStorage)(nil).Update), arg0, arg1)
+}
@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: P:\VK Образование\Web\Sem_2\Project\2023_2_yablonka\internal\storage\history.go
+//
+// Generated by this command:
+//
+//	mockgen.exe --source=P:\VK Образование\Web\Sem_2\Project\2023_2_yablonka\internal\storage\history.go --destination=./mocks/mock_storage/history.go --package=mock_storage
+//
+// Package mock_storage is a generated GoMock package.
+package mock_storage
+
+import (
+	context "context"
+	reflect "reflect"
+	dto "server/internal/pkg/dto"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIHistoryStorage is a mock of IHistoryStorage interface.
+type MockIHistoryStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockIHistoryStorageMockRecorder
+}
+
+// MockIHistoryStorageMockRecorder is the mock recorder for MockIHistoryStorage.
+type MockIHistoryStorageMockRecorder struct {
+	mock *MockIHistoryStorage
+}
+
+// NewMockIHistoryStorage creates a new mock instance.
+func NewMockIHistoryStorage(ctrl *gomock.Controller) *MockIHistoryStorage {
+	mock := &MockIHistoryStorage{ctrl: ctrl}
+	mock.recorder = &MockIHistoryStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIHistoryStorage) EXPECT() *MockIHistoryStorageMockRecorder {
+	return m.recorder
+}
+
+// Read mocks base method.
+func (m *MockIHistoryStorage) Read(arg0 context.Context, arg1 dto.HistoryEntryID) (*dto.BoardHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0, arg1)
+	ret0, _ := ret[0].(*dto.BoardHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockIHistoryStorageMockRecorder) Read(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockIHistoryStorage)(nil).Read), arg0, arg1)
+}
+
+// ReadChanges mocks base method.
+func (m *MockIHistoryStorage) ReadChanges(arg0 context.Context, arg1 dto.BoardChangesRequest) (*[]dto.BoardChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadChanges", arg0, arg1)
+	ret0, _ := ret[0].(*[]dto.BoardChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadChanges indicates an expected call of ReadChanges.
+func (mr *MockIHistoryStorageMockRecorder) ReadChanges(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadChanges", reflect.TypeOf((*MockIHistoryStorage)(nil).ReadChanges), arg0, arg1)
+}
+
+// ReadMany mocks base method.
+func (m *MockIHistoryStorage) ReadMany(arg0 context.Context, arg1 dto.BoardHistoryRequest) (*[]dto.BoardHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadMany", arg0, arg1)
+	ret0, _ := ret[0].(*[]dto.BoardHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadMany indicates an expected call of ReadMany.
+func (mr *MockIHistoryStorageMockRecorder) ReadMany(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMany", reflect.TypeOf((*MockIHistoryStorage)(nil).ReadMany), arg0, arg1)
+}
+
+// ReadTaskActivity mocks base method.
+func (m *MockIHistoryStorage) ReadTaskActivity(arg0 context.Context, arg1 dto.TaskActivityRequest) (*dto.TaskActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTaskActivity", arg0, arg1)
+	ret0, _ := ret[0].(*dto.TaskActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTaskActivity indicates an expected call of ReadTaskActivity.
+func (mr *MockIHistoryStorageMockRecorder) ReadTaskActivity(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTaskActivity", reflect.TypeOf((*MockIHistoryStorage)(nil).ReadTaskActivity), arg0, arg1)
+}
+
+// Record mocks base method.
+func (m *MockIHistoryStorage) Record(arg0 context.Context, arg1 dto.NewHistoryEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockIHistoryStorageMockRecorder) Record(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockIHistoryStorage)(nil).Record), arg0, arg1)
+}
+
+// Revert mocks base method.
+func (m *MockIHistoryStorage) Revert(arg0 context.Context, arg1 dto.HistoryRevertInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revert indicates an expected call of Revert.
+func (mr *MockIHistoryStorageMockRecorder) Revert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revert", reflect.TypeOf((*MockIHistoryStorage)(nil).Revert), arg0, arg1)
+}
+
+// Snapshot mocks base method.
+func (m *MockIHistoryStorage) Snapshot(arg0 context.Context, arg1 dto.HistoryEntityRef) (*dto.EntitySnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshot", arg0, arg1)
+	ret0, _ := ret[0].(*dto.EntitySnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Snapshot indicates an expected call of Snapshot.
+func (mr *MockIHistoryStorageMockRecorder) Snapshot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshot", reflect.TypeOf((*MockIHistoryStorage)(nil).Snapshot), arg0, arg1)
+}
+
+// Transaction mocks base method.
+func (m *MockIHistoryStorage) Transaction(arg0 context.Context, arg1 func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transaction indicates an expected call of Transaction.
+func (mr *MockIHistoryStorageMockRecorder) Transaction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockIHistoryStorage)(nil).Transaction), arg0, arg1)
+}
@@ -40,6 +40,49 @@ func (m *MockIListStorage) EXPECT() *MockIListStorageMockRecorder {
 	return m.recorder
 }
 
+// Archive mocks base method.
+func (m *MockIListStorage) Archive(arg0 context.Context, arg1 dto.ListID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockIListStorageMockRecorder) Archive(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockIListStorage)(nil).Archive), arg0, arg1)
+}
+
+// ArchiveTasks mocks base method.
+func (m *MockIListStorage) ArchiveTasks(arg0 context.Context, arg1 dto.ListID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveTasks", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveTasks indicates an expected call of ArchiveTasks.
+func (mr *MockIListStorageMockRecorder) ArchiveTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveTasks", reflect.TypeOf((*MockIListStorage)(nil).ArchiveTasks), arg0, arg1)
+}
+
+// Copy mocks base method.
+func (m *MockIListStorage) Copy(arg0 context.Context, arg1 dto.ListTransferInfo) (*dto.ListTransferReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", arg0, arg1)
+	ret0, _ := ret[0].(*dto.ListTransferReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Copy indicates an expected call of Copy.
+func (mr *MockIListStorageMockRecorder) Copy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockIListStorage)(nil).Copy), arg0, arg1)
+}
+
 // Create mocks base method.
 func (m *MockIListStorage) Create(arg0 context.Context, arg1 dto.NewListInfo) (*entities.List, error) {
 	m.ctrl.T.Helper()
@@ -84,6 +127,79 @@ func (mr *MockIListStorageMockRecorder) GetTasksWithID(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasksWithID", reflect.TypeOf((*MockIListStorage)(nil).GetTasksWithID), arg0, arg1)
 }
 
+// GetWipInfo mocks base method.
+func (m *MockIListStorage) GetWipInfo(arg0 context.Context, arg1 dto.ListID) (*dto.ListWipInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWipInfo", arg0, arg1)
+	ret0, _ := ret[0].(*dto.ListWipInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWipInfo indicates an expected call of GetWipInfo.
+func (mr *MockIListStorageMockRecorder) GetWipInfo(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWipInfo", reflect.TypeOf((*MockIListStorage)(nil).GetWipInfo), arg0, arg1)
+}
+
+// Move mocks base method.
+func (m *MockIListStorage) Move(arg0 context.Context, arg1 dto.ListTransferInfo) (*dto.ListTransferReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", arg0, arg1)
+	ret0, _ := ret[0].(*dto.ListTransferReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockIListStorageMockRecorder) Move(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockIListStorage)(nil).Move), arg0, arg1)
+}
+
+// Read mocks base method.
+func (m *MockIListStorage) Read(arg0 context.Context, arg1 dto.ListID) (*dto.SingleListInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0, arg1)
+	ret0, _ := ret[0].(*dto.SingleListInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockIListStorageMockRecorder) Read(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockIListStorage)(nil).Read), arg0, arg1)
+}
+
+// SortTasks mocks base method.
+func (m *MockIListStorage) SortTasks(arg0 context.Context, arg1 dto.ListSortInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SortTasks", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SortTasks indicates an expected call of SortTasks.
+func (mr *MockIListStorageMockRecorder) SortTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SortTasks", reflect.TypeOf((*MockIListStorage)(nil).SortTasks), arg0, arg1)
+}
+
+// Unarchive mocks base method.
+func (m *MockIListStorage) Unarchive(arg0 context.Context, arg1 dto.ListID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unarchive", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unarchive indicates an expected call of Unarchive.
+func (mr *MockIListStorageMockRecorder) Unarchive(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unarchive", reflect.TypeOf((*MockIListStorage)(nil).Unarchive), arg0, arg1)
+}
+
 // Update mocks base method.
 func (m *MockIListStorage) Update(arg0 context.Context, arg1 dto.UpdatedListInfo) error {
 	m.ctrl.T.Helper()
@@ -99,7 +215,7 @@ func (mr *MockIListStorageMockRecorder) Update(arg0, arg1 any) *gomock.Call {
 }
 
 // UpdateOrder mocks base method.
-func (m *MockIListStorage) UpdateOrder(arg0 context.Context, arg1 dto.ListIDs) error {
+func (m *MockIListStorage) UpdateOrder(arg0 context.Context, arg1 dto.ListMoveInfo) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateOrder", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: P:\VK Образование\Web\Sem_2\Project\2023_2_yablonka\internal\storage\notification.go
+//
+// Generated by this command:
+//
+//	mockgen.exe --source=P:\VK Образование\Web\Sem_2\Project\2023_2_yablonka\internal\storage\notification.go --destination=./mocks/mock_storage/notification.go --package=mock_storage
+//
+// Package mock_storage is a generated GoMock package.
+package mock_storage
+
+import (
+	context "context"
+	reflect "reflect"
+	dto "server/internal/pkg/dto"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockINotificationStorage is a mock of INotificationStorage interface.
+type MockINotificationStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockINotificationStorageMockRecorder
+}
+
+// MockINotificationStorageMockRecorder is the mock recorder for MockINotificationStorage.
+type MockINotificationStorageMockRecorder struct {
+	mock *MockINotificationStorage
+}
+
+// NewMockINotificationStorage creates a new mock instance.
+func NewMockINotificationStorage(ctrl *gomock.Controller) *MockINotificationStorage {
+	mock := &MockINotificationStorage{ctrl: ctrl}
+	mock.recorder = &MockINotificationStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockINotificationStorage) EXPECT() *MockINotificationStorageMockRecorder {
+	return m.recorder
+}
+
+// CreateDueReminders mocks base method.
+func (m *MockINotificationStorage) CreateDueReminders(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDueReminders", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDueReminders indicates an expected call of CreateDueReminders.
+func (mr *MockINotificationStorageMockRecorder) CreateDueReminders(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDueReminders", reflect.TypeOf((*MockINotificationStorage)(nil).CreateDueReminders), arg0)
+}
+
+// MarkRead mocks base method.
+func (m *MockINotificationStorage) MarkRead(arg0 context.Context, arg1 dto.NotificationsReadInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockINotificationStorageMockRecorder) MarkRead(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockINotificationStorage)(nil).MarkRead), arg0, arg1)
+}
+
+// ReadMany mocks base method.
+func (m *MockINotificationStorage) ReadMany(arg0 context.Context, arg1 dto.NotificationsRequest) (*[]dto.NotificationInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadMany", arg0, arg1)
+	ret0, _ := ret[0].(*[]dto.NotificationInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadMany indicates an expected call of ReadMany.
+func (mr *MockINotificationStorageMockRecorder) ReadMany(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMany", reflect.TypeOf((*MockINotificationStorage)(nil).ReadMany), arg0, arg1)
+}
@@ -40,6 +40,20 @@ func (m *MockITaskStorage) EXPECT() *MockITaskStorageMockRecorder {
 	return m.recorder
 }
 
+// AddDependency mocks base method.
+func (m *MockITaskStorage) AddDependency(arg0 context.Context, arg1 dto.TaskDependencyInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDependency", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDependency indicates an expected call of AddDependency.
+func (mr *MockITaskStorageMockRecorder) AddDependency(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDependency", reflect.TypeOf((*MockITaskStorage)(nil).AddDependency), arg0, arg1)
+}
+
 // AddUser mocks base method.
 func (m *MockITaskStorage) AddUser(arg0 context.Context, arg1 dto.AddTaskUserInfo) error {
 	m.ctrl.T.Helper()
@@ -54,6 +68,35 @@ func (mr *MockITaskStorageMockRecorder) AddUser(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUser", reflect.TypeOf((*MockITaskStorage)(nil).AddUser), arg0, arg1)
 }
 
+// AddWorklog mocks base method.
+func (m *MockITaskStorage) AddWorklog(arg0 context.Context, arg1 dto.NewWorklogInfo) (*dto.WorklogInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorklog", arg0, arg1)
+	ret0, _ := ret[0].(*dto.WorklogInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorklog indicates an expected call of AddWorklog.
+func (mr *MockITaskStorageMockRecorder) AddWorklog(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorklog", reflect.TypeOf((*MockITaskStorage)(nil).AddWorklog), arg0, arg1)
+}
+
+// Archive mocks base method.
+func (m *MockITaskStorage) Archive(arg0 context.Context, arg1 dto.TaskID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockITaskStorageMockRecorder) Archive(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockITaskStorage)(nil).Archive), arg0, arg1)
+}
+
 // AttachFile mocks base method.
 func (m *MockITaskStorage) AttachFile(arg0 context.Context, arg1 dto.AttachedFileInfo) error {
 	m.ctrl.T.Helper()
@@ -68,6 +111,34 @@ func (mr *MockITaskStorageMockRecorder) AttachFile(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachFile", reflect.TypeOf((*MockITaskStorage)(nil).AttachFile), arg0, arg1)
 }
 
+// AttachSubtask mocks base method.
+func (m *MockITaskStorage) AttachSubtask(arg0 context.Context, arg1 dto.SubtaskInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachSubtask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachSubtask indicates an expected call of AttachSubtask.
+func (mr *MockITaskStorageMockRecorder) AttachSubtask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachSubtask", reflect.TypeOf((*MockITaskStorage)(nil).AttachSubtask), arg0, arg1)
+}
+
+// Bulk mocks base method.
+func (m *MockITaskStorage) Bulk(arg0 context.Context, arg1 dto.TaskBulkInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bulk", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Bulk indicates an expected call of Bulk.
+func (mr *MockITaskStorageMockRecorder) Bulk(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bulk", reflect.TypeOf((*MockITaskStorage)(nil).Bulk), arg0, arg1)
+}
+
 // CheckAccess mocks base method.
 func (m *MockITaskStorage) CheckAccess(arg0 context.Context, arg1 dto.CheckTaskAccessInfo) (bool, error) {
 	m.ctrl.T.Helper()
@@ -83,6 +154,21 @@ func (mr *MockITaskStorageMockRecorder) CheckAccess(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccess", reflect.TypeOf((*MockITaskStorage)(nil).CheckAccess), arg0, arg1)
 }
 
+// Copy mocks base method.
+func (m *MockITaskStorage) Copy(arg0 context.Context, arg1 dto.TaskCopyInfo) (*dto.TaskTransferReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", arg0, arg1)
+	ret0, _ := ret[0].(*dto.TaskTransferReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Copy indicates an expected call of Copy.
+func (mr *MockITaskStorageMockRecorder) Copy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockITaskStorage)(nil).Copy), arg0, arg1)
+}
+
 // Create mocks base method.
 func (m *MockITaskStorage) Create(arg0 context.Context, arg1 dto.NewTaskInfo) (*entities.Task, error) {
 	m.ctrl.T.Helper()
@@ -112,6 +198,64 @@ func (mr *MockITaskStorageMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITaskStorage)(nil).Delete), arg0, arg1)
 }
 
+// DeleteWorklog mocks base method.
+func (m *MockITaskStorage) DeleteWorklog(arg0 context.Context, arg1 dto.WorklogDeleteInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorklog", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorklog indicates an expected call of DeleteWorklog.
+func (mr *MockITaskStorageMockRecorder) DeleteWorklog(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorklog", reflect.TypeOf((*MockITaskStorage)(nil).DeleteWorklog), arg0, arg1)
+}
+
+// DetachSubtask mocks base method.
+func (m *MockITaskStorage) DetachSubtask(arg0 context.Context, arg1 dto.TaskID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachSubtask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachSubtask indicates an expected call of DetachSubtask.
+func (mr *MockITaskStorageMockRecorder) DetachSubtask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachSubtask", reflect.TypeOf((*MockITaskStorage)(nil).DetachSubtask), arg0, arg1)
+}
+
+// FileInUse mocks base method.
+func (m *MockITaskStorage) FileInUse(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FileInUse", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FileInUse indicates an expected call of FileInUse.
+func (mr *MockITaskStorageMockRecorder) FileInUse(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileInUse", reflect.TypeOf((*MockITaskStorage)(nil).FileInUse), arg0, arg1)
+}
+
+// GenerateRecurrence mocks base method.
+func (m *MockITaskStorage) GenerateRecurrence(arg0 context.Context, arg1 dto.RecurrenceInstanceInfo) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateRecurrence", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateRecurrence indicates an expected call of GenerateRecurrence.
+func (mr *MockITaskStorageMockRecorder) GenerateRecurrence(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateRecurrence", reflect.TypeOf((*MockITaskStorage)(nil).GenerateRecurrence), arg0, arg1)
+}
+
 // GetFileList mocks base method.
 func (m *MockITaskStorage) GetFileList(arg0 context.Context, arg1 dto.TaskID) (*[]dto.AttachedFileInfo, error) {
 	m.ctrl.T.Helper()
@@ -141,6 +285,21 @@ func (mr *MockITaskStorageMockRecorder) Move(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockITaskStorage)(nil).Move), arg0, arg1)
 }
 
+// MoveToBoard mocks base method.
+func (m *MockITaskStorage) MoveToBoard(arg0 context.Context, arg1 dto.TaskTransferInfo) (*dto.TaskTransferReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveToBoard", arg0, arg1)
+	ret0, _ := ret[0].(*dto.TaskTransferReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveToBoard indicates an expected call of MoveToBoard.
+func (mr *MockITaskStorageMockRecorder) MoveToBoard(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveToBoard", reflect.TypeOf((*MockITaskStorage)(nil).MoveToBoard), arg0, arg1)
+}
+
 // Read mocks base method.
 func (m *MockITaskStorage) Read(arg0 context.Context, arg1 dto.TaskID) (*dto.SingleTaskInfo, error) {
 	m.ctrl.T.Helper()
@@ -156,8 +315,23 @@ func (mr *MockITaskStorageMockRecorder) Read(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockITaskStorage)(nil).Read), arg0, arg1)
 }
 
+// ReadDueRecurrences mocks base method.
+func (m *MockITaskStorage) ReadDueRecurrences(arg0 context.Context) (*[]dto.DueRecurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadDueRecurrences", arg0)
+	ret0, _ := ret[0].(*[]dto.DueRecurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadDueRecurrences indicates an expected call of ReadDueRecurrences.
+func (mr *MockITaskStorageMockRecorder) ReadDueRecurrences(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDueRecurrences", reflect.TypeOf((*MockITaskStorage)(nil).ReadDueRecurrences), arg0)
+}
+
 // ReadMany mocks base method.
-func (m *MockITaskStorage) ReadMany(arg0 context.Context, arg1 dto.TaskIDs) (*[]dto.SingleTaskInfo, error) {
+func (m *MockITaskStorage) ReadMany(arg0 context.Context, arg1 dto.TaskFilter) (*[]dto.SingleTaskInfo, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ReadMany", arg0, arg1)
 	ret0, _ := ret[0].(*[]dto.SingleTaskInfo)
@@ -171,6 +345,80 @@ func (mr *MockITaskStorageMockRecorder) ReadMany(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMany", reflect.TypeOf((*MockITaskStorage)(nil).ReadMany), arg0, arg1)
 }
 
+// ReadSubtasks mocks base method.
+func (m *MockITaskStorage) ReadSubtasks(arg0 context.Context, arg1 dto.TaskID) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadSubtasks", arg0, arg1)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadSubtasks indicates an expected call of ReadSubtasks.
+func (mr *MockITaskStorageMockRecorder) ReadSubtasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSubtasks", reflect.TypeOf((*MockITaskStorage)(nil).ReadSubtasks), arg0, arg1)
+}
+
+// ReadTaskWatchers mocks base method.
+func (m *MockITaskStorage) ReadTaskWatchers(arg0 context.Context, arg1 dto.TaskID) (*[]dto.UserPublicInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTaskWatchers", arg0, arg1)
+	ret0, _ := ret[0].(*[]dto.UserPublicInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTaskWatchers indicates an expected call of ReadTaskWatchers.
+func (mr *MockITaskStorageMockRecorder) ReadTaskWatchers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTaskWatchers", reflect.TypeOf((*MockITaskStorage)(nil).ReadTaskWatchers), arg0, arg1)
+}
+
+// ReadTimer mocks base method.
+func (m *MockITaskStorage) ReadTimer(arg0 context.Context, arg1 dto.UserID) (*dto.WorklogInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTimer", arg0, arg1)
+	ret0, _ := ret[0].(*dto.WorklogInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTimer indicates an expected call of ReadTimer.
+func (mr *MockITaskStorageMockRecorder) ReadTimer(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTimer", reflect.TypeOf((*MockITaskStorage)(nil).ReadTimer), arg0, arg1)
+}
+
+// ReadWorklogs mocks base method.
+func (m *MockITaskStorage) ReadWorklogs(arg0 context.Context, arg1 dto.TaskID) (*[]dto.WorklogInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorklogs", arg0, arg1)
+	ret0, _ := ret[0].(*[]dto.WorklogInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorklogs indicates an expected call of ReadWorklogs.
+func (mr *MockITaskStorageMockRecorder) ReadWorklogs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorklogs", reflect.TypeOf((*MockITaskStorage)(nil).ReadWorklogs), arg0, arg1)
+}
+
+// RemoveDependency mocks base method.
+func (m *MockITaskStorage) RemoveDependency(arg0 context.Context, arg1 dto.TaskDependencyInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDependency", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDependency indicates an expected call of RemoveDependency.
+func (mr *MockITaskStorageMockRecorder) RemoveDependency(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDependency", reflect.TypeOf((*MockITaskStorage)(nil).RemoveDependency), arg0, arg1)
+}
+
 // RemoveFile mocks base method.
 func (m *MockITaskStorage) RemoveFile(arg0 context.Context, arg1 dto.RemoveFileInfo) error {
 	m.ctrl.T.Helper()
@@ -199,6 +447,109 @@ func (mr *MockITaskStorageMockRecorder) RemoveUser(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUser", reflect.TypeOf((*MockITaskStorage)(nil).RemoveUser), arg0, arg1)
 }
 
+// SetRecurrence mocks base method.
+func (m *MockITaskStorage) SetRecurrence(arg0 context.Context, arg1 dto.TaskRecurrenceInfo) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRecurrence", arg0, arg1)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRecurrence indicates an expected call of SetRecurrence.
+func (mr *MockITaskStorageMockRecorder) SetRecurrence(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRecurrence", reflect.TypeOf((*MockITaskStorage)(nil).SetRecurrence), arg0, arg1)
+}
+
+// SetReminders mocks base method.
+func (m *MockITaskStorage) SetReminders(arg0 context.Context, arg1 dto.TaskRemindersInfo) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReminders", arg0, arg1)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetReminders indicates an expected call of SetReminders.
+func (mr *MockITaskStorageMockRecorder) SetReminders(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReminders", reflect.TypeOf((*MockITaskStorage)(nil).SetReminders), arg0, arg1)
+}
+
+// StartTimer mocks base method.
+func (m *MockITaskStorage) StartTimer(arg0 context.Context, arg1 dto.TimerInfo) (*dto.WorklogInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTimer", arg0, arg1)
+	ret0, _ := ret[0].(*dto.WorklogInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartTimer indicates an expected call of StartTimer.
+func (mr *MockITaskStorageMockRecorder) StartTimer(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTimer", reflect.TypeOf((*MockITaskStorage)(nil).StartTimer), arg0, arg1)
+}
+
+// StopRecurrence mocks base method.
+func (m *MockITaskStorage) StopRecurrence(arg0 context.Context, arg1 dto.TaskID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopRecurrence", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopRecurrence indicates an expected call of StopRecurrence.
+func (mr *MockITaskStorageMockRecorder) StopRecurrence(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopRecurrence", reflect.TypeOf((*MockITaskStorage)(nil).StopRecurrence), arg0, arg1)
+}
+
+// StopTimer mocks base method.
+func (m *MockITaskStorage) StopTimer(arg0 context.Context, arg1 dto.TimerStopInfo) (*dto.WorklogInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopTimer", arg0, arg1)
+	ret0, _ := ret[0].(*dto.WorklogInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopTimer indicates an expected call of StopTimer.
+func (mr *MockITaskStorageMockRecorder) StopTimer(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTimer", reflect.TypeOf((*MockITaskStorage)(nil).StopTimer), arg0, arg1)
+}
+
+// Unarchive mocks base method.
+func (m *MockITaskStorage) Unarchive(arg0 context.Context, arg1 dto.TaskID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unarchive", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unarchive indicates an expected call of Unarchive.
+func (mr *MockITaskStorageMockRecorder) Unarchive(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unarchive", reflect.TypeOf((*MockITaskStorage)(nil).Unarchive), arg0, arg1)
+}
+
+// UnwatchTask mocks base method.
+func (m *MockITaskStorage) UnwatchTask(arg0 context.Context, arg1 dto.TaskWatcherInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnwatchTask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnwatchTask indicates an expected call of UnwatchTask.
+func (mr *MockITaskStorageMockRecorder) UnwatchTask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnwatchTask", reflect.TypeOf((*MockITaskStorage)(nil).UnwatchTask), arg0, arg1)
+}
+
 // Update mocks base method.
 func (m *MockITaskStorage) Update(arg0 context.Context, arg1 dto.UpdatedTaskInfo) error {
 	m.ctrl.T.Helper()
@@ -212,3 +563,17 @@ func (mr *MockITaskStorageMockRecorder) Update(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockITaskStorage)(nil).Update), arg0, arg1)
 }
+
+// WatchTask mocks base method.
+func (m *MockITaskStorage) WatchTask(arg0 context.Context, arg1 dto.TaskWatcherInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchTask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchTask indicates an expected call of WatchTask.
+func (mr *MockITaskStorageMockRecorder) WatchTask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchTask", reflect.TypeOf((*MockITaskStorage)(nil).WatchTask), arg0, arg1)
+}
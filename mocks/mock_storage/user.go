@@ -84,6 +84,21 @@ func (mr *MockIUserStorageMockRecorder) GetLoginInfoWithID(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginInfoWithID", reflect.TypeOf((*MockIUserStorage)(nil).GetLoginInfoWithID), arg0, arg1)
 }
 
+// GetTimezone mocks base method.
+func (m *MockIUserStorage) GetTimezone(arg0 context.Context, arg1 dto.UserID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimezone", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimezone indicates an expected call of GetTimezone.
+func (mr *MockIUserStorageMockRecorder) GetTimezone(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimezone", reflect.TypeOf((*MockIUserStorage)(nil).GetTimezone), arg0, arg1)
+}
+
 // GetWithID mocks base method.
 func (m *MockIUserStorage) GetWithID(arg0 context.Context, arg1 dto.UserID) (*entities.User, error) {
 	m.ctrl.T.Helper()
@@ -155,3 +170,31 @@ func (mr *MockIUserStorageMockRecorder) UpdateProfile(arg0, arg1 any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockIUserStorage)(nil).UpdateProfile), arg0, arg1)
 }
+
+// UpdateReminders mocks base method.
+func (m *MockIUserStorage) UpdateReminders(arg0 context.Context, arg1 dto.UserRemindersInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReminders", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReminders indicates an expected call of UpdateReminders.
+func (mr *MockIUserStorageMockRecorder) UpdateReminders(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReminders", reflect.TypeOf((*MockIUserStorage)(nil).UpdateReminders), arg0, arg1)
+}
+
+// UpdateTimezone mocks base method.
+func (m *MockIUserStorage) UpdateTimezone(arg0 context.Context, arg1 dto.UserTimezoneInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimezone", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTimezone indicates an expected call of UpdateTimezone.
+func (mr *MockIUserStorageMockRecorder) UpdateTimezone(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimezone", reflect.TypeOf((*MockIUserStorage)(nil).UpdateTimezone), arg0, arg1)
+}